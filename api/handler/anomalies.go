@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/anomalies/domain"
+	"transaction-tracker/internal/anomalies/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnomalyHandler handles HTTP requests for the anomalies domain.
+type AnomalyHandler struct {
+	anomaliesUsecase usecase.AnomaliesUsecase
+}
+
+// NewAnomalyHandler creates a new instance of AnomalyHandler.
+func NewAnomalyHandler(uca usecase.AnomaliesUsecase) *AnomalyHandler {
+	return &AnomalyHandler{
+		anomaliesUsecase: uca,
+	}
+}
+
+// anomalyErrorResponse answers the errors caused by the request. It reports whether the error
+// was handled.
+func anomalyErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrAnomalyNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "anomaly not found"})
+	case errors.Is(err, domain.ErrInvalidStatus):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetAnomalies handles the GET /anomalies request. It returns the open anomalies, newest
+// first, unless the status query parameter asks for the dismissed ones or all of them.
+func (h *AnomalyHandler) GetAnomalies(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	status, err := domain.ParseStatus(c.Query("status"))
+	if err != nil {
+		anomalyErrorResponse(c, err)
+		return
+	}
+
+	anomalies, err := h.anomaliesUsecase.GetAnomalies(c.Request.Context(), account.ID, status)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_anomalies_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToAnomalyResponses(anomalies),
+	})
+}
+
+// DismissAnomaly handles the POST /anomalies/:id/dismiss request, marking the anomaly as
+// reviewed.
+func (h *AnomalyHandler) DismissAnomaly(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	anomaly, err := h.anomaliesUsecase.DismissAnomaly(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if anomalyErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "dismiss_anomaly_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToAnomalyResponse(anomaly),
+	})
+}
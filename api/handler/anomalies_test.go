@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/anomalies/domain"
+	"transaction-tracker/internal/anomalies/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAnomalies(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		want   domain.Status
+		status int
+	}{
+		{name: "open by default", query: "", want: domain.Open, status: http.StatusOK},
+		{name: "dismissed", query: "?status=dismissed", want: domain.Dismissed, status: http.StatusOK},
+		{name: "all", query: "?status=all", want: "", status: http.StatusOK},
+		{name: "invalid status", query: "?status=closed", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			anomalies := []*domain.Anomaly{{ID: "ANM1", MovementID: "MID1", Kind: domain.DuplicateCharge, Reason: "same amount", Amount: 50000, Status: domain.Open}}
+
+			mockUsecase := new(usecase.MockAnomaliesUsecase)
+			if tt.status == http.StatusOK {
+				mockUsecase.On("GetAnomalies", mock.Anything, "accountID", tt.want).Return(anomalies, nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodGet, "/anomalies"+tt.query, nil)
+
+			NewAnomalyHandler(mockUsecase).GetAnomalies(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var response []*models.AnomalyResponse
+			c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			c.Len(response, 1)
+			c.Equal("duplicate_charge", response[0].Kind)
+		})
+	}
+}
+
+func TestDismissAnomaly(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "not found", err: usecase.ErrAnomalyNotFound, status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockAnomaliesUsecase)
+			if tt.err != nil {
+				mockUsecase.On("DismissAnomaly", mock.Anything, "ANM1", "accountID").Return(nil, tt.err)
+			} else {
+				mockUsecase.On("DismissAnomaly", mock.Anything, "ANM1", "accountID").Return(&domain.Anomaly{ID: "ANM1", Status: domain.Dismissed}, nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPost, "/anomalies/ANM1/dismiss", nil)
+			ginContext.Params = gin.Params{{Key: "id", Value: "ANM1"}}
+
+			NewAnomalyHandler(mockUsecase).DismissAnomaly(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
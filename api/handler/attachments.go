@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/attachments/domain"
+	"transaction-tracker/internal/attachments/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// attachmentFormField is the multipart field the receipt is uploaded in.
+const attachmentFormField = "file"
+
+// AttachmentHandler handles HTTP requests for the receipts attached to movements.
+type AttachmentHandler struct {
+	attachmentsUsecase usecase.AttachmentsUsecase
+}
+
+// NewAttachmentHandler creates a new instance of AttachmentHandler.
+func NewAttachmentHandler(uca usecase.AttachmentsUsecase) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentsUsecase: uca,
+	}
+}
+
+// attachmentErrorResponse answers the errors caused by the request. It reports whether the
+// error was handled.
+func attachmentErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrMovementNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+	case errors.Is(err, usecase.ErrAttachmentNotFound), errors.Is(err, usecase.ErrFileNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "attachment not found"})
+	case errors.Is(err, domain.ErrInvalidAttachment):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// AddAttachment handles the POST /movements/:id/attachments request. The receipt, a JPEG, PNG
+// or PDF of up to 10 MB, is uploaded as multipart in the file field.
+func (h *AttachmentHandler) AddAttachment(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	fileHeader, err := c.FormFile(attachmentFormField)
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "file is required"})
+		return
+	}
+
+	if fileHeader.Size > domain.MaxSize {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "file is larger than 10 MB"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "file can't be read"})
+		return
+	}
+
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, domain.MaxSize+1))
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "file can't be read"})
+		return
+	}
+
+	attachment, err := h.attachmentsUsecase.AddAttachment(c.Request.Context(), account.ID, c.Param("id"), fileHeader.Filename, data)
+	if err != nil {
+		if attachmentErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "add_attachment_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToAttachmentResponse(attachment),
+	})
+}
+
+// GetAttachments handles the GET /movements/:id/attachments request.
+func (h *AttachmentHandler) GetAttachments(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	attachments, err := h.attachmentsUsecase.GetAttachments(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if attachmentErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_attachments_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToAttachmentResponses(attachments),
+	})
+}
+
+// GetAttachment handles the GET /movements/:id/attachments/:attachmentId request. It answers
+// with the file of the receipt.
+func (h *AttachmentHandler) GetAttachment(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	attachment, data, err := h.attachmentsUsecase.GetAttachmentFile(c.Request.Context(), c.Param("attachmentId"), c.Param("id"), account.ID)
+	if err != nil {
+		if attachmentErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_attachment_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": attachment.FileName}))
+	c.Data(http.StatusOK, attachment.ContentType, data)
+}
+
+// DeleteAttachment handles the DELETE /movements/:id/attachments/:attachmentId request.
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.attachmentsUsecase.DeleteAttachment(c.Request.Context(), c.Param("attachmentId"), c.Param("id"), account.ID)
+	if err != nil {
+		if attachmentErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_attachment_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "attachment deleted successfully",
+	})
+}
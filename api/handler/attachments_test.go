@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/attachments/domain"
+	"transaction-tracker/internal/attachments/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var receiptData = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newAttachment() *domain.Attachment {
+	return &domain.Attachment{ID: "ATT1", MovementID: "MID1", AccountID: "accountID", FileName: "dinner.png", ContentType: "image/png", Size: int64(len(receiptData))}
+}
+
+func newReceiptUpload(t *testing.T) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("file", "dinner.png")
+	require.NoError(t, err)
+
+	_, err = part.Write(receiptData)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return body, writer.FormDataContentType()
+}
+
+func TestAddAttachment(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "movement not found", err: usecase.ErrMovementNotFound, status: http.StatusNotFound},
+		{name: "invalid file", err: domain.ErrInvalidAttachment, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockAttachmentsUsecase)
+			if tt.err != nil {
+				mockUsecase.On("AddAttachment", mock.Anything, "accountID", "MID1", "dinner.png", receiptData).Return(nil, tt.err)
+			} else {
+				mockUsecase.On("AddAttachment", mock.Anything, "accountID", "MID1", "dinner.png", receiptData).Return(newAttachment(), nil)
+			}
+
+			body, contentType := newReceiptUpload(t)
+			ginContext, w := setupTestContext(http.MethodPost, "/movements/MID1/attachments", body)
+			ginContext.Request.Header.Set("Content-Type", contentType)
+			ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+			NewAttachmentHandler(mockUsecase).AddAttachment(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAddAttachment_MissingFile(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockAttachmentsUsecase)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/movements/MID1/attachments", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	NewAttachmentHandler(mockUsecase).AddAttachment(ginContext)
+
+	c.Equal(http.StatusBadRequest, w.Code)
+	mockUsecase.AssertNotCalled(t, "AddAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetAttachments(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockAttachmentsUsecase)
+	mockUsecase.On("GetAttachments", mock.Anything, "MID1", "accountID").Return([]*domain.Attachment{newAttachment()}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/movements/MID1/attachments", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	NewAttachmentHandler(mockUsecase).GetAttachments(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.AttachmentResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("dinner.png", response[0].FileName)
+}
+
+func TestGetAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockAttachmentsUsecase)
+		mockUsecase.On("GetAttachmentFile", mock.Anything, "ATT1", "MID1", "accountID").Return(newAttachment(), receiptData, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/movements/MID1/attachments/ATT1", nil)
+		ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}, {Key: "attachmentId", Value: "ATT1"}}
+
+		NewAttachmentHandler(mockUsecase).GetAttachment(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		c.Equal("image/png", w.Header().Get("Content-Type"))
+		c.Contains(w.Header().Get("Content-Disposition"), "dinner.png")
+		c.Equal(receiptData, w.Body.Bytes())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockAttachmentsUsecase)
+		mockUsecase.On("GetAttachmentFile", mock.Anything, "ATT1", "MID1", "accountID").Return(nil, nil, usecase.ErrAttachmentNotFound)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/movements/MID1/attachments/ATT1", nil)
+		ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}, {Key: "attachmentId", Value: "ATT1"}}
+
+		NewAttachmentHandler(mockUsecase).GetAttachment(ginContext)
+
+		c.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockAttachmentsUsecase)
+	mockUsecase.On("DeleteAttachment", mock.Anything, "ATT1", "MID1", "accountID").Return(nil)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/movements/MID1/attachments/ATT1", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}, {Key: "attachmentId", Value: "ATT1"}}
+
+	NewAttachmentHandler(mockUsecase).DeleteAttachment(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"errors"
+	"time"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/budgets/domain"
+	"transaction-tracker/internal/budgets/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BudgetHandler handles HTTP requests for the budgets domain.
+type BudgetHandler struct {
+	budgetsUsecase usecase.BudgetsUsecase
+}
+
+// NewBudgetHandler creates a new instance of BudgetHandler.
+func NewBudgetHandler(ucb usecase.BudgetsUsecase) *BudgetHandler {
+	return &BudgetHandler{
+		budgetsUsecase: ucb,
+	}
+}
+
+// budgetErrorResponse answers the errors caused by the request. It reports whether the
+// error was handled.
+func budgetErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrBudgetNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "budget not found"})
+	case errors.Is(err, domain.ErrInvalidBudget), errors.Is(err, movementsDomain.ErrInvalidMovementCategory):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrBudgetExists):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetBudgets handles the GET /budgets request. It returns the budgets of the month given as
+// 2006-01 in the month query parameter, the current one by default, compared with what was
+// spent in their categories.
+func (h *BudgetHandler) GetBudgets(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	month := domain.MonthOf(time.Now())
+	if raw := c.Query("month"); raw != "" {
+		month, err = domain.ParseMonth(raw)
+		if err != nil {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+	}
+
+	progress, err := h.budgetsUsecase.GetProgress(c.Request.Context(), account.ID, month)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_budgets_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToBudgetProgressResponses(progress),
+	})
+}
+
+// GetBudgetByID handles the GET /budgets/:id request.
+func (h *BudgetHandler) GetBudgetByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	budget, err := h.budgetsUsecase.GetBudget(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if budgetErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_budget_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToBudgetResponse(budget),
+	})
+}
+
+// CreateBudget handles the POST /budgets request.
+func (h *BudgetHandler) CreateBudget(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateBudgetRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	budget, err := domain.NewBudget(account.ID, req.Category, req.Month, req.Amount, req.Rollover)
+	if err == nil {
+		err = h.budgetsUsecase.CreateBudget(c.Request.Context(), budget)
+	}
+
+	if err != nil {
+		if budgetErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_budget_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToBudgetResponse(budget),
+	})
+}
+
+// UpdateBudget handles the PUT /budgets/:id request. The category and month cannot be changed.
+func (h *BudgetHandler) UpdateBudget(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateBudgetRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	budget, err := h.budgetsUsecase.GetBudget(c.Request.Context(), c.Param("id"), account.ID)
+	if err == nil {
+		budget.Amount = req.Amount
+		budget.Rollover = req.Rollover
+		err = h.budgetsUsecase.UpdateBudget(c.Request.Context(), budget)
+	}
+
+	if err != nil {
+		if budgetErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_budget_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToBudgetResponse(budget),
+	})
+}
+
+// DeleteBudget handles the DELETE /budgets/:id request.
+func (h *BudgetHandler) DeleteBudget(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.budgetsUsecase.DeleteBudget(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if budgetErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_budget_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "budget deleted successfully",
+	})
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/budgets/domain"
+	"transaction-tracker/internal/budgets/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBudgets(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		september := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		budget := &domain.Budget{ID: "BUD1", Category: movementsDomain.Food, Month: september, Amount: 1000}
+
+		mockUsecase := new(usecase.MockBudgetsUsecase)
+		mockUsecase.On("GetProgress", mock.Anything, "accountID", september).Return([]*domain.Progress{
+			domain.NewProgress(budget, 850, 0),
+		}, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/budgets?month=2025-09", nil)
+
+		NewBudgetHandler(mockUsecase).GetBudgets(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response []*models.BudgetProgressResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Len(response, 1)
+		c.Equal("2025-09", response[0].Month)
+		c.Equal(150.0, response[0].Remaining)
+		c.Equal([]int{80}, response[0].Thresholds)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockBudgetsUsecase)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/budgets?month=september", nil)
+
+		NewBudgetHandler(mockUsecase).GetBudgets(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "GetProgress", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCreateBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockBudgetsUsecase)
+		mockUsecase.On("CreateBudget", mock.Anything, mock.MatchedBy(func(budget *domain.Budget) bool {
+			return budget.AccountID == "accountID" && budget.Category == movementsDomain.Food && budget.Rollover
+		})).Return(nil)
+
+		body := strings.NewReader(`{"category":"food","month":"2025-09","amount":800000,"rollover":true}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/budgets", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewBudgetHandler(mockUsecase).CreateBudget(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockBudgetsUsecase)
+		mockUsecase.On("CreateBudget", mock.Anything, mock.Anything).Return(domain.ErrBudgetExists)
+
+		body := strings.NewReader(`{"category":"food","month":"2025-09","amount":800000}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/budgets", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewBudgetHandler(mockUsecase).CreateBudget(ginContext)
+
+		c.Equal(http.StatusConflict, w.Code)
+	})
+}
+
+func TestDeleteBudget_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockBudgetsUsecase)
+	mockUsecase.On("DeleteBudget", mock.Anything, "BUD1", "accountID").Return(usecase.ErrBudgetNotFound)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/budgets/BUD1", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "BUD1"}}
+
+	NewBudgetHandler(mockUsecase).DeleteBudget(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
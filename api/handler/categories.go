@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/categories/domain"
+	"transaction-tracker/internal/categories/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CategoryHandler handles HTTP requests for the categories domain.
+type CategoryHandler struct {
+	categoriesUsecase usecase.CategoriesUsecase
+}
+
+// NewCategoryHandler creates a new instance of CategoryHandler.
+func NewCategoryHandler(ucc usecase.CategoriesUsecase) *CategoryHandler {
+	return &CategoryHandler{
+		categoriesUsecase: ucc,
+	}
+}
+
+// categoryErrorResponse answers the errors caused by the request. It reports whether
+// the error was handled.
+func categoryErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrCategoryNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "category not found"})
+	case errors.Is(err, domain.ErrInvalidCategory), errors.Is(err, domain.ErrSystemCategory):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrCategoryExists), errors.Is(err, domain.ErrCategoryInUse):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetCategories handles the GET /categories request. Categories are returned as a tree.
+func (h *CategoryHandler) GetCategories(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	categories, err := h.categoriesUsecase.GetCategories(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_categories_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToCategoryTreeResponse(categories),
+	})
+}
+
+// GetCategoryByID handles the GET /categories/:id request.
+func (h *CategoryHandler) GetCategoryByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	category, err := h.categoriesUsecase.GetCategory(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if categoryErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_category_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToCategoryResponse(category),
+	})
+}
+
+// CreateCategory handles the POST /categories request.
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateCategoryRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	category, err := domain.NewCategory(account.ID, req.Slug, req.Name, req.ParentID, req.Icon, req.Color)
+	if err == nil {
+		err = h.categoriesUsecase.CreateCategory(c.Request.Context(), category)
+	}
+
+	if err != nil {
+		if categoryErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_category_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToCategoryResponse(category),
+	})
+}
+
+// UpdateCategory handles the PUT /categories/:id request. The slug cannot be changed.
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateCategoryRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	category, err := h.categoriesUsecase.GetCategory(c.Request.Context(), c.Param("id"), account.ID)
+	if err == nil {
+		models.ApplyUpdateCategoryRequest(category, req)
+		err = h.categoriesUsecase.UpdateCategory(c.Request.Context(), category)
+	}
+
+	if err != nil {
+		if categoryErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_category_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToCategoryResponse(category),
+	})
+}
+
+// DeleteCategory handles the DELETE /categories/:id request. Categories with subcategories
+// or movements cannot be deleted.
+func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.categoriesUsecase.DeleteCategory(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if categoryErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_category_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "category deleted successfully",
+	})
+}
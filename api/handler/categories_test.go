@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/categories/domain"
+	"transaction-tracker/internal/categories/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCategories_Tree(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockCategoriesUsecase)
+	mockUsecase.On("GetCategories", mock.Anything, "accountID").Return([]*domain.Category{
+		{ID: "CAT1", Slug: movementsDomain.Food, Name: "Food"},
+		{ID: "CAT2", Slug: "groceries", Name: "Groceries", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: "pets", Name: "Pets"},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/categories", nil)
+
+	NewCategoryHandler(mockUsecase).GetCategories(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.CategoryResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 2)
+	c.Len(response[0].Children, 1)
+	c.Equal("groceries", response[0].Children[0].Slug)
+}
+
+func TestCreateCategory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockCategoriesUsecase)
+		mockUsecase.On("CreateCategory", mock.Anything, mock.MatchedBy(func(category *domain.Category) bool {
+			return category.AccountID == "accountID" && category.Slug == "pet_care" && category.Color == "#795548"
+		})).Return(nil)
+
+		body := strings.NewReader(`{"name":"Pet Care","icon":"paw","color":"#795548"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/categories", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewCategoryHandler(mockUsecase).CreateCategory(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid color", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockCategoriesUsecase)
+
+		body := strings.NewReader(`{"name":"Pets","color":"brown"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/categories", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewCategoryHandler(mockUsecase).CreateCategory(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+	})
+
+	t.Run("duplicated slug", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockCategoriesUsecase)
+		mockUsecase.On("CreateCategory", mock.Anything, mock.Anything).Return(domain.ErrCategoryExists)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/categories", strings.NewReader(`{"name":"Food"}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewCategoryHandler(mockUsecase).CreateCategory(ginContext)
+
+		c.Equal(http.StatusConflict, w.Code)
+	})
+}
+
+func TestDeleteCategory_InUse(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockCategoriesUsecase)
+	mockUsecase.On("DeleteCategory", mock.Anything, "CAT1", "accountID").Return(domain.ErrCategoryInUse)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/categories/CAT1", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "CAT1"}}
+
+	NewCategoryHandler(mockUsecase).DeleteCategory(ginContext)
+
+	c.Equal(http.StatusConflict, w.Code)
+}
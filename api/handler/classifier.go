@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/movements/classifier"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClassifierHandler handles HTTP requests about the category classifier.
+type ClassifierHandler struct {
+	metrics *classifier.Metrics
+}
+
+// NewClassifierHandler creates a new instance of ClassifierHandler reporting metrics.
+func NewClassifierHandler(metrics *classifier.Metrics) *ClassifierHandler {
+	return &ClassifierHandler{
+		metrics: metrics,
+	}
+}
+
+// GetMetrics handles the GET /classifier/metrics request. Metrics belong to this process
+// and are reset when it restarts.
+func (h *ClassifierHandler) GetMetrics(c *gin.Context) {
+	_, _, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToClassifierMetricsResponse(h.metrics.Snapshot()),
+	})
+}
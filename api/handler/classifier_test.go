@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/movements/classifier"
+	"transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetClassifierMetrics(t *testing.T) {
+	c := require.New(t)
+
+	model := new(classifier.MockClassifier)
+	model.On("Classify", mock.Anything, mock.Anything).Return(&classifier.Classification{Category: domain.Food}, nil).Once()
+
+	metrics := classifier.NewMetrics()
+	cls := classifier.NewResilientClassifier(model, classifier.ResilienceOptions{Metrics: metrics})
+
+	for range 2 {
+		_, err := cls.Classify(context.Background(), &domain.Movement{Description: "almuerzo"})
+		c.NoError(err)
+	}
+
+	ginContext, w := setupTestContext(http.MethodGet, "/classifier/metrics", nil)
+
+	NewClassifierHandler(metrics).GetMetrics(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response *models.ClassifierMetricsResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Equal(int64(2), response.Requests)
+	c.Equal(int64(1), response.CacheHits)
+	c.Equal(0.5, response.HitRate)
+	c.Equal("closed", response.CircuitState)
+}
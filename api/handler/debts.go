@@ -0,0 +1,363 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/debts/domain"
+	"transaction-tracker/internal/debts/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DebtHandler handles HTTP requests for the debts domain.
+type DebtHandler struct {
+	debtsUsecase usecase.DebtsUsecase
+}
+
+// NewDebtHandler creates a new instance of DebtHandler.
+func NewDebtHandler(ucd usecase.DebtsUsecase) *DebtHandler {
+	return &DebtHandler{
+		debtsUsecase: ucd,
+	}
+}
+
+// debtErrorResponse answers the errors caused by the request. It reports whether the error
+// was handled.
+func debtErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrContactNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "contact not found"})
+	case errors.Is(err, usecase.ErrDebtNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "debt not found"})
+	case errors.Is(err, usecase.ErrSettlementNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "settlement not found"})
+	case errors.Is(err, usecase.ErrMovementNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+	case errors.Is(err, usecase.ErrContactExists), errors.Is(err, usecase.ErrMovementOwed), errors.Is(err, usecase.ErrMovementSettled):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidContact), errors.Is(err, domain.ErrInvalidDebt), errors.Is(err, domain.ErrInvalidSettlement):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetContacts handles the GET /contacts request.
+func (h *DebtHandler) GetContacts(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	contacts, err := h.debtsUsecase.GetContacts(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_contacts_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToContactResponses(contacts),
+	})
+}
+
+// CreateContact handles the POST /contacts request.
+func (h *DebtHandler) CreateContact(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateContactRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	contact, err := h.debtsUsecase.CreateContact(c.Request.Context(), account.ID, req.Name)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_contact_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToContactResponse(contact),
+	})
+}
+
+// DeleteContact handles the DELETE /contacts/:id request. What the contact owes and paid back
+// is removed with it.
+func (h *DebtHandler) DeleteContact(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.debtsUsecase.DeleteContact(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_contact_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "contact deleted successfully",
+	})
+}
+
+// GetDebts handles the GET /debts request, optionally filtered by contact_id.
+func (h *DebtHandler) GetDebts(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	debts, err := h.debtsUsecase.GetDebts(c.Request.Context(), account.ID, c.Query("contact_id"))
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_debts_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToDebtResponses(debts),
+	})
+}
+
+// CreateDebt handles the POST /debts request. It marks an expense, or one of its splits, as
+// owed by a contact; without an amount all of it is owed.
+func (h *DebtHandler) CreateDebt(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateDebtRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	debt, err := h.debtsUsecase.CreateDebt(c.Request.Context(), account.ID, req.ContactID, req.MovementID, req.SplitID, req.Amount, req.Note)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_debt_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToDebtResponse(debt),
+	})
+}
+
+// DeleteDebt handles the DELETE /debts/:id request.
+func (h *DebtHandler) DeleteDebt(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.debtsUsecase.DeleteDebt(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_debt_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "debt deleted successfully",
+	})
+}
+
+// GetDebtSummary handles the GET /debts/summary request. It returns who owes the account and
+// how much, the contacts that owe the most first.
+func (h *DebtHandler) GetDebtSummary(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	summary, err := h.debtsUsecase.GetSummary(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_debt_summary_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToDebtSummaryResponse(summary),
+	})
+}
+
+// GetSettlements handles the GET /settlements request, optionally filtered by contact_id.
+func (h *DebtHandler) GetSettlements(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	settlements, err := h.debtsUsecase.GetSettlements(c.Request.Context(), account.ID, c.Query("contact_id"))
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_settlements_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToSettlementResponses(settlements),
+	})
+}
+
+// CreateSettlement handles the POST /settlements request. It records an income as paid back
+// by a contact; without an amount all of it is.
+func (h *DebtHandler) CreateSettlement(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateSettlementRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	settlement, err := h.debtsUsecase.CreateSettlement(c.Request.Context(), account.ID, req.ContactID, req.MovementID, req.Amount)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_settlement_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToSettlementResponse(settlement),
+	})
+}
+
+// DetectSettlements handles the POST /settlements/detect request. It matches the recent
+// incomes whose description names a contact that owes at least their amount, as a Nequi
+// transfer from them, and returns the settlements created.
+func (h *DebtHandler) DetectSettlements(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	settlements, err := h.debtsUsecase.DetectSettlements(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "detect_settlements_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToSettlementResponses(settlements),
+	})
+}
+
+// DeleteSettlement handles the DELETE /settlements/:id request. Its contact owes that amount
+// again.
+func (h *DebtHandler) DeleteSettlement(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.debtsUsecase.DeleteSettlement(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if debtErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_settlement_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "settlement deleted successfully",
+	})
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/debts/domain"
+	"transaction-tracker/internal/debts/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateContact(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "duplicated", err: usecase.ErrContactExists, status: http.StatusConflict},
+		{name: "invalid name", err: domain.ErrInvalidContact, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockDebtsUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CreateContact", mock.Anything, "accountID", "Juan").Return(nil, tt.err)
+			} else {
+				mockUsecase.On("CreateContact", mock.Anything, "accountID", "Juan").Return(&domain.Contact{ID: "CNT1", Name: "Juan"}, nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPost, "/contacts", strings.NewReader(`{"name":"Juan"}`))
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+
+			NewDebtHandler(mockUsecase).CreateContact(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateDebt(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "movement not found", err: usecase.ErrMovementNotFound, status: http.StatusNotFound},
+		{name: "contact not found", err: usecase.ErrContactNotFound, status: http.StatusNotFound},
+		{name: "already owed", err: usecase.ErrMovementOwed, status: http.StatusConflict},
+		{name: "income", err: domain.ErrInvalidDebt, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockDebtsUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CreateDebt", mock.Anything, "accountID", "CNT1", "MID1", "SPL1", 40000.0, "dinner").Return(nil, tt.err)
+			} else {
+				debt := &domain.Debt{ID: "DBT1", ContactID: "CNT1", MovementID: "MID1", SplitID: "SPL1", Amount: 40000, Note: "dinner"}
+				mockUsecase.On("CreateDebt", mock.Anything, "accountID", "CNT1", "MID1", "SPL1", 40000.0, "dinner").Return(debt, nil)
+			}
+
+			body := strings.NewReader(`{"contact_id":"CNT1","movement_id":"MID1","split_id":"SPL1","amount":40000,"note":"dinner"}`)
+
+			ginContext, w := setupTestContext(http.MethodPost, "/debts", body)
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+
+			NewDebtHandler(mockUsecase).CreateDebt(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetDebtSummary(t *testing.T) {
+	c := require.New(t)
+
+	summary := domain.NewSummary([]*domain.Balance{
+		{Contact: &domain.Contact{ID: "CNT1", Name: "Juan"}, Owed: 120000, Paid: 50000},
+	})
+
+	mockUsecase := new(usecase.MockDebtsUsecase)
+	mockUsecase.On("GetSummary", mock.Anything, "accountID").Return(summary, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/debts/summary", nil)
+
+	NewDebtHandler(mockUsecase).GetDebtSummary(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response models.DebtSummaryResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Equal(70000.0, response.Outstanding)
+	c.Len(response.Contacts, 1)
+	c.Equal("Juan", response.Contacts[0].Name)
+	c.Equal(70000.0, response.Contacts[0].Outstanding)
+}
+
+func TestCreateSettlement(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "already settles", err: usecase.ErrMovementSettled, status: http.StatusConflict},
+		{name: "expense", err: domain.ErrInvalidSettlement, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockDebtsUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CreateSettlement", mock.Anything, "accountID", "CNT1", "MID2", 0.0).Return(nil, tt.err)
+			} else {
+				settlement := &domain.Settlement{ID: "STL1", ContactID: "CNT1", MovementID: "MID2", Amount: 50000, Source: domain.ManualSource}
+				mockUsecase.On("CreateSettlement", mock.Anything, "accountID", "CNT1", "MID2", 0.0).Return(settlement, nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPost, "/settlements", strings.NewReader(`{"contact_id":"CNT1","movement_id":"MID2"}`))
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+
+			NewDebtHandler(mockUsecase).CreateSettlement(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDetectSettlements(t *testing.T) {
+	c := require.New(t)
+
+	settlements := []*domain.Settlement{{ID: "STL1", ContactID: "CNT1", MovementID: "MID2", Amount: 50000, Source: domain.DetectedSource}}
+
+	mockUsecase := new(usecase.MockDebtsUsecase)
+	mockUsecase.On("DetectSettlements", mock.Anything, "accountID").Return(settlements, nil)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/settlements/detect", nil)
+
+	NewDebtHandler(mockUsecase).DetectSettlements(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.SettlementResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("detected", response[0].Source)
+}
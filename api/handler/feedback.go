@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/feedback/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	datasetContentType = "application/x-ndjson"
+	datasetFilename    = "dataset.jsonl"
+)
+
+// FeedbackHandler handles HTTP requests for the classification feedback domain.
+type FeedbackHandler struct {
+	feedbackUsecase usecase.FeedbackUsecase
+}
+
+// NewFeedbackHandler creates a new instance of FeedbackHandler.
+func NewFeedbackHandler(ucf usecase.FeedbackUsecase) *FeedbackHandler {
+	return &FeedbackHandler{
+		feedbackUsecase: ucf,
+	}
+}
+
+// ExportDataset handles the GET /feedback/dataset request. It downloads the category
+// corrections of the account in the JSONL format read by text-classifier/train.py.
+func (h *FeedbackHandler) ExportDataset(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var dataset bytes.Buffer
+
+	written, err := h.feedbackUsecase.ExportDataset(c.Request.Context(), account.ID, &dataset)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "export_dataset_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename="+datasetFilename)
+	c.Header("X-Dataset-Examples", strconv.Itoa(written))
+	c.Data(http.StatusOK, datasetContentType, dataset.Bytes())
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/internal/feedback/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportDataset(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockFeedbackUsecase)
+	mockUsecase.On("ExportDataset", mock.Anything, "accountID", mock.Anything).
+		Run(func(args mock.Arguments) {
+			_, _ = io.WriteString(args.Get(2).(io.Writer), `{"description":"RAPPI","label":"food"}`+"\n")
+		}).
+		Return(1, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/feedback/dataset", nil)
+
+	NewFeedbackHandler(mockUsecase).ExportDataset(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	c.Equal(datasetContentType, w.Header().Get("Content-Type"))
+	c.Equal("attachment; filename="+datasetFilename, w.Header().Get("Content-Disposition"))
+	c.Equal("1", w.Header().Get("X-Dataset-Examples"))
+	c.Equal(`{"description":"RAPPI","label":"food"}`+"\n", w.Body.String())
+}
@@ -0,0 +1,279 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/financialaccounts/domain"
+	"transaction-tracker/internal/financialaccounts/usecase"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FinancialAccountHandler handles HTTP requests for the financial accounts domain.
+type FinancialAccountHandler struct {
+	financialAccountsUsecase usecase.FinancialAccountsUsecase
+}
+
+// NewFinancialAccountHandler creates a new instance of FinancialAccountHandler.
+func NewFinancialAccountHandler(ucf usecase.FinancialAccountsUsecase) *FinancialAccountHandler {
+	return &FinancialAccountHandler{
+		financialAccountsUsecase: ucf,
+	}
+}
+
+// financialAccountErrorResponse answers the errors caused by the request. It reports whether
+// the error was handled.
+func financialAccountErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrFinancialAccountNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "financial account not found"})
+	case errors.Is(err, domain.ErrInvalidFinancialAccount), errors.Is(err, domain.ErrInvalidAnchor):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetFinancialAccounts handles the GET /financial-accounts request. It returns the financial
+// accounts of the account with their current balances.
+func (h *FinancialAccountHandler) GetFinancialAccounts(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	summaries, err := h.financialAccountsUsecase.GetSummaries(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_financial_accounts_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToFinancialAccountResponses(summaries),
+	})
+}
+
+// GetFinancialAccountByID handles the GET /financial-accounts/:id request.
+func (h *FinancialAccountHandler) GetFinancialAccountByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	financialAccount, err := h.financialAccountsUsecase.GetFinancialAccount(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if financialAccountErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_financial_account_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToFinancialAccountResponse(financialAccount),
+	})
+}
+
+// CreateFinancialAccount handles the POST /financial-accounts request. Movements of the
+// institution that are not linked to a financial account yet are linked to the new one.
+func (h *FinancialAccountHandler) CreateFinancialAccount(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateFinancialAccountRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	financialAccount, err := domain.NewFinancialAccount(account.ID, req.InstitutionID, req.Name, req.Kind)
+	if err == nil {
+		err = h.financialAccountsUsecase.CreateFinancialAccount(c.Request.Context(), financialAccount)
+	}
+
+	if err != nil {
+		if financialAccountErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_financial_account_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToFinancialAccountResponse(financialAccount),
+	})
+}
+
+// UpdateFinancialAccount handles the PUT /financial-accounts/:id request.
+func (h *FinancialAccountHandler) UpdateFinancialAccount(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateFinancialAccountRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	financialAccount := &domain.FinancialAccount{
+		ID:        c.Param("id"),
+		AccountID: account.ID,
+		Name:      strings.TrimSpace(req.Name),
+		Kind:      domain.Kind(req.Kind),
+	}
+
+	err = h.financialAccountsUsecase.UpdateFinancialAccount(c.Request.Context(), financialAccount)
+	if err != nil {
+		if financialAccountErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_financial_account_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToFinancialAccountResponse(financialAccount),
+	})
+}
+
+// DeleteFinancialAccount handles the DELETE /financial-accounts/:id request. Its movements are
+// kept and unlinked.
+func (h *FinancialAccountHandler) DeleteFinancialAccount(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.financialAccountsUsecase.DeleteFinancialAccount(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if financialAccountErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_financial_account_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "financial account deleted successfully",
+	})
+}
+
+// CreateAnchor handles the POST /financial-accounts/:id/anchors request. It records the
+// balance the financial account had at the end of the date, written as 2006-01-02, which
+// balances before and after it are computed from.
+func (h *FinancialAccountHandler) CreateAnchor(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateAnchorRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	anchor, err := h.financialAccountsUsecase.SaveAnchor(c.Request.Context(), c.Param("id"), account.ID, req.Date, *req.Balance)
+	if err != nil {
+		if financialAccountErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_anchor_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToAnchorResponse(anchor),
+	})
+}
+
+// GetNetWorth handles the GET /net-worth request. It returns what the financial accounts of
+// the account added up to at the end of every period between the from and to query
+// parameters, written as 2006-01-02, split by the granularity one (month by default).
+func (h *FinancialAccountHandler) GetNetWorth(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	rng, err := reportsDomain.NewRange(c.Query("from"), c.Query("to"), c.Query("granularity"), time.Now())
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	points, err := h.financialAccountsUsecase.GetNetWorth(c.Request.Context(), account.ID, rng)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_net_worth_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToNetWorthResponses(points),
+	})
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/financialaccounts/domain"
+	"transaction-tracker/internal/financialaccounts/usecase"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFinancialAccounts(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+	mockUsecase.On("GetSummaries", mock.Anything, "accountID").Return([]*domain.Summary{
+		{FinancialAccount: &domain.FinancialAccount{ID: "FAC1", InstitutionID: "davivienda", Name: "Savings", Kind: domain.Savings}, Balance: 800},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/financial-accounts", nil)
+
+	NewFinancialAccountHandler(mockUsecase).GetFinancialAccounts(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.FinancialAccountResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("savings", response[0].Kind)
+	c.Equal(800.0, *response[0].Balance)
+}
+
+func TestCreateFinancialAccount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+		mockUsecase.On("CreateFinancialAccount", mock.Anything, mock.MatchedBy(func(account *domain.FinancialAccount) bool {
+			return account.AccountID == "accountID" && account.InstitutionID == "davivienda" && account.Kind == domain.CreditCard
+		})).Return(nil)
+
+		body := strings.NewReader(`{"institution_id":"davivienda","name":"Visa","kind":"credit_card"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/financial-accounts", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewFinancialAccountHandler(mockUsecase).CreateFinancialAccount(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+
+		body := strings.NewReader(`{"institution_id":"davivienda","name":"Visa","kind":"crypto"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/financial-accounts", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewFinancialAccountHandler(mockUsecase).CreateFinancialAccount(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateFinancialAccount", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateFinancialAccount_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+	mockUsecase.On("UpdateFinancialAccount", mock.Anything, mock.Anything).Return(usecase.ErrFinancialAccountNotFound)
+
+	body := strings.NewReader(`{"name":"Savings","kind":"savings"}`)
+
+	ginContext, w := setupTestContext(http.MethodPut, "/financial-accounts/FAC1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/json")
+	ginContext.Params = gin.Params{{Key: "id", Value: "FAC1"}}
+
+	NewFinancialAccountHandler(mockUsecase).UpdateFinancialAccount(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestCreateAnchor(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		anchor := &domain.Anchor{FinancialAccountID: "FAC1", Date: time.Date(2025, 9, 16, 0, 0, 0, 0, time.UTC), Balance: 0, Source: domain.ManualSource}
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+		mockUsecase.On("SaveAnchor", mock.Anything, "FAC1", "accountID", "2025-09-15", 0.0).Return(anchor, nil)
+
+		body := strings.NewReader(`{"date":"2025-09-15","balance":0}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/financial-accounts/FAC1/anchors", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "FAC1"}}
+
+		NewFinancialAccountHandler(mockUsecase).CreateAnchor(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+
+		var response models.AnchorResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("2025-09-15", response.Date)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+		mockUsecase.On("SaveAnchor", mock.Anything, "FAC1", "accountID", "15/09/2025", 500.0).Return(nil, domain.ErrInvalidAnchor)
+
+		body := strings.NewReader(`{"date":"15/09/2025","balance":500}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/financial-accounts/FAC1/anchors", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "FAC1"}}
+
+		NewFinancialAccountHandler(mockUsecase).CreateAnchor(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetNetWorth(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		points := []*domain.NetWorthPoint{
+			{
+				Period:      time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+				Date:        time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+				NetWorth:    300,
+				Assets:      700,
+				Liabilities: -400,
+				Balances:    []*domain.Balance{{FinancialAccountID: "FAC1", Balance: 700}, {FinancialAccountID: "FAC2", Balance: -400}},
+			},
+		}
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+		mockUsecase.On("GetNetWorth", mock.Anything, "accountID", mock.MatchedBy(func(r *reportsDomain.Range) bool {
+			return r.Granularity == reportsDomain.Month && r.From.Equal(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+		})).Return(points, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/net-worth?from=2025-08-01&to=2025-08-31", nil)
+
+		NewFinancialAccountHandler(mockUsecase).GetNetWorth(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response []*models.NetWorthPointResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Len(response, 1)
+		c.Equal("2025-08-31", response[0].Date)
+		c.Equal(300.0, response[0].NetWorth)
+		c.Len(response[0].Balances, 2)
+	})
+
+	t.Run("invalid granularity", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockFinancialAccountsUsecase)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/net-worth?granularity=decade", nil)
+
+		NewFinancialAccountHandler(mockUsecase).GetNetWorth(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/goals/domain"
+	"transaction-tracker/internal/goals/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GoalHandler handles HTTP requests for the savings goals domain.
+type GoalHandler struct {
+	goalsUsecase usecase.GoalsUsecase
+}
+
+// NewGoalHandler creates a new instance of GoalHandler.
+func NewGoalHandler(ucg usecase.GoalsUsecase) *GoalHandler {
+	return &GoalHandler{
+		goalsUsecase: ucg,
+	}
+}
+
+// goalErrorResponse answers the errors caused by the request. It reports whether the error
+// was handled.
+func goalErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrGoalNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "goal not found"})
+	case errors.Is(err, usecase.ErrMovementNotFound), errors.Is(err, usecase.ErrAllocationNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidGoal), errors.Is(err, movementsDomain.ErrInvalidMovementCategory):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrCategoryTaken):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetGoals handles the GET /goals request. It returns the goals of the account with their
+// progress.
+func (h *GoalHandler) GetGoals(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	progress, err := h.goalsUsecase.GetProgressList(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_goals_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToGoalProgressResponses(progress),
+	})
+}
+
+// GetGoalByID handles the GET /goals/:id request.
+func (h *GoalHandler) GetGoalByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	goal, err := h.goalsUsecase.GetGoal(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_goal_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToGoalResponse(goal),
+	})
+}
+
+// GetGoalProgress handles the GET /goals/:id/progress request. It returns what was saved
+// towards the goal and when it is projected to be completed at the average monthly pace.
+func (h *GoalHandler) GetGoalProgress(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	progress, err := h.goalsUsecase.GetProgress(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_goal_progress_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToGoalProgressResponse(progress),
+	})
+}
+
+// CreateGoal handles the POST /goals request.
+func (h *GoalHandler) CreateGoal(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateGoalRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	goal, err := domain.NewGoal(account.ID, req.Name, req.TargetAmount, req.TargetDate, req.Category)
+	if err == nil {
+		err = h.goalsUsecase.CreateGoal(c.Request.Context(), goal)
+	}
+
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_goal_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToGoalResponse(goal),
+	})
+}
+
+// UpdateGoal handles the PUT /goals/:id request.
+func (h *GoalHandler) UpdateGoal(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateGoalRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	targetDate, err := domain.ParseDate(req.TargetDate)
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	goal, err := h.goalsUsecase.GetGoal(c.Request.Context(), c.Param("id"), account.ID)
+	if err == nil {
+		goal.Name = strings.TrimSpace(req.Name)
+		goal.TargetAmount = req.TargetAmount
+		goal.TargetDate = targetDate
+		goal.Category = movementsDomain.MovementCategory(req.Category)
+		err = h.goalsUsecase.UpdateGoal(c.Request.Context(), goal)
+	}
+
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_goal_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToGoalResponse(goal),
+	})
+}
+
+// DeleteGoal handles the DELETE /goals/:id request. The allocated movements are kept.
+func (h *GoalHandler) DeleteGoal(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.goalsUsecase.DeleteGoal(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_goal_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "goal deleted successfully",
+	})
+}
+
+// AllocateMovement handles the POST /goals/:id/movements request. The movement counts towards
+// the goal whatever its category, and stops counting towards any other goal.
+func (h *GoalHandler) AllocateMovement(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.AllocateMovementRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	err = h.goalsUsecase.AllocateMovement(c.Request.Context(), c.Param("id"), account.ID, req.MovementID)
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "allocate_movement_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "movement allocated successfully",
+	})
+}
+
+// DeallocateMovement handles the DELETE /goals/:id/movements/:movement_id request.
+func (h *GoalHandler) DeallocateMovement(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.goalsUsecase.DeallocateMovement(c.Request.Context(), c.Param("id"), account.ID, c.Param("movement_id"))
+	if err != nil {
+		if goalErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "deallocate_movement_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "movement deallocated successfully",
+	})
+}
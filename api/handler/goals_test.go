@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/goals/domain"
+	"transaction-tracker/internal/goals/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGoalProgress(t *testing.T) {
+	c := require.New(t)
+
+	goal := &domain.Goal{
+		ID:           "GOA1",
+		Name:         "Trip",
+		TargetAmount: 1000,
+		TargetDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		CreatedAt:    time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC),
+	}
+	progress := domain.NewProgress(goal, []*domain.Contribution{
+		{Month: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Amount: 200},
+		{Month: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), Amount: 200},
+	}, time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC))
+
+	mockUsecase := new(usecase.MockGoalsUsecase)
+	mockUsecase.On("GetProgress", mock.Anything, "GOA1", "accountID").Return(progress, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/goals/GOA1/progress", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "GOA1"}}
+
+	NewGoalHandler(mockUsecase).GetGoalProgress(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response models.GoalProgressResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Equal("GOA1", response.ID)
+	c.Equal("2025-12-31", response.TargetDate)
+	c.Equal(400.0, response.Saved)
+	c.Equal(200.0, response.AverageMonthly)
+	c.NotEmpty(response.ProjectedAt)
+}
+
+func TestCreateGoal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockGoalsUsecase)
+		mockUsecase.On("CreateGoal", mock.Anything, mock.MatchedBy(func(goal *domain.Goal) bool {
+			return goal.AccountID == "accountID" && goal.Name == "Trip" && goal.Category == movementsDomain.Savings
+		})).Return(nil)
+
+		body := strings.NewReader(`{"name":"Trip","target_amount":3000000,"target_date":"2025-12-31","category":"savings"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/goals", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewGoalHandler(mockUsecase).CreateGoal(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid target date", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockGoalsUsecase)
+
+		body := strings.NewReader(`{"name":"Trip","target_amount":3000000,"target_date":"december"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/goals", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewGoalHandler(mockUsecase).CreateGoal(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateGoal", mock.Anything, mock.Anything)
+	})
+
+	t.Run("category taken", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockGoalsUsecase)
+		mockUsecase.On("CreateGoal", mock.Anything, mock.Anything).Return(domain.ErrCategoryTaken)
+
+		body := strings.NewReader(`{"name":"Trip","target_amount":3000000,"target_date":"2025-12-31","category":"savings"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/goals", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewGoalHandler(mockUsecase).CreateGoal(ginContext)
+
+		c.Equal(http.StatusConflict, w.Code)
+	})
+}
+
+func TestAllocateMovement(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockGoalsUsecase)
+		mockUsecase.On("AllocateMovement", mock.Anything, "GOA1", "accountID", "MID1").Return(nil)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/goals/GOA1/movements", strings.NewReader(`{"movement_id":"MID1"}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "GOA1"}}
+
+		NewGoalHandler(mockUsecase).AllocateMovement(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("movement not found", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockGoalsUsecase)
+		mockUsecase.On("AllocateMovement", mock.Anything, "GOA1", "accountID", "MID1").Return(usecase.ErrMovementNotFound)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/goals/GOA1/movements", strings.NewReader(`{"movement_id":"MID1"}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "GOA1"}}
+
+		NewGoalHandler(mockUsecase).AllocateMovement(ginContext)
+
+		c.Equal(http.StatusNotFound, w.Code)
+	})
+}
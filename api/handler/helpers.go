@@ -12,17 +12,16 @@ import (
 
 var (
 	errMissingAccountID = errors.New("accountID not found in context")
+	errMissingLogger    = errors.New("logger not found in context")
 )
 
 // getContextDependencies extrae el logger y el accountID del contexto de Gin.
 func getContextDependencies(c *gin.Context) (*loggerModels.Logger, *domain.Account, error) {
-	l, ok := c.Get("logger")
-	if !ok {
-		return nil, nil, errors.New("logger not found in context")
+	log, err := getLogger(c)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	log := l.(*loggerModels.Logger)
-
 	acc, ok := c.Get("account")
 	if !ok {
 		log.Error(loggerModels.LogProperties{
@@ -38,3 +37,13 @@ func getContextDependencies(c *gin.Context) (*loggerModels.Logger, *domain.Accou
 
 	return log, account, nil
 }
+
+// getLogger extrae el logger del contexto de Gin, para rutas que no requieren autenticación.
+func getLogger(c *gin.Context) (*loggerModels.Logger, error) {
+	l, ok := c.Get("logger")
+	if !ok {
+		return nil, errMissingLogger
+	}
+
+	return l.(*loggerModels.Logger), nil
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/merchants/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MerchantHandler handles HTTP requests for the merchants domain.
+type MerchantHandler struct {
+	merchantsUsecase usecase.MerchantsUsecase
+}
+
+// NewMerchantHandler creates a new instance of MerchantHandler.
+func NewMerchantHandler(ucm usecase.MerchantsUsecase) *MerchantHandler {
+	return &MerchantHandler{
+		merchantsUsecase: ucm,
+	}
+}
+
+// GetMerchants handles the GET /merchants request. Merchants are returned with the totals
+// of their movements, the ones with the highest spend first.
+func (h *MerchantHandler) GetMerchants(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	summaries, err := h.merchantsUsecase.GetMerchants(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_merchants_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToMerchantResponses(summaries),
+	})
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/merchants/domain"
+	"transaction-tracker/internal/merchants/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMerchants(t *testing.T) {
+	c := require.New(t)
+
+	lastMovementAt := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	mockUsecase := new(usecase.MockMerchantsUsecase)
+	mockUsecase.On("GetMerchants", mock.Anything, "accountID").Return([]*domain.Summary{
+		{
+			Merchant:       &domain.Merchant{ID: "MER1", Name: "Rappi", Aliases: []string{"rappi"}},
+			Movements:      3,
+			Spent:          120000,
+			LastMovementAt: &lastMovementAt,
+		},
+		{
+			Merchant: &domain.Merchant{ID: "MER2", Name: "Cine Colombia", Aliases: []string{"cine colombia"}},
+		},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/merchants", nil)
+
+	NewMerchantHandler(mockUsecase).GetMerchants(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.MerchantResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 2)
+	c.Equal("Rappi", response[0].Name)
+	c.Equal(120000.0, response[0].Spent)
+	c.Equal(3, response[0].Movements)
+	c.Nil(response[1].LastMovementAt)
+}
@@ -31,7 +31,43 @@ func splitInstitutionIDs(raw string) []string {
 	return strings.Split(raw, ",")
 }
 
-// GetMovements handles the GET /movements request.
+// bindErrorMessage describes the first validation error of a request body.
+func bindErrorMessage(err error) string {
+	errorMessage := "invalid form data"
+
+	if validationErrors, ok := err.(validator.ValidationErrors); ok {
+		firstError := validationErrors[0]
+		field := firstError.Field() // Nombre del campo en la struct (ej: "Amount")
+		tag := firstError.Tag()     // Etiqueta de validación (ej: "required")
+
+		if tag == "required" {
+			errorMessage = field + " is required"
+		} else {
+			errorMessage = field + " has invalid value"
+		}
+	}
+
+	return errorMessage
+}
+
+// ledgerErrorResponse answers the errors of requests on the ledger of a workspace the account
+// can't use. It reports whether the error was handled.
+func ledgerErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrWorkspaceNotFound):
+		models.NewResponseForbidden(c, models.Response{Message: "workspace not found"})
+	case errors.Is(err, usecase.ErrForbidden):
+		models.NewResponseForbidden(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetMovements handles the GET /movements request. The institution_ids and tags query
+// parameters, comma separated, filter the movements of those institutions and with all those
+// tags.
 func (h *MovementHandler) GetMovements(c *gin.Context) {
 	log, account, err := getContextDependencies(c)
 	if err != nil {
@@ -57,8 +93,17 @@ func (h *MovementHandler) GetMovements(c *gin.Context) {
 		institutionIDs = splitInstitutionIDs(raw)
 	}
 
-	movements, err := h.movementsUsecase.GetPaginatedMovementsByAccountID(c.Request.Context(), account.ID, institutionIDs, int(limit), int(page))
+	var tags []string
+	if raw := c.Query("tags"); raw != "" {
+		tags = domain.NormalizeTags(strings.Split(raw, ","))
+	}
+
+	movements, err := h.movementsUsecase.GetPaginatedMovementsByAccountID(c.Request.Context(), account.ID, institutionIDs, tags, int(limit), int(page))
 	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
 		log.Error(loggerModels.LogProperties{
 			Event: "get_movements_failed",
 			Error: err,
@@ -92,6 +137,10 @@ func (h *MovementHandler) GetMovementByID(c *gin.Context) {
 
 	movement, err := h.movementsUsecase.GetMovementByID(c.Request.Context(), id, account.ID)
 	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
 		log.Error(loggerModels.LogProperties{
 			Event: "get_movements_failed",
 			Error: err,
@@ -120,21 +169,7 @@ func (h *MovementHandler) CreateMovement(c *gin.Context) {
 			Error: err,
 		})
 
-		errorMessage := "invalid form data"
-
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			firstError := validationErrors[0]
-			field := firstError.Field() // Nombre del campo en la struct (ej: "Amount")
-			tag := firstError.Tag()     // Etiqueta de validación (ej: "required")
-
-			if tag == "required" {
-				errorMessage = field + " is required"
-			} else {
-				errorMessage = field + " has invalid value"
-			}
-		}
-
-		models.NewResponseInvalidRequest(c, models.Response{Message: errorMessage})
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
 		return
 	}
 
@@ -146,7 +181,11 @@ func (h *MovementHandler) CreateMovement(c *gin.Context) {
 
 	err = h.movementsUsecase.CreateMovement(c.Request.Context(), movement)
 	if err != nil {
-		if errors.Is(err, domain.ErrInvalidMovementType) || errors.Is(err, domain.ErrInvalidMovementCategory) {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
+		if errors.Is(err, domain.ErrInvalidMovementType) || errors.Is(err, domain.ErrInvalidMovementCategory) || errors.Is(err, usecase.ErrFinancialAccountNotFound) || errors.Is(err, domain.ErrInvalidTags) || errors.Is(err, domain.ErrInvalidNote) {
 			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
 			return
 		}
@@ -168,6 +207,65 @@ func (h *MovementHandler) CreateMovement(c *gin.Context) {
 	})
 }
 
+// UpdateMovement handles the PUT /movements/:id request.
+func (h *MovementHandler) UpdateMovement(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "movement id is required"})
+		return
+	}
+
+	var req models.UpdateMovementRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	movement := models.UpdateRequestToDomainMovement(id, account.ID, req)
+
+	err = h.movementsUsecase.UpdateMovement(c.Request.Context(), movement)
+	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrMovementNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+			return
+		}
+
+		if errors.Is(err, domain.ErrInvalidMovementType) || errors.Is(err, domain.ErrInvalidMovementCategory) || errors.Is(err, usecase.ErrMustBeGreaterThanZero) || errors.Is(err, usecase.ErrFinancialAccountNotFound) || errors.Is(err, domain.ErrInvalidSplits) || errors.Is(err, domain.ErrInvalidTags) || errors.Is(err, domain.ErrInvalidNote) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_movement_failed",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToMovementResponse(movement),
+	})
+}
+
 // DeleteMovement handles DELETE /movements/:id request
 func (h *MovementHandler) DeleteMovement(c *gin.Context) {
 	log, accconst, err := getContextDependencies(c)
@@ -183,6 +281,10 @@ func (h *MovementHandler) DeleteMovement(c *gin.Context) {
 
 	err = h.movementsUsecase.DeleteMovement(c.Request.Context(), id, accconst.ID)
 	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
 		if errors.Is(err, usecase.ErrMovementNotFound) {
 			models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
 			return
@@ -202,6 +304,110 @@ func (h *MovementHandler) DeleteMovement(c *gin.Context) {
 	})
 }
 
+// GetSplits handles the GET /movements/:id/splits request. A movement that is not split has
+// none.
+func (h *MovementHandler) GetSplits(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	splits, err := h.movementsUsecase.GetSplits(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrMovementNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_splits_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToSplitResponses(splits),
+	})
+}
+
+// SetSplits handles the PUT /movements/:id/splits request. It replaces the splits of the
+// movement with the given ones, which must add up to its amount; an empty list unsplits it.
+func (h *MovementHandler) SetSplits(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.SetSplitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	h.saveSplits(c, log, account.ID, models.ToDomainSplits(req))
+}
+
+// DeleteSplits handles the DELETE /movements/:id/splits request. The movement counts again in
+// its own category.
+func (h *MovementHandler) DeleteSplits(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	h.saveSplits(c, log, account.ID, nil)
+}
+
+func (h *MovementHandler) saveSplits(c *gin.Context, log *loggerModels.Logger, accountID string, splits []*domain.Split) {
+	splits, err := h.movementsUsecase.SetSplits(c.Request.Context(), c.Param("id"), accountID, splits)
+	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrMovementNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+			return
+		}
+
+		if errors.Is(err, domain.ErrInvalidSplits) || errors.Is(err, domain.ErrInvalidMovementCategory) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		if errors.Is(err, usecase.ErrSplitsOwed) {
+			models.NewResponseConflict(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "set_splits_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToSplitResponses(splits),
+	})
+}
+
+// GetMovementsByYear handles the GET /movements/years/:year request. Transfers between own
+// accounts are neither income nor outcome.
 func (h *MovementHandler) GetMovementsByYear(c *gin.Context) {
 	log, account, err := getContextDependencies(c)
 	if err != nil {
@@ -232,6 +438,10 @@ func (h *MovementHandler) GetMovementsByYear(c *gin.Context) {
 
 	movements, err := h.movementsUsecase.GetMovementsByYear(c.Request.Context(), account.ID, institutionIDs, year)
 	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
 		log.Error(loggerModels.LogProperties{
 			Event: "get_movements_by_year_failed",
 			Error: err,
@@ -247,6 +457,10 @@ func (h *MovementHandler) GetMovementsByYear(c *gin.Context) {
 	monthsMap := map[time.Month]models.MovementIncomeOutcomeByMonth{}
 
 	for _, m := range movements {
+		if m.TransferID != "" {
+			continue
+		}
+
 		if m.Type == domain.Income {
 			totalIncome += m.Amount
 		}
@@ -299,6 +513,8 @@ func (h *MovementHandler) GetMovementsByYear(c *gin.Context) {
 	})
 }
 
+// GetMovementsByMonth handles the GET /movements/years/:year/months/:month request. Transfers
+// between own accounts are neither income nor outcome.
 func (h *MovementHandler) GetMovementsByMonth(c *gin.Context) {
 	log, account, err := getContextDependencies(c)
 	if err != nil {
@@ -344,6 +560,10 @@ func (h *MovementHandler) GetMovementsByMonth(c *gin.Context) {
 
 	movements, err := h.movementsUsecase.GetMovementsByMonth(c.Request.Context(), account.ID, institutionIDs, year, month)
 	if err != nil {
+		if ledgerErrorResponse(c, err) {
+			return
+		}
+
 		log.Error(loggerModels.LogProperties{
 			Event: "get_movements_by_month_failed",
 			Error: err,
@@ -359,6 +579,10 @@ func (h *MovementHandler) GetMovementsByMonth(c *gin.Context) {
 	daysMap := map[int]models.MovementIncomeOutcomeByDay{}
 
 	for _, m := range movements {
+		if m.TransferID != "" {
+			continue
+		}
+
 		if m.Type == domain.Income {
 			totalIncome += m.Amount
 		}
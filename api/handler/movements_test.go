@@ -12,6 +12,7 @@ import (
 	"transaction-tracker/internal/movements/domain"
 	"transaction-tracker/internal/movements/usecase"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -29,7 +30,7 @@ func TestGetMovements_Success(t *testing.T) {
 	}
 
 	movementsMock := new(usecase.MockMovementUsecase)
-	movementsMock.On("GetPaginatedMovementsByAccountID", mock.Anything, "accountID", 5, 2).Return(&domain.PaginatedMovements{Movements: movements, CurrentPage: 1}, nil)
+	movementsMock.On("GetPaginatedMovementsByAccountID", mock.Anything, "accountID", []string(nil), []string(nil), 5, 2).Return(&domain.PaginatedMovements{Movements: movements, CurrentPage: 1}, nil)
 
 	testHandler := NewMovementHandler(movementsMock)
 
@@ -51,7 +52,7 @@ func TestGetMovements_UsecaseError(t *testing.T) {
 	c := require.New(t)
 
 	mockUsecase := new(usecase.MockMovementUsecase)
-	mockUsecase.On("GetPaginatedMovementsByAccountID", mock.Anything, "accountID", 5, 2).Return(nil, errors.New("database connection failed"))
+	mockUsecase.On("GetPaginatedMovementsByAccountID", mock.Anything, "accountID", []string(nil), []string(nil), 5, 2).Return(nil, errors.New("database connection failed"))
 
 	testHandler := NewMovementHandler(mockUsecase)
 
@@ -62,6 +63,52 @@ func TestGetMovements_UsecaseError(t *testing.T) {
 	c.Equal(http.StatusInternalServerError, w.Code)
 }
 
+func TestGetMovements_WorkspaceDenied(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "not a member", err: usecase.ErrWorkspaceNotFound},
+		{name: "role not allowed", err: usecase.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockMovementUsecase)
+			mockUsecase.On("GetPaginatedMovementsByAccountID", mock.Anything, "accountID", []string(nil), []string(nil), 5, 2).Return(nil, tt.err)
+
+			testHandler := NewMovementHandler(mockUsecase)
+
+			ginContext, w := setupTestContext(http.MethodGet, "/movements?page=2&limit=5", nil)
+
+			testHandler.GetMovements(ginContext)
+
+			c.Equal(http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestUpdateMovement_WorkspaceViewer(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("UpdateMovement", mock.Anything, mock.Anything).Return(usecase.ErrForbidden)
+
+	testHandler := NewMovementHandler(mockUsecase)
+
+	body := strings.NewReader("type=expense&category=food&amount=1500&date=2025-09-20T10:17:00Z")
+
+	ginContext, w := setupTestContext(http.MethodPut, "/movements/MID1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	testHandler.UpdateMovement(ginContext)
+
+	c.Equal(http.StatusForbidden, w.Code)
+}
+
 func TestCreateMovement_UsecaseError(t *testing.T) {
 	c := require.New(t)
 
@@ -117,3 +164,216 @@ func TestCreateMovement_Success(t *testing.T) {
 
 	c.Equal(http.StatusCreated, w.Code)
 }
+
+func TestCreateMovement_TagsAndNote(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("CreateMovement", mock.Anything, mock.MatchedBy(func(m *domain.Movement) bool {
+		return len(m.Tags) == 2 && m.Tags[0] == "trip-cartagena-2026" && m.Tags[1] == "reimbursable" && m.Note == "team dinner"
+	})).Return(nil)
+
+	testHandler := NewMovementHandler(mockUsecase)
+
+	body := strings.NewReader(
+		"type=expense&institution_id=inst-1&category=food&description=Dinner&amount=1500&date=2025-09-20T10:17:00Z" +
+			"&tags=trip-cartagena-2026&tags=reimbursable&note=team+dinner",
+	)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/movements", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	testHandler.CreateMovement(ginContext)
+
+	c.Equal(http.StatusCreated, w.Code)
+	c.Contains(w.Body.String(), `"tags":["trip-cartagena-2026","reimbursable"]`)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestCreateMovement_InvalidTags(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("CreateMovement", mock.Anything, mock.Anything).Return(domain.ErrInvalidTags)
+
+	testHandler := NewMovementHandler(mockUsecase)
+
+	body := strings.NewReader(
+		"type=expense&institution_id=inst-1&category=food&description=Dinner&amount=1500&date=2025-09-20T10:17:00Z&tags=not+valid!",
+	)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/movements", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	testHandler.CreateMovement(ginContext)
+
+	c.Equal(http.StatusBadRequest, w.Code)
+}
+
+func TestUpdateMovement_Success(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("UpdateMovement", mock.Anything, mock.MatchedBy(func(m *domain.Movement) bool {
+		return m.ID == "MID1" && m.AccountID == "accountID" && m.Category == domain.Food && m.Amount == 1500
+	})).Return(nil)
+
+	testHandler := NewMovementHandler(mockUsecase)
+
+	body := strings.NewReader("type=expense&category=food&description=Lunch&amount=1500&date=2025-09-20T10:17:00Z")
+
+	ginContext, w := setupTestContext(http.MethodPut, "/movements/MID1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	testHandler.UpdateMovement(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestUpdateMovement_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("UpdateMovement", mock.Anything, mock.Anything).Return(usecase.ErrMovementNotFound)
+
+	testHandler := NewMovementHandler(mockUsecase)
+
+	body := strings.NewReader("type=expense&category=food&amount=1500&date=2025-09-20T10:17:00Z")
+
+	ginContext, w := setupTestContext(http.MethodPut, "/movements/MID1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	testHandler.UpdateMovement(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestUpdateMovement_InvalidRequestBody(t *testing.T) {
+	c := require.New(t)
+
+	testHandler := NewMovementHandler(new(usecase.MockMovementUsecase))
+
+	body := strings.NewReader("type=expense&amount=abc")
+
+	ginContext, w := setupTestContext(http.MethodPut, "/movements/MID1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	testHandler.UpdateMovement(ginContext)
+
+	c.Equal(http.StatusBadRequest, w.Code)
+}
+
+func TestGetMovementsByYear_SkipsTransfers(t *testing.T) {
+	c := require.New(t)
+
+	date := time.Date(2025, time.September, 20, 10, 0, 0, 0, time.UTC)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("GetMovementsByYear", mock.Anything, "accountID", []string(nil), 2025).Return([]*domain.Movement{
+		{ID: "MID1", Type: domain.Income, Amount: 3000, Date: date},
+		{ID: "MID2", Type: domain.Expense, Amount: 500, Date: date},
+		{ID: "MID3", Type: domain.Expense, Amount: 1000, Date: date, TransferID: "TRF1"},
+		{ID: "MID4", Type: domain.Income, Amount: 1000, Date: date, TransferID: "TRF1"},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/movements/years/2025", nil)
+	ginContext.Params = gin.Params{{Key: "year", Value: "2025"}}
+
+	NewMovementHandler(mockUsecase).GetMovementsByYear(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response models.MovementByYear
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Equal(3000.0, response.TotalIncome)
+	c.Equal(500.0, response.TotalExpense)
+	c.Len(response.Months, 1)
+	c.Equal(2500.0, response.Balance)
+}
+
+func TestSetSplits(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "amounts don't add up", err: domain.ErrInvalidSplits, status: http.StatusBadRequest},
+		{name: "category not in tree", err: domain.ErrInvalidMovementCategory, status: http.StatusBadRequest},
+		{name: "movement not found", err: usecase.ErrMovementNotFound, status: http.StatusNotFound},
+		{name: "splits owed", err: usecase.ErrSplitsOwed, status: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			splits := []*domain.Split{
+				{ID: "SPL1", Category: domain.Food, Amount: 90000, Note: "groceries"},
+				{ID: "SPL2", Category: domain.Housing, Amount: 60000},
+			}
+
+			mockUsecase := new(usecase.MockMovementUsecase)
+			call := mockUsecase.On("SetSplits", mock.Anything, "MID1", "accountID", mock.MatchedBy(func(splits []*domain.Split) bool {
+				return len(splits) == 2 && splits[0].Category == domain.Food && splits[0].Note == "groceries" && splits[1].Amount == 60000
+			}))
+			if tt.err != nil {
+				call.Return(nil, tt.err)
+			} else {
+				call.Return(splits, nil)
+			}
+
+			body := strings.NewReader(`{"splits":[{"category":"food","amount":90000,"note":"groceries"},{"category":"housing","amount":60000}]}`)
+
+			ginContext, w := setupTestContext(http.MethodPut, "/movements/MID1/splits", body)
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+			ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+			NewMovementHandler(mockUsecase).SetSplits(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+
+			if tt.err == nil {
+				var response []*models.SplitResponse
+				c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+				c.Len(response, 2)
+				c.Equal("food", response[0].Category)
+			}
+		})
+	}
+}
+
+func TestGetSplits(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("GetSplits", mock.Anything, "MID1", "accountID").Return([]*domain.Split{}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/movements/MID1/splits", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	NewMovementHandler(mockUsecase).GetSplits(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	c.JSONEq(`[]`, w.Body.String())
+}
+
+func TestDeleteSplits(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockMovementUsecase)
+	mockUsecase.On("SetSplits", mock.Anything, "MID1", "accountID", []*domain.Split(nil)).Return([]*domain.Split{}, nil)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/movements/MID1/splits", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "MID1"}}
+
+	NewMovementHandler(mockUsecase).DeleteSplits(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
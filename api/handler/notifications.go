@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/notifications/domain"
+	"transaction-tracker/internal/notifications/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles push deliveries from the notifications broker.
+type NotificationHandler struct {
+	notificationUsecase usecase.NotificationUsecase
+	pushToken           string
+}
+
+// NewNotificationHandler creates a new instance of NotificationHandler. Push requests must
+// carry pushToken in the token query parameter; an empty pushToken rejects every request.
+func NewNotificationHandler(ucn usecase.NotificationUsecase, pushToken string) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUsecase: ucn,
+		pushToken:           pushToken,
+	}
+}
+
+// GmailPush handles the POST /notifications/gmail request sent by a Pub/Sub push subscription.
+// Any non 2xx response makes Pub/Sub redeliver the message, so only transient failures
+// answer with an error status; messages that can never be processed are logged and
+// acknowledged with 204 No Content.
+func (h *NotificationHandler) GmailPush(c *gin.Context) {
+	log, err := getLogger(c)
+	if err != nil {
+		return
+	}
+
+	if !h.validToken(c.Query("token")) {
+		models.NewResponseUnauthorized(c, models.Response{Message: "invalid push token"})
+		return
+	}
+
+	var req models.PubSubPushRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseNoContent(c)
+		return
+	}
+
+	notification, err := domain.ParseGmailNotification(req.Message.Data)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_gmail_notification",
+			Error: err,
+		})
+
+		models.NewResponseNoContent(c)
+		return
+	}
+
+	log.Info(loggerModels.LogProperties{
+		Event: "message_received",
+		AdditionalParams: []loggerModels.Properties{
+			notification,
+		},
+	})
+
+	messages, err := h.notificationUsecase.ProcessGmailNotification(c.Request.Context(), notification)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "process_gmail_notification_failed",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				notification,
+			},
+		})
+
+		if usecase.IsPermanentError(err) {
+			models.NewResponseNoContent(c)
+			return
+		}
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	for _, m := range messages {
+		log.Info(loggerModels.LogProperties{
+			Event: "message_processed",
+			AdditionalParams: []loggerModels.Properties{
+				m,
+			},
+		})
+	}
+
+	models.NewResponseOK(c, models.Response{Message: "notification processed"})
+}
+
+func (h *NotificationHandler) validToken(token string) bool {
+	if h.pushToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.pushToken)) == 1
+}
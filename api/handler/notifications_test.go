@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	messagesDomain "transaction-tracker/internal/messages/domain"
+	"transaction-tracker/internal/notifications/domain"
+	"transaction-tracker/internal/notifications/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func pushBody(data string) *bytes.Buffer {
+	encoded := base64.StdEncoding.EncodeToString([]byte(data))
+
+	return bytes.NewBufferString(fmt.Sprintf(`{"message":{"data":"%s","messageId":"1"},"subscription":"projects/p/subscriptions/s"}`, encoded))
+}
+
+func TestGmailPush(t *testing.T) {
+	notification := &domain.GmailNotification{EmailAddress: "user@gmail.com", HistoryID: 42}
+	payload := `{"emailAddress":"user@gmail.com","historyId":42}`
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockNotificationUsecase)
+		mockUsecase.On("ProcessGmailNotification", mock.Anything, notification).Return([]*messagesDomain.Message{{ID: "m1"}}, nil)
+
+		testHandler := NewNotificationHandler(mockUsecase, "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=secret", pushBody(payload))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockNotificationUsecase)
+		testHandler := NewNotificationHandler(mockUsecase, "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=wrong", pushBody(payload))
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusUnauthorized, w.Code)
+		mockUsecase.AssertNotCalled(t, "ProcessGmailNotification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("token not configured", func(t *testing.T) {
+		c := require.New(t)
+
+		testHandler := NewNotificationHandler(new(usecase.MockNotificationUsecase), "")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=", pushBody(payload))
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("invalid notification is acknowledged", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockNotificationUsecase)
+		testHandler := NewNotificationHandler(mockUsecase, "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=secret", pushBody(`{"historyId":42}`))
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusNoContent, w.Code)
+		mockUsecase.AssertNotCalled(t, "ProcessGmailNotification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("undecodable envelope is acknowledged", func(t *testing.T) {
+		c := require.New(t)
+
+		testHandler := NewNotificationHandler(new(usecase.MockNotificationUsecase), "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=secret", bytes.NewBufferString(`{"message":`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusNoContent, w.Code)
+	})
+
+	t.Run("permanent error is acknowledged", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockNotificationUsecase)
+		mockUsecase.On("ProcessGmailNotification", mock.Anything, notification).
+			Return(nil, errors.New("googleapi: Error 404: Requested entity was not found"))
+
+		testHandler := NewNotificationHandler(mockUsecase, "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=secret", pushBody(payload))
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusNoContent, w.Code)
+	})
+
+	t.Run("transient error is retried", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockNotificationUsecase)
+		mockUsecase.On("ProcessGmailNotification", mock.Anything, notification).Return(nil, errors.New("timeout"))
+
+		testHandler := NewNotificationHandler(mockUsecase, "secret")
+
+		ginContext, w := setupTestContext(http.MethodPost, "/notifications/gmail?token=secret", pushBody(payload))
+
+		testHandler.GmailPush(ginContext)
+
+		c.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
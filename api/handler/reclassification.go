@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/reclassification/domain"
+	"transaction-tracker/internal/reclassification/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ReclassificationHandler handles HTTP requests for the reclassification jobs domain.
+type ReclassificationHandler struct {
+	reclassificationUsecase usecase.ReclassificationUsecase
+}
+
+// NewReclassificationHandler creates a new instance of ReclassificationHandler.
+func NewReclassificationHandler(ucr usecase.ReclassificationUsecase) *ReclassificationHandler {
+	return &ReclassificationHandler{
+		reclassificationUsecase: ucr,
+	}
+}
+
+// CreateJob handles the POST /reclassification-jobs request. The job runs in the background
+// and its progress is read with GET /reclassification-jobs/:id.
+func (h *ReclassificationHandler) CreateJob(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateReclassificationJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	var job *domain.Job
+
+	filter, err := models.ToReclassificationFilter(req)
+	if err == nil {
+		job, err = h.reclassificationUsecase.StartJob(c.Request.Context(), account.ID, filter)
+	}
+
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidFilter) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_reclassification_job_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseAccepted(c, models.Response{
+		Data: models.ToReclassificationJobResponse(job),
+	})
+}
+
+// GetJobs handles the GET /reclassification-jobs request.
+func (h *ReclassificationHandler) GetJobs(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	jobs, err := h.reclassificationUsecase.GetJobs(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_reclassification_jobs_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToReclassificationJobResponses(jobs),
+	})
+}
+
+// GetJobByID handles the GET /reclassification-jobs/:id request.
+func (h *ReclassificationHandler) GetJobByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	job, err := h.reclassificationUsecase.GetJob(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrJobNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "reclassification job not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_reclassification_job_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToReclassificationJobResponse(job),
+	})
+}
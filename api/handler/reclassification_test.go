@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reclassification/domain"
+	"transaction-tracker/internal/reclassification/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReclassificationJob(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+		filter := domain.Filter{From: &from, To: &to, Categories: []movementsDomain.MovementCategory{movementsDomain.Unknown}}
+
+		mockUsecase := new(usecase.MockReclassificationUsecase)
+		mockUsecase.On("StartJob", mock.Anything, "accountID", filter).
+			Return(&domain.Job{ID: "RCJ1", AccountID: "accountID", Filter: filter, Status: domain.StatusPending}, nil)
+
+		body := strings.NewReader(`{"from":"2025-09-01","to":"2025-09-30","categories":["unknown"]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/reclassification-jobs", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewReclassificationHandler(mockUsecase).CreateJob(ginContext)
+
+		c.Equal(http.StatusAccepted, w.Code)
+
+		var response *models.ReclassificationJobResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("RCJ1", response.ID)
+		c.Equal("pending", response.Status)
+		c.Equal([]string{"unknown"}, response.Filter.Categories)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReclassificationUsecase)
+
+		body := strings.NewReader(`{"from":"01/09/2025"}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/reclassification-jobs", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewReclassificationHandler(mockUsecase).CreateJob(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "StartJob", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetReclassificationJobByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReclassificationUsecase)
+		mockUsecase.On("GetJob", mock.Anything, "RCJ1", "accountID").
+			Return(&domain.Job{ID: "RCJ1", Status: domain.StatusRunning, Total: 200, Processed: 100, Changed: 40}, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reclassification-jobs/RCJ1", nil)
+		ginContext.Params = gin.Params{{Key: "id", Value: "RCJ1"}}
+
+		NewReclassificationHandler(mockUsecase).GetJobByID(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response *models.ReclassificationJobResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("running", response.Status)
+		c.Equal(0.5, response.Progress)
+		c.Equal(40, response.Changed)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReclassificationUsecase)
+		mockUsecase.On("GetJob", mock.Anything, "RCJ1", "accountID").Return(nil, usecase.ErrJobNotFound)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reclassification-jobs/RCJ1", nil)
+		ginContext.Params = gin.Params{{Key: "id", Value: "RCJ1"}}
+
+		NewReclassificationHandler(mockUsecase).GetJobByID(ginContext)
+
+		c.Equal(http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/recurring/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecurringHandler handles HTTP requests for the recurring charges of an account.
+type RecurringHandler struct {
+	recurringUsecase usecase.RecurringUsecase
+}
+
+// NewRecurringHandler creates a new instance of RecurringHandler.
+func NewRecurringHandler(ucr usecase.RecurringUsecase) *RecurringHandler {
+	return &RecurringHandler{
+		recurringUsecase: ucr,
+	}
+}
+
+// GetRecurrences handles the GET /recurring request. Recurrences are returned by their next
+// expected charge.
+func (h *RecurringHandler) GetRecurrences(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	recurrences, err := h.recurringUsecase.GetRecurrences(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_recurrences_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToRecurrenceResponses(recurrences),
+	})
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/recurring/domain"
+	"transaction-tracker/internal/recurring/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecurrences(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockRecurringUsecase)
+	mockUsecase.On("GetRecurrences", mock.Anything, "accountID").Return([]*domain.Recurrence{
+		{ID: "REC1", Name: "Netflix", Period: domain.Monthly, Amount: 44900, PreviousAmount: 38900, Status: domain.Active},
+		{ID: "REC2", Name: "Smart Fit", Period: domain.Monthly, Amount: 89900, PreviousAmount: 89900, Status: domain.Missed},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/recurring", nil)
+
+	NewRecurringHandler(mockUsecase).GetRecurrences(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.RecurrenceResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 2)
+	c.True(response[0].PriceChanged)
+	c.Equal("monthly", response[0].Period)
+	c.False(response[1].PriceChanged)
+	c.Equal("missed", response[1].Status)
+}
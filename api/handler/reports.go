@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/reports/domain"
+	"transaction-tracker/internal/reports/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	taxCSVContentType  = "text/csv; charset=utf-8"
+	taxHTMLContentType = "text/html; charset=utf-8"
+)
+
+// ReportHandler handles HTTP requests for the reports domain.
+type ReportHandler struct {
+	reportsUsecase usecase.ReportsUsecase
+}
+
+// NewReportHandler creates a new instance of ReportHandler.
+func NewReportHandler(ucr usecase.ReportsUsecase) *ReportHandler {
+	return &ReportHandler{
+		reportsUsecase: ucr,
+	}
+}
+
+// GetCategoryReport handles the GET /reports/categories request. It returns the spend per
+// category between the from and to query parameters, written as 2006-01-02, split into periods
+// of the granularity one (day, week, month, quarter or year, month by default). Every category
+// comes with its share of the period and the change since the previous period.
+func (h *ReportHandler) GetCategoryReport(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	rng, err := domain.NewRange(c.Query("from"), c.Query("to"), c.Query("granularity"), time.Now())
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	report, err := h.reportsUsecase.GetCategoryReport(c.Request.Context(), account.ID, rng)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_category_report_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToCategoryReportResponse(report),
+	})
+}
+
+// GetForecast handles the GET /reports/forecast request. It projects the balance day by day
+// for the number of days in the days query parameter, 30 by default, starting from the balance
+// one or from the balances of the financial accounts when not given.
+func (h *ReportHandler) GetForecast(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	days, err := domain.ParseForecastDays(c.Query("days"))
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	var balance *float64
+	if raw := c.Query("balance"); raw != "" {
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			models.NewResponseInvalidRequest(c, models.Response{Message: "balance must be a number"})
+			return
+		}
+
+		balance = &value
+	}
+
+	forecast, err := h.reportsUsecase.GetForecast(c.Request.Context(), account.ID, days, balance)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_forecast_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToForecastResponse(forecast),
+	})
+}
+
+// GetTagReport handles the GET /reports/tags request. It returns the income and expenses of
+// every tag in the whole months between the from and to query parameters, written as
+// 2006-01-02, the last six months by default. A movement with several tags counts in each of
+// them.
+func (h *ReportHandler) GetTagReport(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	rng, err := domain.NewRange(c.Query("from"), c.Query("to"), string(domain.Month), time.Now())
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	report, err := h.reportsUsecase.GetTagReport(c.Request.Context(), account.ID, rng)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_tag_report_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToTagReportResponse(report),
+	})
+}
+
+// GetTaxReport handles the GET /reports/tax request. It returns what the income declaration of
+// the year in the year query parameter asks for, the previous year by default: the income per
+// category, the deductible expenses and the balances per institution at the end of the year.
+// The format query parameter downloads it as csv or opens it as a printable html document
+// instead of json.
+func (h *ReportHandler) GetTaxReport(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	year, err := domain.ParseTaxYear(c.Query("year"), time.Now())
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "html" {
+		models.NewResponseInvalidRequest(c, models.Response{Message: "format must be one of json, csv or html"})
+		return
+	}
+
+	report, err := h.reportsUsecase.GetTaxReport(c.Request.Context(), account.ID, year)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_tax_report_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	if format == "json" {
+		models.NewResponseOK(c, models.Response{
+			Data: models.ToTaxReportResponse(report),
+		})
+		return
+	}
+
+	var document bytes.Buffer
+
+	contentType := taxHTMLContentType
+	if format == "csv" {
+		contentType = taxCSVContentType
+		err = report.WriteCSV(&document)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=tax-report-%d.csv", year))
+	} else {
+		err = report.WriteHTML(&document)
+	}
+
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "write_tax_report_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, document.Bytes())
+}
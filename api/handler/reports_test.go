@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reports/domain"
+	"transaction-tracker/internal/reports/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCategoryReport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		rng := &domain.Range{
+			From:        time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			To:          time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			Granularity: domain.Month,
+		}
+		change := -40.0
+		report := domain.NewCategoryReport(rng, []*domain.PeriodSpend{
+			{Period: rng.From, CategorySpend: domain.CategorySpend{Category: movementsDomain.Food, Amount: 500, Share: 100}},
+			{Period: rng.From.AddDate(0, 1, 0), CategorySpend: domain.CategorySpend{Category: movementsDomain.Food, Amount: 300, Share: 100, PreviousAmount: 500, Change: &change}},
+		})
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetCategoryReport", mock.Anything, "accountID", rng).Return(report, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/categories?from=2025-07-15&to=2025-09-30&granularity=month", nil)
+
+		NewReportHandler(mockUsecase).GetCategoryReport(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response models.CategoryReportResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("2025-07-01", response.From)
+		c.Equal("2025-09-30", response.To)
+		c.Equal(800.0, response.Total)
+		c.Len(response.Periods, 3)
+		c.Equal(&change, response.Periods[1].Categories[0].Change)
+		c.Nil(response.Periods[0].Categories[0].Change)
+		c.Empty(response.Periods[2].Categories)
+	})
+
+	t.Run("invalid granularity", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/categories?granularity=hour", nil)
+
+		NewReportHandler(mockUsecase).GetCategoryReport(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "GetCategoryReport", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetForecast(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		negative := time.Date(2025, 9, 22, 0, 0, 0, 0, time.UTC)
+		forecast := &domain.Forecast{
+			StartBalance:    100,
+			EndBalance:      -20,
+			MinBalance:      -20,
+			MinBalanceAt:    negative,
+			FirstNegativeAt: &negative,
+			Days: []*domain.ForecastDay{
+				{Date: time.Date(2025, 9, 21, 0, 0, 0, 0, time.UTC), Balance: 40, Lower: 30, Upper: 50, Expenses: 60},
+				{Date: negative, Balance: -20, Lower: -35, Upper: -5, Expenses: 60},
+			},
+		}
+
+		balance := 100.0
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetForecast", mock.Anything, "accountID", 2, &balance).Return(forecast, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/forecast?days=2&balance=100", nil)
+
+		NewReportHandler(mockUsecase).GetForecast(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response models.ForecastResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("2025-09-22", response.FirstNegativeAt)
+		c.Len(response.Days, 2)
+		c.Equal(-35.0, response.Days[1].Lower)
+	})
+
+	t.Run("invalid days", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/forecast?days=1000", nil)
+
+		NewReportHandler(mockUsecase).GetForecast(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "GetForecast", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetTagReport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		rng := &domain.Range{
+			From:        time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			To:          time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			Granularity: domain.Month,
+		}
+		report := domain.NewTagReport(rng, []*domain.TagTotal{
+			{Tag: "trip-cartagena-2026", Expenses: 1200, Count: 8},
+		})
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetTagReport", mock.Anything, "accountID", rng).Return(report, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/tags?from=2025-07-15&to=2025-09-30", nil)
+
+		NewReportHandler(mockUsecase).GetTagReport(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response models.TagReportResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal("2025-07-01", response.From)
+		c.Equal("2025-09-30", response.To)
+		c.Len(response.Tags, 1)
+		c.Equal(-1200.0, response.Tags[0].Net)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/tags?from=july", nil)
+
+		NewReportHandler(mockUsecase).GetTagReport(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "GetTagReport", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetTaxReport(t *testing.T) {
+	report := domain.NewTaxReport(2024,
+		[]*domain.CategoryIncome{{Category: movementsDomain.Salary, Amount: 60000000}},
+		nil,
+		[]*domain.TagTotal{{Tag: domain.MortgageInterestTag, Expenses: 8000000}},
+		time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		[]*domain.AccountBalance{{InstitutionID: "bancolombia", Name: "Savings", Kind: "savings", Balance: 4000000}},
+	)
+
+	t.Run("json", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetTaxReport", mock.Anything, "accountID", 2024).Return(report, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/tax?year=2024", nil)
+
+		NewReportHandler(mockUsecase).GetTaxReport(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response models.TaxReportResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Equal(2024, response.Year)
+		c.Equal(60000000.0, response.TotalIncome)
+		c.Equal(8000000.0, response.TotalDeductions)
+		c.Equal("2024-12-31", response.BalanceDate)
+		c.Len(response.Balances, 1)
+		c.Equal("Savings", response.Balances[0].Accounts[0].Name)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetTaxReport", mock.Anything, "accountID", 2024).Return(report, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/tax?year=2024&format=csv", nil)
+
+		NewReportHandler(mockUsecase).GetTaxReport(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		c.Equal("text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		c.Equal("attachment; filename=tax-report-2024.csv", w.Header().Get("Content-Disposition"))
+		c.Contains(w.Body.String(), "income,salary,60000000.00")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockReportsUsecase)
+		mockUsecase.On("GetTaxReport", mock.Anything, "accountID", 2024).Return(report, nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/reports/tax?year=2024&format=html", nil)
+
+		NewReportHandler(mockUsecase).GetTaxReport(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		c.Equal("text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		c.Contains(w.Body.String(), "<h1>Tax report 2024</h1>")
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		for _, target := range []string{"/reports/tax?year=1990", "/reports/tax?format=pdf"} {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockReportsUsecase)
+
+			ginContext, w := setupTestContext(http.MethodGet, target, nil)
+
+			NewReportHandler(mockUsecase).GetTaxReport(ginContext)
+
+			c.Equal(http.StatusBadRequest, w.Code, target)
+			mockUsecase.AssertNotCalled(t, "GetTaxReport", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"transaction-tracker/api/models"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+	"transaction-tracker/internal/rules/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RuleHandler handles HTTP requests for the categorization rules domain.
+type RuleHandler struct {
+	rulesUsecase usecase.RulesUsecase
+}
+
+// NewRuleHandler creates a new instance of RuleHandler.
+func NewRuleHandler(ucr usecase.RulesUsecase) *RuleHandler {
+	return &RuleHandler{
+		rulesUsecase: ucr,
+	}
+}
+
+func isInvalidRuleError(err error) bool {
+	return errors.Is(err, domain.ErrInvalidRule) ||
+		errors.Is(err, movementsDomain.ErrInvalidMovementCategory) ||
+		errors.Is(err, movementsDomain.ErrInvalidMovementType)
+}
+
+// GetRules handles the GET /rules request.
+func (h *RuleHandler) GetRules(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	rules, err := h.rulesUsecase.GetRules(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_rules_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToRuleResponses(rules),
+	})
+}
+
+// GetRuleByID handles the GET /rules/:id request.
+func (h *RuleHandler) GetRuleByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	rule, err := h.rulesUsecase.GetRule(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "rule not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_rule_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToRuleResponse(rule),
+	})
+}
+
+// CreateRule handles the POST /rules request.
+func (h *RuleHandler) CreateRule(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	rule, err := models.ToRule(account.ID, req)
+	if err == nil {
+		err = h.rulesUsecase.CreateRule(c.Request.Context(), rule)
+	}
+
+	if err != nil {
+		if isInvalidRuleError(err) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_rule_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToRuleResponse(rule),
+	})
+}
+
+// UpdateRule handles the PUT /rules/:id request.
+func (h *RuleHandler) UpdateRule(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	rule, err := h.rulesUsecase.GetRule(c.Request.Context(), c.Param("id"), account.ID)
+	if err == nil {
+		models.ApplyUpdateRuleRequest(rule, req)
+		err = h.rulesUsecase.UpdateRule(c.Request.Context(), rule)
+	}
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "rule not found"})
+			return
+		}
+
+		if isInvalidRuleError(err) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_rule_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToRuleResponse(rule),
+	})
+}
+
+// DeleteRule handles the DELETE /rules/:id request.
+func (h *RuleHandler) DeleteRule(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.rulesUsecase.DeleteRule(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "rule not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_rule_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "rule deleted successfully",
+	})
+}
+
+// PreviewRule handles the GET /rules/:id/preview request. It reports how many existing
+// movements the rule would recategorize without changing them.
+func (h *RuleHandler) PreviewRule(c *gin.Context) {
+	h.evaluateRule(c, h.rulesUsecase.PreviewRule, "preview_rule_failed")
+}
+
+// ApplyRule handles the POST /rules/:id/apply request. It recategorizes the existing
+// movements matched by the rule.
+func (h *RuleHandler) ApplyRule(c *gin.Context) {
+	h.evaluateRule(c, h.rulesUsecase.ApplyRule, "apply_rule_failed")
+}
+
+func (h *RuleHandler) evaluateRule(c *gin.Context, evaluate func(ctx context.Context, id string, accountID string) (*domain.Preview, error), event string) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	preview, err := evaluate(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrRuleNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "rule not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: event,
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToRulePreviewResponse(preview),
+	})
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"transaction-tracker/api/models"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+	"transaction-tracker/internal/rules/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRule(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockRulesUsecase)
+		mockUsecase.On("CreateRule", mock.Anything, mock.MatchedBy(func(r *domain.Rule) bool {
+			return r.AccountID == "accountID" && r.Category == movementsDomain.Food && len(r.Conditions) == 1
+		})).Return(nil)
+
+		body := strings.NewReader(`{"name":"rappi","priority":1,"category":"food","conditions":[{"field":"description","operator":"contains","value":"RAPPI"}]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/rules", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewRuleHandler(mockUsecase).CreateRule(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+
+		var response *models.RuleResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.True(response.Active)
+		c.Equal("food", response.Category)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockRulesUsecase)
+
+		body := strings.NewReader(`{"name":"rappi","category":"food","conditions":[{"field":"amount","operator":"contains","value":"10"}]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/rules", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewRuleHandler(mockUsecase).CreateRule(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateRule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid category", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockRulesUsecase)
+		mockUsecase.On("CreateRule", mock.Anything, mock.Anything).Return(movementsDomain.ErrInvalidMovementCategory)
+
+		body := strings.NewReader(`{"name":"rappi","category":"pets","conditions":[{"field":"description","operator":"contains","value":"RAPPI"}]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/rules", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewRuleHandler(mockUsecase).CreateRule(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdateRule_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockRulesUsecase)
+	mockUsecase.On("GetRule", mock.Anything, "RUL1", "accountID").Return(nil, usecase.ErrRuleNotFound)
+
+	body := strings.NewReader(`{"name":"rappi","category":"food","conditions":[{"field":"description","operator":"contains","value":"RAPPI"}]}`)
+
+	ginContext, w := setupTestContext(http.MethodPut, "/rules/RUL1", body)
+	ginContext.Request.Header.Set("Content-Type", "application/json")
+	ginContext.Params = gin.Params{{Key: "id", Value: "RUL1"}}
+
+	NewRuleHandler(mockUsecase).UpdateRule(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestPreviewRule(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockRulesUsecase)
+	mockUsecase.On("PreviewRule", mock.Anything, "RUL1", "accountID").Return(&domain.Preview{
+		Matched: 3,
+		Changed: 1,
+		Changes: []domain.Change{{MovementID: "MID1", Description: "RAPPI", From: movementsDomain.Unknown, To: movementsDomain.Food}},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/rules/RUL1/preview", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "RUL1"}}
+
+	NewRuleHandler(mockUsecase).PreviewRule(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response *models.RulePreviewResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Equal(3, response.Matched)
+	c.Equal(1, response.Changed)
+	c.Equal("food", response.Changes[0].To)
+	mockUsecase.AssertNotCalled(t, "ApplyRule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplyRule_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockRulesUsecase)
+	mockUsecase.On("ApplyRule", mock.Anything, "RUL1", "accountID").Return(nil, usecase.ErrRuleNotFound)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/rules/RUL1/apply", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "RUL1"}}
+
+	NewRuleHandler(mockUsecase).ApplyRule(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
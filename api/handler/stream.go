@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// heartbeatInterval keeps idle connections open through proxies.
+	heartbeatInterval = 15 * time.Second
+
+	// reconnectDelay is the retry hint sent to EventSource clients, in milliseconds.
+	reconnectDelay = 3000
+)
+
+var (
+	movementStreamEvents = []domain.EventType{domain.MovementCreated, domain.MovementUpdated, domain.MovementDeleted}
+)
+
+// StreamHandler handles Server-Sent Events connections.
+type StreamHandler struct {
+	streamUsecase usecase.StreamUsecase
+	heartbeat     time.Duration
+}
+
+// NewStreamHandler creates a new instance of StreamHandler.
+func NewStreamHandler(ucs usecase.StreamUsecase) *StreamHandler {
+	return &StreamHandler{
+		streamUsecase: ucs,
+		heartbeat:     heartbeatInterval,
+	}
+}
+
+// StreamMovements handles the GET /movements/stream request. Created, updated and deleted
+// movements of the account are pushed as they happen. Clients resume with the Last-Event-ID
+// header, or the last_event_id query parameter since browsers cannot set headers on EventSource.
+func (h *StreamHandler) StreamMovements(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+
+	ctx := c.Request.Context()
+
+	events, err := h.streamUsecase.Subscribe(ctx, account.ID, lastEventID, movementStreamEvents)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "subscribe_movements_stream_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", reconnectDelay)
+	c.Writer.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			err := writeEvent(c, event)
+			if err != nil {
+				log.Error(loggerModels.LogProperties{
+					Event: "write_movements_stream_failed",
+					Error: err,
+					AdditionalParams: []loggerModels.Properties{
+						event,
+					},
+				})
+
+				return
+			}
+		case <-ticker.C:
+			fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			c.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent sends the event payload as a single SSE message.
+func writeEvent(c *gin.Context, event *domain.Event) error {
+	data := &bytes.Buffer{}
+
+	err := json.Compact(data, event.Payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	c.Writer.Flush()
+
+	return nil
+}
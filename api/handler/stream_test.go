@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamMovements(t *testing.T) {
+	t.Run("writes events until the stream ends", func(t *testing.T) {
+		c := require.New(t)
+
+		events := make(chan *domain.Event, 1)
+		events <- &domain.Event{ID: "EVT2", Type: domain.MovementCreated, Payload: json.RawMessage(`{ "id": "MID1" }`)}
+		close(events)
+
+		mockUsecase := new(usecase.MockStreamUsecase)
+		mockUsecase.On("Subscribe", mock.Anything, "accountID", "EVT1", movementStreamEvents).Return((<-chan *domain.Event)(events), nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/movements/stream", nil)
+		ginContext.Request.Header.Set("Last-Event-ID", "EVT1")
+
+		NewStreamHandler(mockUsecase).StreamMovements(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		c.Equal("text/event-stream", w.Header().Get("Content-Type"))
+		c.Equal("retry: 3000\n\nid: EVT2\nevent: movement.created\ndata: {\"id\":\"MID1\"}\n\n", w.Body.String())
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("last event id from query", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mockUsecase := new(usecase.MockStreamUsecase)
+		mockUsecase.On("Subscribe", mock.Anything, "accountID", "EVT9", movementStreamEvents).Return((<-chan *domain.Event)(make(chan *domain.Event)), nil)
+
+		ginContext, w := setupTestContext(http.MethodGet, "/movements/stream?last_event_id=EVT9", nil)
+		ginContext.Request = ginContext.Request.WithContext(ctx)
+
+		NewStreamHandler(mockUsecase).StreamMovements(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("subscribe error", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockStreamUsecase)
+		mockUsecase.On("Subscribe", mock.Anything, "accountID", "", movementStreamEvents).Return(nil, errors.New("db down"))
+
+		ginContext, w := setupTestContext(http.MethodGet, "/movements/stream", nil)
+
+		NewStreamHandler(mockUsecase).StreamMovements(ginContext)
+
+		c.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/transfers/domain"
+	"transaction-tracker/internal/transfers/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TransferHandler handles HTTP requests for the transfers domain.
+type TransferHandler struct {
+	transfersUsecase usecase.TransfersUsecase
+}
+
+// NewTransferHandler creates a new instance of TransferHandler.
+func NewTransferHandler(uct usecase.TransfersUsecase) *TransferHandler {
+	return &TransferHandler{
+		transfersUsecase: uct,
+	}
+}
+
+// transferErrorResponse answers the errors caused by the request. It reports whether the
+// error was handled.
+func transferErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrTransferNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "transfer not found"})
+	case errors.Is(err, usecase.ErrMovementNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "movement not found"})
+	case errors.Is(err, usecase.ErrMovementTransferred):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidTransfer):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetTransfers handles the GET /transfers request.
+func (h *TransferHandler) GetTransfers(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	transfers, err := h.transfersUsecase.GetTransfers(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_transfers_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToTransferResponses(transfers),
+	})
+}
+
+// CreateTransfer handles the POST /transfers request. It links an expense and an income of
+// the account that move money between its own accounts.
+func (h *TransferHandler) CreateTransfer(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateTransferRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	transfer, err := h.transfersUsecase.CreateTransfer(c.Request.Context(), account.ID, req.OutflowMovementID, req.InflowMovementID)
+	if err != nil {
+		if transferErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_transfer_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToTransferResponse(transfer),
+	})
+}
+
+// DetectTransfers handles the POST /transfers/detect request. It pairs the outflows and
+// inflows of different institutions with the same amount made within window_hours of each
+// other, 72 by default, and returns the transfers created.
+func (h *TransferHandler) DetectTransfers(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	hours := 0
+	if raw := c.Query("window_hours"); raw != "" {
+		hours, err = strconv.Atoi(raw)
+		if err != nil {
+			models.NewResponseInvalidRequest(c, models.Response{Message: "invalid window_hours"})
+			return
+		}
+	}
+
+	window, err := domain.ParseWindow(hours)
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	transfers, err := h.transfersUsecase.DetectTransfers(c.Request.Context(), account.ID, window)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "detect_transfers_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToTransferResponses(transfers),
+	})
+}
+
+// DeleteTransfer handles the DELETE /transfers/:id request. Its movements count again as
+// income and expense.
+func (h *TransferHandler) DeleteTransfer(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.transfersUsecase.DeleteTransfer(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if transferErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_transfer_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "transfer deleted successfully",
+	})
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/transfers/domain"
+	"transaction-tracker/internal/transfers/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTransfer() *domain.Transfer {
+	return &domain.Transfer{ID: "TRF1", AccountID: "accountID", OutflowID: "MID1", InflowID: "MID2", Amount: 500000, Source: domain.ManualSource}
+}
+
+func TestGetTransfers(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockTransfersUsecase)
+	mockUsecase.On("GetTransfers", mock.Anything, "accountID").Return([]*domain.Transfer{newTransfer()}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/transfers", nil)
+
+	NewTransferHandler(mockUsecase).GetTransfers(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.TransferResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("MID1", response[0].OutflowMovementID)
+	c.Equal("manual", response[0].Source)
+}
+
+func TestCreateTransfer(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "movement not found", err: usecase.ErrMovementNotFound, status: http.StatusNotFound},
+		{name: "invalid sides", err: domain.ErrInvalidTransfer, status: http.StatusBadRequest},
+		{name: "already transferred", err: usecase.ErrMovementTransferred, status: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockTransfersUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CreateTransfer", mock.Anything, "accountID", "MID1", "MID2").Return(nil, tt.err)
+			} else {
+				mockUsecase.On("CreateTransfer", mock.Anything, "accountID", "MID1", "MID2").Return(newTransfer(), nil)
+			}
+
+			body := strings.NewReader(`{"outflow_movement_id":"MID1","inflow_movement_id":"MID2"}`)
+
+			ginContext, w := setupTestContext(http.MethodPost, "/transfers", body)
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+
+			NewTransferHandler(mockUsecase).CreateTransfer(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDetectTransfers(t *testing.T) {
+	t.Run("custom window", func(t *testing.T) {
+		c := require.New(t)
+
+		detected := newTransfer()
+		detected.Source = domain.DetectedSource
+
+		mockUsecase := new(usecase.MockTransfersUsecase)
+		mockUsecase.On("DetectTransfers", mock.Anything, "accountID", 24*time.Hour).Return([]*domain.Transfer{detected}, nil)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/transfers/detect?window_hours=24", nil)
+
+		NewTransferHandler(mockUsecase).DetectTransfers(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+
+		var response []*models.TransferResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.Len(response, 1)
+		c.Equal("detected", response[0].Source)
+	})
+
+	t.Run("default window", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockTransfersUsecase)
+		mockUsecase.On("DetectTransfers", mock.Anything, "accountID", domain.DefaultWindow).Return([]*domain.Transfer{}, nil)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/transfers/detect", nil)
+
+		NewTransferHandler(mockUsecase).DetectTransfers(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("window out of range", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockTransfersUsecase)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/transfers/detect?window_hours=1000", nil)
+
+		NewTransferHandler(mockUsecase).DetectTransfers(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "DetectTransfers", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockTransfersUsecase)
+	mockUsecase.On("DeleteTransfer", mock.Anything, "TRF1", "accountID").Return(usecase.ErrTransferNotFound)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/transfers/TRF1", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "TRF1"}}
+
+	NewTransferHandler(mockUsecase).DeleteTransfer(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
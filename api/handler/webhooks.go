@@ -0,0 +1,271 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/internal/webhooks/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles HTTP requests for the webhooks domain.
+type WebhookHandler struct {
+	webhooksUsecase usecase.WebhooksUsecase
+}
+
+// NewWebhookHandler creates a new instance of WebhookHandler.
+func NewWebhookHandler(ucw usecase.WebhooksUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		webhooksUsecase: ucw,
+	}
+}
+
+func isInvalidWebhookError(err error) bool {
+	return errors.Is(err, domain.ErrInvalidURL) || errors.Is(err, domain.ErrInvalidEventType)
+}
+
+// GetWebhooks handles the GET /webhooks request.
+func (h *WebhookHandler) GetWebhooks(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	webhooks, err := h.webhooksUsecase.GetWebhooks(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_webhooks_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWebhookResponses(webhooks),
+	})
+}
+
+// GetWebhookByID handles the GET /webhooks/:id request.
+func (h *WebhookHandler) GetWebhookByID(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	webhook, err := h.webhooksUsecase.GetWebhook(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrWebhookNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "webhook not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_webhook_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWebhookResponse(webhook),
+	})
+}
+
+// CreateWebhook handles the POST /webhooks request. The signing secret is only returned here.
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateWebhookRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	webhook, err := domain.NewWebhook(account.ID, req.URL, req.EventTypes)
+	if err != nil {
+		if isInvalidWebhookError(err) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "new_webhook_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	err = h.webhooksUsecase.CreateWebhook(c.Request.Context(), webhook)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "create_webhook_failed",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				webhook,
+			},
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	response := models.ToWebhookResponse(webhook)
+	response.Secret = webhook.Secret
+
+	models.NewResponseCreated(c, models.Response{
+		Data: response,
+	})
+}
+
+// UpdateWebhook handles the PUT /webhooks/:id request.
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateWebhookRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	webhook, err := h.webhooksUsecase.GetWebhook(c.Request.Context(), c.Param("id"), account.ID)
+	if err == nil {
+		err = models.ApplyUpdateWebhookRequest(webhook, req)
+	}
+
+	if err == nil {
+		err = h.webhooksUsecase.UpdateWebhook(c.Request.Context(), webhook)
+	}
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrWebhookNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "webhook not found"})
+			return
+		}
+
+		if isInvalidWebhookError(err) {
+			models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_webhook_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWebhookResponse(webhook),
+	})
+}
+
+// DeleteWebhook handles the DELETE /webhooks/:id request.
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.webhooksUsecase.DeleteWebhook(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrWebhookNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "webhook not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "delete_webhook_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "webhook deleted successfully",
+	})
+}
+
+// GetDeliveries handles the GET /webhooks/:id/deliveries request.
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	deliveries, err := h.webhooksUsecase.GetDeliveries(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrWebhookNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "webhook not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_webhook_deliveries_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWebhookDeliveryResponses(deliveries),
+	})
+}
+
+// SendTestEvent handles the POST /webhooks/:id/test request. It answers with the logged
+// delivery whether the endpoint accepted the event or not.
+func (h *WebhookHandler) SendTestEvent(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	delivery, err := h.webhooksUsecase.SendTestEvent(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrWebhookNotFound) {
+			models.NewResponseNotFound(c, models.Response{Message: "webhook not found"})
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "send_webhook_test_event_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWebhookDeliveryResponse(delivery),
+	})
+}
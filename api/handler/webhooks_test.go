@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"transaction-tracker/api/models"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/internal/webhooks/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	t.Run("success returns the secret", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockWebhooksUsecase)
+		mockUsecase.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(w *domain.Webhook) bool {
+			return w.AccountID == "accountID" && w.URL == "https://example.com/hook" && len(w.EventTypes) == 2
+		})).Return(nil)
+
+		body := strings.NewReader(`{"url":"https://example.com/hook","event_types":["movement.created","extract.processed"]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/webhooks", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewWebhookHandler(mockUsecase).CreateWebhook(ginContext)
+
+		c.Equal(http.StatusCreated, w.Code)
+
+		var response *models.WebhookResponse
+		c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		c.NotEmpty(response.Secret)
+		c.Equal([]string{"movement.created", "extract.processed"}, response.EventTypes)
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("invalid event type", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockWebhooksUsecase)
+
+		body := strings.NewReader(`{"url":"https://example.com/hook","event_types":["message.failed"]}`)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/webhooks", body)
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewWebhookHandler(mockUsecase).CreateWebhook(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+		mockUsecase.AssertNotCalled(t, "CreateWebhook", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing url", func(t *testing.T) {
+		c := require.New(t)
+
+		ginContext, w := setupTestContext(http.MethodPost, "/webhooks", strings.NewReader(`{}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+
+		NewWebhookHandler(new(usecase.MockWebhooksUsecase)).CreateWebhook(ginContext)
+
+		c.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Run("success keeps active when not sent", func(t *testing.T) {
+		c := require.New(t)
+
+		webhook := &domain.Webhook{ID: "WHK1", AccountID: "accountID", URL: "https://example.com/old", Secret: "whsec_1", Active: true}
+
+		mockUsecase := new(usecase.MockWebhooksUsecase)
+		mockUsecase.On("GetWebhook", mock.Anything, "WHK1", "accountID").Return(webhook, nil)
+		mockUsecase.On("UpdateWebhook", mock.Anything, mock.MatchedBy(func(w *domain.Webhook) bool {
+			return w.URL == "https://example.com/new" && w.Active && len(w.EventTypes) == len(domain.SupportedEventTypes)
+		})).Return(nil)
+
+		ginContext, w := setupTestContext(http.MethodPut, "/webhooks/WHK1", strings.NewReader(`{"url":"https://example.com/new"}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "WHK1"}}
+
+		NewWebhookHandler(mockUsecase).UpdateWebhook(ginContext)
+
+		c.Equal(http.StatusOK, w.Code)
+		c.NotContains(w.Body.String(), "whsec_1")
+		mockUsecase.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		mockUsecase := new(usecase.MockWebhooksUsecase)
+		mockUsecase.On("GetWebhook", mock.Anything, "WHK1", "accountID").Return(nil, usecase.ErrWebhookNotFound)
+
+		ginContext, w := setupTestContext(http.MethodPut, "/webhooks/WHK1", strings.NewReader(`{"url":"https://example.com/new"}`))
+		ginContext.Request.Header.Set("Content-Type", "application/json")
+		ginContext.Params = gin.Params{{Key: "id", Value: "WHK1"}}
+
+		NewWebhookHandler(mockUsecase).UpdateWebhook(ginContext)
+
+		c.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteWebhook_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockWebhooksUsecase)
+	mockUsecase.On("DeleteWebhook", mock.Anything, "WHK1", "accountID").Return(usecase.ErrWebhookNotFound)
+
+	ginContext, w := setupTestContext(http.MethodDelete, "/webhooks/WHK1", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "WHK1"}}
+
+	NewWebhookHandler(mockUsecase).DeleteWebhook(ginContext)
+
+	c.Equal(http.StatusNotFound, w.Code)
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockWebhooksUsecase)
+	mockUsecase.On("GetDeliveries", mock.Anything, "WHK1", "accountID").Return([]*domain.Delivery{
+		{ID: "WHD1", WebhookID: "WHK1", EventType: eventsDomain.MovementCreated, Status: domain.DeliveryFailed, Attempts: domain.MaxAttempts, LastError: "timeout"},
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/webhooks/WHK1/deliveries", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "WHK1"}}
+
+	NewWebhookHandler(mockUsecase).GetDeliveries(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.WebhookDeliveryResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("failed", response[0].Status)
+	c.Equal("timeout", response[0].LastError)
+}
+
+func TestSendWebhookTestEvent(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockWebhooksUsecase)
+	mockUsecase.On("SendTestEvent", mock.Anything, "WHK1", "accountID").Return(&domain.Delivery{
+		ID:             "WHD1",
+		EventType:      eventsDomain.WebhookTest,
+		Status:         domain.DeliverySucceeded,
+		Attempts:       1,
+		ResponseStatus: http.StatusOK,
+	}, nil)
+
+	ginContext, w := setupTestContext(http.MethodPost, "/webhooks/WHK1/test", nil)
+	ginContext.Params = gin.Params{{Key: "id", Value: "WHK1"}}
+
+	NewWebhookHandler(mockUsecase).SendTestEvent(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+	c.Contains(w.Body.String(), `"event_type":"webhook.test"`)
+}
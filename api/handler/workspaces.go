@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"errors"
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/workspaces/domain"
+	"transaction-tracker/internal/workspaces/usecase"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WorkspaceHandler handles HTTP requests for the workspaces domain.
+type WorkspaceHandler struct {
+	workspacesUsecase usecase.WorkspacesUsecase
+}
+
+// NewWorkspaceHandler creates a new instance of WorkspaceHandler.
+func NewWorkspaceHandler(ucw usecase.WorkspacesUsecase) *WorkspaceHandler {
+	return &WorkspaceHandler{
+		workspacesUsecase: ucw,
+	}
+}
+
+// workspaceErrorResponse answers the errors caused by the request. It reports whether the
+// error was handled.
+func workspaceErrorResponse(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, usecase.ErrWorkspaceNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "workspace not found"})
+	case errors.Is(err, usecase.ErrMemberNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "member not found"})
+	case errors.Is(err, usecase.ErrInvitationNotFound):
+		models.NewResponseNotFound(c, models.Response{Message: "invitation not found"})
+	case errors.Is(err, usecase.ErrForbidden):
+		models.NewResponseForbidden(c, models.Response{Message: err.Error()})
+	case errors.Is(err, usecase.ErrAlreadyMember), errors.Is(err, usecase.ErrAlreadyInvited):
+		models.NewResponseConflict(c, models.Response{Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidWorkspace), errors.Is(err, domain.ErrInvalidRole), errors.Is(err, domain.ErrInvalidInvitation):
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// GetWorkspaces handles the GET /workspaces request. It returns the workspaces the account is
+// a member of with its role in each.
+func (h *WorkspaceHandler) GetWorkspaces(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	memberships, err := h.workspacesUsecase.GetWorkspaces(c.Request.Context(), account.ID)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_workspaces_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToWorkspaceResponses(memberships),
+	})
+}
+
+// CreateWorkspace handles the POST /workspaces request. The account becomes the owner of the
+// workspace.
+func (h *WorkspaceHandler) CreateWorkspace(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.CreateWorkspaceRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	workspace, err := h.workspacesUsecase.CreateWorkspace(c.Request.Context(), account, req.Name)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "create_workspace_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToWorkspaceResponse(workspace, domain.Owner),
+	})
+}
+
+// GetMembers handles the GET /workspaces/:id/members request.
+func (h *WorkspaceHandler) GetMembers(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	members, err := h.workspacesUsecase.GetMembers(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_workspace_members_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToMemberResponses(members),
+	})
+}
+
+// UpdateMember handles the PUT /workspaces/:id/members/:accountId request. Only the owner can
+// make a member an editor or a viewer.
+func (h *WorkspaceHandler) UpdateMember(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.UpdateMemberRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	role, err := domain.ParseRole(req.Role)
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	member, err := h.workspacesUsecase.UpdateMemberRole(c.Request.Context(), c.Param("id"), account.ID, c.Param("accountId"), role)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "update_workspace_member_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToMemberResponse(member),
+	})
+}
+
+// RemoveMember handles the DELETE /workspaces/:id/members/:accountId request. The owner can
+// remove any other member, and members can remove themselves to leave the workspace.
+func (h *WorkspaceHandler) RemoveMember(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.workspacesUsecase.RemoveMember(c.Request.Context(), c.Param("id"), account.ID, c.Param("accountId"))
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "remove_workspace_member_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "member removed successfully",
+	})
+}
+
+// GetInvitations handles the GET /workspaces/:id/invitations request.
+func (h *WorkspaceHandler) GetInvitations(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	invitations, err := h.workspacesUsecase.GetInvitations(c.Request.Context(), c.Param("id"), account.ID)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "get_workspace_invitations_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToInvitationResponses(invitations),
+	})
+}
+
+// Invite handles the POST /workspaces/:id/invitations request. It invites an email to join the
+// workspace as an editor or a viewer; the invitation is sent to it by email.
+func (h *WorkspaceHandler) Invite(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	var req models.InviteRequest
+	if err := c.ShouldBind(&req); err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "invalid_request_body",
+			Error: err,
+		})
+
+		models.NewResponseInvalidRequest(c, models.Response{Message: bindErrorMessage(err)})
+		return
+	}
+
+	role, err := domain.ParseRole(req.Role)
+	if err != nil {
+		models.NewResponseInvalidRequest(c, models.Response{Message: err.Error()})
+		return
+	}
+
+	invitation, err := h.workspacesUsecase.Invite(c.Request.Context(), c.Param("id"), account.ID, req.Email, role)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "invite_to_workspace_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseCreated(c, models.Response{
+		Data: models.ToInvitationResponse(invitation),
+	})
+}
+
+// RevokeInvitation handles the DELETE /workspaces/:id/invitations/:invitationId request.
+func (h *WorkspaceHandler) RevokeInvitation(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.workspacesUsecase.RevokeInvitation(c.Request.Context(), c.Param("id"), account.ID, c.Param("invitationId"))
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "revoke_workspace_invitation_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "invitation revoked successfully",
+	})
+}
+
+// GetPendingInvitations handles the GET /invitations request. It returns the invitations sent
+// to the email of the account that can still be answered.
+func (h *WorkspaceHandler) GetPendingInvitations(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	invitations, err := h.workspacesUsecase.GetPendingInvitations(c.Request.Context(), account)
+	if err != nil {
+		log.Error(loggerModels.LogProperties{
+			Event: "get_pending_invitations_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToInvitationResponses(invitations),
+	})
+}
+
+// AcceptInvitation handles the POST /invitations/:id/accept request. The account joins the
+// workspace with the role it was invited with.
+func (h *WorkspaceHandler) AcceptInvitation(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	member, err := h.workspacesUsecase.AcceptInvitation(c.Request.Context(), c.Param("id"), account)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "accept_workspace_invitation_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Data: models.ToMemberResponse(member),
+	})
+}
+
+// DeclineInvitation handles the POST /invitations/:id/decline request.
+func (h *WorkspaceHandler) DeclineInvitation(c *gin.Context) {
+	log, account, err := getContextDependencies(c)
+	if err != nil {
+		return
+	}
+
+	err = h.workspacesUsecase.DeclineInvitation(c.Request.Context(), c.Param("id"), account)
+	if err != nil {
+		if workspaceErrorResponse(c, err) {
+			return
+		}
+
+		log.Error(loggerModels.LogProperties{
+			Event: "decline_workspace_invitation_failed",
+			Error: err,
+		})
+
+		models.NewResponseInternalServerError(c)
+		return
+	}
+
+	models.NewResponseOK(c, models.Response{
+		Message: "invitation declined successfully",
+	})
+}
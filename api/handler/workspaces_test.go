@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/api/models"
+	"transaction-tracker/internal/workspaces/domain"
+	"transaction-tracker/internal/workspaces/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWorkspace() *domain.Workspace {
+	return &domain.Workspace{ID: "WSP1", Name: "Home", OwnerID: "accountID", CreatedAt: time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)}
+}
+
+func TestGetWorkspaces(t *testing.T) {
+	c := require.New(t)
+
+	mockUsecase := new(usecase.MockWorkspacesUsecase)
+	mockUsecase.On("GetWorkspaces", mock.Anything, "accountID").Return([]*domain.Membership{{Workspace: newWorkspace(), Role: domain.Editor}}, nil)
+
+	ginContext, w := setupTestContext(http.MethodGet, "/workspaces", nil)
+
+	NewWorkspaceHandler(mockUsecase).GetWorkspaces(ginContext)
+
+	c.Equal(http.StatusOK, w.Code)
+
+	var response []*models.WorkspaceResponse
+	c.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	c.Len(response, 1)
+	c.Equal("WSP1", response[0].ID)
+	c.Equal("editor", response[0].Role)
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "invalid name", err: domain.ErrInvalidWorkspace, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockWorkspacesUsecase)
+			if tt.err != nil {
+				mockUsecase.On("CreateWorkspace", mock.Anything, mock.Anything, "Home").Return(nil, tt.err)
+			} else {
+				mockUsecase.On("CreateWorkspace", mock.Anything, mock.Anything, "Home").Return(newWorkspace(), nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPost, "/workspaces", strings.NewReader(`{"name":"Home"}`))
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+
+			NewWorkspaceHandler(mockUsecase).CreateWorkspace(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateMember(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		err    error
+		status int
+	}{
+		{name: "success", body: `{"role":"viewer"}`, status: http.StatusOK},
+		{name: "owner role", body: `{"role":"owner"}`, status: http.StatusBadRequest},
+		{name: "not the owner", body: `{"role":"viewer"}`, err: usecase.ErrForbidden, status: http.StatusForbidden},
+		{name: "member not found", body: `{"role":"viewer"}`, err: usecase.ErrMemberNotFound, status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockWorkspacesUsecase)
+			if tt.status != http.StatusBadRequest {
+				member := &domain.Member{WorkspaceID: "WSP1", AccountID: "member", Role: domain.Viewer}
+				if tt.err != nil {
+					member = nil
+				}
+
+				mockUsecase.On("UpdateMemberRole", mock.Anything, "WSP1", "accountID", "member", domain.Viewer).Return(member, tt.err)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPut, "/workspaces/WSP1/members/member", strings.NewReader(tt.body))
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+			ginContext.Params = gin.Params{{Key: "id", Value: "WSP1"}, {Key: "accountId", Value: "member"}}
+
+			NewWorkspaceHandler(mockUsecase).UpdateMember(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvite(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusCreated},
+		{name: "invalid email", err: domain.ErrInvalidInvitation, status: http.StatusBadRequest},
+		{name: "viewer cannot invite", err: usecase.ErrForbidden, status: http.StatusForbidden},
+		{name: "already invited", err: usecase.ErrAlreadyInvited, status: http.StatusConflict},
+		{name: "already a member", err: usecase.ErrAlreadyMember, status: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockWorkspacesUsecase)
+			if tt.err != nil {
+				mockUsecase.On("Invite", mock.Anything, "WSP1", "accountID", "ana@mail.com", domain.Editor).Return(nil, tt.err)
+			} else {
+				invitation := &domain.Invitation{ID: "INV1", WorkspaceID: "WSP1", Email: "ana@mail.com", Role: domain.Editor, Status: domain.Pending}
+				mockUsecase.On("Invite", mock.Anything, "WSP1", "accountID", "ana@mail.com", domain.Editor).Return(invitation, nil)
+			}
+
+			body := strings.NewReader(`{"email":"ana@mail.com","role":"editor"}`)
+
+			ginContext, w := setupTestContext(http.MethodPost, "/workspaces/WSP1/invitations", body)
+			ginContext.Request.Header.Set("Content-Type", "application/json")
+			ginContext.Params = gin.Params{{Key: "id", Value: "WSP1"}}
+
+			NewWorkspaceHandler(mockUsecase).Invite(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "invitation not found", err: usecase.ErrInvitationNotFound, status: http.StatusNotFound},
+		{name: "expired", err: domain.ErrInvalidInvitation, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			mockUsecase := new(usecase.MockWorkspacesUsecase)
+			if tt.err != nil {
+				mockUsecase.On("AcceptInvitation", mock.Anything, "INV1", mock.Anything).Return(nil, tt.err)
+			} else {
+				member := &domain.Member{WorkspaceID: "WSP1", AccountID: "accountID", Role: domain.Editor}
+				mockUsecase.On("AcceptInvitation", mock.Anything, "INV1", mock.Anything).Return(member, nil)
+			}
+
+			ginContext, w := setupTestContext(http.MethodPost, "/invitations/INV1/accept", nil)
+			ginContext.Params = gin.Params{{Key: "id", Value: "INV1"}}
+
+			NewWorkspaceHandler(mockUsecase).AcceptInvitation(ginContext)
+
+			c.Equal(tt.status, w.Code)
+			mockUsecase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/anomalies/domain"
+)
+
+type AnomalyResponse struct {
+	ID          string     `json:"id"`
+	MovementID  string     `json:"movement_id"`
+	Kind        string     `json:"kind"`
+	Reason      string     `json:"reason"`
+	Amount      float64    `json:"amount"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DismissedAt *time.Time `json:"dismissed_at,omitempty"`
+}
+
+func ToAnomalyResponse(a *domain.Anomaly) *AnomalyResponse {
+	return &AnomalyResponse{
+		ID:          a.ID,
+		MovementID:  a.MovementID,
+		Kind:        string(a.Kind),
+		Reason:      a.Reason,
+		Amount:      a.Amount,
+		Status:      string(a.Status),
+		CreatedAt:   a.CreatedAt,
+		DismissedAt: a.DismissedAt,
+	}
+}
+
+func ToAnomalyResponses(anomalies []*domain.Anomaly) []*AnomalyResponse {
+	responses := make([]*AnomalyResponse, 0, len(anomalies))
+	for _, a := range anomalies {
+		responses = append(responses, ToAnomalyResponse(a))
+	}
+
+	return responses
+}
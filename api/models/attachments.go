@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/attachments/domain"
+)
+
+type AttachmentResponse struct {
+	ID          string    `json:"id"`
+	MovementID  string    `json:"movement_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func ToAttachmentResponse(a *domain.Attachment) *AttachmentResponse {
+	return &AttachmentResponse{
+		ID:          a.ID,
+		MovementID:  a.MovementID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func ToAttachmentResponses(attachments []*domain.Attachment) []*AttachmentResponse {
+	responses := make([]*AttachmentResponse, 0, len(attachments))
+	for _, a := range attachments {
+		responses = append(responses, ToAttachmentResponse(a))
+	}
+
+	return responses
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/budgets/domain"
+)
+
+type CreateBudgetRequest struct {
+	Category string  `form:"category" json:"category" binding:"required"`
+	Month    string  `form:"month" json:"month" binding:"required"`
+	Amount   float64 `form:"amount" json:"amount" binding:"required"`
+	Rollover bool    `form:"rollover" json:"rollover"`
+}
+
+type UpdateBudgetRequest struct {
+	Amount   float64 `form:"amount" json:"amount" binding:"required"`
+	Rollover bool    `form:"rollover" json:"rollover"`
+}
+
+type BudgetResponse struct {
+	ID        string    `json:"id"`
+	Category  string    `json:"category"`
+	Month     string    `json:"month"`
+	Amount    float64   `json:"amount"`
+	Rollover  bool      `json:"rollover"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BudgetProgressResponse struct {
+	*BudgetResponse
+	RolledOver float64 `json:"rolled_over"`
+	Limit      float64 `json:"limit"`
+	Spent      float64 `json:"spent"`
+	Remaining  float64 `json:"remaining"`
+	Percent    float64 `json:"percent"`
+	Thresholds []int   `json:"thresholds_reached"`
+}
+
+func ToBudgetResponse(budget *domain.Budget) *BudgetResponse {
+	return &BudgetResponse{
+		ID:        budget.ID,
+		Category:  string(budget.Category),
+		Month:     budget.Month.Format(domain.MonthLayout),
+		Amount:    budget.Amount,
+		Rollover:  budget.Rollover,
+		CreatedAt: budget.CreatedAt,
+		UpdatedAt: budget.UpdatedAt,
+	}
+}
+
+func ToBudgetProgressResponses(progress []*domain.Progress) []*BudgetProgressResponse {
+	responses := make([]*BudgetProgressResponse, 0, len(progress))
+	for _, p := range progress {
+		responses = append(responses, &BudgetProgressResponse{
+			BudgetResponse: ToBudgetResponse(p.Budget),
+			RolledOver:     p.Rollover,
+			Limit:          p.Limit,
+			Spent:          p.Spent,
+			Remaining:      p.Remaining,
+			Percent:        p.Percent,
+			Thresholds:     p.Reached(),
+		})
+	}
+
+	return responses
+}
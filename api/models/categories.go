@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/categories/domain"
+)
+
+type CreateCategoryRequest struct {
+	Name     string `form:"name" json:"name" binding:"required"`
+	Slug     string `form:"slug" json:"slug"`
+	ParentID string `form:"parent_id" json:"parent_id"`
+	Icon     string `form:"icon" json:"icon"`
+	Color    string `form:"color" json:"color"`
+}
+
+type UpdateCategoryRequest struct {
+	Name     string `form:"name" json:"name" binding:"required"`
+	ParentID string `form:"parent_id" json:"parent_id"`
+	Icon     string `form:"icon" json:"icon"`
+	Color    string `form:"color" json:"color"`
+}
+
+type CategoryResponse struct {
+	ID        string              `json:"id"`
+	Slug      string              `json:"slug"`
+	Name      string              `json:"name"`
+	ParentID  string              `json:"parent_id,omitempty"`
+	Icon      string              `json:"icon"`
+	Color     string              `json:"color"`
+	System    bool                `json:"system"`
+	Children  []*CategoryResponse `json:"children,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// ApplyUpdateCategoryRequest copies the request into the category. Icon and color are only
+// changed when sent.
+func ApplyUpdateCategoryRequest(category *domain.Category, req UpdateCategoryRequest) {
+	category.Name = req.Name
+	category.ParentID = req.ParentID
+
+	if req.Icon != "" {
+		category.Icon = req.Icon
+	}
+
+	if req.Color != "" {
+		category.Color = req.Color
+	}
+}
+
+func ToCategoryResponse(category *domain.Category) *CategoryResponse {
+	return &CategoryResponse{
+		ID:        category.ID,
+		Slug:      string(category.Slug),
+		Name:      category.Name,
+		ParentID:  category.ParentID,
+		Icon:      category.Icon,
+		Color:     category.Color,
+		System:    category.System,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+// ToCategoryTreeResponse maps the categories as root categories with their subcategories.
+func ToCategoryTreeResponse(categories []*domain.Category) []*CategoryResponse {
+	tree := domain.BuildTree(categories)
+
+	responses := make([]*CategoryResponse, 0, len(tree))
+	for _, node := range tree {
+		response := ToCategoryResponse(node.Category)
+		response.Children = make([]*CategoryResponse, 0, len(node.Children))
+
+		for _, child := range node.Children {
+			response.Children = append(response.Children, ToCategoryResponse(child))
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
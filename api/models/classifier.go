@@ -0,0 +1,31 @@
+package models
+
+import "transaction-tracker/internal/movements/classifier"
+
+type ClassifierMetricsResponse struct {
+	Requests      int64   `json:"requests"`
+	CacheHits     int64   `json:"cache_hits"`
+	CacheMisses   int64   `json:"cache_misses"`
+	HitRate       float64 `json:"hit_rate"`
+	Calls         int64   `json:"calls"`
+	Failures      int64   `json:"failures"`
+	ShortCircuits int64   `json:"short_circuits"`
+	AvgLatencyMs  float64 `json:"avg_latency_ms"`
+	MaxLatencyMs  float64 `json:"max_latency_ms"`
+	CircuitState  string  `json:"circuit_state"`
+}
+
+func ToClassifierMetricsResponse(snapshot classifier.MetricsSnapshot) *ClassifierMetricsResponse {
+	return &ClassifierMetricsResponse{
+		Requests:      snapshot.Requests,
+		CacheHits:     snapshot.CacheHits,
+		CacheMisses:   snapshot.CacheMisses,
+		HitRate:       snapshot.HitRate,
+		Calls:         snapshot.Calls,
+		Failures:      snapshot.Failures,
+		ShortCircuits: snapshot.ShortCircuits,
+		AvgLatencyMs:  snapshot.AvgLatencyMs,
+		MaxLatencyMs:  snapshot.MaxLatencyMs,
+		CircuitState:  string(snapshot.CircuitState),
+	}
+}
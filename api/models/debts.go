@@ -0,0 +1,138 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/debts/domain"
+)
+
+type CreateContactRequest struct {
+	Name string `form:"name" json:"name" binding:"required"`
+}
+
+type CreateDebtRequest struct {
+	ContactID  string  `form:"contact_id" json:"contact_id" binding:"required"`
+	MovementID string  `form:"movement_id" json:"movement_id" binding:"required"`
+	SplitID    string  `form:"split_id" json:"split_id"`
+	Amount     float64 `form:"amount" json:"amount"`
+	Note       string  `form:"note" json:"note"`
+}
+
+type CreateSettlementRequest struct {
+	ContactID  string  `form:"contact_id" json:"contact_id" binding:"required"`
+	MovementID string  `form:"movement_id" json:"movement_id" binding:"required"`
+	Amount     float64 `form:"amount" json:"amount"`
+}
+
+type ContactResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DebtResponse struct {
+	ID         string    `json:"id"`
+	ContactID  string    `json:"contact_id"`
+	MovementID string    `json:"movement_id"`
+	SplitID    string    `json:"split_id,omitempty"`
+	Amount     float64   `json:"amount"`
+	Note       string    `json:"note,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SettlementResponse struct {
+	ID         string    `json:"id"`
+	ContactID  string    `json:"contact_id"`
+	MovementID string    `json:"movement_id"`
+	Amount     float64   `json:"amount"`
+	Source     string    `json:"source"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ContactBalanceResponse struct {
+	ContactID   string  `json:"contact_id"`
+	Name        string  `json:"name"`
+	Owed        float64 `json:"owed"`
+	Paid        float64 `json:"paid"`
+	Outstanding float64 `json:"outstanding"`
+}
+
+type DebtSummaryResponse struct {
+	Outstanding float64                   `json:"outstanding"`
+	Contacts    []*ContactBalanceResponse `json:"contacts"`
+}
+
+func ToContactResponse(c *domain.Contact) *ContactResponse {
+	return &ContactResponse{
+		ID:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func ToContactResponses(contacts []*domain.Contact) []*ContactResponse {
+	responses := make([]*ContactResponse, 0, len(contacts))
+	for _, c := range contacts {
+		responses = append(responses, ToContactResponse(c))
+	}
+
+	return responses
+}
+
+func ToDebtResponse(d *domain.Debt) *DebtResponse {
+	return &DebtResponse{
+		ID:         d.ID,
+		ContactID:  d.ContactID,
+		MovementID: d.MovementID,
+		SplitID:    d.SplitID,
+		Amount:     d.Amount,
+		Note:       d.Note,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func ToDebtResponses(debts []*domain.Debt) []*DebtResponse {
+	responses := make([]*DebtResponse, 0, len(debts))
+	for _, d := range debts {
+		responses = append(responses, ToDebtResponse(d))
+	}
+
+	return responses
+}
+
+func ToSettlementResponse(s *domain.Settlement) *SettlementResponse {
+	return &SettlementResponse{
+		ID:         s.ID,
+		ContactID:  s.ContactID,
+		MovementID: s.MovementID,
+		Amount:     s.Amount,
+		Source:     string(s.Source),
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func ToSettlementResponses(settlements []*domain.Settlement) []*SettlementResponse {
+	responses := make([]*SettlementResponse, 0, len(settlements))
+	for _, s := range settlements {
+		responses = append(responses, ToSettlementResponse(s))
+	}
+
+	return responses
+}
+
+func ToDebtSummaryResponse(s *domain.Summary) *DebtSummaryResponse {
+	contacts := make([]*ContactBalanceResponse, 0, len(s.Balances))
+	for _, b := range s.Balances {
+		contacts = append(contacts, &ContactBalanceResponse{
+			ContactID:   b.Contact.ID,
+			Name:        b.Contact.Name,
+			Owed:        b.Owed,
+			Paid:        b.Paid,
+			Outstanding: b.Outstanding(),
+		})
+	}
+
+	return &DebtSummaryResponse{
+		Outstanding: s.Outstanding,
+		Contacts:    contacts,
+	}
+}
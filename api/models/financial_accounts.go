@@ -0,0 +1,107 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/financialaccounts/domain"
+)
+
+type CreateFinancialAccountRequest struct {
+	InstitutionID string `form:"institution_id" json:"institution_id" binding:"required"`
+	Name          string `form:"name" json:"name" binding:"required"`
+	Kind          string `form:"kind" json:"kind" binding:"required"`
+}
+
+type UpdateFinancialAccountRequest struct {
+	Name string `form:"name" json:"name" binding:"required"`
+	Kind string `form:"kind" json:"kind" binding:"required"`
+}
+
+type CreateAnchorRequest struct {
+	Date    string   `form:"date" json:"date" binding:"required"`
+	Balance *float64 `form:"balance" json:"balance" binding:"required"`
+}
+
+type FinancialAccountResponse struct {
+	ID            string    `json:"id"`
+	InstitutionID string    `json:"institution_id"`
+	Name          string    `json:"name"`
+	Kind          string    `json:"kind"`
+	Balance       *float64  `json:"balance,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type AnchorResponse struct {
+	FinancialAccountID string  `json:"financial_account_id"`
+	Date               string  `json:"date"`
+	Balance            float64 `json:"balance"`
+	Source             string  `json:"source"`
+}
+
+type BalanceResponse struct {
+	FinancialAccountID string  `json:"financial_account_id"`
+	Balance            float64 `json:"balance"`
+}
+
+type NetWorthPointResponse struct {
+	Period      string             `json:"period"`
+	Date        string             `json:"date"`
+	NetWorth    float64            `json:"net_worth"`
+	Assets      float64            `json:"assets"`
+	Liabilities float64            `json:"liabilities"`
+	Balances    []*BalanceResponse `json:"balances"`
+}
+
+func ToFinancialAccountResponse(account *domain.FinancialAccount) *FinancialAccountResponse {
+	return &FinancialAccountResponse{
+		ID:            account.ID,
+		InstitutionID: account.InstitutionID,
+		Name:          account.Name,
+		Kind:          string(account.Kind),
+		CreatedAt:     account.CreatedAt,
+		UpdatedAt:     account.UpdatedAt,
+	}
+}
+
+func ToFinancialAccountResponses(summaries []*domain.Summary) []*FinancialAccountResponse {
+	responses := make([]*FinancialAccountResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		response := ToFinancialAccountResponse(summary.FinancialAccount)
+		response.Balance = &summary.Balance
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+// ToAnchorResponse writes the last day the balance of the anchor counts, the one it was
+// entered with.
+func ToAnchorResponse(anchor *domain.Anchor) *AnchorResponse {
+	return &AnchorResponse{
+		FinancialAccountID: anchor.FinancialAccountID,
+		Date:               anchor.Date.AddDate(0, 0, -1).Format(domain.DateLayout),
+		Balance:            anchor.Balance,
+		Source:             string(anchor.Source),
+	}
+}
+
+func ToNetWorthResponses(points []*domain.NetWorthPoint) []*NetWorthPointResponse {
+	responses := make([]*NetWorthPointResponse, 0, len(points))
+	for _, point := range points {
+		balances := make([]*BalanceResponse, 0, len(point.Balances))
+		for _, balance := range point.Balances {
+			balances = append(balances, &BalanceResponse{FinancialAccountID: balance.FinancialAccountID, Balance: balance.Balance})
+		}
+
+		responses = append(responses, &NetWorthPointResponse{
+			Period:      point.Period.Format(domain.DateLayout),
+			Date:        point.Date.Format(domain.DateLayout),
+			NetWorth:    point.NetWorth,
+			Assets:      point.Assets,
+			Liabilities: point.Liabilities,
+			Balances:    balances,
+		})
+	}
+
+	return responses
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/goals/domain"
+)
+
+type CreateGoalRequest struct {
+	Name         string  `form:"name" json:"name" binding:"required"`
+	TargetAmount float64 `form:"target_amount" json:"target_amount" binding:"required"`
+	TargetDate   string  `form:"target_date" json:"target_date" binding:"required"`
+	Category     string  `form:"category" json:"category"`
+}
+
+type UpdateGoalRequest CreateGoalRequest
+
+type AllocateMovementRequest struct {
+	MovementID string `form:"movement_id" json:"movement_id" binding:"required"`
+}
+
+type GoalResponse struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	TargetAmount float64   `json:"target_amount"`
+	TargetDate   string    `json:"target_date"`
+	Category     string    `json:"category,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type GoalProgressResponse struct {
+	*GoalResponse
+	Saved           float64 `json:"saved"`
+	Remaining       float64 `json:"remaining"`
+	Percent         float64 `json:"percent"`
+	AverageMonthly  float64 `json:"average_monthly"`
+	RequiredMonthly float64 `json:"required_monthly"`
+	ProjectedAt     string  `json:"projected_at,omitempty"`
+	Completed       bool    `json:"completed"`
+	OnTrack         bool    `json:"on_track"`
+}
+
+func ToGoalResponse(goal *domain.Goal) *GoalResponse {
+	return &GoalResponse{
+		ID:           goal.ID,
+		Name:         goal.Name,
+		TargetAmount: goal.TargetAmount,
+		TargetDate:   goal.TargetDate.Format(domain.DateLayout),
+		Category:     string(goal.Category),
+		CreatedAt:    goal.CreatedAt,
+		UpdatedAt:    goal.UpdatedAt,
+	}
+}
+
+func ToGoalProgressResponse(progress *domain.Progress) *GoalProgressResponse {
+	response := &GoalProgressResponse{
+		GoalResponse:    ToGoalResponse(progress.Goal),
+		Saved:           progress.Saved,
+		Remaining:       progress.Remaining,
+		Percent:         progress.Percent,
+		AverageMonthly:  progress.AverageMonthly,
+		RequiredMonthly: progress.RequiredMonthly,
+		Completed:       progress.Completed,
+		OnTrack:         progress.OnTrack,
+	}
+
+	if progress.ProjectedAt != nil {
+		response.ProjectedAt = progress.ProjectedAt.Format(domain.DateLayout)
+	}
+
+	return response
+}
+
+func ToGoalProgressResponses(progress []*domain.Progress) []*GoalProgressResponse {
+	responses := make([]*GoalProgressResponse, 0, len(progress))
+	for _, p := range progress {
+		responses = append(responses, ToGoalProgressResponse(p))
+	}
+
+	return responses
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/merchants/domain"
+)
+
+type MerchantResponse struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Aliases        []string   `json:"aliases"`
+	Movements      int        `json:"movements"`
+	Spent          float64    `json:"spent"`
+	Received       float64    `json:"received"`
+	LastMovementAt *time.Time `json:"last_movement_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func ToMerchantResponse(summary *domain.Summary) *MerchantResponse {
+	return &MerchantResponse{
+		ID:             summary.Merchant.ID,
+		Name:           summary.Merchant.Name,
+		Aliases:        summary.Merchant.Aliases,
+		Movements:      summary.Movements,
+		Spent:          summary.Spent,
+		Received:       summary.Received,
+		LastMovementAt: summary.LastMovementAt,
+		CreatedAt:      summary.Merchant.CreatedAt,
+	}
+}
+
+func ToMerchantResponses(summaries []*domain.Summary) []*MerchantResponse {
+	responses := make([]*MerchantResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		responses = append(responses, ToMerchantResponse(summary))
+	}
+
+	return responses
+}
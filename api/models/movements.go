@@ -6,27 +6,60 @@ import (
 )
 
 type CreateMovementRequest struct {
-	InstitutionID string                  `form:"institution_id" `
-	Type          domain.MovementType     `form:"type" binding:"required"`
-	Amount        float64                 `form:"amount" binding:"required"`
-	Date          time.Time               `form:"date" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
-	Category      domain.MovementCategory `form:"category" binding:"required"`
-	Description   string                  `form:"description"`
-	AccountID     string                  `form:"-"`
+	InstitutionID      string                  `form:"institution_id" `
+	Type               domain.MovementType     `form:"type" binding:"required"`
+	Amount             float64                 `form:"amount" binding:"required"`
+	Date               time.Time               `form:"date" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	Category           domain.MovementCategory `form:"category" binding:"required"`
+	Description        string                  `form:"description"`
+	FinancialAccountID string                  `form:"financial_account_id"`
+	Tags               []string                `form:"tags"`
+	Note               string                  `form:"note"`
+	AccountID          string                  `form:"-"`
+}
+
+type UpdateMovementRequest struct {
+	InstitutionID      string                  `form:"institution_id"`
+	Type               domain.MovementType     `form:"type" binding:"required"`
+	Amount             float64                 `form:"amount" binding:"required"`
+	Date               time.Time               `form:"date" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	Category           domain.MovementCategory `form:"category" binding:"required"`
+	Description        string                  `form:"description"`
+	FinancialAccountID string                  `form:"financial_account_id"`
+	Tags               []string                `form:"tags"`
+	Note               string                  `form:"note"`
+}
+
+type SplitRequest struct {
+	Category domain.MovementCategory `json:"category" binding:"required"`
+	Amount   float64                 `json:"amount" binding:"required"`
+	Note     string                  `json:"note"`
+}
+
+type SetSplitsRequest struct {
+	Splits []SplitRequest `json:"splits" binding:"dive"`
 }
 
 type MovementResponse struct {
-	ID             string    `json:"id"`
-	AccountID      string    `json:"accountId"`
-	InstitutionID  string    `json:"institutionId,omitempty"`
-	MessageID      string    `json:"message_id,omitempty"`
-	NotificationID string    `json:"notification_id,omitempty"`
-	Description    string    `json:"description,omitempty"`
-	Amount         float64   `json:"amount"`
-	Type           string    `json:"type"`
-	Date           time.Time `json:"date"`
-	Source         string    `json:"source,omitempty"`
-	Category       string    `json:"category,omitempty"`
+	ID                 string    `json:"id"`
+	AccountID          string    `json:"accountId"`
+	InstitutionID      string    `json:"institutionId,omitempty"`
+	MessageID          string    `json:"message_id,omitempty"`
+	NotificationID     string    `json:"notification_id,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	MerchantID         string    `json:"merchant_id,omitempty"`
+	FinancialAccountID string    `json:"financial_account_id,omitempty"`
+	TransferID         string    `json:"transfer_id,omitempty"`
+	Tags               []string  `json:"tags"`
+	Note               string    `json:"note,omitempty"`
+	CreatedBy          string    `json:"created_by,omitempty"`
+	Amount             float64   `json:"amount"`
+	Type               string    `json:"type"`
+	Date               time.Time `json:"date"`
+	Source             string    `json:"source,omitempty"`
+	Category           string    `json:"category,omitempty"`
+	CategoryConfidence float64   `json:"category_confidence"`
+	CategorySource     string    `json:"category_source,omitempty"`
 }
 
 type MovementsListResponse struct {
@@ -65,7 +98,7 @@ type MovementIncomeOutcomeByDay struct {
 }
 
 func ToDomainMovement(req CreateMovementRequest) *domain.Movement {
-	return domain.NewMovement(
+	movement := domain.NewMovement(
 		req.AccountID,
 		"",
 		"",
@@ -77,22 +110,62 @@ func ToDomainMovement(req CreateMovementRequest) *domain.Movement {
 		req.Date,
 		domain.ManualSource,
 	)
+
+	movement.FinancialAccountID = req.FinancialAccountID
+	movement.Tags = req.Tags
+	movement.Note = req.Note
+
+	return movement
+}
+
+// UpdateRequestToDomainMovement builds the movement to update from the request fields.
+func UpdateRequestToDomainMovement(id string, accountID string, req UpdateMovementRequest) *domain.Movement {
+	return &domain.Movement{
+		ID:                 id,
+		AccountID:          accountID,
+		InstitutionID:      req.InstitutionID,
+		Description:        req.Description,
+		Amount:             req.Amount,
+		Type:               req.Type,
+		Date:               req.Date,
+		Category:           req.Category,
+		FinancialAccountID: req.FinancialAccountID,
+		Tags:               req.Tags,
+		Note:               req.Note,
+	}
 }
 
 // ToMovementResponse converts a single domain.Movement to an API MovementResponse.
 func ToMovementResponse(m *domain.Movement) *MovementResponse {
 	return &MovementResponse{
-		ID:            m.ID,
-		AccountID:     m.AccountID,
-		InstitutionID: m.InstitutionID,
-		MessageID:     m.MessageID,
-		Description:   m.Description,
-		Amount:        m.Amount,
-		Type:          string(m.Type),
-		Date:          m.Date,
-		Source:        string(m.Source),
-		Category:      string(m.Category),
+		ID:                 m.ID,
+		AccountID:          m.AccountID,
+		InstitutionID:      m.InstitutionID,
+		MessageID:          m.MessageID,
+		Description:        m.Description,
+		MerchantID:         m.MerchantID,
+		FinancialAccountID: m.FinancialAccountID,
+		TransferID:         m.TransferID,
+		Tags:               tagsOrEmpty(m.Tags),
+		Note:               m.Note,
+		CreatedBy:          m.CreatedBy,
+		Amount:             m.Amount,
+		Type:               string(m.Type),
+		Date:               m.Date,
+		Source:             string(m.Source),
+		Category:           string(m.Category),
+		CategoryConfidence: m.CategoryConfidence,
+		CategorySource:     m.CategorySource,
+	}
+}
+
+// tagsOrEmpty returns tags, or no tags instead of nil so they are listed as [].
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
 	}
+
+	return tags
 }
 
 // ToMovementResponses converts a slice of domain.Movement to a slice of API MovementResponse.
@@ -107,3 +180,39 @@ func ToMovementResponses(movements []*domain.Movement) []*MovementResponse {
 	}
 	return response
 }
+
+type SplitResponse struct {
+	ID       string  `json:"id"`
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
+	Note     string  `json:"note,omitempty"`
+}
+
+// ToDomainSplits converts the requested splits of a movement to domain.Split.
+func ToDomainSplits(req SetSplitsRequest) []*domain.Split {
+	splits := make([]*domain.Split, 0, len(req.Splits))
+	for _, split := range req.Splits {
+		splits = append(splits, &domain.Split{
+			Category: split.Category,
+			Amount:   split.Amount,
+			Note:     split.Note,
+		})
+	}
+
+	return splits
+}
+
+// ToSplitResponses converts a slice of domain.Split to a slice of API SplitResponse.
+func ToSplitResponses(splits []*domain.Split) []*SplitResponse {
+	response := make([]*SplitResponse, 0, len(splits))
+	for _, s := range splits {
+		response = append(response, &SplitResponse{
+			ID:       s.ID,
+			Category: string(s.Category),
+			Amount:   s.Amount,
+			Note:     s.Note,
+		})
+	}
+
+	return response
+}
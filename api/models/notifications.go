@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// PubSubPushMessage is the message wrapped by a Pub/Sub push request. Data is sent
+// base64 encoded and decoded automatically when unmarshalled into a byte slice.
+type PubSubPushMessage struct {
+	ID          string            `json:"messageId"`
+	Data        []byte            `json:"data"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	PublishTime time.Time         `json:"publishTime"`
+}
+
+// PubSubPushRequest is the envelope Pub/Sub posts to push endpoints.
+type PubSubPushRequest struct {
+	Message      PubSubPushMessage `json:"message"`
+	Subscription string            `json:"subscription"`
+}
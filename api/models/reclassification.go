@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/reclassification/domain"
+)
+
+// CreateReclassificationJobRequest selects the movements of a job. Dates use the
+// YYYY-MM-DD layout and both ends are inclusive.
+type CreateReclassificationJobRequest struct {
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Categories  []string `json:"categories"`
+	OnlyUnknown bool     `json:"only_unknown"`
+}
+
+type ReclassificationFilterResponse struct {
+	From        *time.Time `json:"from,omitempty"`
+	To          *time.Time `json:"to,omitempty"`
+	Categories  []string   `json:"categories,omitempty"`
+	OnlyUnknown bool       `json:"only_unknown"`
+}
+
+type ReclassificationJobResponse struct {
+	ID         string                         `json:"id"`
+	Filter     ReclassificationFilterResponse `json:"filter"`
+	Status     string                         `json:"status"`
+	Total      int                            `json:"total"`
+	Processed  int                            `json:"processed"`
+	Changed    int                            `json:"changed"`
+	Skipped    int                            `json:"skipped"`
+	Failed     int                            `json:"failed"`
+	Progress   float64                        `json:"progress"`
+	Error      string                         `json:"error,omitempty"`
+	StartedAt  *time.Time                     `json:"started_at,omitempty"`
+	FinishedAt *time.Time                     `json:"finished_at,omitempty"`
+	CreatedAt  time.Time                      `json:"created_at"`
+	UpdatedAt  time.Time                      `json:"updated_at"`
+}
+
+// ToReclassificationFilter parses the filter of the request.
+func ToReclassificationFilter(req CreateReclassificationJobRequest) (domain.Filter, error) {
+	return domain.NewFilter(req.From, req.To, req.Categories, req.OnlyUnknown)
+}
+
+func ToReclassificationJobResponse(job *domain.Job) *ReclassificationJobResponse {
+	categories := make([]string, 0, len(job.Filter.Categories))
+	for _, category := range job.Filter.Categories {
+		categories = append(categories, string(category))
+	}
+
+	return &ReclassificationJobResponse{
+		ID: job.ID,
+		Filter: ReclassificationFilterResponse{
+			From:        job.Filter.From,
+			To:          job.Filter.To,
+			Categories:  categories,
+			OnlyUnknown: job.Filter.OnlyUnknown,
+		},
+		Status:     string(job.Status),
+		Total:      job.Total,
+		Processed:  job.Processed,
+		Changed:    job.Changed,
+		Skipped:    job.Skipped,
+		Failed:     job.Failed,
+		Progress:   job.Progress(),
+		Error:      job.Error,
+		StartedAt:  job.StartedAt,
+		FinishedAt: job.FinishedAt,
+		CreatedAt:  job.CreatedAt,
+		UpdatedAt:  job.UpdatedAt,
+	}
+}
+
+func ToReclassificationJobResponses(jobs []*domain.Job) []*ReclassificationJobResponse {
+	responses := make([]*ReclassificationJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, ToReclassificationJobResponse(job))
+	}
+
+	return responses
+}
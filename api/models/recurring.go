@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/recurring/domain"
+)
+
+type RecurrenceResponse struct {
+	ID             string    `json:"id"`
+	MerchantID     string    `json:"merchant_id"`
+	Name           string    `json:"name"`
+	Period         string    `json:"period"`
+	Amount         float64   `json:"amount"`
+	PreviousAmount float64   `json:"previous_amount,omitempty"`
+	PriceChanged   bool      `json:"price_changed"`
+	Occurrences    int       `json:"occurrences"`
+	FirstChargeAt  time.Time `json:"first_charge_at"`
+	LastChargeAt   time.Time `json:"last_charge_at"`
+	NextChargeAt   time.Time `json:"next_charge_at"`
+	Status         string    `json:"status"`
+}
+
+func ToRecurrenceResponse(recurrence *domain.Recurrence) *RecurrenceResponse {
+	return &RecurrenceResponse{
+		ID:             recurrence.ID,
+		MerchantID:     recurrence.MerchantID,
+		Name:           recurrence.Name,
+		Period:         string(recurrence.Period),
+		Amount:         recurrence.Amount,
+		PreviousAmount: recurrence.PreviousAmount,
+		PriceChanged:   recurrence.PriceChanged(),
+		Occurrences:    recurrence.Occurrences,
+		FirstChargeAt:  recurrence.FirstChargeAt,
+		LastChargeAt:   recurrence.LastChargeAt,
+		NextChargeAt:   recurrence.NextChargeAt,
+		Status:         string(recurrence.Status),
+	}
+}
+
+func ToRecurrenceResponses(recurrences []*domain.Recurrence) []*RecurrenceResponse {
+	responses := make([]*RecurrenceResponse, 0, len(recurrences))
+	for _, recurrence := range recurrences {
+		responses = append(responses, ToRecurrenceResponse(recurrence))
+	}
+
+	return responses
+}
@@ -0,0 +1,260 @@
+package models
+
+import (
+	"transaction-tracker/internal/reports/domain"
+)
+
+type CategorySpendResponse struct {
+	Category       string   `json:"category"`
+	Amount         float64  `json:"amount"`
+	Share          float64  `json:"share"`
+	PreviousAmount float64  `json:"previous_amount"`
+	Change         *float64 `json:"change"`
+}
+
+type CategoryTotalResponse struct {
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
+	Share    float64 `json:"share"`
+}
+
+type ReportPeriodResponse struct {
+	Start      string                   `json:"start"`
+	Total      float64                  `json:"total"`
+	Categories []*CategorySpendResponse `json:"categories"`
+}
+
+type CategoryReportResponse struct {
+	From        string                   `json:"from"`
+	To          string                   `json:"to"`
+	Granularity string                   `json:"granularity"`
+	Total       float64                  `json:"total"`
+	Categories  []*CategoryTotalResponse `json:"categories"`
+	Periods     []*ReportPeriodResponse  `json:"periods"`
+}
+
+// ToCategoryReportResponse writes the range of the report with both dates included.
+func ToCategoryReportResponse(report *domain.CategoryReport) *CategoryReportResponse {
+	response := &CategoryReportResponse{
+		From:        report.From.Format(domain.DateLayout),
+		To:          report.To.AddDate(0, 0, -1).Format(domain.DateLayout),
+		Granularity: string(report.Granularity),
+		Total:       report.Total,
+		Categories:  make([]*CategoryTotalResponse, 0, len(report.Categories)),
+		Periods:     make([]*ReportPeriodResponse, 0, len(report.Periods)),
+	}
+
+	for _, category := range report.Categories {
+		response.Categories = append(response.Categories, &CategoryTotalResponse{
+			Category: string(category.Category),
+			Amount:   category.Amount,
+			Share:    category.Share,
+		})
+	}
+
+	for _, period := range report.Periods {
+		p := &ReportPeriodResponse{
+			Start:      period.Start.Format(domain.DateLayout),
+			Total:      period.Total,
+			Categories: make([]*CategorySpendResponse, 0, len(period.Categories)),
+		}
+
+		for _, category := range period.Categories {
+			p.Categories = append(p.Categories, &CategorySpendResponse{
+				Category:       string(category.Category),
+				Amount:         category.Amount,
+				Share:          category.Share,
+				PreviousAmount: category.PreviousAmount,
+				Change:         category.Change,
+			})
+		}
+
+		response.Periods = append(response.Periods, p)
+	}
+
+	return response
+}
+
+type ForecastChargeResponse struct {
+	Name   string  `json:"name"`
+	Type   string  `json:"type"`
+	Period string  `json:"period"`
+	Amount float64 `json:"amount"`
+}
+
+type ForecastDayResponse struct {
+	Date     string                    `json:"date"`
+	Balance  float64                   `json:"balance"`
+	Lower    float64                   `json:"lower"`
+	Upper    float64                   `json:"upper"`
+	Income   float64                   `json:"income"`
+	Expenses float64                   `json:"expenses"`
+	Charges  []*ForecastChargeResponse `json:"charges"`
+}
+
+type DiscretionarySpendResponse struct {
+	Category     string  `json:"category"`
+	DailyAverage float64 `json:"daily_average"`
+}
+
+type ForecastResponse struct {
+	StartBalance    float64                       `json:"start_balance"`
+	EndBalance      float64                       `json:"end_balance"`
+	MinBalance      float64                       `json:"min_balance"`
+	MinBalanceAt    string                        `json:"min_balance_at"`
+	FirstNegativeAt string                        `json:"first_negative_at,omitempty"`
+	Discretionary   []*DiscretionarySpendResponse `json:"discretionary"`
+	Days            []*ForecastDayResponse        `json:"days"`
+}
+
+func ToForecastResponse(forecast *domain.Forecast) *ForecastResponse {
+	response := &ForecastResponse{
+		StartBalance:  forecast.StartBalance,
+		EndBalance:    forecast.EndBalance,
+		MinBalance:    forecast.MinBalance,
+		MinBalanceAt:  forecast.MinBalanceAt.Format(domain.DateLayout),
+		Discretionary: make([]*DiscretionarySpendResponse, 0, len(forecast.Discretionary)),
+		Days:          make([]*ForecastDayResponse, 0, len(forecast.Days)),
+	}
+
+	if forecast.FirstNegativeAt != nil {
+		response.FirstNegativeAt = forecast.FirstNegativeAt.Format(domain.DateLayout)
+	}
+
+	for _, spend := range forecast.Discretionary {
+		response.Discretionary = append(response.Discretionary, &DiscretionarySpendResponse{
+			Category:     string(spend.Category),
+			DailyAverage: spend.DailyAverage,
+		})
+	}
+
+	for _, day := range forecast.Days {
+		d := &ForecastDayResponse{
+			Date:     day.Date.Format(domain.DateLayout),
+			Balance:  day.Balance,
+			Lower:    day.Lower,
+			Upper:    day.Upper,
+			Income:   day.Income,
+			Expenses: day.Expenses,
+			Charges:  make([]*ForecastChargeResponse, 0, len(day.Charges)),
+		}
+
+		for _, charge := range day.Charges {
+			d.Charges = append(d.Charges, &ForecastChargeResponse{
+				Name:   charge.Name,
+				Type:   string(charge.Type),
+				Period: string(charge.Period),
+				Amount: charge.Amount,
+			})
+		}
+
+		response.Days = append(response.Days, d)
+	}
+
+	return response
+}
+
+type TagTotalResponse struct {
+	Tag      string  `json:"tag"`
+	Income   float64 `json:"income"`
+	Expenses float64 `json:"expenses"`
+	Net      float64 `json:"net"`
+	Count    int     `json:"count"`
+}
+
+type TagReportResponse struct {
+	From string              `json:"from"`
+	To   string              `json:"to"`
+	Tags []*TagTotalResponse `json:"tags"`
+}
+
+// ToTagReportResponse writes the range of the report with both dates included.
+func ToTagReportResponse(report *domain.TagReport) *TagReportResponse {
+	response := &TagReportResponse{
+		From: report.From.Format(domain.DateLayout),
+		To:   report.To.AddDate(0, 0, -1).Format(domain.DateLayout),
+		Tags: make([]*TagTotalResponse, 0, len(report.Tags)),
+	}
+
+	for _, tag := range report.Tags {
+		response.Tags = append(response.Tags, &TagTotalResponse{
+			Tag:      tag.Tag,
+			Income:   tag.Income,
+			Expenses: tag.Expenses,
+			Net:      tag.Net,
+			Count:    tag.Count,
+		})
+	}
+
+	return response
+}
+
+type TaxItemResponse struct {
+	Concept string  `json:"concept"`
+	Amount  float64 `json:"amount"`
+}
+
+type TaxAccountBalanceResponse struct {
+	Name    string  `json:"name"`
+	Kind    string  `json:"kind"`
+	Balance float64 `json:"balance"`
+}
+
+type TaxInstitutionBalanceResponse struct {
+	InstitutionID string                       `json:"institution_id"`
+	Balance       float64                      `json:"balance"`
+	Accounts      []*TaxAccountBalanceResponse `json:"accounts"`
+}
+
+type TaxReportResponse struct {
+	Year            int                              `json:"year"`
+	Income          []*TaxItemResponse               `json:"income"`
+	TotalIncome     float64                          `json:"total_income"`
+	Deductions      []*TaxItemResponse               `json:"deductions"`
+	TotalDeductions float64                          `json:"total_deductions"`
+	BalanceDate     string                           `json:"balance_date"`
+	Balances        []*TaxInstitutionBalanceResponse `json:"balances"`
+	TotalBalance    float64                          `json:"total_balance"`
+}
+
+func ToTaxReportResponse(report *domain.TaxReport) *TaxReportResponse {
+	response := &TaxReportResponse{
+		Year:            report.Year,
+		Income:          toTaxItemResponses(report.Income),
+		TotalIncome:     report.TotalIncome,
+		Deductions:      toTaxItemResponses(report.Deductions),
+		TotalDeductions: report.TotalDeductions,
+		BalanceDate:     report.BalanceDate.Format(domain.DateLayout),
+		Balances:        make([]*TaxInstitutionBalanceResponse, 0, len(report.Balances)),
+		TotalBalance:    report.TotalBalance,
+	}
+
+	for _, institution := range report.Balances {
+		b := &TaxInstitutionBalanceResponse{
+			InstitutionID: institution.InstitutionID,
+			Balance:       institution.Balance,
+			Accounts:      make([]*TaxAccountBalanceResponse, 0, len(institution.Accounts)),
+		}
+
+		for _, account := range institution.Accounts {
+			b.Accounts = append(b.Accounts, &TaxAccountBalanceResponse{
+				Name:    account.Name,
+				Kind:    account.Kind,
+				Balance: account.Balance,
+			})
+		}
+
+		response.Balances = append(response.Balances, b)
+	}
+
+	return response
+}
+
+func toTaxItemResponses(items []*domain.TaxItem) []*TaxItemResponse {
+	responses := make([]*TaxItemResponse, 0, len(items))
+	for _, item := range items {
+		responses = append(responses, &TaxItemResponse{Concept: item.Concept, Amount: item.Amount})
+	}
+
+	return responses
+}
@@ -31,6 +31,14 @@ func NewResponseCreated(c *gin.Context, response Response) {
 	c.JSON(http.StatusCreated, response.DataOrMessage())
 }
 
+func NewResponseAccepted(c *gin.Context, response Response) {
+	c.JSON(http.StatusAccepted, response.DataOrMessage())
+}
+
+func NewResponseNoContent(c *gin.Context) {
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
 func NewResponseInternalServerError(c *gin.Context) {
 	response := Response{Message: "something was wrong, please try again"}
 
@@ -48,3 +56,11 @@ func NewResponseInvalidRequest(c *gin.Context, response Response) {
 func NewResponseUnauthorized(c *gin.Context, response Response) {
 	c.AbortWithStatusJSON(http.StatusUnauthorized, response.DataOrMessage())
 }
+
+func NewResponseForbidden(c *gin.Context, response Response) {
+	c.AbortWithStatusJSON(http.StatusForbidden, response.DataOrMessage())
+}
+
+func NewResponseConflict(c *gin.Context, response Response) {
+	c.JSON(http.StatusConflict, response.DataOrMessage())
+}
@@ -9,13 +9,17 @@ type Method string
 const (
 	GET    Method = "GET"
 	POST   Method = "POST"
+	PUT    Method = "PUT"
 	DELETE Method = "DELETE"
 )
 
+// Route is an endpoint of the API. Shared routes act on the ledger of the workspace in the
+// WorkspaceHeader when the request has one.
 type Route struct {
 	Method         Method
 	Endpoint       string
 	HandlerFunc    gin.HandlerFunc
 	ApiVersion     string
 	NoRequiresAuth bool
+	Shared         bool
 }
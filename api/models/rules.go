@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+)
+
+type RuleConditionRequest struct {
+	Field    string `json:"field" binding:"required"`
+	Operator string `json:"operator" binding:"required"`
+	Value    string `json:"value" binding:"required"`
+}
+
+type CreateRuleRequest struct {
+	Name       string                 `json:"name" binding:"required"`
+	Priority   int                    `json:"priority"`
+	Category   string                 `json:"category" binding:"required"`
+	Conditions []RuleConditionRequest `json:"conditions" binding:"required,dive"`
+}
+
+type UpdateRuleRequest struct {
+	Name       string                 `json:"name" binding:"required"`
+	Priority   int                    `json:"priority"`
+	Category   string                 `json:"category" binding:"required"`
+	Conditions []RuleConditionRequest `json:"conditions" binding:"required,dive"`
+	Active     *bool                  `json:"active"`
+}
+
+type RuleConditionResponse struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+type RuleResponse struct {
+	ID         string                  `json:"id"`
+	Name       string                  `json:"name"`
+	Priority   int                     `json:"priority"`
+	Category   string                  `json:"category"`
+	Conditions []RuleConditionResponse `json:"conditions"`
+	Active     bool                    `json:"active"`
+	CreatedAt  time.Time               `json:"created_at"`
+	UpdatedAt  time.Time               `json:"updated_at"`
+}
+
+type RuleChangeResponse struct {
+	MovementID  string `json:"movement_id"`
+	Description string `json:"description"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+type RulePreviewResponse struct {
+	Matched int                  `json:"matched"`
+	Changed int                  `json:"changed"`
+	Changes []RuleChangeResponse `json:"changes"`
+}
+
+func toConditions(req []RuleConditionRequest) []domain.Condition {
+	conditions := make([]domain.Condition, 0, len(req))
+	for _, c := range req {
+		conditions = append(conditions, domain.Condition{
+			Field:    domain.ConditionField(c.Field),
+			Operator: domain.Operator(c.Operator),
+			Value:    c.Value,
+		})
+	}
+
+	return conditions
+}
+
+// ToRule builds a new rule of the account from the request.
+func ToRule(accountID string, req CreateRuleRequest) (*domain.Rule, error) {
+	return domain.NewRule(accountID, req.Name, req.Priority, movementsDomain.MovementCategory(req.Category), toConditions(req.Conditions))
+}
+
+// ApplyUpdateRuleRequest copies the request into the rule. Active is only changed when sent.
+func ApplyUpdateRuleRequest(rule *domain.Rule, req UpdateRuleRequest) {
+	rule.Name = req.Name
+	rule.Priority = req.Priority
+	rule.Category = movementsDomain.MovementCategory(req.Category)
+	rule.Conditions = toConditions(req.Conditions)
+
+	if req.Active != nil {
+		rule.Active = *req.Active
+	}
+}
+
+func ToRuleResponse(rule *domain.Rule) *RuleResponse {
+	conditions := make([]RuleConditionResponse, 0, len(rule.Conditions))
+	for _, c := range rule.Conditions {
+		conditions = append(conditions, RuleConditionResponse{
+			Field:    string(c.Field),
+			Operator: string(c.Operator),
+			Value:    c.Value,
+		})
+	}
+
+	return &RuleResponse{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		Priority:   rule.Priority,
+		Category:   string(rule.Category),
+		Conditions: conditions,
+		Active:     rule.Active,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+func ToRuleResponses(rules []*domain.Rule) []*RuleResponse {
+	responses := make([]*RuleResponse, 0, len(rules))
+	for _, r := range rules {
+		responses = append(responses, ToRuleResponse(r))
+	}
+
+	return responses
+}
+
+func ToRulePreviewResponse(preview *domain.Preview) *RulePreviewResponse {
+	changes := make([]RuleChangeResponse, 0, len(preview.Changes))
+	for _, c := range preview.Changes {
+		changes = append(changes, RuleChangeResponse{
+			MovementID:  c.MovementID,
+			Description: c.Description,
+			From:        string(c.From),
+			To:          string(c.To),
+		})
+	}
+
+	return &RulePreviewResponse{
+		Matched: preview.Matched,
+		Changed: preview.Changed,
+		Changes: changes,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"transaction-tracker/internal/accounts/usecase"
+	workspacesUsecase "transaction-tracker/internal/workspaces/usecase"
 	"transaction-tracker/logger"
 	loggerModels "transaction-tracker/logger/models"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// WorkspaceHeader is the header a request names the workspace it acts on with.
+const WorkspaceHeader = "X-Workspace-ID"
+
 type Server struct {
 	Port           string
 	accountUsecase usecase.AccountsUsecase
@@ -27,7 +31,7 @@ func NewServer(accountUsecase usecase.AccountsUsecase, port int) *Server {
 	engine.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:8080", "http://localhost:4321"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "Last-Event-ID", WorkspaceHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 	}))
@@ -91,6 +95,20 @@ func (s *Server) AuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+// WorkspaceMiddleware makes the request act on the ledger of the workspace in the
+// WorkspaceHeader, when it has one. The account stays the one making the request; the usecases
+// check its membership and role in the workspace.
+func WorkspaceMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		workspaceID := c.GetHeader(WorkspaceHeader)
+		if workspaceID != "" {
+			c.Request = c.Request.WithContext(workspacesUsecase.WithWorkspace(c.Request.Context(), workspaceID))
+		}
+
+		c.Next()
+	}
+}
+
 func InitLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		log, err := logger.GetLogger(c, "transaction-tracker")
@@ -128,10 +146,13 @@ func (s *Server) AddRoutes(routes []Route) {
 	for _, r := range routes {
 		groupPublic := api.Group(r.ApiVersion)
 		groupPrivate := api.Group(r.ApiVersion, s.AuthMiddleware())
+		groupShared := api.Group(r.ApiVersion, s.AuthMiddleware(), WorkspaceMiddleware())
 
 		group := groupPrivate
 		if r.NoRequiresAuth {
 			group = groupPublic
+		} else if r.Shared {
+			group = groupShared
 		}
 
 		group.Handle(string(r.Method), r.Endpoint, r.HandlerFunc)
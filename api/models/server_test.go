@@ -0,0 +1,50 @@
+package models
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	"transaction-tracker/internal/workspaces/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func runWorkspaceMiddleware(workspaceID string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	c.Request, _ = http.NewRequest(http.MethodGet, "/v1/movements", nil)
+	if workspaceID != "" {
+		c.Request.Header.Set(WorkspaceHeader, workspaceID)
+	}
+
+	c.Set("account", &accountsDomain.Account{ID: "accountID", Email: "ana@mail.com"})
+
+	WorkspaceMiddleware()(c)
+
+	return c
+}
+
+func TestWorkspaceMiddleware(t *testing.T) {
+	t.Run("without header", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx := runWorkspaceMiddleware("")
+
+		c.Empty(usecase.WorkspaceFromContext(ctx.Request.Context()))
+		c.Equal("accountID", ctx.MustGet("account").(*accountsDomain.Account).ID)
+	})
+
+	t.Run("keeps the account and names the workspace", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx := runWorkspaceMiddleware("WSP1")
+
+		c.False(ctx.IsAborted())
+		c.Equal("WSP1", usecase.WorkspaceFromContext(ctx.Request.Context()))
+		c.Equal("accountID", ctx.MustGet("account").(*accountsDomain.Account).ID)
+	})
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/transfers/domain"
+)
+
+type CreateTransferRequest struct {
+	OutflowMovementID string `form:"outflow_movement_id" json:"outflow_movement_id" binding:"required"`
+	InflowMovementID  string `form:"inflow_movement_id" json:"inflow_movement_id" binding:"required"`
+}
+
+type TransferResponse struct {
+	ID                string    `json:"id"`
+	OutflowMovementID string    `json:"outflow_movement_id"`
+	InflowMovementID  string    `json:"inflow_movement_id"`
+	Amount            float64   `json:"amount"`
+	Source            string    `json:"source"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func ToTransferResponse(t *domain.Transfer) *TransferResponse {
+	return &TransferResponse{
+		ID:                t.ID,
+		OutflowMovementID: t.OutflowID,
+		InflowMovementID:  t.InflowID,
+		Amount:            t.Amount,
+		Source:            string(t.Source),
+		CreatedAt:         t.CreatedAt,
+	}
+}
+
+func ToTransferResponses(transfers []*domain.Transfer) []*TransferResponse {
+	responses := make([]*TransferResponse, 0, len(transfers))
+	for _, t := range transfers {
+		responses = append(responses, ToTransferResponse(t))
+	}
+
+	return responses
+}
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/webhooks/domain"
+)
+
+type CreateWebhookRequest struct {
+	URL        string   `form:"url" json:"url" binding:"required"`
+	EventTypes []string `form:"event_types" json:"event_types"`
+}
+
+type UpdateWebhookRequest struct {
+	URL        string   `form:"url" json:"url" binding:"required"`
+	EventTypes []string `form:"event_types" json:"event_types"`
+	Active     *bool    `form:"active" json:"active"`
+}
+
+type WebhookResponse struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Active     bool      `json:"active"`
+	Secret     string    `json:"secret,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhook_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ApplyUpdateWebhookRequest copies the request into the webhook. Active is only changed when sent.
+func ApplyUpdateWebhookRequest(webhook *domain.Webhook, req UpdateWebhookRequest) error {
+	eventTypes, err := domain.ParseEventTypes(req.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	webhook.URL = req.URL
+	webhook.EventTypes = eventTypes
+
+	if req.Active != nil {
+		webhook.Active = *req.Active
+	}
+
+	return nil
+}
+
+// ToWebhookResponse maps a webhook without its secret, which is only shown on creation.
+func ToWebhookResponse(webhook *domain.Webhook) *WebhookResponse {
+	eventTypes := make([]string, 0, len(webhook.EventTypes))
+	for _, t := range webhook.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	return &WebhookResponse{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		EventTypes: eventTypes,
+		Active:     webhook.Active,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+}
+
+func ToWebhookResponses(webhooks []*domain.Webhook) []*WebhookResponse {
+	responses := make([]*WebhookResponse, 0, len(webhooks))
+	for _, w := range webhooks {
+		responses = append(responses, ToWebhookResponse(w))
+	}
+
+	return responses
+}
+
+func ToWebhookDeliveryResponse(delivery *domain.Delivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func ToWebhookDeliveryResponses(deliveries []*domain.Delivery) []*WebhookDeliveryResponse {
+	responses := make([]*WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		responses = append(responses, ToWebhookDeliveryResponse(d))
+	}
+
+	return responses
+}
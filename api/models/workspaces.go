@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+	"transaction-tracker/internal/workspaces/domain"
+)
+
+type CreateWorkspaceRequest struct {
+	Name string `form:"name" json:"name" binding:"required"`
+}
+
+type InviteRequest struct {
+	Email string `form:"email" json:"email" binding:"required"`
+	Role  string `form:"role" json:"role" binding:"required"`
+}
+
+type UpdateMemberRequest struct {
+	Role string `form:"role" json:"role" binding:"required"`
+}
+
+type WorkspaceResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	OwnerID   string    `json:"owner_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberResponse struct {
+	AccountID string    `json:"account_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+type InvitationResponse struct {
+	ID          string    `json:"id"`
+	WorkspaceID string    `json:"workspace_id"`
+	Email       string    `json:"email"`
+	Role        string    `json:"role"`
+	InvitedBy   string    `json:"invited_by"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func ToWorkspaceResponse(w *domain.Workspace, role domain.Role) *WorkspaceResponse {
+	return &WorkspaceResponse{
+		ID:        w.ID,
+		Name:      w.Name,
+		OwnerID:   w.OwnerID,
+		Role:      string(role),
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func ToWorkspaceResponses(memberships []*domain.Membership) []*WorkspaceResponse {
+	responses := make([]*WorkspaceResponse, 0, len(memberships))
+	for _, m := range memberships {
+		responses = append(responses, ToWorkspaceResponse(m.Workspace, m.Role))
+	}
+
+	return responses
+}
+
+func ToMemberResponse(m *domain.Member) *MemberResponse {
+	return &MemberResponse{
+		AccountID: m.AccountID,
+		Email:     m.Email,
+		Role:      string(m.Role),
+		JoinedAt:  m.JoinedAt,
+	}
+}
+
+func ToMemberResponses(members []*domain.Member) []*MemberResponse {
+	responses := make([]*MemberResponse, 0, len(members))
+	for _, m := range members {
+		responses = append(responses, ToMemberResponse(m))
+	}
+
+	return responses
+}
+
+func ToInvitationResponse(i *domain.Invitation) *InvitationResponse {
+	return &InvitationResponse{
+		ID:          i.ID,
+		WorkspaceID: i.WorkspaceID,
+		Email:       i.Email,
+		Role:        string(i.Role),
+		InvitedBy:   i.InvitedBy,
+		Status:      string(i.Status),
+		CreatedAt:   i.CreatedAt,
+		ExpiresAt:   i.ExpiresAt,
+	}
+}
+
+func ToInvitationResponses(invitations []*domain.Invitation) []*InvitationResponse {
+	responses := make([]*InvitationResponse, 0, len(invitations))
+	for _, i := range invitations {
+		responses = append(responses, ToInvitationResponse(i))
+	}
+
+	return responses
+}
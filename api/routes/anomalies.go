@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func AnomaliesRoutes(h *handler.AnomalyHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/anomalies",
+			Method:      models.GET,
+			HandlerFunc: h.GetAnomalies,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/anomalies/:id/dismiss",
+			Method:      models.POST,
+			HandlerFunc: h.DismissAnomaly,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func AttachmentsRoutes(h *handler.AttachmentHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/movements/:id/attachments",
+			Method:      models.GET,
+			HandlerFunc: h.GetAttachments,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/movements/:id/attachments",
+			Method:      models.POST,
+			HandlerFunc: h.AddAttachment,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/movements/:id/attachments/:attachmentId",
+			Method:      models.GET,
+			HandlerFunc: h.GetAttachment,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/movements/:id/attachments/:attachmentId",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteAttachment,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
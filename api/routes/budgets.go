@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func BudgetsRoutes(h *handler.BudgetHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/budgets",
+			Method:      models.GET,
+			HandlerFunc: h.GetBudgets,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/budgets",
+			Method:      models.POST,
+			HandlerFunc: h.CreateBudget,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/budgets/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetBudgetByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/budgets/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateBudget,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/budgets/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteBudget,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
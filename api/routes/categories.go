@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func CategoriesRoutes(h *handler.CategoryHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/categories",
+			Method:      models.GET,
+			HandlerFunc: h.GetCategories,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/categories",
+			Method:      models.POST,
+			HandlerFunc: h.CreateCategory,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/categories/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetCategoryByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/categories/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateCategory,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/categories/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteCategory,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func ClassifierRoutes(h *handler.ClassifierHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/classifier/metrics",
+			Method:      models.GET,
+			HandlerFunc: h.GetMetrics,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
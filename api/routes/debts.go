@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func DebtsRoutes(h *handler.DebtHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/contacts",
+			Method:      models.GET,
+			HandlerFunc: h.GetContacts,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/contacts",
+			Method:      models.POST,
+			HandlerFunc: h.CreateContact,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/contacts/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteContact,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/debts",
+			Method:      models.GET,
+			HandlerFunc: h.GetDebts,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/debts",
+			Method:      models.POST,
+			HandlerFunc: h.CreateDebt,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/debts/summary",
+			Method:      models.GET,
+			HandlerFunc: h.GetDebtSummary,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/debts/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteDebt,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/settlements",
+			Method:      models.GET,
+			HandlerFunc: h.GetSettlements,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/settlements",
+			Method:      models.POST,
+			HandlerFunc: h.CreateSettlement,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/settlements/detect",
+			Method:      models.POST,
+			HandlerFunc: h.DetectSettlements,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/settlements/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteSettlement,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func FeedbackRoutes(h *handler.FeedbackHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/feedback/dataset",
+			Method:      models.GET,
+			HandlerFunc: h.ExportDataset,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
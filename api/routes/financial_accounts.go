@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func FinancialAccountsRoutes(h *handler.FinancialAccountHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/financial-accounts",
+			Method:      models.GET,
+			HandlerFunc: h.GetFinancialAccounts,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/financial-accounts",
+			Method:      models.POST,
+			HandlerFunc: h.CreateFinancialAccount,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/financial-accounts/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetFinancialAccountByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/financial-accounts/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateFinancialAccount,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/financial-accounts/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteFinancialAccount,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/financial-accounts/:id/anchors",
+			Method:      models.POST,
+			HandlerFunc: h.CreateAnchor,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/net-worth",
+			Method:      models.GET,
+			HandlerFunc: h.GetNetWorth,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
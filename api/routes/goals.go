@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func GoalsRoutes(h *handler.GoalHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/goals",
+			Method:      models.GET,
+			HandlerFunc: h.GetGoals,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals",
+			Method:      models.POST,
+			HandlerFunc: h.CreateGoal,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetGoalByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateGoal,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteGoal,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id/progress",
+			Method:      models.GET,
+			HandlerFunc: h.GetGoalProgress,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id/movements",
+			Method:      models.POST,
+			HandlerFunc: h.AllocateMovement,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/goals/:id/movements/:movement_id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeallocateMovement,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
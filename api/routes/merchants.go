@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func MerchantsRoutes(h *handler.MerchantHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/merchants",
+			Method:      models.GET,
+			HandlerFunc: h.GetMerchants,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -25,12 +25,36 @@ func MovementsRoutes(h *handler.MovementHandler) []models.Route {
 			HandlerFunc: h.CreateMovement,
 			ApiVersion:  API_VERSION,
 		},
+		{
+			Endpoint:    "/movements/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateMovement,
+			ApiVersion:  API_VERSION,
+		},
 		{
 			Endpoint:    "/movements/:id",
 			Method:      models.DELETE,
 			HandlerFunc: h.DeleteMovement,
 			ApiVersion:  API_VERSION,
 		},
+		{
+			Endpoint:    "/movements/:id/splits",
+			Method:      models.GET,
+			HandlerFunc: h.GetSplits,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/movements/:id/splits",
+			Method:      models.PUT,
+			HandlerFunc: h.SetSplits,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/movements/:id/splits",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteSplits,
+			ApiVersion:  API_VERSION,
+		},
 		{
 			Endpoint:    "/movements/years/:year",
 			Method:      models.GET,
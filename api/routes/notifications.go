@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func NotificationsRoutes(h *handler.NotificationHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:       "/notifications/gmail",
+			Method:         models.POST,
+			HandlerFunc:    h.GmailPush,
+			ApiVersion:     API_VERSION,
+			NoRequiresAuth: true,
+		},
+	}
+}
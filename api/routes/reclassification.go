@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func ReclassificationRoutes(h *handler.ReclassificationHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/reclassification-jobs",
+			Method:      models.GET,
+			HandlerFunc: h.GetJobs,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/reclassification-jobs",
+			Method:      models.POST,
+			HandlerFunc: h.CreateJob,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/reclassification-jobs/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetJobByID,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
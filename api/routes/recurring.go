@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func RecurringRoutes(h *handler.RecurringHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/recurring",
+			Method:      models.GET,
+			HandlerFunc: h.GetRecurrences,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func ReportsRoutes(h *handler.ReportHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/reports/categories",
+			Method:      models.GET,
+			HandlerFunc: h.GetCategoryReport,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/reports/forecast",
+			Method:      models.GET,
+			HandlerFunc: h.GetForecast,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/reports/tags",
+			Method:      models.GET,
+			HandlerFunc: h.GetTagReport,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/reports/tax",
+			Method:      models.GET,
+			HandlerFunc: h.GetTaxReport,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
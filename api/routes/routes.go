@@ -11,10 +11,29 @@ const (
 
 // Routes holds all the application handlers.
 type RouteHandler struct {
-	AccountHandler  *handler.AccountHandler
-	MessageHandler  *handler.MessageHandler
-	ExtractHandler  *handler.ExtractsHandler
-	MovementHandler *handler.MovementHandler
+	AccountHandler          *handler.AccountHandler
+	MessageHandler          *handler.MessageHandler
+	ExtractHandler          *handler.ExtractsHandler
+	MovementHandler         *handler.MovementHandler
+	NotificationHandler     *handler.NotificationHandler
+	WebhookHandler          *handler.WebhookHandler
+	StreamHandler           *handler.StreamHandler
+	RuleHandler             *handler.RuleHandler
+	CategoryHandler         *handler.CategoryHandler
+	FeedbackHandler         *handler.FeedbackHandler
+	ReclassificationHandler *handler.ReclassificationHandler
+	ClassifierHandler       *handler.ClassifierHandler
+	MerchantHandler         *handler.MerchantHandler
+	RecurringHandler        *handler.RecurringHandler
+	BudgetHandler           *handler.BudgetHandler
+	GoalHandler             *handler.GoalHandler
+	ReportHandler           *handler.ReportHandler
+	FinancialAccountHandler *handler.FinancialAccountHandler
+	TransferHandler         *handler.TransferHandler
+	AttachmentHandler       *handler.AttachmentHandler
+	WorkspaceHandler        *handler.WorkspaceHandler
+	DebtHandler             *handler.DebtHandler
+	AnomalyHandler          *handler.AnomalyHandler
 }
 
 func (r *RouteHandler) Routes() []models.Route {
@@ -23,7 +42,37 @@ func (r *RouteHandler) Routes() []models.Route {
 	routes = append(routes, AccountRoutes(r.AccountHandler)...)
 	routes = append(routes, MessagesRoutes(r.MessageHandler)...)
 	routes = append(routes, ExtractsRoutes(r.ExtractHandler)...)
-	routes = append(routes, MovementsRoutes(r.MovementHandler)...)
+	routes = append(routes, shared(MovementsRoutes(r.MovementHandler))...)
+	routes = append(routes, NotificationsRoutes(r.NotificationHandler)...)
+	routes = append(routes, WebhooksRoutes(r.WebhookHandler)...)
+	routes = append(routes, StreamRoutes(r.StreamHandler)...)
+	routes = append(routes, RulesRoutes(r.RuleHandler)...)
+	routes = append(routes, CategoriesRoutes(r.CategoryHandler)...)
+	routes = append(routes, FeedbackRoutes(r.FeedbackHandler)...)
+	routes = append(routes, ReclassificationRoutes(r.ReclassificationHandler)...)
+	routes = append(routes, ClassifierRoutes(r.ClassifierHandler)...)
+	routes = append(routes, MerchantsRoutes(r.MerchantHandler)...)
+	routes = append(routes, RecurringRoutes(r.RecurringHandler)...)
+	routes = append(routes, BudgetsRoutes(r.BudgetHandler)...)
+	routes = append(routes, GoalsRoutes(r.GoalHandler)...)
+	routes = append(routes, ReportsRoutes(r.ReportHandler)...)
+	routes = append(routes, FinancialAccountsRoutes(r.FinancialAccountHandler)...)
+	routes = append(routes, TransfersRoutes(r.TransferHandler)...)
+	routes = append(routes, AttachmentsRoutes(r.AttachmentHandler)...)
+
+	routes = append(routes, WorkspacesRoutes(r.WorkspaceHandler)...)
+	routes = append(routes, DebtsRoutes(r.DebtHandler)...)
+	routes = append(routes, AnomaliesRoutes(r.AnomalyHandler)...)
+
+	return routes
+}
+
+// shared marks the routes that work on the movements of a ledger, so they can be scoped to a
+// workspace with the X-Workspace-ID header.
+func shared(routes []models.Route) []models.Route {
+	for i := range routes {
+		routes[i].Shared = true
+	}
 
 	return routes
 }
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func RulesRoutes(h *handler.RuleHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/rules",
+			Method:      models.GET,
+			HandlerFunc: h.GetRules,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules",
+			Method:      models.POST,
+			HandlerFunc: h.CreateRule,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetRuleByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateRule,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteRule,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules/:id/preview",
+			Method:      models.GET,
+			HandlerFunc: h.PreviewRule,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/rules/:id/apply",
+			Method:      models.POST,
+			HandlerFunc: h.ApplyRule,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
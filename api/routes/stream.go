@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func StreamRoutes(h *handler.StreamHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/movements/stream",
+			Method:      models.GET,
+			HandlerFunc: h.StreamMovements,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func TransfersRoutes(h *handler.TransferHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/transfers",
+			Method:      models.GET,
+			HandlerFunc: h.GetTransfers,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/transfers",
+			Method:      models.POST,
+			HandlerFunc: h.CreateTransfer,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/transfers/detect",
+			Method:      models.POST,
+			HandlerFunc: h.DetectTransfers,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/transfers/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteTransfer,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
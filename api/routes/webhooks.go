@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func WebhooksRoutes(h *handler.WebhookHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/webhooks",
+			Method:      models.GET,
+			HandlerFunc: h.GetWebhooks,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks",
+			Method:      models.POST,
+			HandlerFunc: h.CreateWebhook,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks/:id",
+			Method:      models.GET,
+			HandlerFunc: h.GetWebhookByID,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks/:id",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateWebhook,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks/:id",
+			Method:      models.DELETE,
+			HandlerFunc: h.DeleteWebhook,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks/:id/deliveries",
+			Method:      models.GET,
+			HandlerFunc: h.GetDeliveries,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/webhooks/:id/test",
+			Method:      models.POST,
+			HandlerFunc: h.SendTestEvent,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
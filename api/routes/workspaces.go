@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"transaction-tracker/api/handler"
+	"transaction-tracker/api/models"
+)
+
+func WorkspacesRoutes(h *handler.WorkspaceHandler) []models.Route {
+	return []models.Route{
+		{
+			Endpoint:    "/workspaces",
+			Method:      models.GET,
+			HandlerFunc: h.GetWorkspaces,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces",
+			Method:      models.POST,
+			HandlerFunc: h.CreateWorkspace,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/members",
+			Method:      models.GET,
+			HandlerFunc: h.GetMembers,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/members/:accountId",
+			Method:      models.PUT,
+			HandlerFunc: h.UpdateMember,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/members/:accountId",
+			Method:      models.DELETE,
+			HandlerFunc: h.RemoveMember,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/invitations",
+			Method:      models.GET,
+			HandlerFunc: h.GetInvitations,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/invitations",
+			Method:      models.POST,
+			HandlerFunc: h.Invite,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/workspaces/:id/invitations/:invitationId",
+			Method:      models.DELETE,
+			HandlerFunc: h.RevokeInvitation,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/invitations",
+			Method:      models.GET,
+			HandlerFunc: h.GetPendingInvitations,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/invitations/:id/accept",
+			Method:      models.POST,
+			HandlerFunc: h.AcceptInvitation,
+			ApiVersion:  API_VERSION,
+		},
+		{
+			Endpoint:    "/invitations/:id/decline",
+			Method:      models.POST,
+			HandlerFunc: h.DeclineInvitation,
+			ApiVersion:  API_VERSION,
+		},
+	}
+}
@@ -3,18 +3,42 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 	"transaction-tracker/api/handler"
 	"transaction-tracker/api/models"
 	"transaction-tracker/api/routes"
+	"transaction-tracker/cmd/internal/setup"
 	accountRepository "transaction-tracker/internal/accounts/repository"
 	accountUsecase "transaction-tracker/internal/accounts/usecase"
+	attachmentRepository "transaction-tracker/internal/attachments/repository"
+	attachmentUsecase "transaction-tracker/internal/attachments/usecase"
+	debtRepository "transaction-tracker/internal/debts/repository"
+	debtUsecase "transaction-tracker/internal/debts/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventRepository "transaction-tracker/internal/events/repository"
+	eventUsecase "transaction-tracker/internal/events/usecase"
 	extractRepostory "transaction-tracker/internal/extracts/repository"
 	extractUsecase "transaction-tracker/internal/extracts/usecase"
+	goalRepository "transaction-tracker/internal/goals/repository"
+	goalUsecase "transaction-tracker/internal/goals/usecase"
 	messageRepository "transaction-tracker/internal/messages/repository"
 	messageUsecase "transaction-tracker/internal/messages/usecase"
-	movementRepostiroy "transaction-tracker/internal/movements/repository"
-	movementUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/movements/classifier"
+	notificationUsecase "transaction-tracker/internal/notifications/usecase"
+	reclassificationRepository "transaction-tracker/internal/reclassification/repository"
+	reclassificationUsecase "transaction-tracker/internal/reclassification/usecase"
+	recurringRepository "transaction-tracker/internal/recurring/repository"
+	recurringUsecase "transaction-tracker/internal/recurring/usecase"
+	reportRepository "transaction-tracker/internal/reports/repository"
+	reportUsecase "transaction-tracker/internal/reports/usecase"
+	ruleUsecase "transaction-tracker/internal/rules/usecase"
+	transferRepository "transaction-tracker/internal/transfers/repository"
+	transferUsecase "transaction-tracker/internal/transfers/usecase"
+	webhookRepository "transaction-tracker/internal/webhooks/repository"
+	webhookUsecase "transaction-tracker/internal/webhooks/usecase"
 	"transaction-tracker/pkg/databases/mongo"
+	"transaction-tracker/pkg/eventbus"
+	"transaction-tracker/pkg/files"
 	"transaction-tracker/pkg/google"
 
 	"transaction-tracker/pkg/databases/postgres"
@@ -52,9 +76,119 @@ func main() {
 		log.Fatal("Unable to get account collection:", err)
 	}
 
-	movementRepo := movementRepostiroy.NewPostgresRepository(dbClient.GetPool())
-	movementUsecase := movementUsecase.NewMovementUsecase(ctx, movementRepo)
-	movementHandler := handler.NewMovementHandler(movementUsecase)
+	bus, err := eventbus.New(ctx, eventbus.ConfigFromEnv())
+	if err != nil {
+		log.Fatal("Unable to create event bus:", err)
+	}
+
+	defer bus.Close()
+
+	transactor := postgres.NewTransactor(dbClient.GetPool())
+
+	eventRepo := eventRepository.NewPostgresRepository(dbClient.GetPool())
+	relayInterval := eventUsecase.DefaultRelayInterval
+	eventsTopic := os.Getenv("DOMAIN_EVENTS_TOPIC")
+	if eventsTopic == "" {
+		eventsTopic = eventsDomain.DefaultTopic
+	}
+
+	streamSubscription := os.Getenv("MOVEMENTS_STREAM_SUBSCRIPTION")
+	if streamSubscription == "" {
+		streamSubscription = eventUsecase.StreamSubscription
+	}
+
+	streamSubscription = eventUsecase.InstanceSubscription(streamSubscription)
+
+	streamUsecase := eventUsecase.NewStreamUsecase(ctx, eventRepo)
+	streamHandler := handler.NewStreamHandler(streamUsecase)
+
+	go func() {
+		err := bus.Subscribe(ctx, eventsTopic, streamSubscription, streamUsecase.HandleMessage)
+		if err != nil {
+			log.Println("Movements stream subscription stopped:", err)
+		}
+	}()
+
+	eventUsecase := eventUsecase.NewEventsUsecase(ctx, eventRepo, transactor, bus, eventsTopic)
+
+	go eventUsecase.RunRelay(ctx, relayInterval)
+
+	webhookSubscription := os.Getenv("WEBHOOKS_SUBSCRIPTION")
+	if webhookSubscription == "" {
+		webhookSubscription = webhookUsecase.Subscription
+	}
+
+	retryInterval := webhookUsecase.DefaultRetryInterval
+	webhookRepo := webhookRepository.NewPostgresRepository(dbClient.GetPool())
+	webhookUsecase := webhookUsecase.NewWebhooksUsecase(ctx, webhookRepo)
+	webhookHandler := handler.NewWebhookHandler(webhookUsecase)
+
+	go webhookUsecase.RunRetries(ctx, retryInterval)
+
+	go func() {
+		err := bus.Subscribe(ctx, eventsTopic, webhookSubscription, webhookUsecase.HandleMessage)
+		if err != nil {
+			log.Println("Webhooks subscription stopped:", err)
+		}
+	}()
+
+	movements := setup.NewMovements(ctx, dbClient.GetPool(), transactor, eventUsecase)
+
+	categoryHandler := handler.NewCategoryHandler(movements.Categories)
+	feedbackHandler := handler.NewFeedbackHandler(movements.Feedback)
+	merchantHandler := handler.NewMerchantHandler(movements.Merchants)
+	budgetHandler := handler.NewBudgetHandler(movements.Budgets)
+
+	goalRepo := goalRepository.NewPostgresRepository(dbClient.GetPool())
+	goalUsecase := goalUsecase.NewGoalsUsecase(goalRepo, movements.Categories)
+	goalHandler := handler.NewGoalHandler(goalUsecase)
+
+	financialAccountHandler := handler.NewFinancialAccountHandler(movements.FinancialAccounts)
+
+	reportRepo := reportRepository.NewPostgresRepository(dbClient.GetPool())
+	reportUsecase := reportUsecase.NewReportsUsecase(reportRepo, movements.FinancialAccounts, movements.Categories)
+	reportHandler := handler.NewReportHandler(reportUsecase)
+
+	anomalyHandler := handler.NewAnomalyHandler(movements.Anomalies)
+	workspaceHandler := handler.NewWorkspaceHandler(movements.Workspaces)
+
+	movementHandler := handler.NewMovementHandler(movements.Usecase)
+	classifierHandler := handler.NewClassifierHandler(classifier.DefaultMetrics)
+
+	ruleUsecase := ruleUsecase.NewRulesUsecase(ctx, movements.Rules, movements.Usecase, movements.Categories)
+	ruleHandler := handler.NewRuleHandler(ruleUsecase)
+
+	reclassificationRepo := reclassificationRepository.NewPostgresRepository(dbClient.GetPool())
+	reclassificationUsecase := reclassificationUsecase.NewReclassificationUsecase(ctx, reclassificationRepo, movements.Usecase, movements.Classifier)
+	reclassificationHandler := handler.NewReclassificationHandler(reclassificationUsecase)
+
+	recurringRepo := recurringRepository.NewPostgresRepository(dbClient.GetPool())
+	recurringUsecase := recurringUsecase.NewRecurringUsecase(ctx, recurringRepo, transactor, eventUsecase)
+	recurringHandler := handler.NewRecurringHandler(recurringUsecase)
+
+	transferRepo := transferRepository.NewPostgresRepository(dbClient.GetPool())
+	transferUsecase := transferUsecase.NewTransfersUsecase(ctx, transferRepo, transactor)
+	transferHandler := handler.NewTransferHandler(transferUsecase)
+
+	attachmentSubscription := os.Getenv("ATTACHMENTS_SUBSCRIPTION")
+	if attachmentSubscription == "" {
+		attachmentSubscription = attachmentUsecase.Subscription
+	}
+
+	attachmentRepo := attachmentRepository.NewPostgresRepository(dbClient.GetPool())
+	attachmentUsecase := attachmentUsecase.NewAttachmentsUsecase(ctx, attachmentRepo, movements.Usecase, files.NewLocalStorage(""))
+	attachmentHandler := handler.NewAttachmentHandler(attachmentUsecase)
+
+	go func() {
+		err := bus.Subscribe(ctx, eventsTopic, attachmentSubscription, attachmentUsecase.HandleMessage)
+		if err != nil {
+			log.Println("Attachments subscription stopped:", err)
+		}
+	}()
+
+	debtRepo := debtRepository.NewPostgresRepository(dbClient.GetPool())
+	debtUsecase := debtUsecase.NewDebtsUsecase(ctx, debtRepo, movements.Usecase)
+	debtHandler := handler.NewDebtHandler(debtUsecase)
 
 	googleClient, err := google.NewGoogleClient(ctx)
 	if err != nil {
@@ -62,10 +196,10 @@ func main() {
 	}
 
 	extractRepo := extractRepostory.NewExtractsRepository(extractCollection)
-	extractUsecase := extractUsecase.NewExtractsUsecase(googleClient, extractRepo)
+	extractUsecase := extractUsecase.NewExtractsUsecase(googleClient, extractRepo, eventUsecase)
 
 	messageRepo := messageRepository.NewMessageRepository(messageCollection)
-	messageUsecase := messageUsecase.NewMessageUsecase(ctx, googleClient, messageRepo, movementUsecase, extractUsecase)
+	messageUsecase := messageUsecase.NewMessageUsecase(ctx, googleClient, messageRepo, movements.Usecase, extractUsecase, eventUsecase, movements.FinancialAccounts)
 	messageHandler := handler.NewMessageHandler(messageUsecase)
 
 	extractHandler := handler.NewExtractsHandler(extractUsecase, messageUsecase)
@@ -74,13 +208,35 @@ func main() {
 	accountUsecase := accountUsecase.NewAccountsUseCase(googleClient, accountRepo)
 	accountHandler := handler.NewAccountHandler(accountUsecase)
 
+	notificationUsecase := notificationUsecase.NewNotificationUsecase(accountUsecase, messageUsecase)
+	notificationHandler := handler.NewNotificationHandler(notificationUsecase, os.Getenv("PUBSUB_PUSH_TOKEN"))
+
 	s := models.NewServer(accountUsecase, 8080)
 
 	routerHandler := &routes.RouteHandler{
-		AccountHandler:  accountHandler,
-		MessageHandler:  messageHandler,
-		ExtractHandler:  extractHandler,
-		MovementHandler: movementHandler,
+		AccountHandler:          accountHandler,
+		MessageHandler:          messageHandler,
+		ExtractHandler:          extractHandler,
+		MovementHandler:         movementHandler,
+		NotificationHandler:     notificationHandler,
+		WebhookHandler:          webhookHandler,
+		StreamHandler:           streamHandler,
+		RuleHandler:             ruleHandler,
+		CategoryHandler:         categoryHandler,
+		FeedbackHandler:         feedbackHandler,
+		ReclassificationHandler: reclassificationHandler,
+		ClassifierHandler:       classifierHandler,
+		MerchantHandler:         merchantHandler,
+		RecurringHandler:        recurringHandler,
+		BudgetHandler:           budgetHandler,
+		GoalHandler:             goalHandler,
+		ReportHandler:           reportHandler,
+		FinancialAccountHandler: financialAccountHandler,
+		TransferHandler:         transferHandler,
+		AttachmentHandler:       attachmentHandler,
+		WorkspaceHandler:        workspaceHandler,
+		DebtHandler:             debtHandler,
+		AnomalyHandler:          anomalyHandler,
 	}
 
 	s.AddRoutes(routerHandler.Routes())
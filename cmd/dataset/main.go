@@ -0,0 +1,62 @@
+// Command dataset exports the category corrections made by users as the JSONL dataset read
+// by text-classifier/train.py.
+//
+//	go run ./cmd/dataset -output text-classifier/data/dataset.jsonl -append
+//	go run ./cmd/dataset -account ACC123 > corrections.jsonl
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+	feedbackRepository "transaction-tracker/internal/feedback/repository"
+	feedbackUsecase "transaction-tracker/internal/feedback/usecase"
+	"transaction-tracker/pkg/databases/postgres"
+
+	_ "transaction-tracker/env"
+)
+
+func main() {
+	accountID := flag.String("account", "", "export only the corrections of this account")
+	output := flag.String("output", "", "file to write the dataset to, stdout when empty")
+	appendOutput := flag.Bool("append", false, "append to the output file instead of replacing it")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	dbClient, err := postgres.NewClient(ctx)
+	if err != nil {
+		log.Fatal("Unable to create postgres database client:", err)
+	}
+
+	defer dbClient.Close()
+
+	var w io.Writer = os.Stdout
+
+	if *output != "" {
+		flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		if *appendOutput {
+			flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+		}
+
+		file, err := os.OpenFile(*output, flags, 0o644)
+		if err != nil {
+			log.Fatal("Unable to open output file:", err)
+		}
+
+		defer file.Close()
+
+		w = file
+	}
+
+	fbUsecase := feedbackUsecase.NewFeedbackUsecase(feedbackRepository.NewPostgresRepository(dbClient.GetPool()))
+
+	written, err := fbUsecase.ExportDataset(ctx, *accountID, w)
+	if err != nil {
+		log.Fatal("Unable to export dataset:", err)
+	}
+
+	log.Printf("exported %d examples", written)
+}
@@ -0,0 +1,72 @@
+// Package setup builds the usecases the commands share over the postgres pool, so every
+// command wires them the same way.
+package setup
+
+import (
+	"context"
+	"os"
+	anomaliesRepository "transaction-tracker/internal/anomalies/repository"
+	anomaliesUsecase "transaction-tracker/internal/anomalies/usecase"
+	budgetsRepository "transaction-tracker/internal/budgets/repository"
+	budgetsUsecase "transaction-tracker/internal/budgets/usecase"
+	categoriesRepository "transaction-tracker/internal/categories/repository"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	feedbackRepository "transaction-tracker/internal/feedback/repository"
+	feedbackUsecase "transaction-tracker/internal/feedback/usecase"
+	financialAccountsRepository "transaction-tracker/internal/financialaccounts/repository"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
+	merchantsRepository "transaction-tracker/internal/merchants/repository"
+	merchantsUsecase "transaction-tracker/internal/merchants/usecase"
+	"transaction-tracker/internal/movements/classifier"
+	movementsRepository "transaction-tracker/internal/movements/repository"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	rulesRepository "transaction-tracker/internal/rules/repository"
+	rulesUsecase "transaction-tracker/internal/rules/usecase"
+	workspacesRepository "transaction-tracker/internal/workspaces/repository"
+	workspacesUsecase "transaction-tracker/internal/workspaces/usecase"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Movements is the movement usecase with the classifier and the usecases it is built over,
+// for the commands that also use them on their own.
+type Movements struct {
+	Usecase           movementsUsecase.MovementUsecase
+	Classifier        classifier.Classifier
+	Rules             rulesRepository.RuleRepository
+	Categories        categoriesUsecase.CategoriesUsecase
+	Feedback          feedbackUsecase.FeedbackUsecase
+	Merchants         merchantsUsecase.MerchantsUsecase
+	Budgets           budgetsUsecase.BudgetsUsecase
+	FinancialAccounts financialAccountsUsecase.FinancialAccountsUsecase
+	Anomalies         anomaliesUsecase.AnomaliesUsecase
+	Workspaces        workspacesUsecase.WorkspacesUsecase
+}
+
+// NewMovements builds the movement usecase over pool. Movements are classified by the rules
+// of the account first and then by the default classifier, which asks the text-classifier
+// at CLASSIFY_CATEGORY_URL when set.
+func NewMovements(ctx context.Context, pool *pgxpool.Pool, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase) *Movements {
+	rulesRepo := rulesRepository.NewPostgresRepository(pool)
+
+	m := &Movements{
+		Classifier: classifier.NewChainClassifier(
+			rulesUsecase.NewAccountRulesClassifier(rulesRepo),
+			classifier.NewDefaultClassifier(os.Getenv("CLASSIFY_CATEGORY_URL")),
+		),
+		Rules:             rulesRepo,
+		Categories:        categoriesUsecase.NewCategoriesUsecase(categoriesRepository.NewPostgresRepository(pool)),
+		Feedback:          feedbackUsecase.NewFeedbackUsecase(feedbackRepository.NewPostgresRepository(pool)),
+		Merchants:         merchantsUsecase.NewMerchantsUsecase(merchantsRepository.NewPostgresRepository(pool)),
+		FinancialAccounts: financialAccountsUsecase.NewFinancialAccountsUsecase(financialAccountsRepository.NewPostgresRepository(pool), transactor),
+		Anomalies:         anomaliesUsecase.NewAnomaliesUsecase(anomaliesRepository.NewPostgresRepository(pool), transactor, evUsecase),
+		Workspaces:        workspacesUsecase.NewWorkspacesUsecase(workspacesRepository.NewPostgresRepository(pool), transactor, evUsecase),
+	}
+
+	m.Budgets = budgetsUsecase.NewBudgetsUsecase(budgetsRepository.NewPostgresRepository(pool), transactor, evUsecase, m.Categories)
+	m.Usecase = movementsUsecase.NewMovementUsecase(ctx, movementsRepository.NewPostgresRepository(pool), transactor, evUsecase, m.Classifier, m.Categories, m.Feedback, m.Merchants, m.Budgets, m.FinancialAccounts, m.Anomalies, m.Workspaces)
+
+	return m
+}
@@ -0,0 +1,120 @@
+// Command reclassify runs a reclassification job over the stored movements of an account and
+// waits for it to finish, printing its progress.
+//
+//	go run ./cmd/reclassify -account ACC123 -only-unknown
+//	go run ./cmd/reclassify -account ACC123 -from 2025-01-01 -to 2025-06-30 -categories food,other
+//
+// Movement events are stored in the outbox and published by the relay of the API.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+	"transaction-tracker/cmd/internal/setup"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsRepository "transaction-tracker/internal/events/repository"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	reclassificationDomain "transaction-tracker/internal/reclassification/domain"
+	reclassificationRepository "transaction-tracker/internal/reclassification/repository"
+	reclassificationUsecase "transaction-tracker/internal/reclassification/usecase"
+	"transaction-tracker/pkg/databases/postgres"
+
+	_ "transaction-tracker/env"
+)
+
+const (
+	progressInterval = 2 * time.Second
+)
+
+func main() {
+	accountID := flag.String("account", "", "account whose movements are reclassified (required)")
+	from := flag.String("from", "", "first day of the movements to reclassify, YYYY-MM-DD")
+	to := flag.String("to", "", "last day of the movements to reclassify, YYYY-MM-DD")
+	categories := flag.String("categories", "", "comma separated categories of the movements to reclassify")
+	onlyUnknown := flag.Bool("only-unknown", false, "reclassify only movements without a category")
+	flag.Parse()
+
+	if *accountID == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	filter, err := reclassificationDomain.NewFilter(*from, *to, strings.Split(*categories, ","), *onlyUnknown)
+	if err != nil {
+		log.Fatal("Invalid filter:", err)
+	}
+
+	err = os.MkdirAll("logs", 0o755)
+	if err != nil {
+		log.Fatal("Unable to create logs directory:", err)
+	}
+
+	ctx := context.Background()
+
+	dbClient, err := postgres.NewClient(ctx)
+	if err != nil {
+		log.Fatal("Unable to create postgres database client:", err)
+	}
+
+	defer dbClient.Close()
+
+	pool := dbClient.GetPool()
+	transactor := postgres.NewTransactor(pool)
+
+	eventsTopic := os.Getenv("DOMAIN_EVENTS_TOPIC")
+	if eventsTopic == "" {
+		eventsTopic = eventsDomain.DefaultTopic
+	}
+
+	evUsecase := eventsUsecase.NewEventsUsecase(ctx, eventsRepository.NewPostgresRepository(pool), transactor, nil, eventsTopic)
+
+	movements := setup.NewMovements(ctx, pool, transactor, evUsecase)
+
+	rcUsecase := reclassificationUsecase.NewReclassificationUsecase(ctx, reclassificationRepository.NewPostgresRepository(pool), movements.Usecase, movements.Classifier)
+
+	job, err := rcUsecase.CreateJob(ctx, *accountID, filter)
+	if err != nil {
+		log.Fatal("Unable to create reclassification job:", err)
+	}
+
+	log.Printf("started job %s", job.ID)
+
+	done := make(chan struct{})
+	go printProgress(ctx, rcUsecase, job.ID, job.AccountID, done)
+
+	err = rcUsecase.RunJob(ctx, job)
+
+	close(done)
+
+	if err != nil {
+		log.Fatal("Reclassification job failed:", err)
+	}
+
+	log.Printf("job %s %s: %d movements, %d changed, %d skipped, %d failed", job.ID, job.Status, job.Total, job.Changed, job.Skipped, job.Failed)
+}
+
+// printProgress reads the progress saved by the job until done is closed.
+func printProgress(ctx context.Context, u reclassificationUsecase.ReclassificationUsecase, id string, accountID string, done <-chan struct{}) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		job, err := u.GetJob(ctx, id, accountID)
+		if err != nil {
+			log.Println("Unable to read job progress:", err)
+			continue
+		}
+
+		log.Printf("%d/%d movements, %d changed", job.Processed, job.Total, job.Changed)
+	}
+}
@@ -2,64 +2,76 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"time"
 
+	"transaction-tracker/cmd/internal/setup"
 	_ "transaction-tracker/env"
 	accountsRepository "transaction-tracker/internal/accounts/repository"
 	accountsUsecase "transaction-tracker/internal/accounts/usecase"
+	debtsRepository "transaction-tracker/internal/debts/repository"
+	debtsUsecase "transaction-tracker/internal/debts/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsRepository "transaction-tracker/internal/events/repository"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
 	extractsRepository "transaction-tracker/internal/extracts/repository"
 	extractsUsecase "transaction-tracker/internal/extracts/usecase"
 	messagesRepository "transaction-tracker/internal/messages/repository"
 	messagesUsecase "transaction-tracker/internal/messages/usecase"
-	movementsRepository "transaction-tracker/internal/movements/repository"
-	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/movements/classifier"
+	notificationsDomain "transaction-tracker/internal/notifications/domain"
+	notificationsUsecase "transaction-tracker/internal/notifications/usecase"
+	recurringRepository "transaction-tracker/internal/recurring/repository"
+	recurringUsecase "transaction-tracker/internal/recurring/usecase"
+	transfersRepository "transaction-tracker/internal/transfers/repository"
+	transfersUsecase "transaction-tracker/internal/transfers/usecase"
 	"transaction-tracker/logger"
 	loggerModels "transaction-tracker/logger/models"
 	"transaction-tracker/pkg/databases/mongo"
 	"transaction-tracker/pkg/databases/postgres"
+	"transaction-tracker/pkg/eventbus"
 	"transaction-tracker/pkg/google"
 )
 
 type subscriptionUsecase struct {
-	accountsUsecase accountsUsecase.AccountsUsecase
-	messagesUsecase messagesUsecase.MessageUsecase
+	notificationUsecase notificationsUsecase.NotificationUsecase
+	recurringUsecase    recurringUsecase.RecurringUsecase
+	transfersUsecase    transfersUsecase.TransfersUsecase
+	debtsUsecase        debtsUsecase.DebtsUsecase
 }
 
 const (
-	projectID    = "transaction-tracker-2473"
-	subscription = "gmail-notifications-sub"
-	topic        = "gmail-notifications"
-)
-
-type Message struct {
-	EmailAdress string `json:"emailAddress"`
-	HistoryID   uint64 `json:"historyId"`
-}
+	defaultSubscription = "gmail-notifications-sub"
+	defaultTopic        = "gmail-notifications"
 
-func (m *Message) LogProperties() map[string]string {
-	return map[string]string{
-		"email":      m.EmailAdress,
-		"history_id": fmt.Sprintf("%d", m.HistoryID),
-	}
-}
+	classifierMetricsInterval = 5 * time.Minute
+)
 
 const (
 	STORE_EMAIL_MAX_RETRIES = 5
 )
 
 var (
-	credentialsFile = "sa-key.json"
-	log             *loggerModels.Logger
+	log *loggerModels.Logger
 )
 
+// receive adapts bus deliveries to handleSubscription. Messages whose Gmail entity no longer
+// exists are acknowledged, since redelivering them can never succeed.
+func (s *subscriptionUsecase) receive(ctx context.Context, msg *eventbus.Message) error {
+	err := s.handleSubscription(ctx, msg.Data)
+	if notificationsUsecase.IsPermanentError(err) {
+		return nil
+	}
+
+	return err
+}
+
 func (s *subscriptionUsecase) handleSubscription(ctx context.Context, msg []byte) error {
 	time.Sleep(2 * time.Second)
 
-	message := &Message{}
-
-	err := json.Unmarshal(msg, message)
+	notification, err := notificationsDomain.ParseGmailNotification(msg)
 	if err != nil {
 		log.Error(loggerModels.LogProperties{
 			Event: "error_unmarshalling_message",
@@ -72,44 +84,17 @@ func (s *subscriptionUsecase) handleSubscription(ctx context.Context, msg []byte
 	log.Info(loggerModels.LogProperties{
 		Event: "message_received",
 		AdditionalParams: []loggerModels.Properties{
-			message,
+			notification,
 		},
 	})
 
-	account, err := s.accountsUsecase.GetAccountByEmail(ctx, message.EmailAdress)
-	if err != nil {
-		log.Error(loggerModels.LogProperties{
-			Event: "error_getting_account",
-			Error: err,
-			AdditionalParams: []loggerModels.Properties{
-				message,
-			},
-		})
-
-		return err
-	}
-
-	err = s.accountsUsecase.RefreshGoogleToken(ctx, account)
-	if err != nil {
-		log.Error(loggerModels.LogProperties{
-			Event: "error_refreshing_google_token",
-			Error: err,
-			AdditionalParams: []loggerModels.Properties{
-				message,
-				account,
-			},
-		})
-
-		return err
-	}
-
-	messages, err := s.messagesUsecase.ProcessByNotification(ctx, account, message.HistoryID)
+	messages, err := s.notificationUsecase.ProcessGmailNotification(ctx, notification)
 	if err != nil {
 		log.Error(loggerModels.LogProperties{
 			Event: "error_processing_messages",
 			Error: err,
 			AdditionalParams: []loggerModels.Properties{
-				message,
+				notification,
 			},
 		})
 
@@ -132,14 +117,14 @@ func (s *subscriptionUsecase) handleSubscription(ctx context.Context, msg []byte
 	log.Info(loggerModels.LogProperties{
 		Event: "message_stored",
 		AdditionalParams: []loggerModels.Properties{
-			message,
+			notification,
 		},
 	})
 
 	return nil
 }
 
-func NewSubscriptionsecase(ctx context.Context) (*subscriptionUsecase, error) {
+func NewSubscriptionsecase(ctx context.Context, bus eventbus.Bus) (*subscriptionUsecase, error) {
 	log := ctx.Value("logger").(*loggerModels.Logger)
 
 	dbClient, err := postgres.NewClient(ctx)
@@ -205,18 +190,24 @@ func NewSubscriptionsecase(ctx context.Context) (*subscriptionUsecase, error) {
 	accRepo := accountsRepository.NewAccountsRepository(accountCollection)
 	accUsecase := accountsUsecase.NewAccountsUseCase(googleClient, accRepo)
 
-	movementsRepo := movementsRepository.NewPostgresRepository(dbClient.GetPool())
-	mvmUsecase := movementsUsecase.NewMovementUsecase(ctx, movementsRepo)
+	transactor := postgres.NewTransactor(dbClient.GetPool())
+
+	eventsRepo := eventsRepository.NewPostgresRepository(dbClient.GetPool())
+	evUsecase := eventsUsecase.NewEventsUsecase(ctx, eventsRepo, transactor, bus, getEnv("DOMAIN_EVENTS_TOPIC", eventsDomain.DefaultTopic))
+
+	movements := setup.NewMovements(ctx, dbClient.GetPool(), transactor, evUsecase)
 
 	extractsRepo := extractsRepository.NewExtractsRepository(extractsCollection)
-	extractUsecase := extractsUsecase.NewExtractsUsecase(googleClient, extractsRepo)
+	extractUsecase := extractsUsecase.NewExtractsUsecase(googleClient, extractsRepo, evUsecase)
 
 	messageRepo := messagesRepository.NewMessageRepository(messageCollection)
-	messageUsecase := messagesUsecase.NewMessageUsecase(ctx, googleClient, messageRepo, mvmUsecase, extractUsecase)
+	messageUsecase := messagesUsecase.NewMessageUsecase(ctx, googleClient, messageRepo, movements.Usecase, extractUsecase, evUsecase, movements.FinancialAccounts)
 
 	return &subscriptionUsecase{
-		accountsUsecase: accUsecase,
-		messagesUsecase: messageUsecase,
+		notificationUsecase: notificationsUsecase.NewNotificationUsecase(accUsecase, messageUsecase),
+		recurringUsecase:    recurringUsecase.NewRecurringUsecase(ctx, recurringRepository.NewPostgresRepository(dbClient.GetPool()), transactor, evUsecase),
+		transfersUsecase:    transfersUsecase.NewTransfersUsecase(ctx, transfersRepository.NewPostgresRepository(dbClient.GetPool()), transactor),
+		debtsUsecase:        debtsUsecase.NewDebtsUsecase(ctx, debtsRepository.NewPostgresRepository(dbClient.GetPool()), movements.Usecase),
 	}, nil
 }
 
@@ -234,22 +225,30 @@ func main() {
 
 	ctx = context.WithValue(ctx, "logger", log)
 
-	pubsubService, err := google.NewGooglePubSub(ctx, projectID, credentialsFile)
+	busConfig := eventbus.ConfigFromEnv()
+
+	bus, err := eventbus.New(ctx, busConfig)
 	if err != nil {
 		log.Error(loggerModels.LogProperties{
-			Event: "failed_to_initialize_pubsub",
+			Event: "failed_to_initialize_event_bus",
 			Error: err,
 		})
 
 		return
 	}
 
+	defer bus.Close()
+
 	log.Info(loggerModels.LogProperties{
-		Event:            "pubsub_subscribed",
-		AdditionalParams: []loggerModels.Properties{},
+		Event: "event_bus_initialized",
+		AdditionalParams: []loggerModels.Properties{
+			logger.MapToProperties(map[string]string{
+				"driver": string(busConfig.Driver),
+			}),
+		},
 	})
 
-	s, err := NewSubscriptionsecase(ctx)
+	s, err := NewSubscriptionsecase(ctx, bus)
 	if err != nil {
 		log.Error(loggerModels.LogProperties{
 			Event: "failed_to_create_subscription_usecase",
@@ -259,11 +258,64 @@ func main() {
 		return
 	}
 
-	err = pubsubService.Subscribe(ctx, subscription, s.handleSubscription)
+	// Events are stored in the outbox and relayed by the API alone, which also delivers the
+	// webhooks and feeds the live streams, so they see the events of the movements created
+	// here whatever the bus driver is.
+	go s.recurringUsecase.RunDetection(ctx, recurringUsecase.DefaultDetectionInterval)
+	go s.transfersUsecase.RunDetection(ctx, transfersUsecase.DefaultDetectionInterval)
+	go s.debtsUsecase.RunDetection(ctx, debtsUsecase.DefaultDetectionInterval)
+	go logClassifierMetrics(ctx, classifierMetricsInterval)
+
+	topic := getEnv("GMAIL_NOTIFICATIONS_TOPIC", defaultTopic)
+	subscription := getEnv("GMAIL_NOTIFICATIONS_SUBSCRIPTION", defaultSubscription)
+
+	err = bus.Subscribe(ctx, topic, subscription, s.receive)
 	if err != nil {
 		log.Error(loggerModels.LogProperties{
-			Event: "failed_to_subscribe_pubsub",
+			Event: "failed_to_subscribe_event_bus",
 			Error: err,
 		})
 	}
 }
+
+// logClassifierMetrics logs the metrics of the category classifier every interval, since the
+// tracker has no HTTP server to expose them.
+func logClassifierMetrics(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		snapshot := classifier.DefaultMetrics.Snapshot()
+
+		log.Info(loggerModels.LogProperties{
+			Event: "classifier_metrics",
+			AdditionalParams: []loggerModels.Properties{
+				logger.MapToProperties(map[string]string{
+					"requests":       strconv.FormatInt(snapshot.Requests, 10),
+					"hit_rate":       strconv.FormatFloat(snapshot.HitRate, 'f', 3, 64),
+					"calls":          strconv.FormatInt(snapshot.Calls, 10),
+					"failures":       strconv.FormatInt(snapshot.Failures, 10),
+					"short_circuits": strconv.FormatInt(snapshot.ShortCircuits, 10),
+					"avg_latency_ms": strconv.FormatFloat(snapshot.AvgLatencyMs, 'f', 1, 64),
+					"max_latency_ms": strconv.FormatFloat(snapshot.MaxLatencyMs, 'f', 1, 64),
+					"circuit_state":  string(snapshot.CircuitState),
+				}),
+			},
+		})
+	}
+}
+
+func getEnv(key string, fallback string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
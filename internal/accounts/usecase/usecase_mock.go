@@ -5,6 +5,9 @@ import (
 
 	"transaction-tracker/internal/accounts/domain"
 	"transaction-tracker/pkg/google"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
 )
 
 // MockAccountsRepository es un mock del repository.AccountsRepository usado en usecase.
@@ -86,3 +89,88 @@ func (m *MockAccountsRepository) RefreshGoogleToken(ctx context.Context, account
 
 	return nil
 }
+
+// MockAccountsUsecase is a mock implementation of the AccountsUsecase interface.
+type MockAccountsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockAccountsUsecase) GetAuthURL() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockAccountsUsecase) CreateAccount(ctx context.Context, account *domain.Account) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockAccountsUsecase) GetAccount(ctx context.Context, accountID string) (*domain.Account, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountsUsecase) GetAccountByEmail(ctx context.Context, email string) (*domain.Account, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountsUsecase) GetOrCreateAccountByEmail(ctx context.Context, email string) (*domain.Account, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountsUsecase) SaveGoogleAccount(ctx context.Context, code string) (*domain.Account, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Account), args.Error(1)
+}
+
+func (m *MockAccountsUsecase) UpdateAccount(ctx context.Context, account *domain.Account) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockAccountsUsecase) GenerateTokens(ctx context.Context, account *domain.Account) (string, string, string, error) {
+	args := m.Called(ctx, account)
+	return args.String(0), args.String(1), args.String(2), args.Error(3)
+}
+
+func (m *MockAccountsUsecase) CreateWatcher(ctx context.Context, account *domain.Account) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockAccountsUsecase) DeleteWatcher(ctx context.Context, account *domain.Account) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockAccountsUsecase) VerifyToken(tokenString string) (*jwt.Token, error) {
+	args := m.Called(tokenString)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*jwt.Token), args.Error(1)
+}
+
+func (m *MockAccountsUsecase) RefreshGoogleToken(ctx context.Context, account *domain.Account) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
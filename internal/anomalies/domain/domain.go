@@ -0,0 +1,275 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+// Kind is the pattern a movement breaks.
+type Kind string
+
+// Status tells whether an anomaly still needs attention.
+type Status string
+
+const (
+	_anomaly_prefix = "ANM"
+
+	// NewMerchant anomalies are large charges from a merchant never seen before.
+	NewMerchant Kind = "new_merchant"
+	// CategorySpike anomalies push the spending of a category far above its monthly norm.
+	CategorySpike Kind = "category_spike"
+	// DuplicateCharge anomalies repeat another charge within minutes.
+	DuplicateCharge Kind = "duplicate_charge"
+
+	// Open anomalies were not reviewed yet.
+	Open Status = "open"
+	// Dismissed anomalies were reviewed by the user.
+	Dismissed Status = "dismissed"
+
+	// HistoryDays is how far back expenses are read to know the usual charge of an account.
+	HistoryDays = 90
+	// MinExpenses is how many expenses the history needs before charges are called large.
+	MinExpenses = 10
+	// LargeFactor is how many times the average expense a charge must be to be large.
+	LargeFactor = 3.0
+
+	// NormMonths is how many months before the one of the movement make a category norm.
+	NormMonths = 6
+	// MinNormMonths is how many of them must have spending for the norm to be trusted.
+	MinNormMonths = 3
+	// Deviations is how many standard deviations above the norm a category spikes.
+	Deviations = 3.0
+	// MinSpikeRatio keeps steady categories, whose deviation is near zero, from spiking on
+	// small changes: a spike is always at least this many times the norm.
+	MinSpikeRatio = 1.5
+
+	// DuplicateWindow is how close two charges of the same amount are duplicates.
+	DuplicateWindow = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidStatus is returned when anomalies are filtered by an unknown status.
+	ErrInvalidStatus = errors.New("invalid anomaly status")
+)
+
+// Anomaly is a movement flagged as out of the pattern of its account, with the reason why.
+type Anomaly struct {
+	ID          string
+	AccountID   string
+	MovementID  string
+	Kind        Kind
+	Reason      string
+	Amount      float64
+	Status      Status
+	CreatedAt   time.Time
+	DismissedAt *time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (a *Anomaly) LogProperties() map[string]string {
+	return map[string]string{
+		"anomaly_id":  a.ID,
+		"account_id":  a.AccountID,
+		"movement_id": a.MovementID,
+		"kind":        string(a.Kind),
+		"status":      string(a.Status),
+		"amount":      strconv.FormatFloat(a.Amount, 'f', 2, 64),
+	}
+}
+
+// NewAnomaly flags the movement.
+func NewAnomaly(movement *movementsDomain.Movement, kind Kind, reason string) *Anomaly {
+	return &Anomaly{
+		ID:         _anomaly_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:  movement.AccountID,
+		MovementID: movement.ID,
+		Kind:       kind,
+		Reason:     reason,
+		Amount:     movement.Amount,
+		Status:     Open,
+	}
+}
+
+// ParseStatus returns the status anomalies are filtered by: Open when empty, and an empty
+// status, meaning any, for "all".
+func ParseStatus(raw string) (Status, error) {
+	switch Status(raw) {
+	case "":
+		return Open, nil
+	case Open, Dismissed:
+		return Status(raw), nil
+	case "all":
+		return "", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidStatus, raw)
+	}
+}
+
+// Charge is an expense of the history of an account.
+type Charge struct {
+	MovementID  string
+	Description string
+	MerchantID  string
+	Amount      float64
+	Date        time.Time
+}
+
+// History is what is known of the account before a movement. None of it includes the
+// movement itself.
+type History struct {
+	// MerchantMovements is how many movements the account has with the merchant of the movement.
+	MerchantMovements int
+	// Expenses and AverageExpense describe the expenses of the last HistoryDays.
+	Expenses       int
+	AverageExpense float64
+	// CategoryCharges are the expenses of the category of the movement from NormMonths
+	// before its month up to its date.
+	CategoryCharges []*Charge
+	// Nearby are the expenses of the same amount made within DuplicateWindow of it.
+	Nearby []*Charge
+}
+
+// Detect returns the anomalies of an expense given the history of its account.
+func Detect(movement *movementsDomain.Movement, history *History) []*Anomaly {
+	anomalies := []*Anomaly{}
+
+	detectors := []func(*movementsDomain.Movement, *History) *Anomaly{
+		detectNewMerchant,
+		detectCategorySpike,
+		detectDuplicate,
+	}
+
+	for _, detect := range detectors {
+		if anomaly := detect(movement, history); anomaly != nil {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	return anomalies
+}
+
+// detectNewMerchant flags the first charge of a merchant when it is LargeFactor times the
+// average expense of the account.
+func detectNewMerchant(movement *movementsDomain.Movement, history *History) *Anomaly {
+	if movement.MerchantID == "" || history.MerchantMovements > 0 {
+		return nil
+	}
+
+	if history.Expenses < MinExpenses || history.AverageExpense <= 0 {
+		return nil
+	}
+
+	ratio := movement.Amount / history.AverageExpense
+	if ratio < LargeFactor {
+		return nil
+	}
+
+	reason := fmt.Sprintf("first charge from this merchant, %.1f times your average expense of %.2f", ratio, history.AverageExpense)
+
+	return NewAnomaly(movement, NewMerchant, reason)
+}
+
+// detectCategorySpike flags the expense that makes the spending of its category in the month
+// go over the norm of the NormMonths before: Deviations standard deviations above their
+// mean, and at least MinSpikeRatio times it.
+func detectCategorySpike(movement *movementsDomain.Movement, history *History) *Anomaly {
+	if movement.Category == "" || movement.Category == movementsDomain.Unknown {
+		return nil
+	}
+
+	month := monthOf(movement.Date)
+	totals := make([]float64, NormMonths)
+	var spent float64
+	for _, charge := range history.CategoryCharges {
+		date := charge.Date.In(movement.Date.Location())
+		monthsBefore := (month.Year()-date.Year())*12 + int(month.Month()-date.Month())
+
+		switch {
+		case monthsBefore == 0:
+			spent += charge.Amount
+		case monthsBefore > 0 && monthsBefore <= NormMonths:
+			totals[monthsBefore-1] += charge.Amount
+		}
+	}
+
+	active := 0
+	for _, total := range totals {
+		if total > 0 {
+			active++
+		}
+	}
+
+	if active < MinNormMonths {
+		return nil
+	}
+
+	mean, deviation := meanAndDeviation(totals)
+	limit := math.Max(mean+Deviations*deviation, mean*MinSpikeRatio)
+
+	after := spent + movement.Amount
+	if spent > limit || after <= limit {
+		return nil
+	}
+
+	reason := fmt.Sprintf("%s spending this month reached %.2f, above its monthly norm of %.2f ± %.2f", movement.Category, after, mean, deviation)
+
+	return NewAnomaly(movement, CategorySpike, reason)
+}
+
+// detectDuplicate flags an expense with the same amount and merchant, or description, as
+// another one made at most DuplicateWindow apart.
+func detectDuplicate(movement *movementsDomain.Movement, history *History) *Anomaly {
+	for _, charge := range history.Nearby {
+		if charge.MovementID == movement.ID || cents(charge.Amount) != cents(movement.Amount) {
+			continue
+		}
+
+		gap := movement.Date.Sub(charge.Date).Abs()
+		if gap > DuplicateWindow {
+			continue
+		}
+
+		sameMerchant := movement.MerchantID != "" && charge.MerchantID == movement.MerchantID
+		sameDescription := strings.EqualFold(strings.TrimSpace(charge.Description), strings.TrimSpace(movement.Description))
+		if !sameMerchant && !sameDescription {
+			continue
+		}
+
+		reason := fmt.Sprintf("same amount charged %d minutes apart from movement %s", int(gap.Minutes()), charge.MovementID)
+
+		return NewAnomaly(movement, DuplicateCharge, reason)
+	}
+
+	return nil
+}
+
+func meanAndDeviation(values []float64) (float64, float64) {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+
+	mean := sum / float64(len(values))
+
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
+
+func monthOf(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
+
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
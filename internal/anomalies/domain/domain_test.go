@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newMovement(amount float64) *movementsDomain.Movement {
+	return &movementsDomain.Movement{
+		ID:          "MID1",
+		AccountID:   "acc1",
+		Description: "Compra RAPPI",
+		MerchantID:  "MER1",
+		Amount:      amount,
+		Type:        movementsDomain.Expense,
+		Category:    movementsDomain.Food,
+		Date:        now,
+	}
+}
+
+// monthlyCharges returns one charge of each amount, the first one in the month before now.
+func monthlyCharges(amounts ...float64) []*Charge {
+	charges := []*Charge{}
+	for i, amount := range amounts {
+		charges = append(charges, &Charge{MovementID: "OLD", Amount: amount, Date: now.AddDate(0, -(i + 1), 0)})
+	}
+
+	return charges
+}
+
+func TestParseStatus(t *testing.T) {
+	c := require.New(t)
+
+	status, err := ParseStatus("")
+	c.NoError(err)
+	c.Equal(Open, status)
+
+	status, err = ParseStatus("dismissed")
+	c.NoError(err)
+	c.Equal(Dismissed, status)
+
+	status, err = ParseStatus("all")
+	c.NoError(err)
+	c.Empty(status)
+
+	_, err = ParseStatus("closed")
+	c.ErrorIs(err, ErrInvalidStatus)
+}
+
+func TestDetectNewMerchant(t *testing.T) {
+	history := &History{Expenses: 40, AverageExpense: 50000}
+
+	t.Run("large first charge", func(t *testing.T) {
+		c := require.New(t)
+
+		anomalies := Detect(newMovement(200000), history)
+		c.Len(anomalies, 1)
+		c.Equal(NewMerchant, anomalies[0].Kind)
+		c.Equal(Open, anomalies[0].Status)
+		c.Equal("MID1", anomalies[0].MovementID)
+		c.Contains(anomalies[0].ID, _anomaly_prefix)
+		c.Contains(anomalies[0].Reason, "4.0 times your average expense")
+	})
+
+	t.Run("small first charge", func(t *testing.T) {
+		require.Empty(t, Detect(newMovement(120000), history))
+	})
+
+	t.Run("known merchant", func(t *testing.T) {
+		require.Empty(t, Detect(newMovement(200000), &History{MerchantMovements: 3, Expenses: 40, AverageExpense: 50000}))
+	})
+
+	t.Run("short history", func(t *testing.T) {
+		require.Empty(t, Detect(newMovement(200000), &History{Expenses: MinExpenses - 1, AverageExpense: 50000}))
+	})
+}
+
+func TestDetectCategorySpike(t *testing.T) {
+	known := func(charges []*Charge) *History {
+		return &History{MerchantMovements: 1, CategoryCharges: charges}
+	}
+
+	t.Run("crosses the norm", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := monthlyCharges(400000, 420000, 380000, 410000, 390000, 400000)
+		charges = append(charges, &Charge{MovementID: "MID0", Amount: 300000, Date: now.AddDate(0, 0, -5)})
+
+		anomalies := Detect(newMovement(350000), known(charges))
+		c.Len(anomalies, 1)
+		c.Equal(CategorySpike, anomalies[0].Kind)
+		c.Contains(anomalies[0].Reason, "food spending this month reached 650000.00")
+	})
+
+	t.Run("already over the norm", func(t *testing.T) {
+		charges := monthlyCharges(400000, 420000, 380000, 410000, 390000, 400000)
+		charges = append(charges, &Charge{MovementID: "MID0", Amount: 700000, Date: now.AddDate(0, 0, -5)})
+
+		require.Empty(t, Detect(newMovement(50000), known(charges)))
+	})
+
+	t.Run("within the norm", func(t *testing.T) {
+		charges := monthlyCharges(400000, 420000, 380000, 410000, 390000, 400000)
+
+		require.Empty(t, Detect(newMovement(420000), known(charges)))
+	})
+
+	t.Run("steady category needs a real jump", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := monthlyCharges(50000, 50000, 50000, 50000, 50000, 50000)
+
+		c.Empty(Detect(newMovement(60000), known(charges)))
+		c.Len(Detect(newMovement(80000), known(charges)), 1)
+	})
+
+	t.Run("not enough months", func(t *testing.T) {
+		require.Empty(t, Detect(newMovement(900000), known(monthlyCharges(10000, 0, 20000))))
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		movement := newMovement(900000)
+		movement.Category = movementsDomain.Unknown
+
+		require.Empty(t, Detect(movement, known(monthlyCharges(10000, 10000, 10000))))
+	})
+}
+
+func TestDetectDuplicate(t *testing.T) {
+	t.Run("same merchant minutes apart", func(t *testing.T) {
+		c := require.New(t)
+
+		history := &History{MerchantMovements: 5, Nearby: []*Charge{
+			{MovementID: "MID0", Description: "Otro texto", MerchantID: "MER1", Amount: 45000, Date: now.Add(-3 * time.Minute)},
+		}}
+
+		anomalies := Detect(newMovement(45000), history)
+		c.Len(anomalies, 1)
+		c.Equal(DuplicateCharge, anomalies[0].Kind)
+		c.Equal("same amount charged 3 minutes apart from movement MID0", anomalies[0].Reason)
+	})
+
+	t.Run("same description", func(t *testing.T) {
+		history := &History{MerchantMovements: 5, Nearby: []*Charge{
+			{MovementID: "MID0", Description: "compra rappi", Amount: 45000, Date: now.Add(time.Minute)},
+		}}
+
+		require.Len(t, Detect(newMovement(45000), history), 1)
+	})
+
+	t.Run("not a duplicate", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			charge *Charge
+		}{
+			{name: "other merchant", charge: &Charge{MovementID: "MID0", Description: "Uber", MerchantID: "MER2", Amount: 45000, Date: now.Add(-time.Minute)}},
+			{name: "other amount", charge: &Charge{MovementID: "MID0", MerchantID: "MER1", Amount: 45000.5, Date: now.Add(-time.Minute)}},
+			{name: "too far apart", charge: &Charge{MovementID: "MID0", MerchantID: "MER1", Amount: 45000, Date: now.Add(-DuplicateWindow - time.Minute)}},
+			{name: "itself", charge: &Charge{MovementID: "MID1", MerchantID: "MER1", Amount: 45000, Date: now}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				require.Empty(t, Detect(newMovement(45000), &History{MerchantMovements: 5, Nearby: []*Charge{tt.charge}}))
+			})
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/anomalies/domain"
+)
+
+// AnomalyRepository stores the anomalies of each account and reads the history of movements
+// they are detected against.
+type AnomalyRepository interface {
+	CreateAnomaly(ctx context.Context, anomaly *domain.Anomaly) (bool, error)
+	GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error)
+	DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error)
+	CountMerchantMovements(ctx context.Context, accountID string, merchantID string, excludeID string) (int, error)
+	GetExpenseStats(ctx context.Context, accountID string, since time.Time, excludeID string) (int, float64, error)
+	GetCategoryCharges(ctx context.Context, accountID string, category string, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error)
+	GetChargesByAmount(ctx context.Context, accountID string, amount float64, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error)
+}
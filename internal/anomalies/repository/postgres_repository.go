@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/anomalies/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	anomalyColumns = `id, account_id, movement_id, kind, reason, amount, status, created_at, dismissed_at`
+	chargeColumns  = `id, description, merchant_id, amount, date`
+)
+
+var (
+	ErrAnomalyNotFound = errors.New("anomaly not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the anomalies repository.
+func NewPostgresRepository(db *pgxpool.Pool) AnomalyRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so an anomaly and its event
+// are written together.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// CreateAnomaly stores an anomaly. It reports false when the movement was already flagged
+// with the same kind, so every anomaly is notified once.
+func (r *postgresRepository) CreateAnomaly(ctx context.Context, anomaly *domain.Anomaly) (bool, error) {
+	now := r.nowFunc()
+
+	query := `INSERT INTO anomalies (` + anomalyColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (movement_id, kind) DO NOTHING`
+
+	tag, err := r.querier(ctx).Exec(ctx, query,
+		anomaly.ID,
+		anomaly.AccountID,
+		anomaly.MovementID,
+		anomaly.Kind,
+		anomaly.Reason,
+		anomaly.Amount,
+		anomaly.Status,
+		now,
+		anomaly.DismissedAt)
+	if err != nil {
+		return false, err
+	}
+
+	anomaly.CreatedAt = now
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// GetAnomalies returns the anomalies of the account with the status, newest first. An empty
+// status returns all of them.
+func (r *postgresRepository) GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error) {
+	query := `SELECT ` + anomalyColumns + `
+	FROM anomalies
+	WHERE account_id = $1 AND ($2 = '' OR status = $2)
+	ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, accountID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	anomalies := []*domain.Anomaly{}
+	for rows.Next() {
+		anomaly, err := scanToAnomaly(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		anomalies = append(anomalies, anomaly)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return anomalies, nil
+}
+
+// DismissAnomaly marks an anomaly of the account as reviewed. Dismissing it again keeps the
+// first dismissal date.
+func (r *postgresRepository) DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error) {
+	query := `UPDATE anomalies SET status = $3, dismissed_at = COALESCE(dismissed_at, $4)
+	WHERE id = $1 AND account_id = $2
+	RETURNING ` + anomalyColumns
+
+	anomaly, err := scanToAnomaly(r.db.QueryRow(ctx, query, id, accountID, domain.Dismissed, r.nowFunc()).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAnomalyNotFound
+	}
+
+	return anomaly, err
+}
+
+// CountMerchantMovements returns how many movements of the account, other than excludeID, are
+// from the merchant.
+func (r *postgresRepository) CountMerchantMovements(ctx context.Context, accountID string, merchantID string, excludeID string) (int, error) {
+	query := `SELECT COUNT(*)
+	FROM movements
+	WHERE account_id = $1 AND merchant_id = $2 AND id <> $3`
+
+	var count int
+
+	err := r.db.QueryRow(ctx, query, accountID, merchantID, excludeID).Scan(&count)
+
+	return count, err
+}
+
+// GetExpenseStats returns how many expenses the account made since the date, other than
+// excludeID and transfers, and their average amount.
+func (r *postgresRepository) GetExpenseStats(ctx context.Context, accountID string, since time.Time, excludeID string) (int, float64, error) {
+	query := `SELECT COUNT(*), COALESCE(AVG(amount), 0)
+	FROM movements
+	WHERE account_id = $1 AND type = $2 AND transfer_id = '' AND date >= $3 AND id <> $4`
+
+	var count int
+	var average float64
+
+	err := r.db.QueryRow(ctx, query, accountID, movementsDomain.Expense, since, excludeID).Scan(&count, &average)
+
+	return count, average, err
+}
+
+// GetCategoryCharges returns the expenses of the category made between the dates, both
+// included, other than excludeID and transfers.
+func (r *postgresRepository) GetCategoryCharges(ctx context.Context, accountID string, category string, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error) {
+	query := `SELECT ` + chargeColumns + `
+	FROM movements
+	WHERE account_id = $1 AND type = $2 AND transfer_id = '' AND category = $3
+		AND date >= $4 AND date <= $5 AND id <> $6
+	ORDER BY date`
+
+	return r.queryCharges(ctx, query, accountID, movementsDomain.Expense, category, from, to, excludeID)
+}
+
+// GetChargesByAmount returns the expenses of the amount made between the dates, both
+// included, other than excludeID.
+func (r *postgresRepository) GetChargesByAmount(ctx context.Context, accountID string, amount float64, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error) {
+	query := `SELECT ` + chargeColumns + `
+	FROM movements
+	WHERE account_id = $1 AND type = $2 AND amount = $3 AND date >= $4 AND date <= $5 AND id <> $6
+	ORDER BY date`
+
+	return r.queryCharges(ctx, query, accountID, movementsDomain.Expense, amount, from, to, excludeID)
+}
+
+func (r *postgresRepository) queryCharges(ctx context.Context, query string, args ...any) ([]*domain.Charge, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	charges := []*domain.Charge{}
+	for rows.Next() {
+		charge := &domain.Charge{}
+
+		err := rows.Scan(&charge.MovementID, &charge.Description, &charge.MerchantID, &charge.Amount, &charge.Date)
+		if err != nil {
+			return nil, err
+		}
+
+		charges = append(charges, charge)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
+
+func scanToAnomaly(scanFn func(...any) error) (*domain.Anomaly, error) {
+	anomaly := &domain.Anomaly{}
+
+	var kind, status string
+
+	err := scanFn(&anomaly.ID, &anomaly.AccountID, &anomaly.MovementID, &kind, &anomaly.Reason, &anomaly.Amount, &status, &anomaly.CreatedAt, &anomaly.DismissedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	anomaly.Kind = domain.Kind(kind)
+	anomaly.Status = domain.Status(status)
+
+	return anomaly, nil
+}
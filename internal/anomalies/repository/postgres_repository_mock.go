@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/anomalies/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAnomalyRepository is a mock of the repository interface.
+type MockAnomalyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAnomalyRepository) CreateAnomaly(ctx context.Context, anomaly *domain.Anomaly) (bool, error) {
+	args := m.Called(ctx, anomaly)
+
+	return args.Get(0).(bool), args.Error(1)
+}
+
+func (m *MockAnomalyRepository) GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error) {
+	args := m.Called(ctx, accountID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Anomaly), args.Error(1)
+}
+
+func (m *MockAnomalyRepository) DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Anomaly), args.Error(1)
+}
+
+func (m *MockAnomalyRepository) CountMerchantMovements(ctx context.Context, accountID string, merchantID string, excludeID string) (int, error) {
+	args := m.Called(ctx, accountID, merchantID, excludeID)
+
+	return args.Get(0).(int), args.Error(1)
+}
+
+func (m *MockAnomalyRepository) GetExpenseStats(ctx context.Context, accountID string, since time.Time, excludeID string) (int, float64, error) {
+	args := m.Called(ctx, accountID, since, excludeID)
+
+	return args.Int(0), args.Get(1).(float64), args.Error(2)
+}
+
+func (m *MockAnomalyRepository) GetCategoryCharges(ctx context.Context, accountID string, category string, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error) {
+	args := m.Called(ctx, accountID, category, from, to, excludeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Charge), args.Error(1)
+}
+
+func (m *MockAnomalyRepository) GetChargesByAmount(ctx context.Context, accountID string, amount float64, from time.Time, to time.Time, excludeID string) ([]*domain.Charge, error) {
+	args := m.Called(ctx, accountID, amount, from, to, excludeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Charge), args.Error(1)
+}
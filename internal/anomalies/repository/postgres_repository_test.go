@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/anomalies/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime   = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	anomalyRows = []string{"id", "account_id", "movement_id", "kind", "reason", "amount", "status", "created_at", "dismissed_at"}
+	chargeRows  = []string{"id", "description", "merchant_id", "amount", "date"}
+)
+
+func setupMockDB(t *testing.T) (AnomalyRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newAnomaly() *domain.Anomaly {
+	return &domain.Anomaly{
+		ID:         "ANM1",
+		AccountID:  "acc1",
+		MovementID: "MID1",
+		Kind:       domain.DuplicateCharge,
+		Reason:     "same amount charged 2 minutes apart from movement MID0",
+		Amount:     45000,
+		Status:     domain.Open,
+	}
+}
+
+func TestCreateAnomaly(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		created  bool
+	}{
+		{name: "created", affected: 1, created: true},
+		{name: "already flagged", affected: 0, created: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			repo, mock := setupMockDB(t)
+
+			anomaly := newAnomaly()
+
+			mock.ExpectExec(`INSERT INTO anomalies (.+) ON CONFLICT \(movement_id, kind\) DO NOTHING`).
+				WithArgs("ANM1", "acc1", "MID1", domain.DuplicateCharge, anomaly.Reason, 45000.0, domain.Open, fixedTime, (*time.Time)(nil)).
+				WillReturnResult(pgxmock.NewResult("INSERT", tt.affected))
+
+			created, err := repo.CreateAnomaly(context.Background(), anomaly)
+			c.NoError(err)
+			c.Equal(tt.created, created)
+			c.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetAnomalies(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	dismissedAt := fixedTime.Add(time.Hour)
+	rows := pgxmock.NewRows(anomalyRows).
+		AddRow("ANM1", "acc1", "MID1", "new_merchant", "first charge", 200000.0, "dismissed", fixedTime, &dismissedAt)
+
+	mock.ExpectQuery(`SELECT (.+) FROM anomalies WHERE account_id = \$1 AND \(\$2 = '' OR status = \$2\) ORDER BY created_at DESC`).
+		WithArgs("acc1", domain.Status("")).
+		WillReturnRows(rows)
+
+	anomalies, err := repo.GetAnomalies(context.Background(), "acc1", "")
+	c.NoError(err)
+	c.Len(anomalies, 1)
+	c.Equal(domain.NewMerchant, anomalies[0].Kind)
+	c.Equal(domain.Dismissed, anomalies[0].Status)
+	c.Equal(dismissedAt, *anomalies[0].DismissedAt)
+}
+
+func TestDismissAnomaly(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(anomalyRows).
+			AddRow("ANM1", "acc1", "MID1", "duplicate_charge", "same amount", 45000.0, "dismissed", fixedTime, &fixedTime)
+
+		mock.ExpectQuery(`UPDATE anomalies SET status = \$3, dismissed_at = COALESCE\(dismissed_at, \$4\) WHERE id = \$1 AND account_id = \$2 RETURNING`).
+			WithArgs("ANM1", "acc1", domain.Dismissed, fixedTime).
+			WillReturnRows(rows)
+
+		anomaly, err := repo.DismissAnomaly(context.Background(), "ANM1", "acc1")
+		c.NoError(err)
+		c.Equal(domain.Dismissed, anomaly.Status)
+		c.Equal(fixedTime, *anomaly.DismissedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`UPDATE anomalies`).
+			WithArgs("ANM1", "acc1", domain.Dismissed, fixedTime).
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.DismissAnomaly(context.Background(), "ANM1", "acc1")
+		require.ErrorIs(t, err, ErrAnomalyNotFound)
+	})
+}
+
+func TestCountMerchantMovements(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM movements WHERE account_id = \$1 AND merchant_id = \$2 AND id <> \$3`).
+		WithArgs("acc1", "MER1", "MID1").
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := repo.CountMerchantMovements(context.Background(), "acc1", "MER1", "MID1")
+	c.NoError(err)
+	c.Equal(3, count)
+}
+
+func TestGetExpenseStats(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	since := fixedTime.AddDate(0, 0, -domain.HistoryDays)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\), COALESCE\(AVG\(amount\), 0\) FROM movements WHERE account_id = \$1 AND type = \$2 AND transfer_id = ''`).
+		WithArgs("acc1", movementsDomain.Expense, since, "MID1").
+		WillReturnRows(pgxmock.NewRows([]string{"count", "avg"}).AddRow(40, 52000.0))
+
+	count, average, err := repo.GetExpenseStats(context.Background(), "acc1", since, "MID1")
+	c.NoError(err)
+	c.Equal(40, count)
+	c.Equal(52000.0, average)
+}
+
+func TestGetCategoryCharges(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	from := fixedTime.AddDate(0, -6, 0)
+	rows := pgxmock.NewRows(chargeRows).AddRow("MID0", "Compra RAPPI", "MER1", 45000.0, from)
+
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE account_id = \$1 AND type = \$2 AND transfer_id = '' AND category = \$3`).
+		WithArgs("acc1", movementsDomain.Expense, "food", from, fixedTime, "MID1").
+		WillReturnRows(rows)
+
+	charges, err := repo.GetCategoryCharges(context.Background(), "acc1", "food", from, fixedTime, "MID1")
+	c.NoError(err)
+	c.Len(charges, 1)
+	c.Equal("MER1", charges[0].MerchantID)
+}
+
+func TestGetChargesByAmount(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	from := fixedTime.Add(-domain.DuplicateWindow)
+	to := fixedTime.Add(domain.DuplicateWindow)
+
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE account_id = \$1 AND type = \$2 AND amount = \$3`).
+		WithArgs("acc1", movementsDomain.Expense, 45000.0, from, to, "MID1").
+		WillReturnRows(pgxmock.NewRows(chargeRows).AddRow("MID0", "Compra RAPPI", "MER1", 45000.0, fixedTime))
+
+	charges, err := repo.GetChargesByAmount(context.Background(), "acc1", 45000, from, to, "MID1")
+	c.NoError(err)
+	c.Len(charges, 1)
+	c.Equal("MID0", charges[0].MovementID)
+}
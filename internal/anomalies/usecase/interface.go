@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/anomalies/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+// AnomaliesUsecase flags the new movements that are out of the pattern of their account and
+// lets the user review them.
+type AnomaliesUsecase interface {
+	CheckMovement(ctx context.Context, movement *movementsDomain.Movement) ([]*domain.Anomaly, error)
+	GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error)
+	DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error)
+}
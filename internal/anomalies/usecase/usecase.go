@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/anomalies/domain"
+	"transaction-tracker/internal/anomalies/repository"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+)
+
+var (
+	ErrAnomalyNotFound = repository.ErrAnomalyNotFound
+)
+
+type anomaliesUsecase struct {
+	repo          repository.AnomalyRepository
+	transactor    postgres.Transactor
+	eventsUsecase eventsUsecase.EventsUsecase
+}
+
+// NewAnomaliesUsecase creates a new instance of AnomaliesUsecase. Anomalies are stored with
+// their anomaly.detected event inside a transaction started by transactor.
+func NewAnomaliesUsecase(repo repository.AnomalyRepository, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase) AnomaliesUsecase {
+	return &anomaliesUsecase{
+		repo:          repo,
+		transactor:    transactor,
+		eventsUsecase: evUsecase,
+	}
+}
+
+// CheckMovement runs the detectors on a stored expense that came from an email or a bank
+// statement, and stores and notifies the anomalies found. Manual movements and transfers are
+// not checked. A movement already flagged with the same kind is not notified again.
+func (u *anomaliesUsecase) CheckMovement(ctx context.Context, movement *movementsDomain.Movement) ([]*domain.Anomaly, error) {
+	created := []*domain.Anomaly{}
+	if movement.Type != movementsDomain.Expense || movement.Source == movementsDomain.ManualSource || movement.TransferID != "" {
+		return created, nil
+	}
+
+	history, err := u.history(ctx, movement)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, anomaly := range domain.Detect(movement, history) {
+		recorded := false
+
+		err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			recorded, err = u.repo.CreateAnomaly(ctx, anomaly)
+			if err != nil || !recorded {
+				return err
+			}
+
+			return u.eventsUsecase.Emit(ctx, eventsDomain.AnomalyDetected, anomaly.AccountID, anomaly.ID, eventsDomain.AnomalyPayload{
+				ID:         anomaly.ID,
+				AccountID:  anomaly.AccountID,
+				MovementID: anomaly.MovementID,
+				Kind:       string(anomaly.Kind),
+				Reason:     anomaly.Reason,
+				Amount:     anomaly.Amount,
+				Date:       movement.Date,
+			})
+		})
+		if err != nil {
+			return created, err
+		}
+
+		if recorded {
+			created = append(created, anomaly)
+		}
+	}
+
+	return created, nil
+}
+
+// history reads what the account had before the movement.
+func (u *anomaliesUsecase) history(ctx context.Context, movement *movementsDomain.Movement) (*domain.History, error) {
+	history := &domain.History{}
+
+	var err error
+	if movement.MerchantID != "" {
+		history.MerchantMovements, err = u.repo.CountMerchantMovements(ctx, movement.AccountID, movement.MerchantID, movement.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history.Expenses, history.AverageExpense, err = u.repo.GetExpenseStats(ctx, movement.AccountID, movement.Date.AddDate(0, 0, -domain.HistoryDays), movement.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if movement.Category != "" && movement.Category != movementsDomain.Unknown {
+		month := time.Date(movement.Date.Year(), movement.Date.Month(), 1, 0, 0, 0, 0, movement.Date.Location())
+
+		history.CategoryCharges, err = u.repo.GetCategoryCharges(ctx, movement.AccountID, string(movement.Category), month.AddDate(0, -domain.NormMonths, 0), movement.Date, movement.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history.Nearby, err = u.repo.GetChargesByAmount(ctx, movement.AccountID, movement.Amount, movement.Date.Add(-domain.DuplicateWindow), movement.Date.Add(domain.DuplicateWindow), movement.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (u *anomaliesUsecase) GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error) {
+	return u.repo.GetAnomalies(ctx, accountID, status)
+}
+
+// DismissAnomaly marks an anomaly as reviewed, so it is no longer listed as open.
+func (u *anomaliesUsecase) DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error) {
+	return u.repo.DismissAnomaly(ctx, id, accountID)
+}
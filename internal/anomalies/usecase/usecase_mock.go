@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/anomalies/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAnomaliesUsecase is a mock implementation of the AnomaliesUsecase interface.
+type MockAnomaliesUsecase struct {
+	mock.Mock
+}
+
+func (m *MockAnomaliesUsecase) CheckMovement(ctx context.Context, movement *movementsDomain.Movement) ([]*domain.Anomaly, error) {
+	args := m.Called(ctx, movement)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Anomaly), args.Error(1)
+}
+
+func (m *MockAnomaliesUsecase) GetAnomalies(ctx context.Context, accountID string, status domain.Status) ([]*domain.Anomaly, error) {
+	args := m.Called(ctx, accountID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Anomaly), args.Error(1)
+}
+
+func (m *MockAnomaliesUsecase) DismissAnomaly(ctx context.Context, id string, accountID string) (*domain.Anomaly, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Anomaly), args.Error(1)
+}
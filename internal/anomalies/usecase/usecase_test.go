@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/anomalies/domain"
+	"transaction-tracker/internal/anomalies/repository"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newMovement() *movementsDomain.Movement {
+	return &movementsDomain.Movement{
+		ID:          "MID1",
+		AccountID:   "acc1",
+		Description: "Compra TIENDA NUEVA",
+		MerchantID:  "MER1",
+		Amount:      300000,
+		Type:        movementsDomain.Expense,
+		Category:    movementsDomain.Shopping,
+		Source:      movementsDomain.EmailSource,
+		Date:        fixedTime,
+	}
+}
+
+// newMockHistory returns a repository whose history makes the movement the first large
+// charge of a new merchant.
+func newMockHistory() *repository.MockAnomalyRepository {
+	since := fixedTime.AddDate(0, 0, -domain.HistoryDays)
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := new(repository.MockAnomalyRepository)
+	repo.On("CountMerchantMovements", mock.Anything, "acc1", "MER1", "MID1").Return(0, nil)
+	repo.On("GetExpenseStats", mock.Anything, "acc1", since, "MID1").Return(40, 50000.0, nil)
+	repo.On("GetCategoryCharges", mock.Anything, "acc1", "shopping", from, fixedTime, "MID1").Return([]*domain.Charge{}, nil)
+	repo.On("GetChargesByAmount", mock.Anything, "acc1", 300000.0, fixedTime.Add(-domain.DuplicateWindow), fixedTime.Add(domain.DuplicateWindow), "MID1").Return([]*domain.Charge{}, nil)
+
+	return repo
+}
+
+func TestCheckMovement(t *testing.T) {
+	t.Run("flags and notifies", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := newMockHistory()
+		repo.On("CreateAnomaly", mock.Anything, mock.AnythingOfType("*domain.Anomaly")).Return(true, nil)
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", mock.Anything, eventsDomain.AnomalyDetected, "acc1", mock.Anything, mock.MatchedBy(func(p eventsDomain.AnomalyPayload) bool {
+			return p.MovementID == "MID1" && p.Kind == "new_merchant" && p.Amount == 300000
+		})).Return(nil)
+
+		anomalies, err := NewAnomaliesUsecase(repo, postgres.NewMockTransactor(), events).CheckMovement(context.Background(), newMovement())
+		c.NoError(err)
+		c.Len(anomalies, 1)
+		c.Equal(domain.NewMerchant, anomalies[0].Kind)
+		repo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("already flagged", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := newMockHistory()
+		repo.On("CreateAnomaly", mock.Anything, mock.AnythingOfType("*domain.Anomaly")).Return(false, nil)
+
+		events := new(eventsUsecase.MockEventsUsecase)
+
+		anomalies, err := NewAnomaliesUsecase(repo, postgres.NewMockTransactor(), events).CheckMovement(context.Background(), newMovement())
+		c.NoError(err)
+		c.Empty(anomalies)
+		events.AssertNotCalled(t, "Emit")
+	})
+
+	t.Run("not checked", func(t *testing.T) {
+		manual := newMovement()
+		manual.Source = movementsDomain.ManualSource
+
+		income := newMovement()
+		income.Type = movementsDomain.Income
+
+		transfer := newMovement()
+		transfer.TransferID = "TRF1"
+
+		for _, movement := range []*movementsDomain.Movement{manual, income, transfer} {
+			repo := new(repository.MockAnomalyRepository)
+
+			anomalies, err := NewAnomaliesUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase)).CheckMovement(context.Background(), movement)
+			require.NoError(t, err)
+			require.Empty(t, anomalies)
+			repo.AssertNotCalled(t, "GetExpenseStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestDismissAnomaly(t *testing.T) {
+	c := require.New(t)
+
+	dismissed := &domain.Anomaly{ID: "ANM1", AccountID: "acc1", Status: domain.Dismissed}
+
+	repo := new(repository.MockAnomalyRepository)
+	repo.On("DismissAnomaly", mock.Anything, "ANM1", "acc1").Return(dismissed, nil)
+
+	anomaly, err := NewAnomaliesUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase)).DismissAnomaly(context.Background(), "ANM1", "acc1")
+	c.NoError(err)
+	c.Equal(domain.Dismissed, anomaly.Status)
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_attachment_prefix = "ATT"
+
+	// MaxSize is the largest receipt that can be attached, in bytes.
+	MaxSize = 10 << 20
+	// MaxFileNameLength is the longest file name kept for an attachment.
+	MaxFileNameLength = 255
+)
+
+var (
+	// ErrInvalidAttachment is returned when a file can't be attached to a movement.
+	ErrInvalidAttachment = errors.New("invalid attachment")
+)
+
+// extensions are the receipt formats accepted, by content type.
+var extensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+// Attachment is a receipt of a movement, an image or a PDF kept in the file storage. Path is
+// where the storage saved it.
+type Attachment struct {
+	ID          string
+	MovementID  string
+	AccountID   string
+	FileName    string
+	ContentType string
+	Size        int64
+	Path        string
+	CreatedAt   time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (a *Attachment) LogProperties() map[string]string {
+	return map[string]string{
+		"attachment_id": a.ID,
+		"movement_id":   a.MovementID,
+		"account_id":    a.AccountID,
+		"content_type":  a.ContentType,
+	}
+}
+
+// NewAttachment creates the attachment of a receipt uploaded as fileName. The content type is
+// detected from the data, so only real images and PDFs are accepted whatever their name.
+func NewAttachment(accountID string, movementID string, fileName string, data []byte) (*Attachment, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidAttachment)
+	}
+
+	if len(data) > MaxSize {
+		return nil, fmt.Errorf("%w: file is larger than %d MB", ErrInvalidAttachment, MaxSize>>20)
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := extensions[contentType]; !ok {
+		return nil, fmt.Errorf("%w: only JPEG, PNG and PDF files are accepted", ErrInvalidAttachment)
+	}
+
+	fileName = strings.TrimSpace(filepath.Base(fileName))
+	if fileName == "." || fileName == string(filepath.Separator) || fileName == "" {
+		fileName = "receipt" + extensions[contentType]
+	}
+
+	if len(fileName) > MaxFileNameLength {
+		fileName = fileName[len(fileName)-MaxFileNameLength:]
+	}
+
+	return &Attachment{
+		ID:          _attachment_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		MovementID:  movementID,
+		AccountID:   accountID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}, nil
+}
+
+// StoredName is the name the file is saved with, so uploads with the same name don't
+// overwrite each other.
+func (a *Attachment) StoredName() string {
+	return a.ID + extensions[a.ContentType]
+}
+
+// Folder is the folder of the storage where the receipts of the movement are saved.
+func (a *Attachment) Folder() string {
+	return MovementFolder(a.MovementID)
+}
+
+// MovementFolder is the folder of the storage where the receipts of a movement are saved.
+func MovementFolder(movementID string) string {
+	return filepath.Join("attachments", movementID)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var pngData = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestNewAttachment(t *testing.T) {
+	t.Run("image", func(t *testing.T) {
+		c := require.New(t)
+
+		attachment, err := NewAttachment("acc1", "MID1", "../dinner.png", pngData)
+		c.NoError(err)
+		c.True(strings.HasPrefix(attachment.ID, _attachment_prefix))
+		c.Equal("dinner.png", attachment.FileName)
+		c.Equal("image/png", attachment.ContentType)
+		c.Equal(int64(len(pngData)), attachment.Size)
+		c.Equal(attachment.ID+".png", attachment.StoredName())
+		c.Equal("attachments/MID1", attachment.Folder())
+	})
+
+	t.Run("pdf without name", func(t *testing.T) {
+		c := require.New(t)
+
+		attachment, err := NewAttachment("acc1", "MID1", "", []byte("%PDF-1.7\n"))
+		c.NoError(err)
+		c.Equal("application/pdf", attachment.ContentType)
+		c.Equal("receipt.pdf", attachment.FileName)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := require.New(t)
+
+		_, err := NewAttachment("acc1", "MID1", "notes.png", []byte("plain text"))
+		c.ErrorIs(err, ErrInvalidAttachment)
+
+		_, err = NewAttachment("acc1", "MID1", "empty.png", nil)
+		c.ErrorIs(err, ErrInvalidAttachment)
+
+		_, err = NewAttachment("acc1", "MID1", "big.png", append(pngData, make([]byte, MaxSize)...))
+		c.ErrorIs(err, ErrInvalidAttachment)
+	})
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/attachments/domain"
+)
+
+// AttachmentRepository stores the receipts attached to the movements of each account.
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment *domain.Attachment) error
+	GetAttachmentByID(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, error)
+	GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error
+}
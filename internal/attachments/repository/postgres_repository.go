@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/attachments/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const attachmentColumns = `id, movement_id, account_id, file_name, content_type, size, path, created_at`
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the attachments repository.
+func NewPostgresRepository(db *pgxpool.Pool) AttachmentRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// CreateAttachment stores an attachment whose file is already saved.
+func (r *postgresRepository) CreateAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO movement_attachments (` + attachmentColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.db.Exec(ctx, query,
+		attachment.ID,
+		attachment.MovementID,
+		attachment.AccountID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.Path,
+		now)
+	if err != nil {
+		return err
+	}
+
+	attachment.CreatedAt = now
+
+	return nil
+}
+
+// GetAttachmentByID returns an attachment of a movement of the account.
+func (r *postgresRepository) GetAttachmentByID(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + `
+	FROM movement_attachments
+	WHERE id = $1 AND movement_id = $2 AND account_id = $3`
+
+	attachment, err := scanToAttachment(r.db.QueryRow(ctx, query, id, movementID, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAttachmentNotFound
+	}
+
+	return attachment, err
+}
+
+// GetAttachments returns the attachments of a movement of the account, oldest first.
+func (r *postgresRepository) GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + `
+	FROM movement_attachments
+	WHERE movement_id = $1 AND account_id = $2
+	ORDER BY created_at`
+
+	rows, err := r.db.Query(ctx, query, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	attachments := []*domain.Attachment{}
+	for rows.Next() {
+		attachment, err := scanToAttachment(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// DeleteAttachment removes an attachment of a movement of the account. Its file is left to
+// the caller.
+func (r *postgresRepository) DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error {
+	query := `DELETE FROM movement_attachments WHERE id = $1 AND movement_id = $2 AND account_id = $3`
+
+	tag, err := r.db.Exec(ctx, query, id, movementID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+func scanToAttachment(scanFn func(...any) error) (*domain.Attachment, error) {
+	attachment := &domain.Attachment{}
+
+	err := scanFn(
+		&attachment.ID,
+		&attachment.MovementID,
+		&attachment.AccountID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.Path,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/attachments/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentRepository is a mock of the repository interface.
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) CreateAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	args := m.Called(ctx, attachment)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) GetAttachmentByID(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, error) {
+	args := m.Called(ctx, id, movementID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, movementID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error {
+	args := m.Called(ctx, id, movementID, accountID)
+	return args.Error(0)
+}
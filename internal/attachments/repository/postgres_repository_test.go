@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/attachments/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime      = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	attachmentRows = []string{"id", "movement_id", "account_id", "file_name", "content_type", "size", "path", "created_at"}
+)
+
+func setupMockDB(t *testing.T) (AttachmentRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newAttachment() *domain.Attachment {
+	return &domain.Attachment{
+		ID:          "ATT1",
+		MovementID:  "MID1",
+		AccountID:   "acc1",
+		FileName:    "dinner.png",
+		ContentType: "image/png",
+		Size:        2048,
+		Path:        "/files/acc1/attachments/MID1/ATT1.png",
+	}
+}
+
+func TestCreateAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		attachment := newAttachment()
+		mock.ExpectExec(`INSERT INTO movement_attachments \(id, movement_id, account_id, file_name, content_type, size, path, created_at\)`).
+			WithArgs("ATT1", "MID1", "acc1", "dinner.png", "image/png", int64(2048), attachment.Path, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateAttachment(context.Background(), attachment))
+		c.Equal(fixedTime, attachment.CreatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO movement_attachments`).WillReturnError(errors.New("db down"))
+
+		require.Error(t, repo.CreateAttachment(context.Background(), newAttachment()))
+	})
+}
+
+func TestGetAttachmentByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(attachmentRows).
+			AddRow("ATT1", "MID1", "acc1", "dinner.png", "image/png", int64(2048), "/files/ATT1.png", fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM movement_attachments WHERE id = \$1 AND movement_id = \$2 AND account_id = \$3`).
+			WithArgs("ATT1", "MID1", "acc1").
+			WillReturnRows(rows)
+
+		attachment, err := repo.GetAttachmentByID(context.Background(), "ATT1", "MID1", "acc1")
+		c.NoError(err)
+		c.Equal("dinner.png", attachment.FileName)
+		c.Equal(int64(2048), attachment.Size)
+		c.Equal("/files/ATT1.png", attachment.Path)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM movement_attachments`).
+			WithArgs("ATT1", "MID1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetAttachmentByID(context.Background(), "ATT1", "MID1", "acc1")
+		require.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+}
+
+func TestGetAttachments(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(attachmentRows).
+		AddRow("ATT1", "MID1", "acc1", "dinner.png", "image/png", int64(2048), "/files/ATT1.png", fixedTime).
+		AddRow("ATT2", "MID1", "acc1", "invoice.pdf", "application/pdf", int64(4096), "/files/ATT2.pdf", fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM movement_attachments WHERE movement_id = \$1 AND account_id = \$2 ORDER BY created_at`).
+		WithArgs("MID1", "acc1").
+		WillReturnRows(rows)
+
+	attachments, err := repo.GetAttachments(context.Background(), "MID1", "acc1")
+	c.NoError(err)
+	c.Len(attachments, 2)
+	c.Equal("application/pdf", attachments[1].ContentType)
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM movement_attachments WHERE id = \$1 AND movement_id = \$2 AND account_id = \$3`).
+			WithArgs("ATT1", "MID1", "acc1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		c.NoError(repo.DeleteAttachment(context.Background(), "ATT1", "MID1", "acc1"))
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM movement_attachments`).
+			WithArgs("ATT1", "MID1", "acc1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := repo.DeleteAttachment(context.Background(), "ATT1", "MID1", "acc1")
+		require.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+}
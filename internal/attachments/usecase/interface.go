@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/attachments/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/eventbus"
+)
+
+// AttachmentsUsecase keeps the receipts of the movements, saving their files in the file
+// storage.
+type AttachmentsUsecase interface {
+	AddAttachment(ctx context.Context, accountID string, movementID string, fileName string, data []byte) (*domain.Attachment, error)
+	GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error)
+	GetAttachmentFile(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, []byte, error)
+	DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error
+	HandleEvent(ctx context.Context, event *eventsDomain.Event) error
+	HandleMessage(ctx context.Context, msg *eventbus.Message) error
+}
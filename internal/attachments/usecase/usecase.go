@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/attachments/domain"
+	"transaction-tracker/internal/attachments/repository"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/eventbus"
+	"transaction-tracker/pkg/files"
+)
+
+const (
+	// Subscription is the event bus subscription that removes the receipts of deleted
+	// movements.
+	Subscription = "attachments"
+)
+
+var (
+	ErrAttachmentNotFound = repository.ErrAttachmentNotFound
+	ErrMovementNotFound   = movementsUsecase.ErrMovementNotFound
+	ErrFileNotFound       = files.ErrFileNotFound
+)
+
+type attachmentsUsecase struct {
+	repo             repository.AttachmentRepository
+	movementsUsecase movementsUsecase.MovementUsecase
+	storage          files.Storage
+	log              *loggerModels.Logger
+}
+
+// NewAttachmentsUsecase creates a new instance of AttachmentsUsecase.
+func NewAttachmentsUsecase(ctx context.Context, repo repository.AttachmentRepository, mvmUsecase movementsUsecase.MovementUsecase, storage files.Storage) AttachmentsUsecase {
+	log, _ := logger.GetLogger(ctx, "attachments-usecase")
+
+	return &attachmentsUsecase{
+		repo:             repo,
+		movementsUsecase: mvmUsecase,
+		storage:          storage,
+		log:              log,
+	}
+}
+
+// AddAttachment saves a receipt of a movement of the account. The file is removed again when
+// the attachment can't be stored.
+func (u *attachmentsUsecase) AddAttachment(ctx context.Context, accountID string, movementID string, fileName string, data []byte) (*domain.Attachment, error) {
+	_, err := u.movementsUsecase.GetMovementByID(ctx, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := domain.NewAttachment(accountID, movementID, fileName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment.Path, err = u.storage.Save(accountID, attachment.Folder(), attachment.StoredName(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.CreateAttachment(ctx, attachment)
+	if err != nil {
+		u.deleteFile(attachment)
+
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// GetAttachments returns the attachments of a movement of the account.
+func (u *attachmentsUsecase) GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error) {
+	_, err := u.movementsUsecase.GetMovementByID(ctx, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.GetAttachments(ctx, movementID, accountID)
+}
+
+// GetAttachmentFile returns an attachment with the content of its file.
+func (u *attachmentsUsecase) GetAttachmentFile(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, []byte, error) {
+	attachment, err := u.repo.GetAttachmentByID(ctx, id, movementID, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := u.storage.Read(attachment.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, data, nil
+}
+
+// DeleteAttachment removes an attachment and its file.
+func (u *attachmentsUsecase) DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error {
+	attachment, err := u.repo.GetAttachmentByID(ctx, id, movementID, accountID)
+	if err != nil {
+		return err
+	}
+
+	err = u.repo.DeleteAttachment(ctx, id, movementID, accountID)
+	if err != nil {
+		return err
+	}
+
+	u.deleteFile(attachment)
+
+	return nil
+}
+
+// HandleEvent removes the receipt files of a deleted movement. The attachments themselves
+// are deleted with the movement, wherever it was deleted from.
+func (u *attachmentsUsecase) HandleEvent(ctx context.Context, event *eventsDomain.Event) error {
+	if event.Type != eventsDomain.MovementDeleted {
+		return nil
+	}
+
+	return u.storage.DeleteFolder(event.AccountID, domain.MovementFolder(event.AggregateID))
+}
+
+// HandleMessage is the event bus handler of the attachments subscription. Messages that are
+// not domain events are acknowledged and dropped.
+func (u *attachmentsUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	event, err := eventsDomain.ParseEvent(msg.Data)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "attachments_event_discarded",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				msg,
+			},
+		})
+
+		return nil
+	}
+
+	return u.HandleEvent(ctx, event)
+}
+
+// deleteFile removes the file of an attachment. A file left behind is logged, since the
+// attachment is already gone for the user.
+func (u *attachmentsUsecase) deleteFile(attachment *domain.Attachment) {
+	err := u.storage.Delete(attachment.Path)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "delete_attachment_file_failed",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				logger.MapToProperties(attachment.LogProperties()),
+			},
+		})
+	}
+}
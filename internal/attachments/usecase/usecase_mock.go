@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/attachments/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentsUsecase is a mock implementation of the AttachmentsUsecase interface.
+type MockAttachmentsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentsUsecase) AddAttachment(ctx context.Context, accountID string, movementID string, fileName string, data []byte) (*domain.Attachment, error) {
+	args := m.Called(ctx, accountID, movementID, fileName, data)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentsUsecase) GetAttachments(ctx context.Context, movementID string, accountID string) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, movementID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentsUsecase) GetAttachmentFile(ctx context.Context, id string, movementID string, accountID string) (*domain.Attachment, []byte, error) {
+	args := m.Called(ctx, id, movementID, accountID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+
+	var data []byte
+	if args.Get(1) != nil {
+		data = args.Get(1).([]byte)
+	}
+
+	return args.Get(0).(*domain.Attachment), data, args.Error(2)
+}
+
+func (m *MockAttachmentsUsecase) DeleteAttachment(ctx context.Context, id string, movementID string, accountID string) error {
+	args := m.Called(ctx, id, movementID, accountID)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentsUsecase) HandleEvent(ctx context.Context, event *eventsDomain.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentsUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"transaction-tracker/internal/attachments/domain"
+	"transaction-tracker/internal/attachments/repository"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/pkg/files"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var pngData = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type mocks struct {
+	repo      *repository.MockAttachmentRepository
+	movements *movementsUsecase.MockMovementUsecase
+	storage   *files.MockStorage
+}
+
+func newUsecase() (*attachmentsUsecase, *mocks) {
+	m := &mocks{
+		repo:      new(repository.MockAttachmentRepository),
+		movements: new(movementsUsecase.MockMovementUsecase),
+		storage:   new(files.MockStorage),
+	}
+
+	return &attachmentsUsecase{repo: m.repo, movementsUsecase: m.movements, storage: m.storage}, m
+}
+
+func newAttachment() *domain.Attachment {
+	return &domain.Attachment{
+		ID:          "ATT1",
+		MovementID:  "MID1",
+		AccountID:   "acc1",
+		FileName:    "dinner.png",
+		ContentType: "image/png",
+		Path:        "/files/acc1/attachments/MID1/ATT1.png",
+	}
+}
+
+func TestAddAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		u, m := newUsecase()
+		m.movements.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(&movementsDomain.Movement{ID: "MID1"}, nil)
+		m.storage.On("Save", "acc1", "attachments/MID1", mock.AnythingOfType("string"), pngData).Return("/files/ATT.png", nil)
+		m.repo.On("CreateAttachment", mock.Anything, mock.AnythingOfType("*domain.Attachment")).Return(nil)
+
+		attachment, err := u.AddAttachment(context.Background(), "acc1", "MID1", "dinner.png", pngData)
+		c.NoError(err)
+		c.Equal("/files/ATT.png", attachment.Path)
+		c.Equal("image/png", attachment.ContentType)
+		m.storage.AssertExpectations(t)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("movement not found", func(t *testing.T) {
+		u, m := newUsecase()
+		m.movements.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(nil, movementsUsecase.ErrMovementNotFound)
+
+		_, err := u.AddAttachment(context.Background(), "acc1", "MID1", "dinner.png", pngData)
+		require.ErrorIs(t, err, ErrMovementNotFound)
+		m.storage.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		u, m := newUsecase()
+		m.movements.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(&movementsDomain.Movement{ID: "MID1"}, nil)
+
+		_, err := u.AddAttachment(context.Background(), "acc1", "MID1", "notes.txt", []byte("plain text"))
+		require.ErrorIs(t, err, domain.ErrInvalidAttachment)
+	})
+
+	t.Run("repository error removes the file", func(t *testing.T) {
+		c := require.New(t)
+
+		u, m := newUsecase()
+		m.movements.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(&movementsDomain.Movement{ID: "MID1"}, nil)
+		m.storage.On("Save", "acc1", "attachments/MID1", mock.AnythingOfType("string"), pngData).Return("/files/ATT.png", nil)
+		m.repo.On("CreateAttachment", mock.Anything, mock.Anything).Return(errors.New("db down"))
+		m.storage.On("Delete", "/files/ATT.png").Return(nil)
+
+		_, err := u.AddAttachment(context.Background(), "acc1", "MID1", "dinner.png", pngData)
+		c.Error(err)
+		m.storage.AssertExpectations(t)
+	})
+}
+
+func TestGetAttachmentFile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		u, m := newUsecase()
+		m.repo.On("GetAttachmentByID", mock.Anything, "ATT1", "MID1", "acc1").Return(newAttachment(), nil)
+		m.storage.On("Read", "/files/acc1/attachments/MID1/ATT1.png").Return(pngData, nil)
+
+		attachment, data, err := u.GetAttachmentFile(context.Background(), "ATT1", "MID1", "acc1")
+		c.NoError(err)
+		c.Equal("dinner.png", attachment.FileName)
+		c.Equal(pngData, data)
+	})
+
+	t.Run("file missing", func(t *testing.T) {
+		u, m := newUsecase()
+		m.repo.On("GetAttachmentByID", mock.Anything, "ATT1", "MID1", "acc1").Return(newAttachment(), nil)
+		m.storage.On("Read", mock.Anything).Return(nil, files.ErrFileNotFound)
+
+		_, _, err := u.GetAttachmentFile(context.Background(), "ATT1", "MID1", "acc1")
+		require.ErrorIs(t, err, ErrFileNotFound)
+	})
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		u, m := newUsecase()
+		m.repo.On("GetAttachmentByID", mock.Anything, "ATT1", "MID1", "acc1").Return(newAttachment(), nil)
+		m.repo.On("DeleteAttachment", mock.Anything, "ATT1", "MID1", "acc1").Return(nil)
+		m.storage.On("Delete", "/files/acc1/attachments/MID1/ATT1.png").Return(nil)
+
+		c.NoError(u.DeleteAttachment(context.Background(), "ATT1", "MID1", "acc1"))
+		m.repo.AssertExpectations(t)
+		m.storage.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		u, m := newUsecase()
+		m.repo.On("GetAttachmentByID", mock.Anything, "ATT1", "MID1", "acc1").Return(nil, ErrAttachmentNotFound)
+
+		err := u.DeleteAttachment(context.Background(), "ATT1", "MID1", "acc1")
+		require.ErrorIs(t, err, ErrAttachmentNotFound)
+		m.storage.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
+
+func TestHandleEvent(t *testing.T) {
+	t.Run("deleted movement removes its receipts", func(t *testing.T) {
+		c := require.New(t)
+
+		event, err := eventsDomain.NewEvent(eventsDomain.MovementDeleted, "acc1", "MID1", eventsDomain.MovementDeletedPayload{ID: "MID1", AccountID: "acc1"})
+		c.NoError(err)
+
+		u, m := newUsecase()
+		m.storage.On("DeleteFolder", "acc1", domain.MovementFolder("MID1")).Return(nil).Once()
+
+		c.NoError(u.HandleEvent(context.Background(), event))
+		m.storage.AssertExpectations(t)
+	})
+
+	t.Run("other events are ignored", func(t *testing.T) {
+		c := require.New(t)
+
+		event, err := eventsDomain.NewEvent(eventsDomain.MovementCreated, "acc1", "MID1", eventsDomain.MovementPayload{ID: "MID1"})
+		c.NoError(err)
+
+		u, m := newUsecase()
+
+		c.NoError(u.HandleEvent(context.Background(), event))
+		m.storage.AssertNotCalled(t, "DeleteFolder", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_budget_prefix = "BUD"
+
+	// MonthLayout is the layout months are written with in requests, e.g. 2025-09.
+	MonthLayout = "2006-01"
+)
+
+var (
+	// ErrInvalidBudget is returned when a budget has invalid fields.
+	ErrInvalidBudget = errors.New("invalid budget")
+	// ErrBudgetExists is returned when the account already has a budget for the category and month.
+	ErrBudgetExists = errors.New("budget already exists")
+
+	// Thresholds are the percentages of the limit that raise a notification when reached.
+	Thresholds = []int{80, 100}
+)
+
+// Budget is the amount an account plans to spend in a category during a month. With
+// Rollover, what was left of the budget of the previous month is added to the limit.
+type Budget struct {
+	ID        string
+	AccountID string
+	Category  movementsDomain.MovementCategory
+	Month     time.Time
+	Amount    float64
+	Rollover  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (b *Budget) LogProperties() map[string]string {
+	return map[string]string{
+		"budget_id":  b.ID,
+		"account_id": b.AccountID,
+		"category":   string(b.Category),
+		"month":      b.Month.Format(MonthLayout),
+		"amount":     strconv.FormatFloat(b.Amount, 'f', 2, 64),
+		"rollover":   strconv.FormatBool(b.Rollover),
+	}
+}
+
+// NewBudget creates the budget of the account for a category and a month written as MonthLayout.
+func NewBudget(accountID string, category string, month string, amount float64, rollover bool) (*Budget, error) {
+	start, err := ParseMonth(month)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := &Budget{
+		ID:        _budget_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		Category:  movementsDomain.MovementCategory(category),
+		Month:     start,
+		Amount:    amount,
+		Rollover:  rollover,
+	}
+
+	err = budget.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+// Validate checks the fields of the budget.
+func (b *Budget) Validate() error {
+	if b.Category == "" {
+		return fmt.Errorf("%w: category is required", ErrInvalidBudget)
+	}
+
+	if b.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be greater than zero", ErrInvalidBudget)
+	}
+
+	return nil
+}
+
+// End returns the first instant after the month of the budget.
+func (b *Budget) End() time.Time {
+	return b.Month.AddDate(0, 1, 0)
+}
+
+// ParseMonth returns the first day of a month written as MonthLayout, in UTC.
+func ParseMonth(month string) (time.Time, error) {
+	start, err := time.Parse(MonthLayout, month)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: month %q must look like %s", ErrInvalidBudget, month, MonthLayout)
+	}
+
+	return start, nil
+}
+
+// MonthOf returns the first day of the month of t, in UTC.
+func MonthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Progress is a budget compared with what was spent in its month. Limit is the amount of the
+// budget plus the Rollover from the previous month.
+type Progress struct {
+	Budget    *Budget
+	Rollover  float64
+	Limit     float64
+	Spent     float64
+	Remaining float64
+	Percent   float64
+}
+
+// NewProgress compares the budget with the amount spent in its month. previous is what was
+// left of the budget of the previous month, only used when the budget rolls over.
+func NewProgress(budget *Budget, spent float64, previous float64) *Progress {
+	progress := &Progress{
+		Budget: budget,
+		Spent:  spent,
+	}
+
+	if budget.Rollover && previous > 0 {
+		progress.Rollover = previous
+	}
+
+	progress.Limit = budget.Amount + progress.Rollover
+	progress.Remaining = progress.Limit - spent
+	progress.Percent = math.Round(spent/progress.Limit*10000) / 100
+
+	return progress
+}
+
+// Reached returns the thresholds the spending has reached, lowest first.
+func (p *Progress) Reached() []int {
+	reached := []int{}
+	for _, threshold := range Thresholds {
+		if p.Percent >= float64(threshold) {
+			reached = append(reached, threshold)
+		}
+	}
+
+	return reached
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		budget, err := NewBudget("acc1", "food", "2025-09", 800000, true)
+		c.NoError(err)
+		c.Contains(budget.ID, _budget_prefix)
+		c.Equal(movementsDomain.Food, budget.Category)
+		c.Equal(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), budget.Month)
+		c.Equal(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), budget.End())
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		_, err := NewBudget("acc1", "food", "09/2025", 800000, false)
+		require.ErrorIs(t, err, ErrInvalidBudget)
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		_, err := NewBudget("acc1", "food", "2025-09", 0, false)
+		require.ErrorIs(t, err, ErrInvalidBudget)
+	})
+}
+
+func TestNewProgress(t *testing.T) {
+	t.Run("without rollover", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(&Budget{Amount: 1000}, 850, 300)
+		c.Zero(progress.Rollover)
+		c.Equal(1000.0, progress.Limit)
+		c.Equal(150.0, progress.Remaining)
+		c.Equal(85.0, progress.Percent)
+		c.Equal([]int{80}, progress.Reached())
+	})
+
+	t.Run("with rollover", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(&Budget{Amount: 1000, Rollover: true}, 1000, 250)
+		c.Equal(250.0, progress.Rollover)
+		c.Equal(1250.0, progress.Limit)
+		c.Equal(80.0, progress.Percent)
+	})
+
+	t.Run("overspent previous month does not roll over", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(&Budget{Amount: 1000, Rollover: true}, 1200, -100)
+		c.Zero(progress.Rollover)
+		c.Equal(-200.0, progress.Remaining)
+		c.Equal([]int{80, 100}, progress.Reached())
+	})
+}
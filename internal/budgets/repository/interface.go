@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+// BudgetRepository stores the budgets of each account and aggregates the movements they are
+// compared with.
+type BudgetRepository interface {
+	CreateBudget(ctx context.Context, budget *domain.Budget) error
+	GetBudgetByID(ctx context.Context, id string, accountID string) (*domain.Budget, error)
+	GetBudgetsByMonth(ctx context.Context, accountID string, month time.Time) ([]*domain.Budget, error)
+	UpdateBudget(ctx context.Context, budget *domain.Budget) error
+	DeleteBudget(ctx context.Context, id string, accountID string) error
+	GetSpent(ctx context.Context, accountID string, categories []movementsDomain.MovementCategory, from time.Time, to time.Time) (float64, error)
+	RecordAlert(ctx context.Context, budgetID string, threshold int) (bool, error)
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	budgetColumns = `id, account_id, category, month, amount, rollover, created_at, updated_at`
+)
+
+var (
+	ErrBudgetNotFound = errors.New("budget not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the budgets repository.
+func NewPostgresRepository(db *pgxpool.Pool) BudgetRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so alerts are recorded
+// together with the events they raise.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// CreateBudget inserts a new budget. It returns domain.ErrBudgetExists when the account
+// already has a budget for the category and month.
+func (r *postgresRepository) CreateBudget(ctx context.Context, budget *domain.Budget) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO budgets (` + budgetColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $7)`
+
+	_, err := r.db.Exec(ctx, query,
+		budget.ID,
+		budget.AccountID,
+		budget.Category,
+		budget.Month,
+		budget.Amount,
+		budget.Rollover,
+		now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domain.ErrBudgetExists
+		}
+
+		return err
+	}
+
+	budget.CreatedAt = now
+	budget.UpdatedAt = now
+
+	return nil
+}
+
+// GetBudgetByID returns a budget of the account.
+func (r *postgresRepository) GetBudgetByID(ctx context.Context, id string, accountID string) (*domain.Budget, error) {
+	query := `SELECT ` + budgetColumns + `
+	FROM budgets
+	WHERE id = $1 AND account_id = $2`
+
+	budget, err := scanToBudget(r.db.QueryRow(ctx, query, id, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrBudgetNotFound
+	}
+
+	return budget, err
+}
+
+// GetBudgetsByMonth returns the budgets of the account for the month starting at month.
+func (r *postgresRepository) GetBudgetsByMonth(ctx context.Context, accountID string, month time.Time) ([]*domain.Budget, error) {
+	query := `SELECT ` + budgetColumns + `
+	FROM budgets
+	WHERE account_id = $1 AND month = $2
+	ORDER BY category`
+
+	rows, err := r.db.Query(ctx, query, accountID, month)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	budgets := []*domain.Budget{}
+	for rows.Next() {
+		budget, err := scanToBudget(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		budgets = append(budgets, budget)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+// UpdateBudget saves the amount and rollover of a budget.
+func (r *postgresRepository) UpdateBudget(ctx context.Context, budget *domain.Budget) error {
+	now := r.nowFunc()
+
+	query := `UPDATE budgets
+	SET amount = $1, rollover = $2, updated_at = $3
+	WHERE id = $4 AND account_id = $5`
+
+	tag, err := r.db.Exec(ctx, query, budget.Amount, budget.Rollover, now, budget.ID, budget.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrBudgetNotFound
+	}
+
+	budget.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteBudget removes a budget of the account together with its alerts.
+func (r *postgresRepository) DeleteBudget(ctx context.Context, id string, accountID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM budgets WHERE id = $1 AND account_id = $2`, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+// GetSpent returns the sum of the expenses of the account in the categories between from,
+// inclusive, and to, exclusive. Transfers are not spending and split movements count in the
+// categories of their splits.
+func (r *postgresRepository) GetSpent(ctx context.Context, accountID string, categories []movementsDomain.MovementCategory, from time.Time, to time.Time) (float64, error) {
+	query := `SELECT COALESCE(SUM(COALESCE(sp.amount, m.amount)), 0)
+	FROM movements m
+	LEFT JOIN movement_splits sp ON sp.movement_id = m.id
+	WHERE m.account_id = $1 AND m.type = $2 AND m.transfer_id = ''
+	AND COALESCE(sp.category, m.category) = ANY($3) AND m.date >= $4 AND m.date < $5`
+
+	slugs := make([]string, 0, len(categories))
+	for _, category := range categories {
+		slugs = append(slugs, string(category))
+	}
+
+	var spent float64
+
+	err := r.db.QueryRow(ctx, query, accountID, string(movementsDomain.Expense), slugs, from, to).Scan(&spent)
+	if err != nil {
+		return 0, err
+	}
+
+	return spent, nil
+}
+
+// RecordAlert stores that the budget reached the threshold. It reports false when it was
+// already recorded, so every threshold is notified once.
+func (r *postgresRepository) RecordAlert(ctx context.Context, budgetID string, threshold int) (bool, error) {
+	query := `INSERT INTO budget_alerts (budget_id, threshold, created_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (budget_id, threshold) DO NOTHING`
+
+	tag, err := r.querier(ctx).Exec(ctx, query, budgetID, threshold, r.nowFunc())
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanToBudget(scanFn func(...any) error) (*domain.Budget, error) {
+	budget := &domain.Budget{}
+
+	var category string
+
+	err := scanFn(&budget.ID, &budget.AccountID, &category, &budget.Month, &budget.Amount, &budget.Rollover, &budget.CreatedAt, &budget.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	budget.Category = movementsDomain.MovementCategory(category)
+
+	return budget, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBudgetRepository is a mock of the repository interface.
+type MockBudgetRepository struct {
+	mock.Mock
+}
+
+func (m *MockBudgetRepository) CreateBudget(ctx context.Context, budget *domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) GetBudgetByID(ctx context.Context, id string, accountID string) (*domain.Budget, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) GetBudgetsByMonth(ctx context.Context, accountID string, month time.Time) ([]*domain.Budget, error) {
+	args := m.Called(ctx, accountID, month)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) UpdateBudget(ctx context.Context, budget *domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) DeleteBudget(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) GetSpent(ctx context.Context, accountID string, categories []movementsDomain.MovementCategory, from time.Time, to time.Time) (float64, error) {
+	args := m.Called(ctx, accountID, categories, from, to)
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func (m *MockBudgetRepository) RecordAlert(ctx context.Context, budgetID string, threshold int) (bool, error) {
+	args := m.Called(ctx, budgetID, threshold)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	month     = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func setupMockDB(t *testing.T) (BudgetRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func budgetRows() *pgxmock.Rows {
+	return pgxmock.NewRows([]string{"id", "account_id", "category", "month", "amount", "rollover", "created_at", "updated_at"})
+}
+
+func TestCreateBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		budget := &domain.Budget{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: month, Amount: 800000, Rollover: true}
+
+		mock.ExpectExec(`INSERT INTO budgets`).
+			WithArgs("BUD1", "acc1", movementsDomain.Food, month, 800000.0, true, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateBudget(context.Background(), budget))
+		c.Equal(fixedTime, budget.CreatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicated", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO budgets`).
+			WithArgs("BUD1", "acc1", movementsDomain.Food, month, 800000.0, false, fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateBudget(context.Background(), &domain.Budget{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: month, Amount: 800000})
+		require.ErrorIs(t, err, domain.ErrBudgetExists)
+	})
+}
+
+func TestGetBudgetByID_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM budgets WHERE id = \$1 AND account_id = \$2`).
+		WithArgs("BUD1", "acc1").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetBudgetByID(context.Background(), "BUD1", "acc1")
+	require.ErrorIs(t, err, ErrBudgetNotFound)
+}
+
+func TestGetBudgetsByMonth(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM budgets WHERE account_id = \$1 AND month = \$2`).
+		WithArgs("acc1", month).
+		WillReturnRows(budgetRows().
+			AddRow("BUD1", "acc1", "food", month, 800000.0, true, fixedTime, fixedTime).
+			AddRow("BUD2", "acc1", "transport", month, 200000.0, false, fixedTime, fixedTime))
+
+	budgets, err := repo.GetBudgetsByMonth(context.Background(), "acc1", month)
+	c.NoError(err)
+	c.Len(budgets, 2)
+	c.Equal(movementsDomain.Food, budgets[0].Category)
+	c.True(budgets[0].Rollover)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateBudget_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE budgets`).
+		WithArgs(900000.0, false, fixedTime, "BUD1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.UpdateBudget(context.Background(), &domain.Budget{ID: "BUD1", AccountID: "acc1", Amount: 900000})
+	require.ErrorIs(t, err, ErrBudgetNotFound)
+}
+
+func TestGetSpent(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(COALESCE\(sp.amount, m.amount\)\), 0\) FROM movements m LEFT JOIN movement_splits sp ON sp.movement_id = m.id WHERE m.account_id = \$1 AND m.type = \$2 AND m.transfer_id = '' AND COALESCE\(sp.category, m.category\) = ANY\(\$3\)`).
+		WithArgs("acc1", "expense", []string{"food", "groceries"}, month, month.AddDate(0, 1, 0)).
+		WillReturnRows(pgxmock.NewRows([]string{"sum"}).AddRow(650000.0))
+
+	spent, err := repo.GetSpent(context.Background(), "acc1", []movementsDomain.MovementCategory{movementsDomain.Food, "groceries"}, month, month.AddDate(0, 1, 0))
+	c.NoError(err)
+	c.Equal(650000.0, spent)
+}
+
+func TestRecordAlert(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`INSERT INTO budget_alerts (.+) ON CONFLICT \(budget_id, threshold\) DO NOTHING`).
+		WithArgs("BUD1", 80, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO budget_alerts`).
+		WithArgs("BUD1", 80, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	recorded, err := repo.RecordAlert(context.Background(), "BUD1", 80)
+	c.NoError(err)
+	c.True(recorded)
+
+	recorded, err = repo.RecordAlert(context.Background(), "BUD1", 80)
+	c.NoError(err)
+	c.False(recorded)
+}
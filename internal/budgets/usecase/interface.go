@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+// BudgetsUsecase manages the monthly budgets of an account and tracks the spending against them.
+type BudgetsUsecase interface {
+	CreateBudget(ctx context.Context, budget *domain.Budget) error
+	GetBudget(ctx context.Context, id string, accountID string) (*domain.Budget, error)
+	UpdateBudget(ctx context.Context, budget *domain.Budget) error
+	DeleteBudget(ctx context.Context, id string, accountID string) error
+	GetProgress(ctx context.Context, accountID string, month time.Time) ([]*domain.Progress, error)
+	CheckThresholds(ctx context.Context, movement *movementsDomain.Movement, splits []*movementsDomain.Split) error
+}
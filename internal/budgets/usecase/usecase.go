@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+	"transaction-tracker/internal/budgets/domain"
+	"transaction-tracker/internal/budgets/repository"
+	categoriesDomain "transaction-tracker/internal/categories/domain"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+)
+
+var (
+	ErrBudgetNotFound = repository.ErrBudgetNotFound
+)
+
+type budgetsUsecase struct {
+	repo              repository.BudgetRepository
+	transactor        postgres.Transactor
+	eventsUsecase     eventsUsecase.EventsUsecase
+	categoriesUsecase categoriesUsecase.CategoriesUsecase
+}
+
+// NewBudgetsUsecase creates a new instance of BudgetsUsecase. Budget categories are checked
+// against the tree in catUsecase and threshold notifications are emitted in evUsecase.
+func NewBudgetsUsecase(repo repository.BudgetRepository, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase, catUsecase categoriesUsecase.CategoriesUsecase) BudgetsUsecase {
+	return &budgetsUsecase{
+		repo:              repo,
+		transactor:        transactor,
+		eventsUsecase:     evUsecase,
+		categoriesUsecase: catUsecase,
+	}
+}
+
+// CreateBudget validates and stores a new budget.
+func (u *budgetsUsecase) CreateBudget(ctx context.Context, budget *domain.Budget) error {
+	err := budget.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = u.categoriesUsecase.ValidateCategory(ctx, budget.AccountID, budget.Category)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.CreateBudget(ctx, budget)
+}
+
+func (u *budgetsUsecase) GetBudget(ctx context.Context, id string, accountID string) (*domain.Budget, error) {
+	return u.repo.GetBudgetByID(ctx, id, accountID)
+}
+
+// UpdateBudget saves the amount and rollover of a budget. The category and month are kept.
+func (u *budgetsUsecase) UpdateBudget(ctx context.Context, budget *domain.Budget) error {
+	err := budget.Validate()
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateBudget(ctx, budget)
+}
+
+func (u *budgetsUsecase) DeleteBudget(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteBudget(ctx, id, accountID)
+}
+
+// GetProgress compares the budgets of the month with the expenses of their categories and
+// subcategories.
+func (u *budgetsUsecase) GetProgress(ctx context.Context, accountID string, month time.Time) ([]*domain.Progress, error) {
+	budgets, err := u.repo.GetBudgetsByMonth(ctx, accountID, month)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(budgets) == 0 {
+		return []*domain.Progress{}, nil
+	}
+
+	categories, err := u.categoriesUsecase.GetCategories(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]*domain.Progress, 0, len(budgets))
+	for _, budget := range budgets {
+		p, err := u.progress(ctx, budget, categories)
+		if err != nil {
+			return nil, err
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+// CheckThresholds notifies the thresholds the movement made the budgets of its category reach
+// in its month. A split movement counts in the categories of its splits instead. Every
+// threshold of a budget is notified once.
+func (u *budgetsUsecase) CheckThresholds(ctx context.Context, movement *movementsDomain.Movement, splits []*movementsDomain.Split) error {
+	if movement.Type != movementsDomain.Expense {
+		return nil
+	}
+
+	budgets, err := u.repo.GetBudgetsByMonth(ctx, movement.AccountID, domain.MonthOf(movement.Date))
+	if err != nil {
+		return err
+	}
+
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	categories, err := u.categoriesUsecase.GetCategories(ctx, movement.AccountID)
+	if err != nil {
+		return err
+	}
+
+	spentIn := []movementsDomain.MovementCategory{movement.Category}
+	if len(splits) > 0 {
+		spentIn = make([]movementsDomain.MovementCategory, 0, len(splits))
+		for _, split := range splits {
+			spentIn = append(spentIn, split.Category)
+		}
+	}
+
+	for _, budget := range budgets {
+		subtree := categoriesDomain.Subtree(categories, budget.Category)
+		if !slices.ContainsFunc(spentIn, func(category movementsDomain.MovementCategory) bool {
+			return slices.Contains(subtree, category)
+		}) {
+			continue
+		}
+
+		progress, err := u.progress(ctx, budget, categories)
+		if err != nil {
+			return err
+		}
+
+		for _, threshold := range progress.Reached() {
+			err := u.notify(ctx, progress, threshold)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// progress computes the progress of the budget. Budgets that roll over take what was left of
+// the budget of the same category in the previous month, including what that one rolled over
+// in turn.
+func (u *budgetsUsecase) progress(ctx context.Context, budget *domain.Budget, categories []*categoriesDomain.Category) (*domain.Progress, error) {
+	return u.progressOf(ctx, budget, categoriesDomain.Subtree(categories, budget.Category))
+}
+
+func (u *budgetsUsecase) progressOf(ctx context.Context, budget *domain.Budget, slugs []movementsDomain.MovementCategory) (*domain.Progress, error) {
+	spent, err := u.repo.GetSpent(ctx, budget.AccountID, slugs, budget.Month, budget.End())
+	if err != nil {
+		return nil, err
+	}
+
+	if !budget.Rollover {
+		return domain.NewProgress(budget, spent, 0), nil
+	}
+
+	previousBudgets, err := u.repo.GetBudgetsByMonth(ctx, budget.AccountID, budget.Month.AddDate(0, -1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(previousBudgets, func(previous *domain.Budget) bool {
+		return previous.Category == budget.Category
+	})
+	if i < 0 {
+		return domain.NewProgress(budget, spent, 0), nil
+	}
+
+	previous, err := u.progressOf(ctx, previousBudgets[i], slugs)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewProgress(budget, spent, previous.Remaining), nil
+}
+
+// notify records the threshold of the budget and emits its notification, unless it was
+// already notified.
+func (u *budgetsUsecase) notify(ctx context.Context, progress *domain.Progress, threshold int) error {
+	budget := progress.Budget
+
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		recorded, err := u.repo.RecordAlert(ctx, budget.ID, threshold)
+		if err != nil {
+			return err
+		}
+
+		if !recorded {
+			return nil
+		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.BudgetThresholdReached, budget.AccountID, budget.ID, eventsDomain.BudgetThresholdPayload{
+			ID:        budget.ID,
+			AccountID: budget.AccountID,
+			Category:  string(budget.Category),
+			Month:     budget.Month.Format(domain.MonthLayout),
+			Threshold: threshold,
+			Limit:     progress.Limit,
+			Spent:     progress.Spent,
+			Percent:   progress.Percent,
+		})
+	})
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/budgets/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBudgetsUsecase is a mock implementation of the BudgetsUsecase interface.
+type MockBudgetsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockBudgetsUsecase) CreateBudget(ctx context.Context, budget *domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetsUsecase) GetBudget(ctx context.Context, id string, accountID string) (*domain.Budget, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Budget), args.Error(1)
+}
+
+func (m *MockBudgetsUsecase) UpdateBudget(ctx context.Context, budget *domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetsUsecase) DeleteBudget(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockBudgetsUsecase) GetProgress(ctx context.Context, accountID string, month time.Time) ([]*domain.Progress, error) {
+	args := m.Called(ctx, accountID, month)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Progress), args.Error(1)
+}
+
+func (m *MockBudgetsUsecase) CheckThresholds(ctx context.Context, movement *movementsDomain.Movement, splits []*movementsDomain.Split) error {
+	args := m.Called(ctx, movement, splits)
+	return args.Error(0)
+}
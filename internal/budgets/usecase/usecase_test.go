@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/budgets/domain"
+	"transaction-tracker/internal/budgets/repository"
+	categoriesDomain "transaction-tracker/internal/categories/domain"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	september = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	august    = time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	october   = time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	foodSubtree = []movementsDomain.MovementCategory{movementsDomain.Food, "groceries"}
+)
+
+func newMockCategories() *categoriesUsecase.MockCategoriesUsecase {
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("GetCategories", mock.Anything, "acc1").Return([]*categoriesDomain.Category{
+		{ID: "CAT1", Slug: movementsDomain.Food},
+		{ID: "CAT2", Slug: "groceries", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: movementsDomain.Transport},
+	}, nil)
+	categories.On("ValidateCategory", mock.Anything, "acc1", mock.Anything).Return(nil)
+
+	return categories
+}
+
+func TestGetProgress(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	food := &domain.Budget{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: september, Amount: 1000, Rollover: true}
+
+	repo := new(repository.MockBudgetRepository)
+	repo.On("GetBudgetsByMonth", ctx, "acc1", september).Return([]*domain.Budget{food}, nil)
+	repo.On("GetBudgetsByMonth", ctx, "acc1", august).Return([]*domain.Budget{
+		{ID: "BUD0", AccountID: "acc1", Category: movementsDomain.Food, Month: august, Amount: 1000},
+	}, nil)
+	repo.On("GetSpent", ctx, "acc1", foodSubtree, september, october).Return(600.0, nil)
+	repo.On("GetSpent", ctx, "acc1", foodSubtree, august, september).Return(800.0, nil)
+
+	progress, err := NewBudgetsUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase), newMockCategories()).GetProgress(ctx, "acc1", september)
+	c.NoError(err)
+	c.Len(progress, 1)
+	c.Equal(200.0, progress[0].Rollover)
+	c.Equal(1200.0, progress[0].Limit)
+	c.Equal(50.0, progress[0].Percent)
+}
+
+func TestGetProgress_ChainsRollover(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	july := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	food := &domain.Budget{ID: "BUD2", AccountID: "acc1", Category: movementsDomain.Food, Month: september, Amount: 1000, Rollover: true}
+
+	repo := new(repository.MockBudgetRepository)
+	repo.On("GetBudgetsByMonth", ctx, "acc1", september).Return([]*domain.Budget{food}, nil)
+	repo.On("GetBudgetsByMonth", ctx, "acc1", august).Return([]*domain.Budget{
+		{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: august, Amount: 1000, Rollover: true},
+	}, nil)
+	repo.On("GetBudgetsByMonth", ctx, "acc1", july).Return([]*domain.Budget{
+		{ID: "BUD0", AccountID: "acc1", Category: movementsDomain.Food, Month: july, Amount: 1000},
+	}, nil)
+	repo.On("GetSpent", ctx, "acc1", foodSubtree, september, october).Return(600.0, nil)
+	repo.On("GetSpent", ctx, "acc1", foodSubtree, august, september).Return(800.0, nil)
+	repo.On("GetSpent", ctx, "acc1", foodSubtree, july, august).Return(500.0, nil)
+
+	progress, err := NewBudgetsUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase), newMockCategories()).GetProgress(ctx, "acc1", september)
+	c.NoError(err)
+	c.Equal(700.0, progress[0].Rollover)
+	c.Equal(1700.0, progress[0].Limit)
+}
+
+func TestCreateBudget_InvalidCategory(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("ValidateCategory", ctx, "acc1", movementsDomain.MovementCategory("pets")).Return(movementsDomain.ErrInvalidMovementCategory)
+
+	repo := new(repository.MockBudgetRepository)
+
+	err := NewBudgetsUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase), categories).CreateBudget(ctx, &domain.Budget{AccountID: "acc1", Category: "pets", Amount: 100})
+	c.ErrorIs(err, movementsDomain.ErrInvalidMovementCategory)
+
+	repo.AssertNotCalled(t, "CreateBudget", mock.Anything, mock.Anything)
+}
+
+func TestCheckThresholds(t *testing.T) {
+	ctx := context.Background()
+
+	movement := &movementsDomain.Movement{AccountID: "acc1", Type: movementsDomain.Expense, Category: "groceries", Amount: 300, Date: time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)}
+
+	t.Run("notifies every reached threshold once", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockBudgetRepository)
+		repo.On("GetBudgetsByMonth", ctx, "acc1", september).Return([]*domain.Budget{
+			{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: september, Amount: 1000},
+			{ID: "BUD2", AccountID: "acc1", Category: movementsDomain.Transport, Month: september, Amount: 100},
+		}, nil)
+		repo.On("GetSpent", ctx, "acc1", foodSubtree, september, october).Return(1050.0, nil)
+		repo.On("RecordAlert", ctx, "BUD1", 80).Return(false, nil).Once()
+		repo.On("RecordAlert", ctx, "BUD1", 100).Return(true, nil).Once()
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", ctx, eventsDomain.BudgetThresholdReached, "acc1", "BUD1", mock.MatchedBy(func(payload eventsDomain.BudgetThresholdPayload) bool {
+			return payload.Threshold == 100 && payload.Month == "2025-09" && payload.Spent == 1050
+		})).Return(nil).Once()
+
+		c.NoError(NewBudgetsUsecase(repo, postgres.NewMockTransactor(), events, newMockCategories()).CheckThresholds(ctx, movement, nil))
+
+		repo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("below the thresholds", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockBudgetRepository)
+		repo.On("GetBudgetsByMonth", ctx, "acc1", september).Return([]*domain.Budget{
+			{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: september, Amount: 1000},
+		}, nil)
+		repo.On("GetSpent", ctx, "acc1", foodSubtree, september, october).Return(300.0, nil)
+
+		c.NoError(NewBudgetsUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase), newMockCategories()).CheckThresholds(ctx, movement, nil))
+
+		repo.AssertNotCalled(t, "RecordAlert", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("split movement counts in the categories of its splits", func(t *testing.T) {
+		c := require.New(t)
+
+		split := *movement
+		split.Category = movementsDomain.Transport
+
+		repo := new(repository.MockBudgetRepository)
+		repo.On("GetBudgetsByMonth", ctx, "acc1", september).Return([]*domain.Budget{
+			{ID: "BUD1", AccountID: "acc1", Category: movementsDomain.Food, Month: september, Amount: 1000},
+		}, nil)
+		repo.On("GetSpent", ctx, "acc1", foodSubtree, september, october).Return(900.0, nil)
+		repo.On("RecordAlert", ctx, "BUD1", 80).Return(true, nil).Once()
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", ctx, eventsDomain.BudgetThresholdReached, "acc1", "BUD1", mock.Anything).Return(nil).Once()
+
+		c.NoError(NewBudgetsUsecase(repo, postgres.NewMockTransactor(), events, newMockCategories()).CheckThresholds(ctx, &split, []*movementsDomain.Split{
+			{Category: movementsDomain.Transport, Amount: 100},
+			{Category: "groceries", Amount: 200},
+		}))
+
+		repo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("income is ignored", func(t *testing.T) {
+		repo := new(repository.MockBudgetRepository)
+
+		income := *movement
+		income.Type = movementsDomain.Income
+
+		require.NoError(t, NewBudgetsUsecase(repo, postgres.NewMockTransactor(), new(eventsUsecase.MockEventsUsecase), newMockCategories()).CheckThresholds(ctx, &income, nil))
+		repo.AssertExpectations(t)
+	})
+}
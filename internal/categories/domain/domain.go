@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_category_prefix = "CAT"
+
+	// DefaultColor is used for categories created without a color.
+	DefaultColor = "#9E9E9E"
+	// DefaultIcon is used for categories created without an icon.
+	DefaultIcon = "tag"
+
+	maxNameLength = 50
+)
+
+var (
+	// ErrInvalidCategory is returned when a category has invalid fields.
+	ErrInvalidCategory = errors.New("invalid category")
+	// ErrCategoryExists is returned when the account already has a category with the same slug.
+	ErrCategoryExists = errors.New("category already exists")
+	// ErrCategoryInUse is returned when deleting a category that still has subcategories or movements.
+	ErrCategoryInUse = errors.New("category is in use")
+	// ErrSystemCategory is returned when deleting a category the application relies on.
+	ErrSystemCategory = errors.New("system categories cannot be deleted")
+
+	slugRegex  = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+	colorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+	slugClean  = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Category is a node of the category tree of an account. Movements reference it by Slug.
+// Subcategories have a ParentID and cannot have children of their own.
+type Category struct {
+	ID        string
+	AccountID string
+	Slug      movementsDomain.MovementCategory
+	Name      string
+	ParentID  string
+	Icon      string
+	Color     string
+	System    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (c *Category) LogProperties() map[string]string {
+	return map[string]string{
+		"category_id": c.ID,
+		"account_id":  c.AccountID,
+		"slug":        string(c.Slug),
+		"parent_id":   c.ParentID,
+	}
+}
+
+// NewCategory creates a category of the account. The slug is derived from the name when empty.
+func NewCategory(accountID string, slug string, name string, parentID string, icon string, color string) (*Category, error) {
+	if slug == "" {
+		slug = Slugify(name)
+	}
+
+	category := &Category{
+		ID:        _category_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		Slug:      movementsDomain.MovementCategory(slug),
+		Name:      strings.TrimSpace(name),
+		ParentID:  parentID,
+		Icon:      icon,
+		Color:     color,
+	}
+
+	if category.Icon == "" {
+		category.Icon = DefaultIcon
+	}
+
+	if category.Color == "" {
+		category.Color = DefaultColor
+	}
+
+	err := category.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// Validate checks the fields of the category.
+func (c *Category) Validate() error {
+	if !slugRegex.MatchString(string(c.Slug)) {
+		return fmt.Errorf("%w: slug %q must be lowercase letters, numbers and underscores", ErrInvalidCategory, c.Slug)
+	}
+
+	name := strings.TrimSpace(c.Name)
+	if name == "" || len(name) > maxNameLength {
+		return fmt.Errorf("%w: name is required and must have at most %d characters", ErrInvalidCategory, maxNameLength)
+	}
+
+	if !colorRegex.MatchString(c.Color) {
+		return fmt.Errorf("%w: color %q must be a hex color like #4CAF50", ErrInvalidCategory, c.Color)
+	}
+
+	if c.ParentID == c.ID && c.ID != "" {
+		return fmt.Errorf("%w: a category cannot be its own parent", ErrInvalidCategory)
+	}
+
+	return nil
+}
+
+// Slugify turns a category name into a slug, e.g. "Pet Care" becomes "pet_care".
+func Slugify(name string) string {
+	return strings.Trim(slugClean.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+// defaultCategory describes one of the categories every account starts with.
+type defaultCategory struct {
+	slug  movementsDomain.MovementCategory
+	name  string
+	icon  string
+	color string
+}
+
+var defaultCategories = []defaultCategory{
+	{movementsDomain.Salary, "Salary", "briefcase", "#4CAF50"},
+	{movementsDomain.Freelance, "Freelance", "laptop", "#8BC34A"},
+	{movementsDomain.Investment, "Investment", "trending-up", "#009688"},
+	{movementsDomain.Housing, "Housing", "home", "#795548"},
+	{movementsDomain.Transport, "Transport", "car", "#3F51B5"},
+	{movementsDomain.Food, "Food", "utensils", "#FF9800"},
+	{movementsDomain.Entertainment, "Entertainment", "film", "#E91E63"},
+	{movementsDomain.Shopping, "Shopping", "shopping-bag", "#9C27B0"},
+	{movementsDomain.Health, "Health", "heart", "#F44336"},
+	{movementsDomain.Education, "Education", "book", "#2196F3"},
+	{movementsDomain.Travel, "Travel", "plane", "#00BCD4"},
+	{movementsDomain.Savings, "Savings", "piggy-bank", "#CDDC39"},
+	{movementsDomain.Debt, "Debt", "credit-card", "#607D8B"},
+	{movementsDomain.Unknown, "Unknown", "help-circle", DefaultColor},
+}
+
+// DefaultCategories returns the tree an account is seeded with, one root per movement
+// category constant. Unknown is a system category because the classifier falls back to it.
+func DefaultCategories(accountID string) []*Category {
+	categories := make([]*Category, 0, len(defaultCategories))
+	for _, d := range defaultCategories {
+		categories = append(categories, &Category{
+			ID:        _category_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+			AccountID: accountID,
+			Slug:      d.slug,
+			Name:      d.name,
+			Icon:      d.icon,
+			Color:     d.color,
+			System:    d.slug == movementsDomain.Unknown,
+		})
+	}
+
+	return categories
+}
+
+// Node is a root category with its subcategories.
+type Node struct {
+	*Category
+	Children []*Category
+}
+
+// BuildTree groups the categories of an account under their parents, keeping their order.
+func BuildTree(categories []*Category) []*Node {
+	nodes := []*Node{}
+	byID := map[string]*Node{}
+
+	for _, c := range categories {
+		if c.ParentID == "" {
+			node := &Node{Category: c, Children: []*Category{}}
+			nodes = append(nodes, node)
+			byID[c.ID] = node
+		}
+	}
+
+	for _, c := range categories {
+		if c.ParentID == "" {
+			continue
+		}
+
+		if parent, ok := byID[c.ParentID]; ok {
+			parent.Children = append(parent.Children, c)
+		}
+	}
+
+	return nodes
+}
+
+// FindBySlug returns the category with the given slug.
+func FindBySlug(categories []*Category, slug movementsDomain.MovementCategory) (*Category, bool) {
+	for _, c := range categories {
+		if c.Slug == slug {
+			return c, true
+		}
+	}
+
+	return nil, false
+}
+
+// Subtree returns the slug of the category followed by the ones of its subcategories, the
+// categories a movement can have to count under it.
+func Subtree(categories []*Category, slug movementsDomain.MovementCategory) []movementsDomain.MovementCategory {
+	category, ok := FindBySlug(categories, slug)
+	if !ok {
+		return []movementsDomain.MovementCategory{slug}
+	}
+
+	slugs := []movementsDomain.MovementCategory{slug}
+	for _, c := range categories {
+		if c.ParentID == category.ID {
+			slugs = append(slugs, c.Slug)
+		}
+	}
+
+	return slugs
+}
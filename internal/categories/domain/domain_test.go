@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		slug     string
+		catName  string
+		color    string
+		wantSlug string
+		wantErr  bool
+	}{
+		{name: "slug from name", catName: "Pet Care", wantSlug: "pet_care"},
+		{name: "explicit slug", slug: "groceries", catName: "Groceries", color: "#4caf50", wantSlug: "groceries"},
+		{name: "invalid slug", slug: "Pets!", catName: "Pets", wantErr: true},
+		{name: "missing name", slug: "pets", wantErr: true},
+		{name: "invalid color", catName: "Pets", color: "green", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			category, err := NewCategory("acc1", tt.slug, tt.catName, "", "", tt.color)
+			if tt.wantErr {
+				c.ErrorIs(err, ErrInvalidCategory)
+				return
+			}
+
+			c.NoError(err)
+			c.Equal(movementsDomain.MovementCategory(tt.wantSlug), category.Slug)
+			c.Equal(DefaultIcon, category.Icon)
+		})
+	}
+}
+
+func TestDefaultCategories(t *testing.T) {
+	c := require.New(t)
+
+	categories := DefaultCategories("acc1")
+
+	for _, category := range categories {
+		_, err := movementsDomain.ParseMovementCategory(string(category.Slug))
+		c.NoError(err)
+		c.NoError(category.Validate())
+	}
+
+	unknown, ok := FindBySlug(categories, movementsDomain.Unknown)
+	c.True(ok)
+	c.True(unknown.System)
+}
+
+func TestBuildTree(t *testing.T) {
+	c := require.New(t)
+
+	food := &Category{ID: "CAT1", Slug: movementsDomain.Food}
+	groceries := &Category{ID: "CAT2", Slug: "groceries", ParentID: "CAT1"}
+	restaurants := &Category{ID: "CAT3", Slug: "restaurants", ParentID: "CAT1"}
+	pets := &Category{ID: "CAT4", Slug: "pets"}
+
+	tree := BuildTree([]*Category{groceries, food, pets, restaurants})
+
+	c.Len(tree, 2)
+	c.Equal(food, tree[0].Category)
+	c.Equal([]*Category{groceries, restaurants}, tree[0].Children)
+	c.Empty(tree[1].Children)
+}
+
+func TestSubtree(t *testing.T) {
+	c := require.New(t)
+
+	categories := []*Category{
+		{ID: "CAT1", Slug: movementsDomain.Food},
+		{ID: "CAT2", Slug: "groceries", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: "restaurants", ParentID: "CAT1"},
+		{ID: "CAT4", Slug: "pets"},
+	}
+
+	c.Equal([]movementsDomain.MovementCategory{movementsDomain.Food, "groceries", "restaurants"}, Subtree(categories, movementsDomain.Food))
+	c.Equal([]movementsDomain.MovementCategory{"groceries"}, Subtree(categories, "groceries"))
+	c.Equal([]movementsDomain.MovementCategory{"travel"}, Subtree(categories, "travel"))
+}
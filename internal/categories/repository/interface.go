@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/categories/domain"
+)
+
+// CategoryRepository stores the category tree of each account.
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category *domain.Category) error
+	CreateCategories(ctx context.Context, categories []*domain.Category) error
+	GetCategoryByID(ctx context.Context, id string, accountID string) (*domain.Category, error)
+	GetCategoriesByAccountID(ctx context.Context, accountID string) ([]*domain.Category, error)
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	DeleteCategory(ctx context.Context, id string, accountID string) error
+	IsCategoryInUse(ctx context.Context, category *domain.Category) (bool, error)
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/categories/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+)
+
+var (
+	ErrCategoryNotFound = errors.New("category not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the categories repository.
+func NewPostgresRepository(db *pgxpool.Pool) CategoryRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+const (
+	categoryColumns = `id, account_id, slug, name, COALESCE(parent_id, ''), icon, color, system, created_at, updated_at`
+	insertCategory  = `INSERT INTO categories (id, account_id, slug, name, parent_id, icon, color, system, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10)`
+)
+
+// CreateCategory inserts a new category. It returns domain.ErrCategoryExists when the account
+// already has the slug.
+func (r *postgresRepository) CreateCategory(ctx context.Context, category *domain.Category) error {
+	now := r.nowFunc()
+
+	_, err := r.db.Exec(ctx, insertCategory, categoryArgs(category, now)...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return domain.ErrCategoryExists
+		}
+
+		return err
+	}
+
+	category.CreatedAt = now
+	category.UpdatedAt = now
+
+	return nil
+}
+
+// CreateCategories inserts the categories skipping the slugs the account already has, so
+// seeding the same account twice is harmless.
+func (r *postgresRepository) CreateCategories(ctx context.Context, categories []*domain.Category) error {
+	now := r.nowFunc()
+
+	for _, category := range categories {
+		_, err := r.db.Exec(ctx, insertCategory+` ON CONFLICT (account_id, slug) DO NOTHING`, categoryArgs(category, now)...)
+		if err != nil {
+			return err
+		}
+
+		category.CreatedAt = now
+		category.UpdatedAt = now
+	}
+
+	return nil
+}
+
+// GetCategoryByID returns a category of the account.
+func (r *postgresRepository) GetCategoryByID(ctx context.Context, id string, accountID string) (*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE id = $1 AND account_id = $2`
+
+	category, err := scanCategory(r.db.QueryRow(ctx, query, id, accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCategoryNotFound
+	}
+
+	return category, err
+}
+
+// GetCategoriesByAccountID returns every category of the account sorted by name.
+func (r *postgresRepository) GetCategoriesByAccountID(ctx context.Context, accountID string) ([]*domain.Category, error) {
+	query := `SELECT ` + categoryColumns + ` FROM categories WHERE account_id = $1 ORDER BY name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	categories := []*domain.Category{}
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// UpdateCategory saves the name, parent, icon and color of a category. The slug never
+// changes because movements reference it.
+func (r *postgresRepository) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	now := r.nowFunc()
+
+	query := `UPDATE categories
+	SET name = $1, parent_id = NULLIF($2, ''), icon = $3, color = $4, updated_at = $5
+	WHERE id = $6 AND account_id = $7`
+
+	tag, err := r.db.Exec(ctx, query,
+		category.Name,
+		category.ParentID,
+		category.Icon,
+		category.Color,
+		now,
+		category.ID,
+		category.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrCategoryNotFound
+	}
+
+	category.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteCategory removes a category of the account.
+func (r *postgresRepository) DeleteCategory(ctx context.Context, id string, accountID string) error {
+	query := `DELETE FROM categories WHERE id = $1 AND account_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// IsCategoryInUse reports whether the category has subcategories, or movements or splits of
+// its account.
+func (r *postgresRepository) IsCategoryInUse(ctx context.Context, category *domain.Category) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM categories WHERE parent_id = $1)
+	OR EXISTS (SELECT 1 FROM movements WHERE account_id = $2 AND category = $3)
+	OR EXISTS (SELECT 1 FROM movement_splits sp JOIN movements m ON m.id = sp.movement_id WHERE m.account_id = $2 AND sp.category = $3)`
+
+	var inUse bool
+
+	err := r.db.QueryRow(ctx, query, category.ID, category.AccountID, string(category.Slug)).Scan(&inUse)
+	if err != nil {
+		return false, err
+	}
+
+	return inUse, nil
+}
+
+func categoryArgs(category *domain.Category, now time.Time) []any {
+	return []any{
+		category.ID,
+		category.AccountID,
+		string(category.Slug),
+		category.Name,
+		category.ParentID,
+		category.Icon,
+		category.Color,
+		category.System,
+		now,
+		now,
+	}
+}
+
+func scanCategory(row pgx.Row) (*domain.Category, error) {
+	category := &domain.Category{}
+
+	var slug string
+
+	err := row.Scan(&category.ID, &category.AccountID, &slug, &category.Name, &category.ParentID, &category.Icon, &category.Color, &category.System, &category.CreatedAt, &category.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	category.Slug = movementsDomain.MovementCategory(slug)
+
+	return category, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/categories/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCategoryRepository is a mock of the repository interface.
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) CreateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) CreateCategories(ctx context.Context, categories []*domain.Category) error {
+	args := m.Called(ctx, categories)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) GetCategoryByID(ctx context.Context, id string, accountID string) (*domain.Category, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) GetCategoriesByAccountID(ctx context.Context, accountID string) ([]*domain.Category, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) DeleteCategory(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) IsCategoryInUse(ctx context.Context, category *domain.Category) (bool, error) {
+	args := m.Called(ctx, category)
+	return args.Bool(0), args.Error(1)
+}
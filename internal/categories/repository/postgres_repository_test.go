@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/categories/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	categoryRowColumns = []string{"id", "account_id", "slug", "name", "parent_id", "icon", "color", "system", "created_at", "updated_at"}
+)
+
+func setupMockDB(t *testing.T) (CategoryRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateCategory(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	category := &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: "groceries", Name: "Groceries", ParentID: "CAT0", Icon: "cart", Color: "#FF9800"}
+
+	mock.ExpectExec(`INSERT INTO categories`).
+		WithArgs("CAT1", "acc1", "groceries", "Groceries", "CAT0", "cart", "#FF9800", false, fixedTime, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateCategory(context.Background(), category))
+	c.Equal(fixedTime, category.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateCategory_Duplicated(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`INSERT INTO categories`).
+		WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+	err := repo.CreateCategory(context.Background(), &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: "pets"})
+	require.ErrorIs(t, err, domain.ErrCategoryExists)
+}
+
+func TestCreateCategories_SkipsExisting(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	categories := domain.DefaultCategories("acc1")
+	for range categories {
+		mock.ExpectExec(`INSERT INTO categories (.+) ON CONFLICT \(account_id, slug\) DO NOTHING`).
+			WithArgs(pgxmock.AnyArg(), "acc1", pgxmock.AnyArg(), pgxmock.AnyArg(), "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), fixedTime, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+	}
+
+	c.NoError(repo.CreateCategories(context.Background(), categories))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetCategoriesByAccountID(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(categoryRowColumns).
+		AddRow("CAT1", "acc1", "food", "Food", "", "utensils", "#FF9800", false, fixedTime, fixedTime).
+		AddRow("CAT2", "acc1", "groceries", "Groceries", "CAT1", "cart", "#FF9800", false, fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM categories WHERE account_id = \$1 ORDER BY name`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	categories, err := repo.GetCategoriesByAccountID(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(categories, 2)
+	c.Equal(movementsDomain.Food, categories[0].Slug)
+	c.Equal("CAT1", categories[1].ParentID)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestIsCategoryInUse(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs("CAT1", "acc1", "food").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	inUse, err := repo.IsCategoryInUse(context.Background(), &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: movementsDomain.Food})
+	c.NoError(err)
+	c.True(inUse)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestIsCategoryInUse_Splits(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`OR EXISTS \(SELECT 1 FROM movement_splits sp JOIN movements m ON m.id = sp.movement_id WHERE m.account_id = \$2 AND sp.category = \$3\)`).
+		WithArgs("CAT2", "acc1", "pets").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	inUse, err := repo.IsCategoryInUse(context.Background(), &domain.Category{ID: "CAT2", AccountID: "acc1", Slug: "pets"})
+	c.NoError(err)
+	c.True(inUse, "a category only used by splits is in use")
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateAndDeleteCategory_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE categories`).
+		WithArgs("Pets", "", "paw", "#000000", fixedTime, "CAT1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectExec(`DELETE FROM categories`).WithArgs("CAT1", "acc1").WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	c.ErrorIs(repo.UpdateCategory(context.Background(), &domain.Category{ID: "CAT1", AccountID: "acc1", Name: "Pets", Icon: "paw", Color: "#000000"}), ErrCategoryNotFound)
+	c.ErrorIs(repo.DeleteCategory(context.Background(), "CAT1", "acc1"), ErrCategoryNotFound)
+	c.NoError(mock.ExpectationsWereMet())
+}
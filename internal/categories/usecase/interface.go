@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/categories/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+// CategoriesUsecase manages the category tree of an account.
+type CategoriesUsecase interface {
+	GetCategories(ctx context.Context, accountID string) ([]*domain.Category, error)
+	GetCategory(ctx context.Context, id string, accountID string) (*domain.Category, error)
+	CreateCategory(ctx context.Context, category *domain.Category) error
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	DeleteCategory(ctx context.Context, id string, accountID string) error
+	ValidateCategory(ctx context.Context, accountID string, slug movementsDomain.MovementCategory) error
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"transaction-tracker/internal/categories/domain"
+	"transaction-tracker/internal/categories/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+var (
+	ErrCategoryNotFound = repository.ErrCategoryNotFound
+)
+
+type categoriesUsecase struct {
+	repo repository.CategoryRepository
+}
+
+// NewCategoriesUsecase creates a new instance of CategoriesUsecase.
+func NewCategoriesUsecase(repo repository.CategoryRepository) CategoriesUsecase {
+	return &categoriesUsecase{
+		repo: repo,
+	}
+}
+
+// GetCategories returns the categories of the account. Accounts without categories are
+// seeded with the default tree first.
+func (u *categoriesUsecase) GetCategories(ctx context.Context, accountID string) ([]*domain.Category, error) {
+	categories, err := u.repo.GetCategoriesByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categories) > 0 {
+		return categories, nil
+	}
+
+	err = u.repo.CreateCategories(ctx, domain.DefaultCategories(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Reading them back keeps the IDs of a concurrent seed that won the race.
+	return u.repo.GetCategoriesByAccountID(ctx, accountID)
+}
+
+func (u *categoriesUsecase) GetCategory(ctx context.Context, id string, accountID string) (*domain.Category, error) {
+	return u.repo.GetCategoryByID(ctx, id, accountID)
+}
+
+// CreateCategory validates and stores a new category or subcategory.
+func (u *categoriesUsecase) CreateCategory(ctx context.Context, category *domain.Category) error {
+	err := category.Validate()
+	if err != nil {
+		return err
+	}
+
+	categories, err := u.GetCategories(ctx, category.AccountID)
+	if err != nil {
+		return err
+	}
+
+	err = validateParent(category, categories)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.CreateCategory(ctx, category)
+}
+
+// UpdateCategory validates and stores the changes of a category.
+func (u *categoriesUsecase) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	err := category.Validate()
+	if err != nil {
+		return err
+	}
+
+	categories, err := u.GetCategories(ctx, category.AccountID)
+	if err != nil {
+		return err
+	}
+
+	err = validateParent(category, categories)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateCategory(ctx, category)
+}
+
+// DeleteCategory removes a category that has no subcategories nor movements.
+func (u *categoriesUsecase) DeleteCategory(ctx context.Context, id string, accountID string) error {
+	category, err := u.repo.GetCategoryByID(ctx, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if category.System {
+		return domain.ErrSystemCategory
+	}
+
+	inUse, err := u.repo.IsCategoryInUse(ctx, category)
+	if err != nil {
+		return err
+	}
+
+	if inUse {
+		return domain.ErrCategoryInUse
+	}
+
+	return u.repo.DeleteCategory(ctx, id, accountID)
+}
+
+// ValidateCategory checks that slug is part of the category tree of the account.
+func (u *categoriesUsecase) ValidateCategory(ctx context.Context, accountID string, slug movementsDomain.MovementCategory) error {
+	categories, err := u.GetCategories(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	_, ok := domain.FindBySlug(categories, slug)
+	if !ok {
+		return fmt.Errorf("%w: %q is not a category of the account", movementsDomain.ErrInvalidMovementCategory, slug)
+	}
+
+	return nil
+}
+
+// validateParent keeps the tree two levels deep: parents must be root categories of the same
+// account and categories with subcategories cannot become subcategories themselves.
+func validateParent(category *domain.Category, categories []*domain.Category) error {
+	if category.ParentID == "" {
+		return nil
+	}
+
+	var parent *domain.Category
+
+	for _, c := range categories {
+		if c.ID == category.ParentID {
+			parent = c
+		}
+
+		if c.ParentID == category.ID {
+			return fmt.Errorf("%w: a category with subcategories cannot have a parent", domain.ErrInvalidCategory)
+		}
+	}
+
+	if parent == nil {
+		return fmt.Errorf("%w: parent category not found", domain.ErrInvalidCategory)
+	}
+
+	if parent.ParentID != "" || parent.System {
+		return fmt.Errorf("%w: %q cannot have subcategories", domain.ErrInvalidCategory, parent.Slug)
+	}
+
+	return nil
+}
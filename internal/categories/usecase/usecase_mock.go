@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/categories/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCategoriesUsecase is a mock implementation of the CategoriesUsecase interface.
+type MockCategoriesUsecase struct {
+	mock.Mock
+}
+
+func (m *MockCategoriesUsecase) GetCategories(ctx context.Context, accountID string) ([]*domain.Category, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoriesUsecase) GetCategory(ctx context.Context, id string, accountID string) (*domain.Category, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Category), args.Error(1)
+}
+
+func (m *MockCategoriesUsecase) CreateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoriesUsecase) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoriesUsecase) DeleteCategory(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockCategoriesUsecase) ValidateCategory(ctx context.Context, accountID string, slug movementsDomain.MovementCategory) error {
+	args := m.Called(ctx, accountID, slug)
+	return args.Error(0)
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"transaction-tracker/internal/categories/domain"
+	"transaction-tracker/internal/categories/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCategories_SeedsDefaults(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	seeded := domain.DefaultCategories("acc1")
+
+	repo := new(repository.MockCategoryRepository)
+	repo.On("GetCategoriesByAccountID", ctx, "acc1").Return([]*domain.Category{}, nil).Once()
+	repo.On("CreateCategories", ctx, mock.MatchedBy(func(categories []*domain.Category) bool {
+		return len(categories) == len(seeded)
+	})).Return(nil).Once()
+	repo.On("GetCategoriesByAccountID", ctx, "acc1").Return(seeded, nil).Once()
+
+	categories, err := NewCategoriesUsecase(repo).GetCategories(ctx, "acc1")
+	c.NoError(err)
+	c.Equal(seeded, categories)
+
+	repo.AssertExpectations(t)
+}
+
+func TestValidateCategory(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockCategoryRepository)
+	repo.On("GetCategoriesByAccountID", ctx, "acc1").Return([]*domain.Category{
+		{ID: "CAT1", Slug: movementsDomain.Food},
+		{ID: "CAT2", Slug: "pets"},
+	}, nil)
+
+	u := NewCategoriesUsecase(repo)
+
+	c.NoError(u.ValidateCategory(ctx, "acc1", "pets"))
+	c.ErrorIs(u.ValidateCategory(ctx, "acc1", movementsDomain.Travel), movementsDomain.ErrInvalidMovementCategory)
+}
+
+func TestCreateCategory_Parent(t *testing.T) {
+	ctx := context.Background()
+
+	categories := []*domain.Category{
+		{ID: "CAT1", Slug: movementsDomain.Food},
+		{ID: "CAT2", Slug: "groceries", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: movementsDomain.Unknown, System: true},
+	}
+
+	tests := []struct {
+		name     string
+		parentID string
+		wantErr  bool
+	}{
+		{name: "root category", parentID: ""},
+		{name: "subcategory", parentID: "CAT1"},
+		{name: "missing parent", parentID: "CAT9", wantErr: true},
+		{name: "subcategory as parent", parentID: "CAT2", wantErr: true},
+		{name: "system category as parent", parentID: "CAT3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			category, err := domain.NewCategory("acc1", "", "Restaurants", tt.parentID, "", "")
+			c.NoError(err)
+
+			repo := new(repository.MockCategoryRepository)
+			repo.On("GetCategoriesByAccountID", ctx, "acc1").Return(categories, nil)
+			repo.On("CreateCategory", ctx, category).Return(nil)
+
+			err = NewCategoriesUsecase(repo).CreateCategory(ctx, category)
+			if tt.wantErr {
+				c.ErrorIs(err, domain.ErrInvalidCategory)
+				repo.AssertNotCalled(t, "CreateCategory", mock.Anything, mock.Anything)
+				return
+			}
+
+			c.NoError(err)
+		})
+	}
+}
+
+func TestUpdateCategory_WithChildrenCannotHaveParent(t *testing.T) {
+	ctx := context.Background()
+
+	food := &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: movementsDomain.Food, Name: "Food", Color: "#FF9800", ParentID: "CAT3"}
+
+	repo := new(repository.MockCategoryRepository)
+	repo.On("GetCategoriesByAccountID", ctx, "acc1").Return([]*domain.Category{
+		food,
+		{ID: "CAT2", Slug: "groceries", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: movementsDomain.Shopping},
+	}, nil)
+
+	err := NewCategoriesUsecase(repo).UpdateCategory(ctx, food)
+	require.ErrorIs(t, err, domain.ErrInvalidCategory)
+}
+
+func TestDeleteCategory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("system category", func(t *testing.T) {
+		repo := new(repository.MockCategoryRepository)
+		repo.On("GetCategoryByID", ctx, "CAT1", "acc1").Return(&domain.Category{ID: "CAT1", System: true}, nil)
+
+		err := NewCategoriesUsecase(repo).DeleteCategory(ctx, "CAT1", "acc1")
+		require.ErrorIs(t, err, domain.ErrSystemCategory)
+	})
+
+	t.Run("in use", func(t *testing.T) {
+		category := &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: movementsDomain.Food}
+
+		repo := new(repository.MockCategoryRepository)
+		repo.On("GetCategoryByID", ctx, "CAT1", "acc1").Return(category, nil)
+		repo.On("IsCategoryInUse", ctx, category).Return(true, nil)
+
+		err := NewCategoriesUsecase(repo).DeleteCategory(ctx, "CAT1", "acc1")
+		require.ErrorIs(t, err, domain.ErrCategoryInUse)
+		repo.AssertNotCalled(t, "DeleteCategory", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		category := &domain.Category{ID: "CAT1", AccountID: "acc1", Slug: "pets"}
+
+		repo := new(repository.MockCategoryRepository)
+		repo.On("GetCategoryByID", ctx, "CAT1", "acc1").Return(category, nil)
+		repo.On("IsCategoryInUse", ctx, category).Return(false, nil)
+		repo.On("DeleteCategory", ctx, "CAT1", "acc1").Return(nil)
+
+		require.NoError(t, NewCategoriesUsecase(repo).DeleteCategory(ctx, "CAT1", "acc1"))
+	})
+}
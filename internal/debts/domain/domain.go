@@ -0,0 +1,319 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_contact_prefix    = "CNT"
+	_debt_prefix       = "DBT"
+	_settlement_prefix = "STL"
+
+	// MaxNameLength is the longest contact name, in characters.
+	MaxNameLength = 100
+	// MaxNoteLength is the longest note, in characters, a debt can have.
+	MaxNoteLength = 255
+	// HistoryDays is how far back incomes are read when matching settlements.
+	HistoryDays = 90
+)
+
+// Source tells how a settlement was found.
+type Source string
+
+const (
+	// DetectedSource settlements were matched automatically from an income.
+	DetectedSource Source = "detected"
+	// ManualSource settlements were recorded by the user.
+	ManualSource Source = "manual"
+)
+
+var (
+	// ErrInvalidContact is returned when the name of a contact is not valid.
+	ErrInvalidContact = errors.New("invalid contact")
+	// ErrInvalidDebt is returned when a movement can't be owed as requested.
+	ErrInvalidDebt = errors.New("invalid debt")
+	// ErrInvalidSettlement is returned when a movement can't settle a debt as requested.
+	ErrInvalidSettlement = errors.New("invalid settlement")
+
+	accents        = strings.NewReplacer("á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n")
+	nonWordPattern = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Contact is a person the account pays for and gets paid back by.
+type Contact struct {
+	ID        string
+	AccountID string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (c *Contact) LogProperties() map[string]string {
+	return map[string]string{
+		"contact_id": c.ID,
+		"account_id": c.AccountID,
+		"name":       c.Name,
+	}
+}
+
+// NewContact creates a contact of the account.
+func NewContact(accountID string, name string) (*Contact, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" || len([]rune(name)) > MaxNameLength {
+		return nil, fmt.Errorf("%w: name must have between 1 and %d characters", ErrInvalidContact, MaxNameLength)
+	}
+
+	return &Contact{
+		ID:        _contact_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		Name:      name,
+	}, nil
+}
+
+// Debt is the part of an expense a contact owes the account, as when paying a dinner for a
+// friend. SplitID is the split of the movement that is owed, empty when the movement itself
+// is. The amount is fixed when the debt is created.
+type Debt struct {
+	ID         string
+	AccountID  string
+	ContactID  string
+	MovementID string
+	SplitID    string
+	Amount     float64
+	Note       string
+	CreatedAt  time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (d *Debt) LogProperties() map[string]string {
+	return map[string]string{
+		"debt_id":     d.ID,
+		"account_id":  d.AccountID,
+		"contact_id":  d.ContactID,
+		"movement_id": d.MovementID,
+		"split_id":    d.SplitID,
+		"amount":      strconv.FormatFloat(d.Amount, 'f', 2, 64),
+	}
+}
+
+// NewDebt creates what the contact owes of the expense, or of the split of it with splitID
+// among its splits. A zero amount owes all of it.
+func NewDebt(contact *Contact, movement *movementsDomain.Movement, splits []*movementsDomain.Split, splitID string, amount float64, note string) (*Debt, error) {
+	if movement.Type != movementsDomain.Expense {
+		return nil, fmt.Errorf("%w: only expenses can be owed", ErrInvalidDebt)
+	}
+
+	if movement.TransferID != "" {
+		return nil, fmt.Errorf("%w: transfers between own accounts can't be owed", ErrInvalidDebt)
+	}
+
+	limit := movement.Amount
+	if splitID != "" {
+		index := slices.IndexFunc(splits, func(split *movementsDomain.Split) bool {
+			return split.ID == splitID
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("%w: the movement has no split %s", ErrInvalidDebt, splitID)
+		}
+
+		limit = splits[index].Amount
+	}
+
+	if amount == 0 {
+		amount = limit
+	}
+
+	if amount < 0 || cents(amount) > cents(limit) {
+		return nil, fmt.Errorf("%w: amount must be greater than zero and at most %.2f", ErrInvalidDebt, limit)
+	}
+
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > MaxNoteLength {
+		return nil, fmt.Errorf("%w: note must have at most %d characters", ErrInvalidDebt, MaxNoteLength)
+	}
+
+	return &Debt{
+		ID:         _debt_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:  contact.AccountID,
+		ContactID:  contact.ID,
+		MovementID: movement.ID,
+		SplitID:    splitID,
+		Amount:     amount,
+		Note:       note,
+	}, nil
+}
+
+// Settlement is an income with which a contact paid back part of what it owes.
+type Settlement struct {
+	ID         string
+	AccountID  string
+	ContactID  string
+	MovementID string
+	Amount     float64
+	Source     Source
+	CreatedAt  time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (s *Settlement) LogProperties() map[string]string {
+	return map[string]string{
+		"settlement_id": s.ID,
+		"account_id":    s.AccountID,
+		"contact_id":    s.ContactID,
+		"movement_id":   s.MovementID,
+		"amount":        strconv.FormatFloat(s.Amount, 'f', 2, 64),
+		"source":        string(s.Source),
+	}
+}
+
+// NewSettlement records the income as paid back by the contact. A zero amount settles all
+// of it.
+func NewSettlement(contact *Contact, income *Income, amount float64, source Source) (*Settlement, error) {
+	if income.Type != movementsDomain.Income {
+		return nil, fmt.Errorf("%w: only incomes can settle debts", ErrInvalidSettlement)
+	}
+
+	if income.TransferID != "" {
+		return nil, fmt.Errorf("%w: transfers between own accounts can't settle debts", ErrInvalidSettlement)
+	}
+
+	if amount == 0 {
+		amount = income.Amount
+	}
+
+	if amount < 0 || cents(amount) > cents(income.Amount) {
+		return nil, fmt.Errorf("%w: amount must be greater than zero and at most %.2f", ErrInvalidSettlement, income.Amount)
+	}
+
+	return &Settlement{
+		ID:         _settlement_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:  contact.AccountID,
+		ContactID:  contact.ID,
+		MovementID: income.MovementID,
+		Amount:     amount,
+		Source:     source,
+	}, nil
+}
+
+// Income is a movement that can settle debts. TransferID is the transfer it is a side of, if
+// any, and RejectedBy the contacts whose settlement with it the user deleted.
+type Income struct {
+	MovementID  string
+	TransferID  string
+	Type        movementsDomain.MovementType
+	Description string
+	Amount      float64
+	Date        time.Time
+	RejectedBy  []string
+}
+
+// Balance is what a contact owes the account: the debts it has minus what it paid back.
+type Balance struct {
+	Contact *Contact
+	Owed    float64
+	Paid    float64
+}
+
+// Outstanding is what the contact still owes, negative when it paid back more.
+func (b *Balance) Outstanding() float64 {
+	return float64(cents(b.Owed)-cents(b.Paid)) / 100
+}
+
+// Summary is who owes the account and how much.
+type Summary struct {
+	Balances    []*Balance
+	Outstanding float64
+}
+
+// NewSummary keeps the contacts that still owe something, the ones that owe the most first.
+func NewSummary(balances []*Balance) *Summary {
+	owing := []*Balance{}
+	var total int64
+	for _, balance := range balances {
+		if cents(balance.Outstanding()) <= 0 {
+			continue
+		}
+
+		owing = append(owing, balance)
+		total += cents(balance.Outstanding())
+	}
+
+	slices.SortStableFunc(owing, func(a, b *Balance) int {
+		return cmp.Compare(b.Outstanding(), a.Outstanding())
+	})
+
+	return &Summary{
+		Balances:    owing,
+		Outstanding: float64(total) / 100,
+	}
+}
+
+// Match settles the debts of the balances with the incomes whose description names exactly
+// one contact that still owes at least their amount, as the "Recibiste $50.000 de Juan Perez"
+// of a Nequi transfer. Incomes are matched oldest first; an income naming several contacts
+// is left for the user, and contacts that rejected the income are not matched with it.
+func Match(balances []*Balance, incomes []*Income) []*Settlement {
+	outstanding := map[string]int64{}
+	for _, balance := range balances {
+		outstanding[balance.Contact.ID] = cents(balance.Outstanding())
+	}
+
+	incomes = slices.Clone(incomes)
+	slices.SortStableFunc(incomes, func(a, b *Income) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	settlements := []*Settlement{}
+	for _, income := range incomes {
+		description := " " + normalize(income.Description) + " "
+
+		var match *Contact
+		matches := 0
+		for _, balance := range balances {
+			name := normalize(balance.Contact.Name)
+			if name == "" || !strings.Contains(description, " "+name+" ") || slices.Contains(income.RejectedBy, balance.Contact.ID) {
+				continue
+			}
+
+			matches++
+			match = balance.Contact
+		}
+
+		if matches != 1 || outstanding[match.ID] < cents(income.Amount) {
+			continue
+		}
+
+		settlement, err := NewSettlement(match, income, 0, DetectedSource)
+		if err != nil {
+			continue
+		}
+
+		outstanding[match.ID] -= cents(income.Amount)
+		settlements = append(settlements, settlement)
+	}
+
+	return settlements
+}
+
+// normalize lowercases the text and keeps its words without accents.
+func normalize(text string) string {
+	text = accents.Replace(strings.ToLower(text))
+
+	return strings.Join(strings.Fields(nonWordPattern.ReplaceAllString(text, " ")), " ")
+}
+
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+func contact(id string, name string) *Contact {
+	return &Contact{ID: id, AccountID: "acc1", Name: name}
+}
+
+func income(id string, description string, amount float64, days int) *Income {
+	return &Income{
+		MovementID:  id,
+		Type:        movementsDomain.Income,
+		Description: description,
+		Amount:      amount,
+		Date:        start.AddDate(0, 0, days),
+	}
+}
+
+func TestNewContact(t *testing.T) {
+	c := require.New(t)
+
+	created, err := NewContact("acc1", "  Juan   Pérez ")
+	c.NoError(err)
+	c.Contains(created.ID, _contact_prefix)
+	c.Equal("Juan Pérez", created.Name)
+
+	_, err = NewContact("acc1", "  ")
+	c.ErrorIs(err, ErrInvalidContact)
+
+	_, err = NewContact("acc1", strings.Repeat("a", MaxNameLength+1))
+	c.ErrorIs(err, ErrInvalidContact)
+}
+
+func TestNewDebt(t *testing.T) {
+	movement := &movementsDomain.Movement{ID: "MID1", AccountID: "acc1", Type: movementsDomain.Expense, Amount: 120000}
+	splits := []*movementsDomain.Split{
+		{ID: "SPL1", MovementID: "MID1", Amount: 80000},
+		{ID: "SPL2", MovementID: "MID1", Amount: 40000},
+	}
+
+	t.Run("whole movement", func(t *testing.T) {
+		c := require.New(t)
+
+		debt, err := NewDebt(contact("CNT1", "Juan"), movement, nil, "", 0, " dinner ")
+		c.NoError(err)
+		c.Contains(debt.ID, _debt_prefix)
+		c.Equal("acc1", debt.AccountID)
+		c.Equal("CNT1", debt.ContactID)
+		c.Equal(120000.0, debt.Amount)
+		c.Equal("dinner", debt.Note)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		c := require.New(t)
+
+		debt, err := NewDebt(contact("CNT1", "Juan"), movement, splits, "SPL2", 0, "")
+		c.NoError(err)
+		c.Equal("SPL2", debt.SplitID)
+		c.Equal(40000.0, debt.Amount)
+
+		_, err = NewDebt(contact("CNT1", "Juan"), movement, splits, "SPL2", 40000.01, "")
+		c.ErrorIs(err, ErrInvalidDebt)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			movement *movementsDomain.Movement
+			splitID  string
+			amount   float64
+			note     string
+		}{
+			{name: "income", movement: &movementsDomain.Movement{ID: "MID2", Type: movementsDomain.Income, Amount: 100}},
+			{name: "transfer", movement: &movementsDomain.Movement{ID: "MID3", Type: movementsDomain.Expense, Amount: 100, TransferID: "TRF1"}},
+			{name: "unknown split", movement: movement, splitID: "SPL9"},
+			{name: "more than the movement", movement: movement, amount: 120001},
+			{name: "negative amount", movement: movement, amount: -1},
+			{name: "long note", movement: movement, note: strings.Repeat("a", MaxNoteLength+1)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := NewDebt(contact("CNT1", "Juan"), tt.movement, splits, tt.splitID, tt.amount, tt.note)
+				require.ErrorIs(t, err, ErrInvalidDebt)
+			})
+		}
+	})
+}
+
+func TestNewSettlement(t *testing.T) {
+	c := require.New(t)
+
+	settlement, err := NewSettlement(contact("CNT1", "Juan"), income("MID1", "", 50000, 0), 0, ManualSource)
+	c.NoError(err)
+	c.Contains(settlement.ID, _settlement_prefix)
+	c.Equal(50000.0, settlement.Amount)
+	c.Equal(ManualSource, settlement.Source)
+
+	settlement, err = NewSettlement(contact("CNT1", "Juan"), income("MID1", "", 50000, 0), 20000, ManualSource)
+	c.NoError(err)
+	c.Equal(20000.0, settlement.Amount)
+
+	_, err = NewSettlement(contact("CNT1", "Juan"), income("MID1", "", 50000, 0), 50001, ManualSource)
+	c.ErrorIs(err, ErrInvalidSettlement)
+
+	expense := income("MID2", "", 50000, 0)
+	expense.Type = movementsDomain.Expense
+	_, err = NewSettlement(contact("CNT1", "Juan"), expense, 0, ManualSource)
+	c.ErrorIs(err, ErrInvalidSettlement)
+
+	transferred := income("MID3", "", 50000, 0)
+	transferred.TransferID = "TRF1"
+	_, err = NewSettlement(contact("CNT1", "Juan"), transferred, 0, ManualSource)
+	c.ErrorIs(err, ErrInvalidSettlement)
+}
+
+func TestNewSummary(t *testing.T) {
+	c := require.New(t)
+
+	summary := NewSummary([]*Balance{
+		{Contact: contact("CNT1", "Juan"), Owed: 100000, Paid: 60000},
+		{Contact: contact("CNT2", "Ana"), Owed: 90000.5},
+		{Contact: contact("CNT3", "Luis"), Owed: 30000, Paid: 30000},
+		{Contact: contact("CNT4", "Sara"), Owed: 10000, Paid: 15000},
+	})
+
+	c.Len(summary.Balances, 2)
+	c.Equal("CNT2", summary.Balances[0].Contact.ID)
+	c.Equal(90000.5, summary.Balances[0].Outstanding())
+	c.Equal("CNT1", summary.Balances[1].Contact.ID)
+	c.Equal(40000.0, summary.Balances[1].Outstanding())
+	c.Equal(130000.5, summary.Outstanding)
+}
+
+func TestMatch(t *testing.T) {
+	t.Run("names in the description", func(t *testing.T) {
+		c := require.New(t)
+
+		balances := []*Balance{
+			{Contact: contact("CNT1", "Juan Pérez"), Owed: 80000},
+			{Contact: contact("CNT2", "Ana"), Owed: 30000},
+		}
+
+		settlements := Match(balances, []*Income{
+			income("MID3", "Recibiste $30.000 de JUAN PEREZ", 30000, 2),
+			income("MID1", "Nequi: Recibiste $50.000 de Juan Perez", 50000, 0),
+			income("MID2", "Transferencia de Anastasia", 10000, 1),
+			income("MID4", "Abono de Ana", 10000, 3),
+		})
+
+		c.Len(settlements, 3)
+		c.Equal("MID1", settlements[0].MovementID)
+		c.Equal("CNT1", settlements[0].ContactID)
+		c.Equal(DetectedSource, settlements[0].Source)
+		c.Equal("MID3", settlements[1].MovementID)
+		c.Equal("MID4", settlements[2].MovementID)
+		c.Equal("CNT2", settlements[2].ContactID)
+	})
+
+	t.Run("more than owed", func(t *testing.T) {
+		balances := []*Balance{{Contact: contact("CNT1", "Juan"), Owed: 50000, Paid: 20000}}
+
+		settlements := Match(balances, []*Income{income("MID1", "Recibiste de Juan", 30000.01, 0)})
+		require.Empty(t, settlements)
+	})
+
+	t.Run("several contacts named", func(t *testing.T) {
+		balances := []*Balance{
+			{Contact: contact("CNT1", "Juan"), Owed: 50000},
+			{Contact: contact("CNT2", "Ana"), Owed: 50000},
+		}
+
+		settlements := Match(balances, []*Income{income("MID1", "Pago de Juan y Ana", 20000, 0)})
+		require.Empty(t, settlements)
+	})
+
+	t.Run("rejected by the contact", func(t *testing.T) {
+		balances := []*Balance{{Contact: contact("CNT1", "Juan"), Owed: 50000}}
+
+		rejected := income("MID1", "Recibiste de Juan", 20000, 0)
+		rejected.RejectedBy = []string{"CNT1"}
+
+		settlements := Match(balances, []*Income{rejected})
+		require.Empty(t, settlements)
+	})
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/debts/domain"
+)
+
+// DebtRepository stores the contacts of each account, what they owe and what they paid back,
+// and reads the incomes settlements are matched from.
+type DebtRepository interface {
+	CreateContact(ctx context.Context, contact *domain.Contact) error
+	GetContactByID(ctx context.Context, id string, accountID string) (*domain.Contact, error)
+	GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error)
+	DeleteContact(ctx context.Context, id string, accountID string) error
+	CreateDebt(ctx context.Context, debt *domain.Debt) error
+	GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error)
+	DeleteDebt(ctx context.Context, id string, accountID string) error
+	CreateSettlement(ctx context.Context, settlement *domain.Settlement) error
+	GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error)
+	DeleteSettlement(ctx context.Context, id string, accountID string) error
+	GetBalances(ctx context.Context, accountID string) ([]*domain.Balance, error)
+	GetIncomes(ctx context.Context, accountID string, since time.Time) ([]*domain.Income, error)
+	GetAccountIDs(ctx context.Context) ([]string, error)
+}
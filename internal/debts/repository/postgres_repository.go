@@ -0,0 +1,448 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/debts/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	contactColumns    = `id, account_id, name, created_at, updated_at`
+	debtColumns       = `id, account_id, contact_id, movement_id, split_id, amount, note, created_at`
+	settlementColumns = `id, account_id, contact_id, movement_id, amount, source, created_at`
+)
+
+var (
+	ErrContactNotFound    = errors.New("contact not found")
+	ErrDebtNotFound       = errors.New("debt not found")
+	ErrSettlementNotFound = errors.New("settlement not found")
+	// ErrContactExists is returned when the account already has a contact with the name.
+	ErrContactExists = errors.New("contact already exists")
+	// ErrMovementOwed is returned when a movement, or its split, is already owed by a contact,
+	// in whole or in part.
+	ErrMovementOwed = errors.New("movement is already owed")
+	// ErrMovementSettled is returned when an income already settles debts.
+	ErrMovementSettled = errors.New("movement already settles a debt")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the debts repository.
+func NewPostgresRepository(db *pgxpool.Pool) DebtRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// CreateContact stores a contact of the account. Names are unique per account regardless of
+// case.
+func (r *postgresRepository) CreateContact(ctx context.Context, contact *domain.Contact) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO contacts (` + contactColumns + `)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.Exec(ctx, query, contact.ID, contact.AccountID, contact.Name, now, now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrContactExists
+		}
+
+		return err
+	}
+
+	contact.CreatedAt = now
+	contact.UpdatedAt = now
+
+	return nil
+}
+
+// GetContactByID returns a contact of the account.
+func (r *postgresRepository) GetContactByID(ctx context.Context, id string, accountID string) (*domain.Contact, error) {
+	query := `SELECT ` + contactColumns + `
+	FROM contacts
+	WHERE id = $1 AND account_id = $2`
+
+	contact, err := scanToContact(r.db.QueryRow(ctx, query, id, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrContactNotFound
+	}
+
+	return contact, err
+}
+
+// GetContacts returns the contacts of the account by name.
+func (r *postgresRepository) GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error) {
+	query := `SELECT ` + contactColumns + `
+	FROM contacts
+	WHERE account_id = $1
+	ORDER BY name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	contacts := []*domain.Contact{}
+	for rows.Next() {
+		contact, err := scanToContact(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		contacts = append(contacts, contact)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// DeleteContact removes a contact of the account along with its debts and settlements.
+func (r *postgresRepository) DeleteContact(ctx context.Context, id string, accountID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM contacts WHERE id = $1 AND account_id = $2`, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrContactNotFound
+	}
+
+	return nil
+}
+
+// CreateDebt stores what a contact owes of a movement. A movement is owed whole or by splits,
+// so a whole debt is refused when its splits are owed and the other way around.
+func (r *postgresRepository) CreateDebt(ctx context.Context, debt *domain.Debt) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO debts (` + debtColumns + `)
+	SELECT $1::VARCHAR, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::VARCHAR, $6::DECIMAL, $7::VARCHAR, $8::TIMESTAMPTZ
+	WHERE NOT EXISTS (SELECT 1 FROM debts WHERE movement_id = $4 AND (split_id = '' OR $5 = ''))`
+
+	tag, err := r.db.Exec(ctx, query,
+		debt.ID,
+		debt.AccountID,
+		debt.ContactID,
+		debt.MovementID,
+		debt.SplitID,
+		debt.Amount,
+		debt.Note,
+		now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrMovementOwed
+		}
+
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMovementOwed
+	}
+
+	debt.CreatedAt = now
+
+	return nil
+}
+
+// GetDebts returns the debts of the account, newest first. An empty contactID returns the
+// debts of every contact.
+func (r *postgresRepository) GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error) {
+	query := `SELECT ` + debtColumns + `
+	FROM debts
+	WHERE account_id = $1 AND ($2 = '' OR contact_id = $2)
+	ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, accountID, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	debts := []*domain.Debt{}
+	for rows.Next() {
+		debt, err := scanToDebt(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		debts = append(debts, debt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return debts, nil
+}
+
+// DeleteDebt removes a debt of the account.
+func (r *postgresRepository) DeleteDebt(ctx context.Context, id string, accountID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM debts WHERE id = $1 AND account_id = $2`, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrDebtNotFound
+	}
+
+	return nil
+}
+
+// CreateSettlement stores an income with which a contact paid back.
+func (r *postgresRepository) CreateSettlement(ctx context.Context, settlement *domain.Settlement) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO settlements (` + settlementColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.Exec(ctx, query,
+		settlement.ID,
+		settlement.AccountID,
+		settlement.ContactID,
+		settlement.MovementID,
+		settlement.Amount,
+		settlement.Source,
+		now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrMovementSettled
+		}
+
+		return err
+	}
+
+	settlement.CreatedAt = now
+
+	return nil
+}
+
+// GetSettlements returns the settlements of the account, newest first. An empty contactID
+// returns the settlements of every contact.
+func (r *postgresRepository) GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+	FROM settlements
+	WHERE account_id = $1 AND ($2 = '' OR contact_id = $2)
+	ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, accountID, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	settlements := []*domain.Settlement{}
+	for rows.Next() {
+		settlement, err := scanToSettlement(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		settlements = append(settlements, settlement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return settlements, nil
+}
+
+// DeleteSettlement removes a settlement of the account, so its contact owes it again, and
+// remembers the income was rejected for the contact so detection doesn't match them again.
+func (r *postgresRepository) DeleteSettlement(ctx context.Context, id string, accountID string) error {
+	query := `WITH deleted AS (
+		DELETE FROM settlements WHERE id = $1 AND account_id = $2
+		RETURNING account_id, contact_id, movement_id
+	)
+	INSERT INTO rejected_settlements (account_id, contact_id, movement_id, created_at)
+	SELECT account_id, contact_id, movement_id, $3 FROM deleted
+	ON CONFLICT (movement_id, contact_id) DO UPDATE SET created_at = EXCLUDED.created_at`
+
+	tag, err := r.db.Exec(ctx, query, id, accountID, r.nowFunc())
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrSettlementNotFound
+	}
+
+	return nil
+}
+
+// GetBalances returns every contact of the account with the total of its debts and of its
+// settlements.
+func (r *postgresRepository) GetBalances(ctx context.Context, accountID string) ([]*domain.Balance, error) {
+	query := `SELECT c.id, c.account_id, c.name, c.created_at, c.updated_at,
+		COALESCE((SELECT SUM(d.amount) FROM debts d WHERE d.contact_id = c.id), 0),
+		COALESCE((SELECT SUM(s.amount) FROM settlements s WHERE s.contact_id = c.id), 0)
+	FROM contacts c
+	WHERE c.account_id = $1
+	ORDER BY c.name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	balances := []*domain.Balance{}
+	for rows.Next() {
+		balance := &domain.Balance{Contact: &domain.Contact{}}
+
+		err := rows.Scan(
+			&balance.Contact.ID,
+			&balance.Contact.AccountID,
+			&balance.Contact.Name,
+			&balance.Contact.CreatedAt,
+			&balance.Contact.UpdatedAt,
+			&balance.Owed,
+			&balance.Paid)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// GetIncomes returns the incomes of the account made since the date that settle nothing yet
+// and are not a side of a transfer, oldest first, with the contacts that rejected them.
+func (r *postgresRepository) GetIncomes(ctx context.Context, accountID string, since time.Time) ([]*domain.Income, error) {
+	query := `SELECT m.id, m.type, m.description, m.amount, m.date,
+		ARRAY(SELECT r.contact_id FROM rejected_settlements r WHERE r.movement_id = m.id ORDER BY r.contact_id)
+	FROM movements m
+	WHERE m.account_id = $1 AND m.type = $2 AND m.transfer_id = '' AND m.date >= $3
+		AND NOT EXISTS (SELECT 1 FROM settlements s WHERE s.movement_id = m.id)
+	ORDER BY m.date`
+
+	rows, err := r.db.Query(ctx, query, accountID, movementsDomain.Income, since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	incomes := []*domain.Income{}
+	for rows.Next() {
+		income := &domain.Income{}
+
+		var movementType string
+
+		err := rows.Scan(&income.MovementID, &movementType, &income.Description, &income.Amount, &income.Date, &income.RejectedBy)
+		if err != nil {
+			return nil, err
+		}
+
+		income.Type = movementsDomain.MovementType(movementType)
+		incomes = append(incomes, income)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return incomes, nil
+}
+
+// GetAccountIDs returns the accounts with debts.
+func (r *postgresRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT DISTINCT account_id FROM debts ORDER BY account_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accountIDs := []string{}
+	for rows.Next() {
+		var accountID string
+
+		err := rows.Scan(&accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func scanToContact(scanFn func(...any) error) (*domain.Contact, error) {
+	contact := &domain.Contact{}
+
+	err := scanFn(&contact.ID, &contact.AccountID, &contact.Name, &contact.CreatedAt, &contact.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func scanToDebt(scanFn func(...any) error) (*domain.Debt, error) {
+	debt := &domain.Debt{}
+
+	err := scanFn(&debt.ID, &debt.AccountID, &debt.ContactID, &debt.MovementID, &debt.SplitID, &debt.Amount, &debt.Note, &debt.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return debt, nil
+}
+
+func scanToSettlement(scanFn func(...any) error) (*domain.Settlement, error) {
+	settlement := &domain.Settlement{}
+
+	var source string
+
+	err := scanFn(&settlement.ID, &settlement.AccountID, &settlement.ContactID, &settlement.MovementID, &settlement.Amount, &source, &settlement.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement.Source = domain.Source(source)
+
+	return settlement, nil
+}
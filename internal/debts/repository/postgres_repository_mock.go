@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/debts/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDebtRepository is a mock of the repository interface.
+type MockDebtRepository struct {
+	mock.Mock
+}
+
+func (m *MockDebtRepository) CreateContact(ctx context.Context, contact *domain.Contact) error {
+	args := m.Called(ctx, contact)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) GetContactByID(ctx context.Context, id string, accountID string) (*domain.Contact, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Contact), args.Error(1)
+}
+
+func (m *MockDebtRepository) GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Contact), args.Error(1)
+}
+
+func (m *MockDebtRepository) DeleteContact(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) CreateDebt(ctx context.Context, debt *domain.Debt) error {
+	args := m.Called(ctx, debt)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error) {
+	args := m.Called(ctx, accountID, contactID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Debt), args.Error(1)
+}
+
+func (m *MockDebtRepository) DeleteDebt(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) CreateSettlement(ctx context.Context, settlement *domain.Settlement) error {
+	args := m.Called(ctx, settlement)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error) {
+	args := m.Called(ctx, accountID, contactID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Settlement), args.Error(1)
+}
+
+func (m *MockDebtRepository) DeleteSettlement(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtRepository) GetBalances(ctx context.Context, accountID string) ([]*domain.Balance, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Balance), args.Error(1)
+}
+
+func (m *MockDebtRepository) GetIncomes(ctx context.Context, accountID string, since time.Time) ([]*domain.Income, error) {
+	args := m.Called(ctx, accountID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Income), args.Error(1)
+}
+
+func (m *MockDebtRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/debts/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime      = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	contactRows    = []string{"id", "account_id", "name", "created_at", "updated_at"}
+	debtRows       = []string{"id", "account_id", "contact_id", "movement_id", "split_id", "amount", "note", "created_at"}
+	settlementRows = []string{"id", "account_id", "contact_id", "movement_id", "amount", "source", "created_at"}
+)
+
+func setupMockDB(t *testing.T) (DebtRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateContact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		contact := &domain.Contact{ID: "CNT1", AccountID: "acc1", Name: "Juan"}
+
+		mock.ExpectExec(`INSERT INTO contacts`).
+			WithArgs("CNT1", "acc1", "Juan", fixedTime, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateContact(context.Background(), contact))
+		c.Equal(fixedTime, contact.CreatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO contacts`).
+			WithArgs("CNT1", "acc1", "Juan", fixedTime, fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateContact(context.Background(), &domain.Contact{ID: "CNT1", AccountID: "acc1", Name: "Juan"})
+		require.ErrorIs(t, err, ErrContactExists)
+	})
+}
+
+func TestGetContactByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM contacts WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("CNT1", "acc1").
+			WillReturnRows(pgxmock.NewRows(contactRows).AddRow("CNT1", "acc1", "Juan", fixedTime, fixedTime))
+
+		contact, err := repo.GetContactByID(context.Background(), "CNT1", "acc1")
+		c.NoError(err)
+		c.Equal("Juan", contact.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM contacts`).
+			WithArgs("CNT1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetContactByID(context.Background(), "CNT1", "acc1")
+		require.ErrorIs(t, err, ErrContactNotFound)
+	})
+}
+
+func TestDeleteContact(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`DELETE FROM contacts WHERE id = \$1 AND account_id = \$2`).
+		WithArgs("CNT1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.DeleteContact(context.Background(), "CNT1", "acc1")
+	require.ErrorIs(t, err, ErrContactNotFound)
+}
+
+func TestCreateDebt(t *testing.T) {
+	debt := &domain.Debt{ID: "DBT1", AccountID: "acc1", ContactID: "CNT1", MovementID: "MID1", SplitID: "SPL1", Amount: 40000, Note: "dinner"}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO debts (.+) SELECT (.+) WHERE NOT EXISTS \(SELECT 1 FROM debts WHERE movement_id = \$4 AND \(split_id = '' OR \$5 = ''\)\)`).
+			WithArgs("DBT1", "acc1", "CNT1", "MID1", "SPL1", 40000.0, "dinner", fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateDebt(context.Background(), debt))
+		c.Equal(fixedTime, debt.CreatedAt)
+	})
+
+	t.Run("owed whole or by splits", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO debts`).
+			WithArgs("DBT1", "acc1", "CNT1", "MID1", "SPL1", 40000.0, "dinner", fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		err := repo.CreateDebt(context.Background(), debt)
+		require.ErrorIs(t, err, ErrMovementOwed)
+	})
+
+	t.Run("already owed", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO debts`).
+			WithArgs("DBT1", "acc1", "CNT1", "MID1", "SPL1", 40000.0, "dinner", fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateDebt(context.Background(), debt)
+		require.ErrorIs(t, err, ErrMovementOwed)
+	})
+}
+
+func TestGetDebts(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM debts WHERE account_id = \$1 AND \(\$2 = '' OR contact_id = \$2\) ORDER BY created_at DESC`).
+		WithArgs("acc1", "CNT1").
+		WillReturnRows(pgxmock.NewRows(debtRows).AddRow("DBT1", "acc1", "CNT1", "MID1", "", 120000.0, "", fixedTime))
+
+	debts, err := repo.GetDebts(context.Background(), "acc1", "CNT1")
+	c.NoError(err)
+	c.Len(debts, 1)
+	c.Equal("MID1", debts[0].MovementID)
+	c.Equal(120000.0, debts[0].Amount)
+}
+
+func TestCreateSettlement(t *testing.T) {
+	settlement := &domain.Settlement{ID: "STL1", AccountID: "acc1", ContactID: "CNT1", MovementID: "MID2", Amount: 50000, Source: domain.DetectedSource}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO settlements`).
+			WithArgs("STL1", "acc1", "CNT1", "MID2", 50000.0, domain.DetectedSource, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateSettlement(context.Background(), settlement))
+		c.Equal(fixedTime, settlement.CreatedAt)
+	})
+
+	t.Run("already settles", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO settlements`).
+			WithArgs("STL1", "acc1", "CNT1", "MID2", 50000.0, domain.DetectedSource, fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateSettlement(context.Background(), settlement)
+		require.ErrorIs(t, err, ErrMovementSettled)
+	})
+}
+
+func TestGetSettlements(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM settlements WHERE account_id = \$1`).
+		WithArgs("acc1", "").
+		WillReturnRows(pgxmock.NewRows(settlementRows).AddRow("STL1", "acc1", "CNT1", "MID2", 50000.0, "manual", fixedTime))
+
+	settlements, err := repo.GetSettlements(context.Background(), "acc1", "")
+	c.NoError(err)
+	c.Len(settlements, 1)
+	c.Equal(domain.ManualSource, settlements[0].Source)
+}
+
+func TestDeleteSettlement(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`DELETE FROM settlements WHERE id = \$1 AND account_id = \$2 (.+) INSERT INTO rejected_settlements`).
+		WithArgs("STL1", "acc1", fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	require.NoError(t, repo.DeleteSettlement(context.Background(), "STL1", "acc1"))
+}
+
+func TestDeleteSettlement_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`DELETE FROM settlements`).
+		WithArgs("STL1", "acc1", fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+	require.ErrorIs(t, repo.DeleteSettlement(context.Background(), "STL1", "acc1"), ErrSettlementNotFound)
+}
+
+func TestGetBalances(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "account_id", "name", "created_at", "updated_at", "owed", "paid"}).
+		AddRow("CNT1", "acc1", "Juan", fixedTime, fixedTime, 120000.0, 50000.0)
+
+	mock.ExpectQuery(`SELECT (.+) FROM contacts c WHERE c.account_id = \$1 ORDER BY c.name`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	balances, err := repo.GetBalances(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(balances, 1)
+	c.Equal("Juan", balances[0].Contact.Name)
+	c.Equal(70000.0, balances[0].Outstanding())
+}
+
+func TestGetIncomes(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	since := fixedTime.AddDate(0, 0, -domain.HistoryDays)
+	rows := pgxmock.NewRows([]string{"id", "type", "description", "amount", "date", "rejected_by"}).
+		AddRow("MID2", "income", "Recibiste de Juan", 50000.0, fixedTime, []string{"CNT2"})
+
+	mock.ExpectQuery(`SELECT (.+) FROM movements m WHERE m.account_id = \$1 AND m.type = \$2 AND m.transfer_id = '' AND m.date >= \$3 AND NOT EXISTS`).
+		WithArgs("acc1", movementsDomain.Income, since).
+		WillReturnRows(rows)
+
+	incomes, err := repo.GetIncomes(context.Background(), "acc1", since)
+	c.NoError(err)
+	c.Len(incomes, 1)
+	c.Equal(movementsDomain.Income, incomes[0].Type)
+	c.Equal("Recibiste de Juan", incomes[0].Description)
+	c.Equal([]string{"CNT2"}, incomes[0].RejectedBy)
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/debts/domain"
+)
+
+// DebtsUsecase keeps track of the expenses contacts owe the account and of the incomes with
+// which they pay them back.
+type DebtsUsecase interface {
+	CreateContact(ctx context.Context, accountID string, name string) (*domain.Contact, error)
+	GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error)
+	DeleteContact(ctx context.Context, id string, accountID string) error
+	CreateDebt(ctx context.Context, accountID string, contactID string, movementID string, splitID string, amount float64, note string) (*domain.Debt, error)
+	GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error)
+	DeleteDebt(ctx context.Context, id string, accountID string) error
+	CreateSettlement(ctx context.Context, accountID string, contactID string, movementID string, amount float64) (*domain.Settlement, error)
+	GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error)
+	DeleteSettlement(ctx context.Context, id string, accountID string) error
+	GetSummary(ctx context.Context, accountID string) (*domain.Summary, error)
+	DetectSettlements(ctx context.Context, accountID string) ([]*domain.Settlement, error)
+	RunDetection(ctx context.Context, interval time.Duration)
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/debts/domain"
+	"transaction-tracker/internal/debts/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/shared"
+)
+
+// DefaultDetectionInterval is how often the settlements of every account are matched.
+const DefaultDetectionInterval = time.Hour
+
+var (
+	ErrContactNotFound    = repository.ErrContactNotFound
+	ErrDebtNotFound       = repository.ErrDebtNotFound
+	ErrSettlementNotFound = repository.ErrSettlementNotFound
+	ErrContactExists      = repository.ErrContactExists
+	ErrMovementOwed       = repository.ErrMovementOwed
+	ErrMovementSettled    = repository.ErrMovementSettled
+	ErrMovementNotFound   = movementsUsecase.ErrMovementNotFound
+)
+
+type debtsUsecase struct {
+	repo             repository.DebtRepository
+	movementsUsecase movementsUsecase.MovementUsecase
+	nowFunc          func() time.Time
+	log              *loggerModels.Logger
+}
+
+// NewDebtsUsecase creates a new instance of DebtsUsecase.
+func NewDebtsUsecase(ctx context.Context, repo repository.DebtRepository, mvmUsecase movementsUsecase.MovementUsecase) DebtsUsecase {
+	log, _ := logger.GetLogger(ctx, "debts-usecase")
+
+	return &debtsUsecase{
+		repo:             repo,
+		movementsUsecase: mvmUsecase,
+		nowFunc:          time.Now,
+		log:              log,
+	}
+}
+
+func (u *debtsUsecase) CreateContact(ctx context.Context, accountID string, name string) (*domain.Contact, error) {
+	contact, err := domain.NewContact(accountID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.CreateContact(ctx, contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (u *debtsUsecase) GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error) {
+	return u.repo.GetContacts(ctx, accountID)
+}
+
+// DeleteContact removes a contact along with what it owes and what it paid back.
+func (u *debtsUsecase) DeleteContact(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteContact(ctx, id, accountID)
+}
+
+// CreateDebt records that the contact owes the expense of the account, or the split of it
+// with splitID when given. A zero amount owes all of it.
+func (u *debtsUsecase) CreateDebt(ctx context.Context, accountID string, contactID string, movementID string, splitID string, amount float64, note string) (*domain.Debt, error) {
+	contact, err := u.repo.GetContactByID(ctx, contactID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := u.movementsUsecase.GetMovementByID(ctx, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var splits []*movementsDomain.Split
+	if splitID != "" {
+		splits, err = u.movementsUsecase.GetSplits(ctx, movementID, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	debt, err := domain.NewDebt(contact, movement, splits, splitID, amount, note)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.CreateDebt(ctx, debt)
+	if err != nil {
+		return nil, err
+	}
+
+	return debt, nil
+}
+
+func (u *debtsUsecase) GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error) {
+	return u.repo.GetDebts(ctx, accountID, contactID)
+}
+
+func (u *debtsUsecase) DeleteDebt(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteDebt(ctx, id, accountID)
+}
+
+// CreateSettlement records that the contact paid back with the income of the account. A zero
+// amount settles all of it.
+func (u *debtsUsecase) CreateSettlement(ctx context.Context, accountID string, contactID string, movementID string, amount float64) (*domain.Settlement, error) {
+	contact, err := u.repo.GetContactByID(ctx, contactID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := u.movementsUsecase.GetMovementByID(ctx, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	income := &domain.Income{
+		MovementID:  movement.ID,
+		TransferID:  movement.TransferID,
+		Type:        movement.Type,
+		Description: movement.Description,
+		Amount:      movement.Amount,
+		Date:        movement.Date,
+	}
+
+	settlement, err := domain.NewSettlement(contact, income, amount, domain.ManualSource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.CreateSettlement(ctx, settlement)
+	if err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+func (u *debtsUsecase) GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error) {
+	return u.repo.GetSettlements(ctx, accountID, contactID)
+}
+
+// DeleteSettlement removes a settlement, so its contact owes that amount again. Detection
+// won't match the income with that contact again.
+func (u *debtsUsecase) DeleteSettlement(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteSettlement(ctx, id, accountID)
+}
+
+// GetSummary returns who owes the account and how much.
+func (u *debtsUsecase) GetSummary(ctx context.Context, accountID string) (*domain.Summary, error) {
+	balances, err := u.repo.GetBalances(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewSummary(balances), nil
+}
+
+// DetectSettlements matches the recent incomes of the account that settle nothing yet with
+// the contacts that owe it, and stores the settlements found. An income settled meanwhile is
+// skipped.
+func (u *debtsUsecase) DetectSettlements(ctx context.Context, accountID string) ([]*domain.Settlement, error) {
+	balances, err := u.repo.GetBalances(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	created := []*domain.Settlement{}
+	if len(domain.NewSummary(balances).Balances) == 0 {
+		return created, nil
+	}
+
+	incomes, err := u.repo.GetIncomes(ctx, accountID, u.nowFunc().AddDate(0, 0, -domain.HistoryDays))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, settlement := range domain.Match(balances, incomes) {
+		err := u.repo.CreateSettlement(ctx, settlement)
+		if errors.Is(err, ErrMovementSettled) {
+			continue
+		}
+
+		if err != nil {
+			return created, err
+		}
+
+		created = append(created, settlement)
+	}
+
+	return created, nil
+}
+
+// RunDetection matches the settlements of every account with debts every interval until the
+// context is done.
+func (u *debtsUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	shared.RunPerAccount(ctx, u.log, "settlements", interval, u.repo.GetAccountIDs, func(ctx context.Context, accountID string) error {
+		_, err := u.DetectSettlements(ctx, accountID)
+		return err
+	})
+}
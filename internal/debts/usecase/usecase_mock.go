@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/debts/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDebtsUsecase is a mock implementation of the DebtsUsecase interface.
+type MockDebtsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockDebtsUsecase) CreateContact(ctx context.Context, accountID string, name string) (*domain.Contact, error) {
+	args := m.Called(ctx, accountID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Contact), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) GetContacts(ctx context.Context, accountID string) ([]*domain.Contact, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Contact), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) DeleteContact(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtsUsecase) CreateDebt(ctx context.Context, accountID string, contactID string, movementID string, splitID string, amount float64, note string) (*domain.Debt, error) {
+	args := m.Called(ctx, accountID, contactID, movementID, splitID, amount, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Debt), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) GetDebts(ctx context.Context, accountID string, contactID string) ([]*domain.Debt, error) {
+	args := m.Called(ctx, accountID, contactID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Debt), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) DeleteDebt(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtsUsecase) CreateSettlement(ctx context.Context, accountID string, contactID string, movementID string, amount float64) (*domain.Settlement, error) {
+	args := m.Called(ctx, accountID, contactID, movementID, amount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Settlement), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) GetSettlements(ctx context.Context, accountID string, contactID string) ([]*domain.Settlement, error) {
+	args := m.Called(ctx, accountID, contactID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Settlement), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) DeleteSettlement(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+
+	return args.Error(0)
+}
+
+func (m *MockDebtsUsecase) GetSummary(ctx context.Context, accountID string) (*domain.Summary, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Summary), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) DetectSettlements(ctx context.Context, accountID string) ([]*domain.Settlement, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Settlement), args.Error(1)
+}
+
+func (m *MockDebtsUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
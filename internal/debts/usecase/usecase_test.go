@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/debts/domain"
+	"transaction-tracker/internal/debts/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newUsecase(repo repository.DebtRepository, mvmUsecase movementsUsecase.MovementUsecase) *debtsUsecase {
+	return &debtsUsecase{
+		repo:             repo,
+		movementsUsecase: mvmUsecase,
+		nowFunc:          func() time.Time { return fixedTime },
+	}
+}
+
+func newContact() *domain.Contact {
+	return &domain.Contact{ID: "CNT1", AccountID: "acc1", Name: "Juan Perez"}
+}
+
+func TestCreateContact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockDebtRepository)
+		repo.On("CreateContact", mock.Anything, mock.AnythingOfType("*domain.Contact")).Return(nil)
+
+		contact, err := newUsecase(repo, nil).CreateContact(context.Background(), "acc1", " Juan ")
+		c.NoError(err)
+		c.Equal("Juan", contact.Name)
+		c.Equal("acc1", contact.AccountID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		repo := new(repository.MockDebtRepository)
+
+		_, err := newUsecase(repo, nil).CreateContact(context.Background(), "acc1", "")
+		require.ErrorIs(t, err, domain.ErrInvalidContact)
+		repo.AssertNotCalled(t, "CreateContact")
+	})
+}
+
+func TestCreateDebt(t *testing.T) {
+	movement := &movementsDomain.Movement{ID: "MID1", AccountID: "acc1", Type: movementsDomain.Expense, Amount: 120000}
+
+	t.Run("split of a movement", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetContactByID", mock.Anything, "CNT1", "acc1").Return(newContact(), nil)
+		repo.On("CreateDebt", mock.Anything, mock.AnythingOfType("*domain.Debt")).Return(nil)
+
+		mvmUsecase := new(movementsUsecase.MockMovementUsecase)
+		mvmUsecase.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(movement, nil)
+		mvmUsecase.On("GetSplits", mock.Anything, "MID1", "acc1").Return([]*movementsDomain.Split{
+			{ID: "SPL1", Amount: 80000},
+			{ID: "SPL2", Amount: 40000},
+		}, nil)
+
+		debt, err := newUsecase(repo, mvmUsecase).CreateDebt(context.Background(), "acc1", "CNT1", "MID1", "SPL2", 0, "")
+		c.NoError(err)
+		c.Equal(40000.0, debt.Amount)
+		c.Equal("SPL2", debt.SplitID)
+		repo.AssertExpectations(t)
+		mvmUsecase.AssertExpectations(t)
+	})
+
+	t.Run("contact not found", func(t *testing.T) {
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetContactByID", mock.Anything, "CNT1", "acc1").Return(nil, ErrContactNotFound)
+
+		_, err := newUsecase(repo, nil).CreateDebt(context.Background(), "acc1", "CNT1", "MID1", "", 0, "")
+		require.ErrorIs(t, err, ErrContactNotFound)
+	})
+
+	t.Run("income", func(t *testing.T) {
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetContactByID", mock.Anything, "CNT1", "acc1").Return(newContact(), nil)
+
+		mvmUsecase := new(movementsUsecase.MockMovementUsecase)
+		mvmUsecase.On("GetMovementByID", mock.Anything, "MID1", "acc1").Return(&movementsDomain.Movement{ID: "MID1", Type: movementsDomain.Income, Amount: 100}, nil)
+
+		_, err := newUsecase(repo, mvmUsecase).CreateDebt(context.Background(), "acc1", "CNT1", "MID1", "", 0, "")
+		require.ErrorIs(t, err, domain.ErrInvalidDebt)
+		repo.AssertNotCalled(t, "CreateDebt")
+	})
+}
+
+func TestCreateSettlement(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockDebtRepository)
+	repo.On("GetContactByID", mock.Anything, "CNT1", "acc1").Return(newContact(), nil)
+	repo.On("CreateSettlement", mock.Anything, mock.AnythingOfType("*domain.Settlement")).Return(nil)
+
+	mvmUsecase := new(movementsUsecase.MockMovementUsecase)
+	mvmUsecase.On("GetMovementByID", mock.Anything, "MID2", "acc1").Return(&movementsDomain.Movement{ID: "MID2", Type: movementsDomain.Income, Amount: 50000}, nil)
+
+	settlement, err := newUsecase(repo, mvmUsecase).CreateSettlement(context.Background(), "acc1", "CNT1", "MID2", 20000)
+	c.NoError(err)
+	c.Equal(20000.0, settlement.Amount)
+	c.Equal(domain.ManualSource, settlement.Source)
+	repo.AssertExpectations(t)
+}
+
+func TestGetSummary(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockDebtRepository)
+	repo.On("GetBalances", mock.Anything, "acc1").Return([]*domain.Balance{
+		{Contact: newContact(), Owed: 120000, Paid: 50000},
+		{Contact: &domain.Contact{ID: "CNT2", Name: "Ana"}, Owed: 30000, Paid: 30000},
+	}, nil)
+
+	summary, err := newUsecase(repo, nil).GetSummary(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(summary.Balances, 1)
+	c.Equal(70000.0, summary.Outstanding)
+}
+
+func TestDetectSettlements(t *testing.T) {
+	since := fixedTime.AddDate(0, 0, -domain.HistoryDays)
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		incomes := []*domain.Income{
+			{MovementID: "MID2", Type: movementsDomain.Income, Description: "Recibiste de JUAN PEREZ", Amount: 50000, Date: fixedTime},
+			{MovementID: "MID3", Type: movementsDomain.Income, Description: "Recibiste de JUAN PEREZ", Amount: 40000, Date: fixedTime},
+		}
+
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetBalances", mock.Anything, "acc1").Return([]*domain.Balance{{Contact: newContact(), Owed: 100000}}, nil)
+		repo.On("GetIncomes", mock.Anything, "acc1", since).Return(incomes, nil)
+		repo.On("CreateSettlement", mock.Anything, mock.MatchedBy(func(s *domain.Settlement) bool { return s.MovementID == "MID2" })).Return(ErrMovementSettled)
+		repo.On("CreateSettlement", mock.Anything, mock.MatchedBy(func(s *domain.Settlement) bool { return s.MovementID == "MID3" })).Return(nil)
+
+		settlements, err := newUsecase(repo, nil).DetectSettlements(context.Background(), "acc1")
+		c.NoError(err)
+		c.Len(settlements, 1)
+		c.Equal("MID3", settlements[0].MovementID)
+		c.Equal(domain.DetectedSource, settlements[0].Source)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("nobody owes", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetBalances", mock.Anything, "acc1").Return([]*domain.Balance{{Contact: newContact(), Owed: 100000, Paid: 100000}}, nil)
+
+		settlements, err := newUsecase(repo, nil).DetectSettlements(context.Background(), "acc1")
+		c.NoError(err)
+		c.Empty(settlements)
+		repo.AssertNotCalled(t, "GetIncomes")
+	})
+
+	t.Run("create error", func(t *testing.T) {
+		repo := new(repository.MockDebtRepository)
+		repo.On("GetBalances", mock.Anything, "acc1").Return([]*domain.Balance{{Contact: newContact(), Owed: 100000}}, nil)
+		repo.On("GetIncomes", mock.Anything, "acc1", since).Return([]*domain.Income{
+			{MovementID: "MID2", Type: movementsDomain.Income, Description: "Juan Perez", Amount: 50000, Date: fixedTime},
+		}, nil)
+		repo.On("CreateSettlement", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+		_, err := newUsecase(repo, nil).DetectSettlements(context.Background(), "acc1")
+		require.EqualError(t, err, "db down")
+	})
+}
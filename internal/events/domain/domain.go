@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies what happened. Consumers route on it.
+type EventType string
+
+const (
+	_event_prefix = "EVT"
+
+	// DefaultTopic is the broker topic the relay publishes every domain event to.
+	DefaultTopic = "domain-events"
+
+	// MovementCreated is raised after a movement is stored.
+	MovementCreated EventType = "movement.created"
+	// MovementUpdated is raised after a movement changes.
+	MovementUpdated EventType = "movement.updated"
+	// MovementDeleted is raised after a movement is removed.
+	MovementDeleted EventType = "movement.deleted"
+	// MessageFailed is raised when an email message could not be processed.
+	MessageFailed EventType = "message.failed"
+	// ExtractProcessed is raised when every movement of a bank statement was extracted.
+	ExtractProcessed EventType = "extract.processed"
+	// RecurringMissed is raised when an expected recurring charge did not arrive.
+	RecurringMissed EventType = "recurring.missed"
+	// RecurringPriceChanged is raised when a recurring charge arrives with a new amount.
+	RecurringPriceChanged EventType = "recurring.price_changed"
+	// BudgetThresholdReached is raised when the spending of a budget reaches one of its thresholds.
+	BudgetThresholdReached EventType = "budget.threshold_reached"
+	// WorkspaceInvitationCreated is raised when an email is invited to join a workspace.
+	WorkspaceInvitationCreated EventType = "workspace.invitation_created"
+	// AnomalyDetected is raised when a new movement is flagged as out of pattern.
+	AnomalyDetected EventType = "anomaly.detected"
+	// WebhookTest is sent on demand to check a webhook endpoint. It never goes through the outbox.
+	WebhookTest EventType = "webhook.test"
+)
+
+var (
+	// ErrUnknownEventType is returned when an event type has no registered schema.
+	ErrUnknownEventType = errors.New("unknown event type")
+
+	// schemaVersions holds the current payload version of each event type. Bump it
+	// whenever a payload changes in a way old consumers cannot read.
+	schemaVersions = map[EventType]int{
+		MovementCreated:            1,
+		MovementUpdated:            1,
+		MovementDeleted:            1,
+		MessageFailed:              1,
+		ExtractProcessed:           1,
+		RecurringMissed:            1,
+		RecurringPriceChanged:      1,
+		BudgetThresholdReached:     1,
+		WorkspaceInvitationCreated: 1,
+		AnomalyDetected:            1,
+		WebhookTest:                1,
+	}
+)
+
+// Event is a fact raised by a usecase, stored in the outbox and relayed to the broker.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        EventType       `json:"type"`
+	Version     int             `json:"version"`
+	AccountID   string          `json:"account_id"`
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+}
+
+// LogProperties is the map to logger attibutes
+func (e *Event) LogProperties() map[string]string {
+	return map[string]string{
+		"event_id":     e.ID,
+		"event_type":   string(e.Type),
+		"version":      strconv.Itoa(e.Version),
+		"account_id":   e.AccountID,
+		"aggregate_id": e.AggregateID,
+		"occurred_at":  e.OccurredAt.Local().String(),
+	}
+}
+
+// NewEvent creates an event of the given type with the current schema version of its payload.
+func NewEvent(eventType EventType, accountID string, aggregateID string, payload any) (*Event, error) {
+	version, ok := SchemaVersion(eventType)
+	if !ok {
+		return nil, ErrUnknownEventType
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		ID:          _event_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Type:        eventType,
+		Version:     version,
+		AccountID:   accountID,
+		AggregateID: aggregateID,
+		Payload:     data,
+		OccurredAt:  time.Now().UTC(),
+	}, nil
+}
+
+// SchemaVersion returns the current payload version of an event type.
+func SchemaVersion(eventType EventType) (int, bool) {
+	version, ok := schemaVersions[eventType]
+
+	return version, ok
+}
+
+// ParseEvent decodes an event published by the relay.
+func ParseEvent(data []byte) (*Event, error) {
+	event := &Event{}
+
+	err := json.Unmarshal(data, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := SchemaVersion(event.Type); !ok {
+		return nil, ErrUnknownEventType
+	}
+
+	return event, nil
+}
+
+// DecodePayload unmarshals the payload into v, usually one of the payload types of this package.
+func (e *Event) DecodePayload(v any) error {
+	return json.Unmarshal(e.Payload, v)
+}
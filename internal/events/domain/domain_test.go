@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		event, err := NewEvent(MovementDeleted, "acc1", "MID1", MovementDeletedPayload{ID: "MID1", AccountID: "acc1"})
+		c.NoError(err)
+		c.Contains(event.ID, _event_prefix)
+		c.Equal(MovementDeleted, event.Type)
+		c.Equal(1, event.Version)
+		c.Equal("acc1", event.AccountID)
+		c.Equal("MID1", event.AggregateID)
+		c.JSONEq(`{"id":"MID1","account_id":"acc1"}`, string(event.Payload))
+		c.False(event.OccurredAt.IsZero())
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := NewEvent("movement.archived", "acc1", "MID1", nil)
+		require.ErrorIs(t, err, ErrUnknownEventType)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := NewEvent(MovementCreated, "acc1", "MID1", make(chan int))
+		require.Error(t, err)
+	})
+}
+
+func TestParseEvent(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		c := require.New(t)
+
+		event, err := NewEvent(ExtractProcessed, "acc1", "EXI1", ExtractProcessedPayload{ID: "EXI1", Movements: 3})
+		c.NoError(err)
+
+		data, err := json.Marshal(event)
+		c.NoError(err)
+
+		parsed, err := ParseEvent(data)
+		c.NoError(err)
+		c.Equal(event.ID, parsed.ID)
+		c.Equal(event.Type, parsed.Type)
+
+		payload := ExtractProcessedPayload{}
+		c.NoError(parsed.DecodePayload(&payload))
+		c.Equal(3, payload.Movements)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseEvent([]byte("{"))
+		require.Error(t, err)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		_, err := ParseEvent([]byte(`{"type":"other"}`))
+		require.ErrorIs(t, err, ErrUnknownEventType)
+	})
+}
+
+func TestEvent_LogProperties(t *testing.T) {
+	event := &Event{ID: "EVT1", Type: MessageFailed, Version: 1}
+
+	props := event.LogProperties()
+	require.Equal(t, "EVT1", props["event_id"])
+	require.Equal(t, "message.failed", props["event_type"])
+	require.Equal(t, "1", props["version"])
+}
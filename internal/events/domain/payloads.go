@@ -0,0 +1,106 @@
+package domain
+
+import "time"
+
+// MovementPayload is the version 1 payload of movement.created and movement.updated.
+type MovementPayload struct {
+	ID                 string    `json:"id"`
+	AccountID          string    `json:"account_id"`
+	InstitutionID      string    `json:"institution_id,omitempty"`
+	MessageID          string    `json:"message_id,omitempty"`
+	ExtractID          string    `json:"extract_id,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	MerchantID         string    `json:"merchant_id,omitempty"`
+	FinancialAccountID string    `json:"financial_account_id,omitempty"`
+	Tags               []string  `json:"tags,omitempty"`
+	Note               string    `json:"note,omitempty"`
+	CreatedBy          string    `json:"created_by,omitempty"`
+	Amount             float64   `json:"amount"`
+	Type               string    `json:"type"`
+	Category           string    `json:"category"`
+	Source             string    `json:"source"`
+	Date               time.Time `json:"date"`
+}
+
+// MovementDeletedPayload is the version 1 payload of movement.deleted.
+type MovementDeletedPayload struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+}
+
+// MessageFailedPayload is the version 1 payload of message.failed.
+type MessageFailedPayload struct {
+	ID             string `json:"id"`
+	AccountID      string `json:"account_id"`
+	ExternalID     string `json:"external_id"`
+	NotificationID string `json:"notification_id,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// ExtractProcessedPayload is the version 1 payload of extract.processed.
+type ExtractProcessedPayload struct {
+	ID             string   `json:"id"`
+	AccountID      string   `json:"account_id"`
+	MessageID      string   `json:"message_id"`
+	InstitutionID  string   `json:"institution_id,omitempty"`
+	Month          int      `json:"month"`
+	Year           int      `json:"year"`
+	Movements      int      `json:"movements"`
+	ClosingBalance *float64 `json:"closing_balance,omitempty"`
+}
+
+// RecurringPayload is the version 1 payload of recurring.missed and recurring.price_changed.
+type RecurringPayload struct {
+	ID             string    `json:"id"`
+	AccountID      string    `json:"account_id"`
+	MerchantID     string    `json:"merchant_id"`
+	Name           string    `json:"name"`
+	Period         string    `json:"period"`
+	Amount         float64   `json:"amount"`
+	PreviousAmount float64   `json:"previous_amount,omitempty"`
+	LastChargeAt   time.Time `json:"last_charge_at"`
+	NextChargeAt   time.Time `json:"next_charge_at"`
+}
+
+// BudgetThresholdPayload is the version 1 payload of budget.threshold_reached. Month is
+// written as 2006-01 and Threshold is a percentage of Limit.
+type BudgetThresholdPayload struct {
+	ID        string  `json:"id"`
+	AccountID string  `json:"account_id"`
+	Category  string  `json:"category"`
+	Month     string  `json:"month"`
+	Threshold int     `json:"threshold"`
+	Limit     float64 `json:"limit"`
+	Spent     float64 `json:"spent"`
+	Percent   float64 `json:"percent"`
+}
+
+// WorkspaceInvitationPayload is the version 1 payload of workspace.invitation_created. It has
+// what is needed to send the invitation email.
+type WorkspaceInvitationPayload struct {
+	ID            string    `json:"id"`
+	WorkspaceID   string    `json:"workspace_id"`
+	WorkspaceName string    `json:"workspace_name"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	InvitedBy     string    `json:"invited_by"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// AnomalyPayload is the version 1 payload of anomaly.detected. Kind is new_merchant,
+// category_spike or duplicate_charge.
+type AnomalyPayload struct {
+	ID         string    `json:"id"`
+	AccountID  string    `json:"account_id"`
+	MovementID string    `json:"movement_id"`
+	Kind       string    `json:"kind"`
+	Reason     string    `json:"reason"`
+	Amount     float64   `json:"amount"`
+	Date       time.Time `json:"date"`
+}
+
+// WebhookTestPayload is the version 1 payload of webhook.test.
+type WebhookTestPayload struct {
+	WebhookID string `json:"webhook_id"`
+	Message   string `json:"message"`
+}
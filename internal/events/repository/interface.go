@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/events/domain"
+)
+
+// OutboxRepository stores domain events until they are relayed to the broker.
+type OutboxRepository interface {
+	Save(ctx context.Context, event *domain.Event) error
+	GetPending(ctx context.Context, limit int) ([]*domain.Event, error)
+	MarkPublished(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, reason string) error
+	GetPublishedAfter(ctx context.Context, accountID string, afterID string, eventTypes []domain.EventType, limit int) ([]*domain.Event, error)
+}
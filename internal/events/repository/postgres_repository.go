@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the outbox repository. Writes join the transaction stored
+// in the context, so events are committed together with the change that raised them.
+func NewPostgresRepository(db *pgxpool.Pool) OutboxRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// Save inserts an event in the outbox.
+func (r *postgresRepository) Save(ctx context.Context, event *domain.Event) error {
+	query := `INSERT INTO outbox_events (
+	id,
+	type,
+	version,
+	account_id,
+	aggregate_id,
+	payload,
+	occurred_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.querier(ctx).Exec(ctx, query,
+		event.ID,
+		event.Type,
+		event.Version,
+		event.AccountID,
+		event.AggregateID,
+		event.Payload,
+		event.OccurredAt)
+
+	return err
+}
+
+// GetPending returns the oldest unpublished events. Rows are locked until the surrounding
+// transaction ends and rows locked by other relays are skipped.
+func (r *postgresRepository) GetPending(ctx context.Context, limit int) ([]*domain.Event, error) {
+	query := `SELECT
+	id, type, version, account_id, aggregate_id, payload, occurred_at
+	FROM outbox_events
+	WHERE published_at IS NULL
+	ORDER BY occurred_at
+	LIMIT $1
+	FOR UPDATE SKIP LOCKED`
+
+	rows, err := r.querier(ctx).Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEvents(rows)
+}
+
+// MarkPublished flags an event as relayed.
+func (r *postgresRepository) MarkPublished(ctx context.Context, id string) error {
+	query := `UPDATE outbox_events SET published_at = $1, attempts = attempts + 1, last_error = NULL WHERE id = $2`
+
+	_, err := r.querier(ctx).Exec(ctx, query, r.nowFunc(), id)
+
+	return err
+}
+
+// MarkFailed records a failed relay attempt.
+func (r *postgresRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	query := `UPDATE outbox_events SET attempts = attempts + 1, last_error = $1 WHERE id = $2`
+
+	_, err := r.querier(ctx).Exec(ctx, query, reason, id)
+
+	return err
+}
+
+// GetPublishedAfter returns the published events of the account that occurred after the given
+// event, oldest first. Nothing is returned when the event is unknown.
+func (r *postgresRepository) GetPublishedAfter(ctx context.Context, accountID string, afterID string, eventTypes []domain.EventType, limit int) ([]*domain.Event, error) {
+	query := `SELECT
+	id, type, version, account_id, aggregate_id, payload, occurred_at
+	FROM outbox_events
+	WHERE account_id = $1
+	AND type = ANY($2)
+	AND published_at IS NOT NULL
+	AND (occurred_at, id) > (SELECT occurred_at, id FROM outbox_events WHERE id = $3)
+	ORDER BY occurred_at, id
+	LIMIT $4`
+
+	types := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		types = append(types, string(t))
+	}
+
+	rows, err := r.querier(ctx).Query(ctx, query, accountID, types, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanEvents(rows)
+}
+
+func scanEvents(rows pgx.Rows) ([]*domain.Event, error) {
+	defer rows.Close()
+
+	events := []*domain.Event{}
+	for rows.Next() {
+		e := &domain.Event{}
+
+		var eventType string
+
+		err := rows.Scan(&e.ID, &eventType, &e.Version, &e.AccountID, &e.AggregateID, &e.Payload, &e.OccurredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Type = domain.EventType(eventType)
+
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/events/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOutboxRepository is a mock of the repository interface.
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Save(ctx context.Context, event *domain.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) GetPending(ctx context.Context, limit int) ([]*domain.Event, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Event), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id string, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) GetPublishedAfter(ctx context.Context, accountID string, afterID string, eventTypes []domain.EventType, limit int) ([]*domain.Event, error) {
+	args := m.Called(ctx, accountID, afterID, eventTypes, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Event), args.Error(1)
+}
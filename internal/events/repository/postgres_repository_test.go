@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+)
+
+func setupMockDB(t *testing.T) (OutboxRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestSave(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	event := &domain.Event{
+		ID:          "EVT1",
+		Type:        domain.MovementCreated,
+		Version:     1,
+		AccountID:   "acc1",
+		AggregateID: "MID1",
+		Payload:     json.RawMessage(`{"id":"MID1"}`),
+		OccurredAt:  fixedTime,
+	}
+
+	mock.ExpectExec(`INSERT INTO outbox_events`).
+		WithArgs(event.ID, event.Type, event.Version, event.AccountID, event.AggregateID, event.Payload, event.OccurredAt).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.Save(context.Background(), event))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSave_JoinsTransaction(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	anyArgs := []any{}
+	for i := 0; i < 7; i++ {
+		anyArgs = append(anyArgs, pgxmock.AnyArg())
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO outbox_events`).WithArgs(anyArgs...).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectRollback()
+
+	expectedErr := errors.New("movement failed")
+
+	err := postgres.NewTransactor(mock).WithinTransaction(context.Background(), func(ctx context.Context) error {
+		err := repo.Save(ctx, &domain.Event{ID: "EVT1"})
+		c.NoError(err)
+
+		return expectedErr
+	})
+	c.ErrorIs(err, expectedErr)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetPending(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows([]string{"id", "type", "version", "account_id", "aggregate_id", "payload", "occurred_at"}).
+			AddRow("EVT1", "movement.created", 1, "acc1", "MID1", json.RawMessage(`{}`), fixedTime).
+			AddRow("EVT2", "movement.deleted", 1, "acc1", "MID1", json.RawMessage(`{}`), fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM outbox_events WHERE published_at IS NULL`).
+			WithArgs(10).
+			WillReturnRows(rows)
+
+		events, err := repo.GetPending(context.Background(), 10)
+		c.NoError(err)
+		c.Len(events, 2)
+		c.Equal(domain.MovementCreated, events[0].Type)
+		c.Equal(domain.MovementDeleted, events[1].Type)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM outbox_events`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetPending(context.Background(), 10)
+		require.Error(t, err)
+	})
+}
+
+func TestMarkPublished(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE outbox_events SET published_at`).
+		WithArgs(fixedTime, "EVT1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	c.NoError(repo.MarkPublished(context.Background(), "EVT1"))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestMarkFailed(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE outbox_events SET attempts`).
+		WithArgs("broker down", "EVT1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	c.NoError(repo.MarkFailed(context.Background(), "EVT1", "broker down"))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetPublishedAfter(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "type", "version", "account_id", "aggregate_id", "payload", "occurred_at"}).
+		AddRow("EVT2", "movement.updated", 1, "acc1", "MID1", json.RawMessage(`{}`), fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM outbox_events WHERE account_id = \$1 AND type = ANY\(\$2\) AND published_at IS NOT NULL AND \(occurred_at, id\) > \(SELECT occurred_at, id FROM outbox_events WHERE id = \$3\)`).
+		WithArgs("acc1", []string{"movement.created", "movement.updated"}, "EVT1", 50).
+		WillReturnRows(rows)
+
+	events, err := repo.GetPublishedAfter(context.Background(), "acc1", "EVT1", []domain.EventType{domain.MovementCreated, domain.MovementUpdated}, 50)
+	c.NoError(err)
+	c.Len(events, 1)
+	c.Equal("EVT2", events[0].ID)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/eventbus"
+)
+
+// EventsUsecase records domain events in the outbox and relays them to the broker.
+type EventsUsecase interface {
+	Emit(ctx context.Context, eventType domain.EventType, accountID string, aggregateID string, payload any) error
+	Relay(ctx context.Context) (int, error)
+	RunRelay(ctx context.Context, interval time.Duration)
+}
+
+// StreamUsecase fans relayed events out to live subscribers, such as SSE connections.
+type StreamUsecase interface {
+	Subscribe(ctx context.Context, accountID string, lastEventID string, eventTypes []domain.EventType) (<-chan *domain.Event, error)
+	HandleMessage(ctx context.Context, msg *eventbus.Message) error
+}
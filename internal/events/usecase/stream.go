@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// StreamSubscription prefixes the event bus subscriptions that feed live streams. Every
+	// API instance needs its own subscription so each one sees every event; see
+	// InstanceSubscription.
+	StreamSubscription = "movements-stream"
+
+	// streamBufferSize is how many events a subscriber may lag behind before it is dropped.
+	streamBufferSize = 64
+
+	// replayLimit caps the events replayed when a subscriber resumes.
+	replayLimit = 500
+)
+
+// nonNamePattern matches what can't be part of a subscription name on every bus driver.
+var nonNamePattern = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// InstanceSubscription returns the subscription of this instance: the prefix followed by the
+// host name, stable across restarts of the same container, or by a random id when the host
+// name is unknown. Instances sharing a subscription would split the events among them.
+func InstanceSubscription(prefix string) string {
+	instance, err := os.Hostname()
+	if err != nil || instance == "" {
+		instance = strings.ReplaceAll(uuid.New().String(), "-", "")
+	}
+
+	return prefix + "-" + strings.Trim(nonNamePattern.ReplaceAllString(instance, "-"), "-")
+}
+
+type streamSubscriber struct {
+	eventTypes []domain.EventType
+	events     chan *domain.Event
+}
+
+type streamUsecase struct {
+	repo        repository.OutboxRepository
+	mu          sync.RWMutex
+	subscribers map[string]map[*streamSubscriber]struct{}
+	log         *loggerModels.Logger
+}
+
+// NewStreamUsecase creates a new instance of StreamUsecase. Resumed subscriptions are
+// replayed from the outbox.
+func NewStreamUsecase(ctx context.Context, repo repository.OutboxRepository) StreamUsecase {
+	log, _ := logger.GetLogger(ctx, "stream-usecase")
+
+	return &streamUsecase{
+		repo:        repo,
+		subscribers: map[string]map[*streamSubscriber]struct{}{},
+		log:         log,
+	}
+}
+
+// Subscribe returns the events of the account with one of the given types. When lastEventID
+// is set, the events published after it are replayed first. The channel is closed when the
+// context is done or when the subscriber falls too far behind.
+func (u *streamUsecase) Subscribe(ctx context.Context, accountID string, lastEventID string, eventTypes []domain.EventType) (<-chan *domain.Event, error) {
+	sub := &streamSubscriber{
+		eventTypes: eventTypes,
+		events:     make(chan *domain.Event, streamBufferSize),
+	}
+
+	// Register before replaying so nothing published in between is lost.
+	u.add(accountID, sub)
+
+	replay := []*domain.Event{}
+
+	if lastEventID != "" {
+		events, err := u.repo.GetPublishedAfter(ctx, accountID, lastEventID, eventTypes, replayLimit)
+		if err != nil {
+			u.remove(accountID, sub)
+
+			return nil, err
+		}
+
+		replay = events
+	}
+
+	out := make(chan *domain.Event)
+
+	go func() {
+		defer close(out)
+		defer u.remove(accountID, sub)
+
+		replayed := map[string]struct{}{}
+
+		for _, event := range replay {
+			replayed[event.ID] = struct{}{}
+
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case event, ok := <-sub.events:
+				if !ok {
+					return
+				}
+
+				if _, ok := replayed[event.ID]; ok {
+					continue
+				}
+
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// HandleMessage is the event bus handler of the stream subscription. Messages that are not
+// domain events are acknowledged and dropped.
+func (u *streamUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	event, err := domain.ParseEvent(msg.Data)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "stream_event_discarded",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				msg,
+			},
+		})
+
+		return nil
+	}
+
+	u.broadcast(event)
+
+	return nil
+}
+
+// broadcast hands the event to the subscribers of its account. Subscribers with a full
+// buffer are dropped instead of blocking the others.
+func (u *streamUsecase) broadcast(event *domain.Event) {
+	slow := []*streamSubscriber{}
+
+	u.mu.RLock()
+
+	for sub := range u.subscribers[event.AccountID] {
+		if !slices.Contains(sub.eventTypes, event.Type) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+
+	u.mu.RUnlock()
+
+	for _, sub := range slow {
+		u.remove(event.AccountID, sub)
+	}
+}
+
+func (u *streamUsecase) add(accountID string, sub *streamSubscriber) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	subs, ok := u.subscribers[accountID]
+	if !ok {
+		subs = map[*streamSubscriber]struct{}{}
+		u.subscribers[accountID] = subs
+	}
+
+	subs[sub] = struct{}{}
+}
+
+// remove unregisters the subscriber and closes its buffer. It is safe to call more than once.
+func (u *streamUsecase) remove(accountID string, sub *streamSubscriber) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	subs := u.subscribers[accountID]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	close(sub.events)
+
+	if len(subs) == 0 {
+		delete(u.subscribers, accountID)
+	}
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/repository"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	movementTypes = []domain.EventType{domain.MovementCreated, domain.MovementUpdated, domain.MovementDeleted}
+)
+
+func newEventMessage(t *testing.T, event *domain.Event) *eventbus.Message {
+	msg, err := toMessage(event)
+	require.NoError(t, err)
+
+	return msg
+}
+
+func receive(t *testing.T, events <-chan *domain.Event) *domain.Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+
+	return nil
+}
+
+func TestStream(t *testing.T) {
+	created, _ := domain.NewEvent(domain.MovementCreated, "acc1", "MID1", domain.MovementPayload{ID: "MID1"})
+	updated, _ := domain.NewEvent(domain.MovementUpdated, "acc1", "MID1", domain.MovementPayload{ID: "MID1"})
+	other, _ := domain.NewEvent(domain.MovementCreated, "acc2", "MID2", domain.MovementPayload{ID: "MID2"})
+	extract, _ := domain.NewEvent(domain.ExtractProcessed, "acc1", "EXT1", domain.ExtractProcessedPayload{ID: "EXT1"})
+
+	t.Run("pushes events of the account", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		u := NewStreamUsecase(ctx, new(repository.MockOutboxRepository))
+
+		events, err := u.Subscribe(ctx, "acc1", "", movementTypes)
+		c.NoError(err)
+
+		c.NoError(u.HandleMessage(ctx, newEventMessage(t, other)))
+		c.NoError(u.HandleMessage(ctx, newEventMessage(t, extract)))
+		c.NoError(u.HandleMessage(ctx, newEventMessage(t, created)))
+
+		c.Equal(created.ID, receive(t, events).ID)
+
+		cancel()
+
+		_, ok := <-events
+		c.False(ok)
+	})
+
+	t.Run("replays after last event id without duplicates", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		repoMock := new(repository.MockOutboxRepository)
+
+		u := NewStreamUsecase(ctx, repoMock)
+
+		// The event is broadcast while the replay query runs, so it is both replayed and live.
+		repoMock.On("GetPublishedAfter", mock.Anything, "acc1", "EVT0", movementTypes, replayLimit).
+			Run(func(args mock.Arguments) {
+				c.NoError(u.HandleMessage(ctx, newEventMessage(t, created)))
+			}).
+			Return([]*domain.Event{created}, nil)
+
+		events, err := u.Subscribe(ctx, "acc1", "EVT0", movementTypes)
+		c.NoError(err)
+
+		c.NoError(u.HandleMessage(ctx, newEventMessage(t, updated)))
+
+		c.Equal(created.ID, receive(t, events).ID)
+		c.Equal(updated.ID, receive(t, events).ID)
+	})
+
+	t.Run("replay error", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock := new(repository.MockOutboxRepository)
+		repoMock.On("GetPublishedAfter", mock.Anything, "acc1", "EVT0", movementTypes, replayLimit).Return(nil, errors.New("db down"))
+
+		u := NewStreamUsecase(context.Background(), repoMock).(*streamUsecase)
+
+		_, err := u.Subscribe(context.Background(), "acc1", "EVT0", movementTypes)
+		c.Error(err)
+		c.Empty(u.subscribers)
+	})
+
+	t.Run("slow subscriber is dropped", func(t *testing.T) {
+		c := require.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		u := NewStreamUsecase(ctx, new(repository.MockOutboxRepository)).(*streamUsecase)
+
+		events, err := u.Subscribe(ctx, "acc1", "", movementTypes)
+		c.NoError(err)
+
+		for i := 0; i < streamBufferSize+2; i++ {
+			u.broadcast(created)
+		}
+
+		received := 0
+		for range events {
+			received++
+		}
+
+		c.LessOrEqual(received, streamBufferSize+1)
+		c.Empty(u.subscribers)
+	})
+}
+
+func TestInstanceSubscription(t *testing.T) {
+	c := require.New(t)
+
+	subscription := InstanceSubscription(StreamSubscription)
+	c.True(strings.HasPrefix(subscription, StreamSubscription+"-"))
+	c.Regexp(`^[A-Za-z0-9_-]+$`, subscription)
+	c.Greater(len(subscription), len(StreamSubscription)+1)
+}
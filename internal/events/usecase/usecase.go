@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/databases/postgres"
+	"transaction-tracker/pkg/eventbus"
+)
+
+const (
+	// DefaultRelayInterval is how often the relay looks for pending events.
+	DefaultRelayInterval = 2 * time.Second
+
+	// relayBatchSize is the maximum number of events published per relay pass.
+	relayBatchSize = 100
+)
+
+type eventsUsecase struct {
+	repo       repository.OutboxRepository
+	transactor postgres.Transactor
+	bus        eventbus.Bus
+	topic      string
+	log        *loggerModels.Logger
+}
+
+// NewEventsUsecase creates a new instance of EventsUsecase. Relayed events are published
+// to topic, or to domain.DefaultTopic when it is empty.
+func NewEventsUsecase(ctx context.Context, repo repository.OutboxRepository, transactor postgres.Transactor, bus eventbus.Bus, topic string) EventsUsecase {
+	log, _ := logger.GetLogger(ctx, "events-usecase")
+
+	if topic == "" {
+		topic = domain.DefaultTopic
+	}
+
+	return &eventsUsecase{
+		repo:       repo,
+		transactor: transactor,
+		bus:        bus,
+		topic:      topic,
+		log:        log,
+	}
+}
+
+// Emit builds an event and stores it in the outbox. When the context carries a transaction
+// the event is committed or rolled back together with it.
+func (u *eventsUsecase) Emit(ctx context.Context, eventType domain.EventType, accountID string, aggregateID string, payload any) error {
+	event, err := domain.NewEvent(eventType, accountID, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.Save(ctx, event)
+}
+
+// Relay publishes a batch of pending events in the order they occurred and returns how many
+// were published. The batch stops at the first publish failure so per account ordering holds.
+func (u *eventsUsecase) Relay(ctx context.Context) (int, error) {
+	published := 0
+
+	var publishErr error
+
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		events, err := u.repo.GetPending(ctx, relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			msg, err := toMessage(event)
+			if err != nil {
+				return err
+			}
+
+			err = u.bus.Publish(ctx, u.topic, msg)
+			if err != nil {
+				publishErr = err
+
+				return u.repo.MarkFailed(ctx, event.ID, err.Error())
+			}
+
+			err = u.repo.MarkPublished(ctx, event.ID)
+			if err != nil {
+				return err
+			}
+
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, publishErr
+}
+
+// RunRelay relays pending events every interval until the context is done. Full batches
+// are followed by another pass right away.
+func (u *eventsUsecase) RunRelay(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := u.Relay(ctx)
+		if err != nil {
+			u.log.Error(loggerModels.LogProperties{
+				Event: "relay_events_failed",
+				Error: err,
+			})
+		}
+
+		if err == nil && published == relayBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func toMessage(event *domain.Event) (*eventbus.Message, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := eventbus.NewMessage(data)
+	msg.ID = event.ID
+	msg.OrderingKey = event.AccountID
+	msg.Attributes["type"] = string(event.Type)
+	msg.Attributes["version"] = strconv.Itoa(event.Version)
+	msg.Attributes["account_id"] = event.AccountID
+
+	return msg, nil
+}
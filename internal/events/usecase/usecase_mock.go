@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEventsUsecase is a mock implementation of the EventsUsecase interface.
+type MockEventsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockEventsUsecase) Emit(ctx context.Context, eventType domain.EventType, accountID string, aggregateID string, payload any) error {
+	args := m.Called(ctx, eventType, accountID, aggregateID, payload)
+	return args.Error(0)
+}
+
+func (m *MockEventsUsecase) Relay(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEventsUsecase) RunRelay(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
+
+// MockStreamUsecase is a mock implementation of the StreamUsecase interface.
+type MockStreamUsecase struct {
+	mock.Mock
+}
+
+func (m *MockStreamUsecase) Subscribe(ctx context.Context, accountID string, lastEventID string, eventTypes []domain.EventType) (<-chan *domain.Event, error) {
+	args := m.Called(ctx, accountID, lastEventID, eventTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(<-chan *domain.Event), args.Error(1)
+}
+
+func (m *MockStreamUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/events/repository"
+	"transaction-tracker/pkg/databases/postgres"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmit(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock := new(repository.MockOutboxRepository)
+		repoMock.On("Save", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+			return e.Type == domain.MovementDeleted && e.AccountID == "acc1" && e.AggregateID == "MID1" && e.Version == 1
+		})).Return(nil)
+
+		u := NewEventsUsecase(context.Background(), repoMock, nil, nil, "")
+
+		err := u.Emit(context.Background(), domain.MovementDeleted, "acc1", "MID1", domain.MovementDeletedPayload{ID: "MID1"})
+		c.NoError(err)
+
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		u := NewEventsUsecase(context.Background(), new(repository.MockOutboxRepository), nil, nil, "")
+
+		err := u.Emit(context.Background(), "other", "acc1", "MID1", nil)
+		require.ErrorIs(t, err, domain.ErrUnknownEventType)
+	})
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+
+	first, _ := domain.NewEvent(domain.MovementCreated, "acc1", "MID1", domain.MovementPayload{ID: "MID1"})
+	second, _ := domain.NewEvent(domain.MovementDeleted, "acc1", "MID1", domain.MovementDeletedPayload{ID: "MID1"})
+
+	setup := func() (*repository.MockOutboxRepository, *postgres.MockTransactor, *eventbus.MockBus) {
+		transactor := new(postgres.MockTransactor)
+		transactor.On("WithinTransaction", mock.Anything).Return(nil)
+
+		return new(repository.MockOutboxRepository), transactor, new(eventbus.MockBus)
+	}
+
+	t.Run("publishes pending events", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock, transactor, bus := setup()
+		repoMock.On("GetPending", mock.Anything, relayBatchSize).Return([]*domain.Event{first, second}, nil)
+		repoMock.On("MarkPublished", mock.Anything, first.ID).Return(nil)
+		repoMock.On("MarkPublished", mock.Anything, second.ID).Return(nil)
+
+		bus.On("Publish", mock.Anything, "events", mock.MatchedBy(func(msg *eventbus.Message) bool {
+			return msg.ID == first.ID && msg.OrderingKey == "acc1" && msg.Attributes["type"] == "movement.created" && msg.Attributes["version"] == "1"
+		})).Return(nil).Once()
+		bus.On("Publish", mock.Anything, "events", mock.MatchedBy(func(msg *eventbus.Message) bool {
+			return msg.ID == second.ID
+		})).Return(nil).Once()
+
+		u := NewEventsUsecase(ctx, repoMock, transactor, bus, "events")
+
+		published, err := u.Relay(ctx)
+		c.NoError(err)
+		c.Equal(2, published)
+
+		repoMock.AssertExpectations(t)
+		bus.AssertExpectations(t)
+	})
+
+	t.Run("stops at first publish failure", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock, transactor, bus := setup()
+		repoMock.On("GetPending", mock.Anything, relayBatchSize).Return([]*domain.Event{first, second}, nil)
+		repoMock.On("MarkFailed", mock.Anything, first.ID, "broker down").Return(nil)
+
+		bus.On("Publish", mock.Anything, domain.DefaultTopic, mock.Anything).Return(errors.New("broker down")).Once()
+
+		u := NewEventsUsecase(ctx, repoMock, transactor, bus, "")
+
+		published, err := u.Relay(ctx)
+		c.EqualError(err, "broker down")
+		c.Equal(0, published)
+
+		repoMock.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything)
+		bus.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock, transactor, bus := setup()
+		repoMock.On("GetPending", mock.Anything, relayBatchSize).Return(nil, errors.New("db error"))
+
+		u := NewEventsUsecase(ctx, repoMock, transactor, bus, "")
+
+		_, err := u.Relay(ctx)
+		c.EqualError(err, "db error")
+	})
+}
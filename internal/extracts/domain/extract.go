@@ -29,8 +29,10 @@ type Extract struct {
 	Year          int           `bson:"year" json:"year"`
 	Path          string        `bson:"path" json:"path"`
 	Status        ExtractStatus `bson:"status" json:"status"`
-	CreatedAt     time.Time     `bson:"created_at" json:"created_at"`
-	UpdatedAt     time.Time     `bson:"updated_at" json:"updated_at"`
+	// ClosingBalance is the balance the statement closes the month with, when it shows one.
+	ClosingBalance *float64  `bson:"closing_balance,omitempty" json:"closing_balance,omitempty"`
+	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time `bson:"updated_at" json:"updated_at"`
 }
 
 func (e *Extract) LogProperties() map[string]string {
@@ -12,4 +12,5 @@ type ExtractsUsecase interface {
 	GetExtractMessages(ctx context.Context, bankName string, account *accountsDomain.Account) ([]string, error)
 	Save(ctx context.Context, extract *domain.Extract) error
 	Update(ctx context.Context, extract *domain.Extract) error
+	MarkProcessed(ctx context.Context, extract *domain.Extract, movements int) error
 }
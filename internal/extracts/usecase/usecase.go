@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
 	"transaction-tracker/internal/extracts/domain"
 	"transaction-tracker/internal/extracts/repository"
 	"transaction-tracker/pkg/google"
@@ -16,15 +18,17 @@ var (
 )
 
 type extractsUsecase struct {
-	repo         repository.ExtractsRepository
-	googleClient google.GoogleClientAPI
+	repo          repository.ExtractsRepository
+	googleClient  google.GoogleClientAPI
+	eventsUsecase eventsUsecase.EventsUsecase
 }
 
 // NewExtractsUsecase creates a new instance of ExtractsUsecase.
-func NewExtractsUsecase(googleClient google.GoogleClientAPI, repo repository.ExtractsRepository) ExtractsUsecase {
+func NewExtractsUsecase(googleClient google.GoogleClientAPI, repo repository.ExtractsRepository, evUsecase eventsUsecase.EventsUsecase) ExtractsUsecase {
 	return &extractsUsecase{
-		repo:         repo,
-		googleClient: googleClient,
+		repo:          repo,
+		googleClient:  googleClient,
+		eventsUsecase: evUsecase,
 	}
 }
 
@@ -70,3 +74,25 @@ func (u *extractsUsecase) Save(ctx context.Context, extract *domain.Extract) err
 func (u *extractsUsecase) Update(ctx context.Context, extract *domain.Extract) error {
 	return u.repo.Update(ctx, extract)
 }
+
+// MarkProcessed flags the extract as processed and raises extract.processed with the
+// number of movements found in the statement.
+func (u *extractsUsecase) MarkProcessed(ctx context.Context, extract *domain.Extract, movements int) error {
+	extract.Status = domain.ExtractStatusProcessed
+
+	err := u.repo.Update(ctx, extract)
+	if err != nil {
+		return err
+	}
+
+	return u.eventsUsecase.Emit(ctx, eventsDomain.ExtractProcessed, extract.AccountID, extract.ID, eventsDomain.ExtractProcessedPayload{
+		ID:             extract.ID,
+		AccountID:      extract.AccountID,
+		MessageID:      extract.MessageID,
+		InstitutionID:  extract.InstitutionID,
+		Month:          int(extract.Month),
+		Year:           extract.Year,
+		Movements:      movements,
+		ClosingBalance: extract.ClosingBalance,
+	})
+}
@@ -39,3 +39,8 @@ func (m *MockExtractsUsecase) Update(ctx context.Context, extract *extractsDomai
 	args := m.Called(ctx, extract)
 	return args.Error(0)
 }
+
+func (m *MockExtractsUsecase) MarkProcessed(ctx context.Context, extract *extractsDomain.Extract, movements int) error {
+	args := m.Called(ctx, extract, movements)
+	return args.Error(0)
+}
@@ -142,3 +142,18 @@ func TestMockExtractsUsecase_Update_Error(t *testing.T) {
 	require.EqualError(t, err, "update failed")
 	mockUsecase.AssertExpectations(t)
 }
+
+func TestMockExtractsUsecase_MarkProcessed(t *testing.T) {
+	ctx := context.Background()
+	mockUsecase := new(MockExtractsUsecase)
+	extract := &extractsDomain.Extract{ID: "ext-processed"}
+
+	mockUsecase.
+		On("MarkProcessed", ctx, extract, 3).
+		Return(nil)
+
+	err := mockUsecase.MarkProcessed(ctx, extract, 3)
+
+	require.NoError(t, err)
+	mockUsecase.AssertExpectations(t)
+}
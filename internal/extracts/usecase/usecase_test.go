@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
 	"transaction-tracker/internal/extracts/domain"
 	"transaction-tracker/internal/extracts/repository"
 	"transaction-tracker/pkg/google"
@@ -26,7 +28,7 @@ func TestGetByMessageID_Success(t *testing.T) {
 
 	googleClientMock := new(google.MockGoogleClient)
 
-	u := NewExtractsUsecase(googleClientMock, mockRepo)
+	u := NewExtractsUsecase(googleClientMock, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	res, err := u.GetByMessageID(context.Background(), ex.MessageID)
 	c.NoError(err)
@@ -42,7 +44,7 @@ func TestGetByMessageID_NotFound(t *testing.T) {
 
 	googleClientMock := new(google.MockGoogleClient)
 
-	u := NewExtractsUsecase(googleClientMock, mockRepo)
+	u := NewExtractsUsecase(googleClientMock, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	res, err := u.GetByMessageID(context.Background(), "noexist")
 	c.Nil(res)
@@ -74,7 +76,7 @@ func TestGetExtractMessages_Success(t *testing.T) {
 	mockRepo := new(repository.MockExtractsRepository)
 	mockRepo.On("Save", context.Background(), &domain.Extract{}).Return(nil)
 
-	u := NewExtractsUsecase(mockGoogle, mockRepo)
+	u := NewExtractsUsecase(mockGoogle, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	res, err := u.GetExtractMessages(context.Background(), "SomeBank", account)
 	c.NoError(err)
@@ -94,7 +96,7 @@ func TestGetExtractMessages_GmailServiceError(t *testing.T) {
 
 	mockRepo := new(repository.MockExtractsRepository)
 
-	u := NewExtractsUsecase(mockGoogle, mockRepo)
+	u := NewExtractsUsecase(mockGoogle, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	_, err := u.GetExtractMessages(context.Background(), "Bank", account)
 	c.Error(err)
@@ -116,7 +118,7 @@ func TestGetExtractMessages_GetMessagesError(t *testing.T) {
 
 	mockRepo := new(repository.MockExtractsRepository)
 
-	u := NewExtractsUsecase(mockGoogle, mockRepo)
+	u := NewExtractsUsecase(mockGoogle, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	_, err := u.GetExtractMessages(context.Background(), "Bank", account)
 	c.Error(err)
@@ -131,7 +133,7 @@ func TestSave_DelegatesToRepo(t *testing.T) {
 
 	mockGoogleClient := new(google.MockGoogleClient)
 
-	u := NewExtractsUsecase(mockGoogleClient, mockRepo)
+	u := NewExtractsUsecase(mockGoogleClient, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	err := u.Save(context.Background(), &domain.Extract{})
 	c.NoError(err)
@@ -153,7 +155,7 @@ func TestUpdate_DelegatesToRepo(t *testing.T) {
 	mockGoogleClient := new(google.MockGoogleClient)
 	mockGoogleClient.On("GmailService").Return(mockGmail, nil)
 
-	u := NewExtractsUsecase(mockGoogleClient, mockRepo)
+	u := NewExtractsUsecase(mockGoogleClient, mockRepo, new(eventsUsecase.MockEventsUsecase))
 
 	err := u.Update(context.Background(), &domain.Extract{})
 	c.NoError(err)
@@ -162,3 +164,51 @@ func TestUpdate_DelegatesToRepo(t *testing.T) {
 
 	c.True(called)
 }
+
+func TestMarkProcessed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		ex := domain.NewExtract("acc-1", "msg-1", "inst-1", "/p.pdf", time.March, 2025)
+
+		mockRepo := new(repository.MockExtractsRepository)
+		mockRepo.On("Update", ctx, ex).Return(nil)
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", ctx, eventsDomain.ExtractProcessed, "acc-1", ex.ID, eventsDomain.ExtractProcessedPayload{
+			ID:            ex.ID,
+			AccountID:     "acc-1",
+			MessageID:     "msg-1",
+			InstitutionID: "inst-1",
+			Month:         3,
+			Year:          2025,
+			Movements:     4,
+		}).Return(nil)
+
+		u := NewExtractsUsecase(new(google.MockGoogleClient), mockRepo, events)
+
+		c.NoError(u.MarkProcessed(ctx, ex, 4))
+		c.Equal(domain.ExtractStatusProcessed, ex.Status)
+
+		mockRepo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		c := require.New(t)
+
+		ex := domain.NewExtract("acc-1", "msg-1", "inst-1", "/p.pdf", time.March, 2025)
+
+		mockRepo := new(repository.MockExtractsRepository)
+		mockRepo.On("Update", ctx, ex).Return(errors.New("db error"))
+
+		events := new(eventsUsecase.MockEventsUsecase)
+
+		u := NewExtractsUsecase(new(google.MockGoogleClient), mockRepo, events)
+
+		c.EqualError(u.MarkProcessed(ctx, ex, 4), "db error")
+		events.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_feedback_prefix = "FBK"
+)
+
+// Feedback records a manual category change of a movement together with the category,
+// confidence and source it replaced.
+type Feedback struct {
+	ID                  string
+	AccountID           string
+	MovementID          string
+	Description         string
+	PredictedCategory   movementsDomain.MovementCategory
+	PredictedConfidence float64
+	PredictedSource     string
+	CorrectedCategory   movementsDomain.MovementCategory
+	CreatedAt           time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (f *Feedback) LogProperties() map[string]string {
+	return map[string]string{
+		"feedback_id":          f.ID,
+		"account_id":           f.AccountID,
+		"movement_id":          f.MovementID,
+		"predicted_category":   string(f.PredictedCategory),
+		"predicted_confidence": strconv.FormatFloat(f.PredictedConfidence, 'f', 2, 64),
+		"predicted_source":     f.PredictedSource,
+		"corrected_category":   string(f.CorrectedCategory),
+	}
+}
+
+// NewFeedback creates the feedback of changing the category of previous to corrected.
+func NewFeedback(previous *movementsDomain.Movement, corrected movementsDomain.MovementCategory) *Feedback {
+	return &Feedback{
+		ID:                  _feedback_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:           previous.AccountID,
+		MovementID:          previous.ID,
+		Description:         previous.Description,
+		PredictedCategory:   previous.Category,
+		PredictedConfidence: previous.CategoryConfidence,
+		PredictedSource:     previous.CategorySource,
+		CorrectedCategory:   corrected,
+	}
+}
+
+// Example is a labeled description, one line of the JSONL dataset read by
+// text-classifier/train.py.
+type Example struct {
+	Description string `json:"description"`
+	Label       string `json:"label"`
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/feedback/domain"
+)
+
+// FeedbackRepository stores the category corrections made by users.
+type FeedbackRepository interface {
+	CreateFeedback(ctx context.Context, feedback *domain.Feedback) error
+	GetExamples(ctx context.Context, accountID string) ([]*domain.Example, error)
+}
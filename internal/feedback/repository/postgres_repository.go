@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/feedback/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the category feedback repository.
+func NewPostgresRepository(db *pgxpool.Pool) FeedbackRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so feedback is committed
+// together with the movement change that caused it.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// CreateFeedback inserts a category correction.
+func (r *postgresRepository) CreateFeedback(ctx context.Context, feedback *domain.Feedback) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO category_feedback (
+	id,
+	account_id,
+	movement_id,
+	description,
+	predicted_category,
+	predicted_confidence,
+	predicted_source,
+	corrected_category,
+	created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.querier(ctx).Exec(ctx, query,
+		feedback.ID,
+		feedback.AccountID,
+		feedback.MovementID,
+		feedback.Description,
+		string(feedback.PredictedCategory),
+		feedback.PredictedConfidence,
+		feedback.PredictedSource,
+		string(feedback.CorrectedCategory),
+		now)
+	if err != nil {
+		return err
+	}
+
+	feedback.CreatedAt = now
+
+	return nil
+}
+
+// GetExamples returns the latest correction of every corrected movement with a description.
+// An empty accountID returns the corrections of every account.
+func (r *postgresRepository) GetExamples(ctx context.Context, accountID string) ([]*domain.Example, error) {
+	query := `SELECT DISTINCT ON (movement_id) description, corrected_category
+	FROM category_feedback
+	WHERE ($1 = '' OR account_id = $1) AND description <> ''
+	ORDER BY movement_id, created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	examples := []*domain.Example{}
+	for rows.Next() {
+		example := &domain.Example{}
+
+		err := rows.Scan(&example.Description, &example.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		examples = append(examples, example)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return examples, nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/feedback/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFeedbackRepository is a mock of the repository interface.
+type MockFeedbackRepository struct {
+	mock.Mock
+}
+
+func (m *MockFeedbackRepository) CreateFeedback(ctx context.Context, feedback *domain.Feedback) error {
+	args := m.Called(ctx, feedback)
+	return args.Error(0)
+}
+
+func (m *MockFeedbackRepository) GetExamples(ctx context.Context, accountID string) ([]*domain.Example, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Example), args.Error(1)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/feedback/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func setupMockDB(t *testing.T) (FeedbackRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateFeedback(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	feedback := domain.NewFeedback(&movementsDomain.Movement{
+		ID:                 "MID1",
+		AccountID:          "acc1",
+		Description:        "RAPPI COLOMBIA",
+		Category:           movementsDomain.Shopping,
+		CategoryConfidence: 0.61,
+		CategorySource:     "model",
+	}, movementsDomain.Food)
+
+	mock.ExpectExec(`INSERT INTO category_feedback`).
+		WithArgs(feedback.ID, "acc1", "MID1", "RAPPI COLOMBIA", "shopping", 0.61, "model", "food", fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateFeedback(context.Background(), feedback))
+	c.Equal(fixedTime, feedback.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetExamples(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT DISTINCT ON \(movement_id\) description, corrected_category FROM category_feedback`).
+		WithArgs("").
+		WillReturnRows(pgxmock.NewRows([]string{"description", "corrected_category"}).
+			AddRow("RAPPI COLOMBIA", "food").
+			AddRow("NETFLIX", "entertainment"))
+
+	examples, err := repo.GetExamples(context.Background(), "")
+	c.NoError(err)
+	c.Equal([]*domain.Example{
+		{Description: "RAPPI COLOMBIA", Label: "food"},
+		{Description: "NETFLIX", Label: "entertainment"},
+	}, examples)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+// FeedbackUsecase records category corrections and turns them into training data for the
+// text-classifier.
+type FeedbackUsecase interface {
+	RecordCorrection(ctx context.Context, previous *movementsDomain.Movement, corrected movementsDomain.MovementCategory) error
+	ExportDataset(ctx context.Context, accountID string, w io.Writer) (int, error)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"transaction-tracker/internal/feedback/domain"
+	"transaction-tracker/internal/feedback/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+type feedbackUsecase struct {
+	repo repository.FeedbackRepository
+}
+
+// NewFeedbackUsecase creates a new instance of FeedbackUsecase.
+func NewFeedbackUsecase(repo repository.FeedbackRepository) FeedbackUsecase {
+	return &feedbackUsecase{
+		repo: repo,
+	}
+}
+
+// RecordCorrection stores that the category of previous was changed to corrected. Call it
+// inside the transaction that updates the movement.
+func (u *feedbackUsecase) RecordCorrection(ctx context.Context, previous *movementsDomain.Movement, corrected movementsDomain.MovementCategory) error {
+	return u.repo.CreateFeedback(ctx, domain.NewFeedback(previous, corrected))
+}
+
+// ExportDataset writes the corrections of the account, or of every account when accountID
+// is empty, as the JSONL dataset read by text-classifier/train.py. It returns the number
+// of examples written.
+func (u *feedbackUsecase) ExportDataset(ctx context.Context, accountID string, w io.Writer) (int, error) {
+	examples, err := u.repo.GetExamples(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	encoder := json.NewEncoder(w)
+
+	written := 0
+	for _, example := range examples {
+		example.Description = strings.TrimSpace(example.Description)
+		if example.Description == "" {
+			continue
+		}
+
+		err := encoder.Encode(example)
+		if err != nil {
+			return written, err
+		}
+
+		written++
+	}
+
+	return written, nil
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFeedbackUsecase is a mock implementation of the FeedbackUsecase interface.
+type MockFeedbackUsecase struct {
+	mock.Mock
+}
+
+func (m *MockFeedbackUsecase) RecordCorrection(ctx context.Context, previous *movementsDomain.Movement, corrected movementsDomain.MovementCategory) error {
+	args := m.Called(ctx, previous, corrected)
+	return args.Error(0)
+}
+
+func (m *MockFeedbackUsecase) ExportDataset(ctx context.Context, accountID string, w io.Writer) (int, error) {
+	args := m.Called(ctx, accountID, w)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"transaction-tracker/internal/feedback/domain"
+	"transaction-tracker/internal/feedback/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordCorrection(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	previous := &movementsDomain.Movement{
+		ID:                 "MID1",
+		AccountID:          "acc1",
+		Description:        "RAPPI COLOMBIA",
+		Category:           movementsDomain.Shopping,
+		CategoryConfidence: 0.55,
+		CategorySource:     "model",
+	}
+
+	repo := new(repository.MockFeedbackRepository)
+	repo.On("CreateFeedback", ctx, mock.MatchedBy(func(f *domain.Feedback) bool {
+		return f.MovementID == "MID1" &&
+			f.PredictedCategory == movementsDomain.Shopping &&
+			f.PredictedConfidence == 0.55 &&
+			f.PredictedSource == "model" &&
+			f.CorrectedCategory == movementsDomain.Food
+	})).Return(nil).Once()
+
+	c.NoError(NewFeedbackUsecase(repo).RecordCorrection(ctx, previous, movementsDomain.Food))
+	repo.AssertExpectations(t)
+}
+
+func TestExportDataset(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockFeedbackRepository)
+	repo.On("GetExamples", ctx, "acc1").Return([]*domain.Example{
+		{Description: " RAPPI COLOMBIA ", Label: "food"},
+		{Description: "  ", Label: "unknown"},
+		{Description: "NETFLIX", Label: "entertainment"},
+	}, nil)
+
+	var buf bytes.Buffer
+
+	written, err := NewFeedbackUsecase(repo).ExportDataset(ctx, "acc1", &buf)
+	c.NoError(err)
+	c.Equal(2, written)
+	c.Equal(`{"description":"RAPPI COLOMBIA","label":"food"}
+{"description":"NETFLIX","label":"entertainment"}
+`, buf.String())
+}
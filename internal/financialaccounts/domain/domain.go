@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_financial_account_prefix = "FAC"
+
+	// DateLayout is the layout dates are written with in requests, e.g. 2025-09-30.
+	DateLayout = "2006-01-02"
+
+	maxNameLength = 100
+)
+
+// Kind is the type of product a financial account is.
+type Kind string
+
+const (
+	Savings    Kind = "savings"
+	Checking   Kind = "checking"
+	CreditCard Kind = "credit_card"
+	Wallet     Kind = "wallet"
+	Investment Kind = "investment"
+	Loan       Kind = "loan"
+)
+
+// Kinds are the kinds a financial account can have.
+var Kinds = []Kind{Savings, Checking, CreditCard, Wallet, Investment, Loan}
+
+// AnchorSource tells where a known balance comes from.
+type AnchorSource string
+
+const (
+	// ExtractSource anchors are the closing balances of bank statements.
+	ExtractSource AnchorSource = "extract"
+	// ManualSource anchors are balances entered by the user.
+	ManualSource AnchorSource = "manual"
+)
+
+var (
+	// ErrInvalidFinancialAccount is returned when a financial account has invalid fields.
+	ErrInvalidFinancialAccount = errors.New("invalid financial account")
+	// ErrInvalidAnchor is returned when a balance anchor has invalid fields.
+	ErrInvalidAnchor = errors.New("invalid balance anchor")
+)
+
+// FinancialAccount is a product of an institution where the money of an account is kept or
+// owed, as savings accounts, credit cards and wallets are. Movements of the institution are
+// linked to the oldest financial account the account has there.
+type FinancialAccount struct {
+	ID            string
+	AccountID     string
+	InstitutionID string
+	Name          string
+	Kind          Kind
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (f *FinancialAccount) LogProperties() map[string]string {
+	return map[string]string{
+		"financial_account_id": f.ID,
+		"account_id":           f.AccountID,
+		"institution_id":       f.InstitutionID,
+		"name":                 f.Name,
+		"kind":                 string(f.Kind),
+	}
+}
+
+// NewFinancialAccount creates a financial account of the account in an institution.
+func NewFinancialAccount(accountID string, institutionID string, name string, kind string) (*FinancialAccount, error) {
+	account := &FinancialAccount{
+		ID:            _financial_account_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:     accountID,
+		InstitutionID: strings.TrimSpace(institutionID),
+		Name:          strings.TrimSpace(name),
+		Kind:          Kind(kind),
+	}
+
+	err := account.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// Validate checks the fields of the financial account.
+func (f *FinancialAccount) Validate() error {
+	if f.InstitutionID == "" {
+		return fmt.Errorf("%w: institution is required", ErrInvalidFinancialAccount)
+	}
+
+	if f.Name == "" || len(f.Name) > maxNameLength {
+		return fmt.Errorf("%w: name is required and must have at most %d characters", ErrInvalidFinancialAccount, maxNameLength)
+	}
+
+	if !slices.Contains(Kinds, f.Kind) {
+		return fmt.Errorf("%w: kind %q is not one of %v", ErrInvalidFinancialAccount, f.Kind, Kinds)
+	}
+
+	return nil
+}
+
+// Anchor is a known balance of a financial account: the balance once every movement made
+// before Date is counted. Credit cards and loans have negative balances when money is owed.
+type Anchor struct {
+	FinancialAccountID string
+	AccountID          string
+	Date               time.Time
+	Balance            float64
+	Source             AnchorSource
+	ExtractID          string
+	CreatedAt          time.Time
+}
+
+// NewManualAnchor creates the balance the financial account had at the end of the day
+// written as DateLayout.
+func NewManualAnchor(account *FinancialAccount, date string, balance float64) (*Anchor, error) {
+	day, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: date %q must look like %s", ErrInvalidAnchor, date, DateLayout)
+	}
+
+	return &Anchor{
+		FinancialAccountID: account.ID,
+		AccountID:          account.AccountID,
+		Date:               day.AddDate(0, 0, 1),
+		Balance:            balance,
+		Source:             ManualSource,
+	}, nil
+}
+
+// NewStatementAnchor creates the closing balance of the statement of a month, which counts
+// every movement made until the month ends.
+func NewStatementAnchor(account *FinancialAccount, extractID string, year int, month time.Month, balance float64) *Anchor {
+	return &Anchor{
+		FinancialAccountID: account.ID,
+		AccountID:          account.AccountID,
+		Date:               time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC),
+		Balance:            balance,
+		Source:             ExtractSource,
+		ExtractID:          extractID,
+	}
+}
+
+// Flow is what came into a financial account minus what went out of it during the day
+// starting at Date.
+type Flow struct {
+	FinancialAccountID string
+	Date               time.Time
+	Net                float64
+}
+
+// Ledger holds the anchors and flows of a financial account, both sorted by date.
+type Ledger struct {
+	Anchors []*Anchor
+	Flows   []*Flow
+}
+
+// NewLedgers groups anchors and flows sorted by date per financial account.
+func NewLedgers(anchors []*Anchor, flows []*Flow) map[string]*Ledger {
+	ledgers := map[string]*Ledger{}
+	ledger := func(id string) *Ledger {
+		if _, ok := ledgers[id]; !ok {
+			ledgers[id] = &Ledger{}
+		}
+
+		return ledgers[id]
+	}
+
+	for _, anchor := range anchors {
+		l := ledger(anchor.FinancialAccountID)
+		l.Anchors = append(l.Anchors, anchor)
+	}
+
+	for _, flow := range flows {
+		l := ledger(flow.FinancialAccountID)
+		l.Flows = append(l.Flows, flow)
+	}
+
+	return ledgers
+}
+
+// BalanceAt returns the balance once every flow before at is counted. It runs forward from
+// the last anchor before at, backward from the first anchor after it when there is none, and
+// from zero when the financial account has no anchors.
+func (l *Ledger) BalanceAt(at time.Time) float64 {
+	var before, after *Anchor
+	for _, anchor := range l.Anchors {
+		if !anchor.Date.After(at) {
+			before = anchor
+			continue
+		}
+
+		after = anchor
+		break
+	}
+
+	switch {
+	case before != nil:
+		return round(before.Balance + l.sum(before.Date, at))
+	case after != nil:
+		return round(after.Balance - l.sum(at, after.Date))
+	default:
+		return round(l.sum(time.Time{}, at))
+	}
+}
+
+// sum adds the flows of the days starting in [from, to).
+func (l *Ledger) sum(from time.Time, to time.Time) float64 {
+	var total float64
+	for _, flow := range l.Flows {
+		if !flow.Date.Before(from) && flow.Date.Before(to) {
+			total += flow.Net
+		}
+	}
+
+	return total
+}
+
+// Summary is a financial account with its balance as of now.
+type Summary struct {
+	*FinancialAccount
+	Balance float64
+}
+
+// Balance is the balance of a financial account at a point of a net worth series.
+type Balance struct {
+	FinancialAccountID string
+	Balance            float64
+}
+
+// NetWorthPoint is what the financial accounts of an account added up to at the end of the
+// period starting at Period. Date is the last day counted. Assets are the positive balances
+// and Liabilities the negative ones.
+type NetWorthPoint struct {
+	Period      time.Time
+	Date        time.Time
+	NetWorth    float64
+	Assets      float64
+	Liabilities float64
+	Balances    []*Balance
+}
+
+// NewNetWorthPoint adds up the balances of the financial accounts once every flow before at
+// is counted.
+func NewNetWorthPoint(period time.Time, at time.Time, accounts []*FinancialAccount, ledgers map[string]*Ledger) *NetWorthPoint {
+	point := &NetWorthPoint{
+		Period:   period,
+		Date:     dayOf(at.Add(-time.Nanosecond)),
+		Balances: make([]*Balance, 0, len(accounts)),
+	}
+
+	for _, account := range accounts {
+		var balance float64
+		if ledger, ok := ledgers[account.ID]; ok {
+			balance = ledger.BalanceAt(at)
+		}
+
+		point.Balances = append(point.Balances, &Balance{FinancialAccountID: account.ID, Balance: balance})
+
+		if balance >= 0 {
+			point.Assets += balance
+		} else {
+			point.Liabilities += balance
+		}
+	}
+
+	point.Assets = round(point.Assets)
+	point.Liabilities = round(point.Liabilities)
+	point.NetWorth = round(point.Assets + point.Liabilities)
+
+	return point
+}
+
+func dayOf(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
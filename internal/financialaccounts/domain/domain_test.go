@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewFinancialAccount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		account, err := NewFinancialAccount("acc1", " davivienda ", " Savings ", "savings")
+		c.NoError(err)
+		c.Contains(account.ID, _financial_account_prefix)
+		c.Equal("davivienda", account.InstitutionID)
+		c.Equal("Savings", account.Name)
+		c.Equal(Savings, account.Kind)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, args := range map[string][3]string{
+			"institution": {"", "Savings", "savings"},
+			"name":        {"davivienda", " ", "savings"},
+			"kind":        {"davivienda", "Savings", "piggy_bank"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewFinancialAccount("acc1", args[0], args[1], args[2])
+				require.ErrorIs(t, err, ErrInvalidFinancialAccount)
+			})
+		}
+	})
+}
+
+func TestAnchors(t *testing.T) {
+	c := require.New(t)
+
+	account := &FinancialAccount{ID: "FAC1", AccountID: "acc1"}
+
+	anchor, err := NewManualAnchor(account, "2025-09-15", 500)
+	c.NoError(err)
+	c.Equal(date(2025, 9, 16), anchor.Date)
+	c.Equal(ManualSource, anchor.Source)
+
+	_, err = NewManualAnchor(account, "15/09/2025", 500)
+	c.ErrorIs(err, ErrInvalidAnchor)
+
+	statement := NewStatementAnchor(account, "EXI1", 2025, time.December, 947232.64)
+	c.Equal(date(2026, 1, 1), statement.Date)
+	c.Equal(ExtractSource, statement.Source)
+	c.Equal("EXI1", statement.ExtractID)
+}
+
+func TestLedgerBalanceAt(t *testing.T) {
+	flows := []*Flow{
+		{FinancialAccountID: "FAC1", Date: date(2025, 8, 10), Net: 1000},
+		{FinancialAccountID: "FAC1", Date: date(2025, 8, 20), Net: -200},
+		{FinancialAccountID: "FAC1", Date: date(2025, 9, 5), Net: -100},
+		{FinancialAccountID: "FAC1", Date: date(2025, 9, 25), Net: 50},
+	}
+
+	t.Run("without anchors", func(t *testing.T) {
+		ledger := NewLedgers(nil, flows)["FAC1"]
+
+		require.Equal(t, 800.0, ledger.BalanceAt(date(2025, 9, 1)))
+	})
+
+	t.Run("forward and backward from anchors", func(t *testing.T) {
+		c := require.New(t)
+
+		ledger := NewLedgers([]*Anchor{{FinancialAccountID: "FAC1", Date: date(2025, 9, 1), Balance: 5000}}, flows)["FAC1"]
+
+		c.Equal(5000.0, ledger.BalanceAt(date(2025, 9, 1)))
+		c.Equal(4900.0, ledger.BalanceAt(date(2025, 9, 10)))
+		c.Equal(4950.0, ledger.BalanceAt(date(2025, 10, 1)))
+		c.Equal(5200.0, ledger.BalanceAt(date(2025, 8, 15)))
+		c.Equal(4200.0, ledger.BalanceAt(date(2025, 8, 1)))
+	})
+}
+
+func TestNewNetWorthPoint(t *testing.T) {
+	c := require.New(t)
+
+	accounts := []*FinancialAccount{{ID: "FAC1"}, {ID: "FAC2"}, {ID: "FAC3"}}
+	ledgers := NewLedgers(
+		[]*Anchor{
+			{FinancialAccountID: "FAC1", Date: date(2025, 9, 1), Balance: 5000},
+			{FinancialAccountID: "FAC2", Date: date(2025, 9, 1), Balance: -1200.5},
+		},
+		[]*Flow{{FinancialAccountID: "FAC2", Date: date(2025, 9, 10), Net: -300}},
+	)
+
+	point := NewNetWorthPoint(date(2025, 9, 1), date(2025, 10, 1), accounts, ledgers)
+
+	c.Equal(date(2025, 9, 30), point.Date)
+	c.Equal(5000.0, point.Assets)
+	c.Equal(-1500.5, point.Liabilities)
+	c.Equal(3499.5, point.NetWorth)
+	c.Equal([]*Balance{{"FAC1", 5000}, {"FAC2", -1500.5}, {"FAC3", 0}}, point.Balances)
+}
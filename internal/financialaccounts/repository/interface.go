@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/financialaccounts/domain"
+)
+
+// FinancialAccountRepository stores the financial accounts of each account, their known
+// balances and reads the flows of their movements.
+type FinancialAccountRepository interface {
+	CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error
+	GetFinancialAccountByID(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error)
+	GetFinancialAccountByInstitution(ctx context.Context, accountID string, institutionID string) (*domain.FinancialAccount, error)
+	GetFinancialAccounts(ctx context.Context, accountID string) ([]*domain.FinancialAccount, error)
+	UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error
+	DeleteFinancialAccount(ctx context.Context, id string, accountID string) error
+	LinkMovements(ctx context.Context, account *domain.FinancialAccount) (int64, error)
+	UnlinkMovements(ctx context.Context, id string, accountID string) error
+	SaveAnchor(ctx context.Context, anchor *domain.Anchor) error
+	GetAnchors(ctx context.Context, accountID string) ([]*domain.Anchor, error)
+	GetFlows(ctx context.Context, accountID string) ([]*domain.Flow, error)
+}
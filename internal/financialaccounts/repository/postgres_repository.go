@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/financialaccounts/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	financialAccountColumns = `id, account_id, institution_id, name, kind, created_at, updated_at`
+)
+
+var (
+	ErrFinancialAccountNotFound = errors.New("financial account not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the financial accounts repository.
+func NewPostgresRepository(db *pgxpool.Pool) FinancialAccountRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so a financial account is
+// linked to its movements atomically.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// CreateFinancialAccount inserts a new financial account.
+func (r *postgresRepository) CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO financial_accounts (` + financialAccountColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $6)`
+
+	_, err := r.querier(ctx).Exec(ctx, query, account.ID, account.AccountID, account.InstitutionID, account.Name, account.Kind, now)
+	if err != nil {
+		return err
+	}
+
+	account.CreatedAt = now
+	account.UpdatedAt = now
+
+	return nil
+}
+
+// GetFinancialAccountByID returns a financial account of the account.
+func (r *postgresRepository) GetFinancialAccountByID(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error) {
+	query := `SELECT ` + financialAccountColumns + `
+	FROM financial_accounts
+	WHERE id = $1 AND account_id = $2`
+
+	return scanOne(r.querier(ctx).QueryRow(ctx, query, id, accountID).Scan)
+}
+
+// GetFinancialAccountByInstitution returns the oldest financial account the account has in
+// the institution, the one its movements are linked to.
+func (r *postgresRepository) GetFinancialAccountByInstitution(ctx context.Context, accountID string, institutionID string) (*domain.FinancialAccount, error) {
+	query := `SELECT ` + financialAccountColumns + `
+	FROM financial_accounts
+	WHERE account_id = $1 AND institution_id = $2
+	ORDER BY created_at, id
+	LIMIT 1`
+
+	return scanOne(r.querier(ctx).QueryRow(ctx, query, accountID, institutionID).Scan)
+}
+
+// GetFinancialAccounts returns the financial accounts of the account sorted by institution
+// and name.
+func (r *postgresRepository) GetFinancialAccounts(ctx context.Context, accountID string) ([]*domain.FinancialAccount, error) {
+	query := `SELECT ` + financialAccountColumns + `
+	FROM financial_accounts
+	WHERE account_id = $1
+	ORDER BY institution_id, name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accounts := []*domain.FinancialAccount{}
+	for rows.Next() {
+		account, err := scanToFinancialAccount(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// UpdateFinancialAccount saves the name and kind of a financial account.
+func (r *postgresRepository) UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	now := r.nowFunc()
+
+	query := `UPDATE financial_accounts
+	SET name = $1, kind = $2, updated_at = $3
+	WHERE id = $4 AND account_id = $5`
+
+	tag, err := r.querier(ctx).Exec(ctx, query, account.Name, account.Kind, now, account.ID, account.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrFinancialAccountNotFound
+	}
+
+	account.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteFinancialAccount removes a financial account of the account with its anchors.
+func (r *postgresRepository) DeleteFinancialAccount(ctx context.Context, id string, accountID string) error {
+	tag, err := r.querier(ctx).Exec(ctx, `DELETE FROM financial_accounts WHERE id = $1 AND account_id = $2`, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrFinancialAccountNotFound
+	}
+
+	return nil
+}
+
+// LinkMovements links the movements of the institution of the financial account that are not
+// linked to any. It returns how many were linked.
+func (r *postgresRepository) LinkMovements(ctx context.Context, account *domain.FinancialAccount) (int64, error) {
+	query := `UPDATE movements
+	SET financial_account_id = $1
+	WHERE account_id = $2 AND institution_id = $3 AND financial_account_id = ''`
+
+	tag, err := r.querier(ctx).Exec(ctx, query, account.ID, account.AccountID, account.InstitutionID)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// UnlinkMovements unlinks the movements of a financial account.
+func (r *postgresRepository) UnlinkMovements(ctx context.Context, id string, accountID string) error {
+	query := `UPDATE movements
+	SET financial_account_id = ''
+	WHERE account_id = $1 AND financial_account_id = $2`
+
+	_, err := r.querier(ctx).Exec(ctx, query, accountID, id)
+
+	return err
+}
+
+// SaveAnchor stores a known balance, replacing the one of the same financial account and date.
+func (r *postgresRepository) SaveAnchor(ctx context.Context, anchor *domain.Anchor) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO balance_anchors (financial_account_id, date, account_id, balance, source, extract_id, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (financial_account_id, date) DO UPDATE
+	SET balance = EXCLUDED.balance, source = EXCLUDED.source, extract_id = EXCLUDED.extract_id, created_at = EXCLUDED.created_at`
+
+	_, err := r.querier(ctx).Exec(ctx, query,
+		anchor.FinancialAccountID,
+		anchor.Date,
+		anchor.AccountID,
+		anchor.Balance,
+		anchor.Source,
+		anchor.ExtractID,
+		now)
+	if err != nil {
+		return err
+	}
+
+	anchor.CreatedAt = now
+
+	return nil
+}
+
+// GetAnchors returns the known balances of every financial account of the account, oldest first.
+func (r *postgresRepository) GetAnchors(ctx context.Context, accountID string) ([]*domain.Anchor, error) {
+	query := `SELECT financial_account_id, date, account_id, balance, source, extract_id, created_at
+	FROM balance_anchors
+	WHERE account_id = $1
+	ORDER BY date`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	anchors := []*domain.Anchor{}
+	for rows.Next() {
+		anchor := &domain.Anchor{}
+
+		var source string
+
+		err := rows.Scan(&anchor.FinancialAccountID, &anchor.Date, &anchor.AccountID, &anchor.Balance, &source, &anchor.ExtractID, &anchor.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		anchor.Date = anchor.Date.UTC()
+		anchor.Source = domain.AnchorSource(source)
+		anchors = append(anchors, anchor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return anchors, nil
+}
+
+// GetFlows returns what came into each financial account of the account minus what went out
+// of it per day, in UTC, oldest first.
+func (r *postgresRepository) GetFlows(ctx context.Context, accountID string) ([]*domain.Flow, error) {
+	query := `SELECT financial_account_id, date_trunc('day', date AT TIME ZONE 'UTC') AS day,
+	SUM(CASE WHEN type = $2 THEN amount ELSE -amount END)
+	FROM movements
+	WHERE account_id = $1 AND financial_account_id <> ''
+	GROUP BY financial_account_id, day
+	ORDER BY day`
+
+	rows, err := r.db.Query(ctx, query, accountID, string(movementsDomain.Income))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	flows := []*domain.Flow{}
+	for rows.Next() {
+		flow := &domain.Flow{}
+
+		err := rows.Scan(&flow.FinancialAccountID, &flow.Date, &flow.Net)
+		if err != nil {
+			return nil, err
+		}
+
+		flow.Date = flow.Date.UTC()
+		flows = append(flows, flow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+func scanOne(scanFn func(...any) error) (*domain.FinancialAccount, error) {
+	account, err := scanToFinancialAccount(scanFn)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrFinancialAccountNotFound
+	}
+
+	return account, err
+}
+
+func scanToFinancialAccount(scanFn func(...any) error) (*domain.FinancialAccount, error) {
+	account := &domain.FinancialAccount{}
+
+	var kind string
+
+	err := scanFn(&account.ID, &account.AccountID, &account.InstitutionID, &account.Name, &kind, &account.CreatedAt, &account.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Kind = domain.Kind(kind)
+
+	return account, nil
+}
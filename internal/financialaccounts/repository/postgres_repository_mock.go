@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/financialaccounts/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFinancialAccountRepository is a mock of the repository interface.
+type MockFinancialAccountRepository struct {
+	mock.Mock
+}
+
+func (m *MockFinancialAccountRepository) CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountRepository) GetFinancialAccountByID(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.FinancialAccount), args.Error(1)
+}
+
+func (m *MockFinancialAccountRepository) GetFinancialAccountByInstitution(ctx context.Context, accountID string, institutionID string) (*domain.FinancialAccount, error) {
+	args := m.Called(ctx, accountID, institutionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.FinancialAccount), args.Error(1)
+}
+
+func (m *MockFinancialAccountRepository) GetFinancialAccounts(ctx context.Context, accountID string) ([]*domain.FinancialAccount, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.FinancialAccount), args.Error(1)
+}
+
+func (m *MockFinancialAccountRepository) UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountRepository) DeleteFinancialAccount(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountRepository) LinkMovements(ctx context.Context, account *domain.FinancialAccount) (int64, error) {
+	args := m.Called(ctx, account)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFinancialAccountRepository) UnlinkMovements(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountRepository) SaveAnchor(ctx context.Context, anchor *domain.Anchor) error {
+	args := m.Called(ctx, anchor)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountRepository) GetAnchors(ctx context.Context, accountID string) ([]*domain.Anchor, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Anchor), args.Error(1)
+}
+
+func (m *MockFinancialAccountRepository) GetFlows(ctx context.Context, accountID string) ([]*domain.Flow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Flow), args.Error(1)
+}
@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/financialaccounts/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime   = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	accountRows = []string{"id", "account_id", "institution_id", "name", "kind", "created_at", "updated_at"}
+)
+
+func setupMockDB(t *testing.T) (FinancialAccountRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newFinancialAccount() *domain.FinancialAccount {
+	return &domain.FinancialAccount{
+		ID:            "FAC1",
+		AccountID:     "acc1",
+		InstitutionID: "davivienda",
+		Name:          "Savings",
+		Kind:          domain.Savings,
+	}
+}
+
+func TestCreateFinancialAccount(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	account := newFinancialAccount()
+
+	mock.ExpectExec(`INSERT INTO financial_accounts`).
+		WithArgs("FAC1", "acc1", "davivienda", "Savings", domain.Savings, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateFinancialAccount(context.Background(), account))
+	c.Equal(fixedTime, account.CreatedAt)
+	c.Equal(fixedTime, account.UpdatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetFinancialAccountByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(accountRows).
+			AddRow("FAC1", "acc1", "davivienda", "Savings", "savings", fixedTime, fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM financial_accounts WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("FAC1", "acc1").
+			WillReturnRows(rows)
+
+		account, err := repo.GetFinancialAccountByID(context.Background(), "FAC1", "acc1")
+		c.NoError(err)
+		c.Equal(domain.Savings, account.Kind)
+		c.Equal("davivienda", account.InstitutionID)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM financial_accounts`).
+			WithArgs("FAC1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetFinancialAccountByID(context.Background(), "FAC1", "acc1")
+		require.ErrorIs(t, err, ErrFinancialAccountNotFound)
+	})
+}
+
+func TestGetFinancialAccountByInstitution(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(accountRows).
+		AddRow("FAC1", "acc1", "davivienda", "Savings", "savings", fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM financial_accounts WHERE account_id = \$1 AND institution_id = \$2 ORDER BY created_at, id LIMIT 1`).
+		WithArgs("acc1", "davivienda").
+		WillReturnRows(rows)
+
+	account, err := repo.GetFinancialAccountByInstitution(context.Background(), "acc1", "davivienda")
+	c.NoError(err)
+	c.Equal("FAC1", account.ID)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetFinancialAccounts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(accountRows).
+			AddRow("FAC1", "acc1", "davivienda", "Savings", "savings", fixedTime, fixedTime).
+			AddRow("FAC2", "acc1", "davivienda", "Visa", "credit_card", fixedTime, fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM financial_accounts WHERE account_id = \$1`).
+			WithArgs("acc1").
+			WillReturnRows(rows)
+
+		accounts, err := repo.GetFinancialAccounts(context.Background(), "acc1")
+		c.NoError(err)
+		c.Len(accounts, 2)
+		c.Equal(domain.CreditCard, accounts[1].Kind)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM financial_accounts`).
+			WithArgs("acc1").
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetFinancialAccounts(context.Background(), "acc1")
+		require.Error(t, err)
+	})
+}
+
+func TestUpdateFinancialAccount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		account := newFinancialAccount()
+
+		mock.ExpectExec(`UPDATE financial_accounts SET name = \$1, kind = \$2, updated_at = \$3`).
+			WithArgs("Savings", domain.Savings, fixedTime, "FAC1", "acc1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		c.NoError(repo.UpdateFinancialAccount(context.Background(), account))
+		c.Equal(fixedTime, account.UpdatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`UPDATE financial_accounts`).
+			WithArgs("Savings", domain.Savings, fixedTime, "FAC1", "acc1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := repo.UpdateFinancialAccount(context.Background(), newFinancialAccount())
+		require.ErrorIs(t, err, ErrFinancialAccountNotFound)
+	})
+}
+
+func TestDeleteFinancialAccount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM financial_accounts WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("FAC1", "acc1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		require.NoError(t, repo.DeleteFinancialAccount(context.Background(), "FAC1", "acc1"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM financial_accounts`).
+			WithArgs("FAC1", "acc1").
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := repo.DeleteFinancialAccount(context.Background(), "FAC1", "acc1")
+		require.ErrorIs(t, err, ErrFinancialAccountNotFound)
+	})
+}
+
+func TestLinkMovements(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE movements SET financial_account_id = \$1 WHERE account_id = \$2 AND institution_id = \$3 AND financial_account_id = ''`).
+		WithArgs("FAC1", "acc1", "davivienda").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 12))
+
+	linked, err := repo.LinkMovements(context.Background(), newFinancialAccount())
+	c.NoError(err)
+	c.Equal(int64(12), linked)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUnlinkMovements(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE movements SET financial_account_id = ''`).
+		WithArgs("acc1", "FAC1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 3))
+
+	c.NoError(repo.UnlinkMovements(context.Background(), "FAC1", "acc1"))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSaveAnchor(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	date := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	anchor := &domain.Anchor{
+		FinancialAccountID: "FAC1",
+		AccountID:          "acc1",
+		Date:               date,
+		Balance:            947232.64,
+		Source:             domain.ExtractSource,
+		ExtractID:          "EXT1",
+	}
+
+	mock.ExpectExec(`INSERT INTO balance_anchors (.+) ON CONFLICT \(financial_account_id, date\) DO UPDATE`).
+		WithArgs("FAC1", date, "acc1", 947232.64, domain.ExtractSource, "EXT1", fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.SaveAnchor(context.Background(), anchor))
+	c.Equal(fixedTime, anchor.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetAnchors(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	date := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"financial_account_id", "date", "account_id", "balance", "source", "extract_id", "created_at"}).
+		AddRow("FAC1", date, "acc1", 947232.64, "extract", "EXT1", fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM balance_anchors WHERE account_id = \$1 ORDER BY date`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	anchors, err := repo.GetAnchors(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(anchors, 1)
+	c.Equal(domain.ExtractSource, anchors[0].Source)
+	c.Equal(date, anchors[0].Date)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetFlows(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	day := time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"financial_account_id", "day", "sum"}).
+		AddRow("FAC1", day, -45000.5)
+
+	mock.ExpectQuery(`SELECT financial_account_id, date_trunc\('day', date AT TIME ZONE 'UTC'\) AS day, (.+) FROM movements WHERE account_id = \$1 AND financial_account_id <> '' GROUP BY financial_account_id, day`).
+		WithArgs("acc1", "income").
+		WillReturnRows(rows)
+
+	flows, err := repo.GetFlows(context.Background(), "acc1")
+	c.NoError(err)
+	c.Equal([]*domain.Flow{{FinancialAccountID: "FAC1", Date: day, Net: -45000.5}}, flows)
+	c.NoError(mock.ExpectationsWereMet())
+}
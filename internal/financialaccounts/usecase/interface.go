@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	extractsDomain "transaction-tracker/internal/extracts/domain"
+	"transaction-tracker/internal/financialaccounts/domain"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+)
+
+// FinancialAccountsUsecase manages the financial accounts of an account, their known balances
+// and the net worth they add up to.
+type FinancialAccountsUsecase interface {
+	CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error
+	GetFinancialAccount(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error)
+	GetSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error)
+	UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error
+	DeleteFinancialAccount(ctx context.Context, id string, accountID string) error
+	SaveAnchor(ctx context.Context, id string, accountID string, date string, balance float64) (*domain.Anchor, error)
+	ResolveFinancialAccount(ctx context.Context, accountID string, institutionID string) (string, error)
+	AnchorStatement(ctx context.Context, extract *extractsDomain.Extract) error
+	GetNetWorth(ctx context.Context, accountID string, r *reportsDomain.Range) ([]*domain.NetWorthPoint, error)
+}
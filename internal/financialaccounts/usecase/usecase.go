@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	extractsDomain "transaction-tracker/internal/extracts/domain"
+	"transaction-tracker/internal/financialaccounts/domain"
+	"transaction-tracker/internal/financialaccounts/repository"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+	"transaction-tracker/pkg/databases/postgres"
+)
+
+var (
+	ErrFinancialAccountNotFound = repository.ErrFinancialAccountNotFound
+)
+
+type financialAccountsUsecase struct {
+	repo       repository.FinancialAccountRepository
+	transactor postgres.Transactor
+	nowFunc    func() time.Time
+}
+
+// NewFinancialAccountsUsecase creates a new instance of FinancialAccountsUsecase.
+func NewFinancialAccountsUsecase(repo repository.FinancialAccountRepository, transactor postgres.Transactor) FinancialAccountsUsecase {
+	return &financialAccountsUsecase{
+		repo:       repo,
+		transactor: transactor,
+		nowFunc:    time.Now,
+	}
+}
+
+// CreateFinancialAccount stores a new financial account and links to it the movements of its
+// institution that are not linked to any.
+func (u *financialAccountsUsecase) CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.repo.CreateFinancialAccount(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.repo.LinkMovements(ctx, account)
+
+		return err
+	})
+}
+
+func (u *financialAccountsUsecase) GetFinancialAccount(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error) {
+	return u.repo.GetFinancialAccountByID(ctx, id, accountID)
+}
+
+// GetSummaries returns the financial accounts of the account with their balances as of now.
+func (u *financialAccountsUsecase) GetSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	accounts, ledgers, err := u.load(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.nowFunc()
+
+	summaries := make([]*domain.Summary, 0, len(accounts))
+	for _, account := range accounts {
+		summary := &domain.Summary{FinancialAccount: account}
+		if ledger, ok := ledgers[account.ID]; ok {
+			summary.Balance = ledger.BalanceAt(now)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+// UpdateFinancialAccount saves the name and kind of a financial account. Its institution can't
+// change since its movements come from it.
+func (u *financialAccountsUsecase) UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	current, err := u.repo.GetFinancialAccountByID(ctx, account.ID, account.AccountID)
+	if err != nil {
+		return err
+	}
+
+	account.InstitutionID = current.InstitutionID
+	account.CreatedAt = current.CreatedAt
+
+	err = account.Validate()
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateFinancialAccount(ctx, account)
+}
+
+// DeleteFinancialAccount removes a financial account with its anchors and unlinks its movements.
+func (u *financialAccountsUsecase) DeleteFinancialAccount(ctx context.Context, id string, accountID string) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.repo.UnlinkMovements(ctx, id, accountID)
+		if err != nil {
+			return err
+		}
+
+		return u.repo.DeleteFinancialAccount(ctx, id, accountID)
+	})
+}
+
+// SaveAnchor stores the balance a financial account had at the end of the date.
+func (u *financialAccountsUsecase) SaveAnchor(ctx context.Context, id string, accountID string, date string, balance float64) (*domain.Anchor, error) {
+	account, err := u.repo.GetFinancialAccountByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	anchor, err := domain.NewManualAnchor(account, date, balance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.SaveAnchor(ctx, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	return anchor, nil
+}
+
+// ResolveFinancialAccount returns the ID of the financial account movements of the institution
+// are linked to, or an empty ID when the account has none there.
+func (u *financialAccountsUsecase) ResolveFinancialAccount(ctx context.Context, accountID string, institutionID string) (string, error) {
+	account, err := u.repo.GetFinancialAccountByInstitution(ctx, accountID, institutionID)
+	if errors.Is(err, repository.ErrFinancialAccountNotFound) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return account.ID, nil
+}
+
+// AnchorStatement stores the closing balance of a processed extract as a known balance of the
+// financial account of its institution. Extracts without a closing balance or whose
+// institution has no financial account are skipped.
+func (u *financialAccountsUsecase) AnchorStatement(ctx context.Context, extract *extractsDomain.Extract) error {
+	if extract.ClosingBalance == nil || extract.InstitutionID == "" {
+		return nil
+	}
+
+	account, err := u.repo.GetFinancialAccountByInstitution(ctx, extract.AccountID, extract.InstitutionID)
+	if errors.Is(err, repository.ErrFinancialAccountNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	anchor := domain.NewStatementAnchor(account, extract.ID, extract.Year, extract.Month, *extract.ClosingBalance)
+
+	return u.repo.SaveAnchor(ctx, anchor)
+}
+
+// GetNetWorth returns what the financial accounts of the account added up to at the end of
+// every period of the range. The current period ends now and periods starting after now are
+// left out.
+func (u *financialAccountsUsecase) GetNetWorth(ctx context.Context, accountID string, r *reportsDomain.Range) ([]*domain.NetWorthPoint, error) {
+	accounts, ledgers, err := u.load(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.nowFunc()
+
+	points := []*domain.NetWorthPoint{}
+	for _, period := range r.Periods() {
+		if period.After(now) {
+			break
+		}
+
+		at := r.Granularity.Add(period, 1)
+		if at.After(now) {
+			at = now
+		}
+
+		points = append(points, domain.NewNetWorthPoint(period, at, accounts, ledgers))
+	}
+
+	return points, nil
+}
+
+func (u *financialAccountsUsecase) load(ctx context.Context, accountID string) ([]*domain.FinancialAccount, map[string]*domain.Ledger, error) {
+	accounts, err := u.repo.GetFinancialAccounts(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anchors, err := u.repo.GetAnchors(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flows, err := u.repo.GetFlows(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, domain.NewLedgers(anchors, flows), nil
+}
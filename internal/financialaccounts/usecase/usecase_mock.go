@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	extractsDomain "transaction-tracker/internal/extracts/domain"
+	"transaction-tracker/internal/financialaccounts/domain"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFinancialAccountsUsecase is a mock implementation of the FinancialAccountsUsecase interface.
+type MockFinancialAccountsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockFinancialAccountsUsecase) CreateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountsUsecase) GetFinancialAccount(ctx context.Context, id string, accountID string) (*domain.FinancialAccount, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.FinancialAccount), args.Error(1)
+}
+
+func (m *MockFinancialAccountsUsecase) GetSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Summary), args.Error(1)
+}
+
+func (m *MockFinancialAccountsUsecase) UpdateFinancialAccount(ctx context.Context, account *domain.FinancialAccount) error {
+	args := m.Called(ctx, account)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountsUsecase) DeleteFinancialAccount(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountsUsecase) SaveAnchor(ctx context.Context, id string, accountID string, date string, balance float64) (*domain.Anchor, error) {
+	args := m.Called(ctx, id, accountID, date, balance)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Anchor), args.Error(1)
+}
+
+func (m *MockFinancialAccountsUsecase) ResolveFinancialAccount(ctx context.Context, accountID string, institutionID string) (string, error) {
+	args := m.Called(ctx, accountID, institutionID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockFinancialAccountsUsecase) AnchorStatement(ctx context.Context, extract *extractsDomain.Extract) error {
+	args := m.Called(ctx, extract)
+	return args.Error(0)
+}
+
+func (m *MockFinancialAccountsUsecase) GetNetWorth(ctx context.Context, accountID string, r *reportsDomain.Range) ([]*domain.NetWorthPoint, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.NetWorthPoint), args.Error(1)
+}
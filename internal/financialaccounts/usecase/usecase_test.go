@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	extractsDomain "transaction-tracker/internal/extracts/domain"
+	"transaction-tracker/internal/financialaccounts/domain"
+	"transaction-tracker/internal/financialaccounts/repository"
+	reportsDomain "transaction-tracker/internal/reports/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newUsecase(repo repository.FinancialAccountRepository) *financialAccountsUsecase {
+	return &financialAccountsUsecase{
+		repo:       repo,
+		transactor: postgres.NewMockTransactor(),
+		nowFunc:    func() time.Time { return fixedTime },
+	}
+}
+
+func newFinancialAccounts() []*domain.FinancialAccount {
+	return []*domain.FinancialAccount{
+		{ID: "FAC1", AccountID: "acc1", InstitutionID: "davivienda", Name: "Savings", Kind: domain.Savings},
+		{ID: "FAC2", AccountID: "acc1", InstitutionID: "visa", Name: "Card", Kind: domain.CreditCard},
+	}
+}
+
+// newLedgerRepo returns a savings account whose July statement closed at 1000 and a card
+// without anchors.
+func newLedgerRepo() *repository.MockFinancialAccountRepository {
+	repo := new(repository.MockFinancialAccountRepository)
+	repo.On("GetFinancialAccounts", mock.Anything, "acc1").Return(newFinancialAccounts(), nil)
+	repo.On("GetAnchors", mock.Anything, "acc1").Return([]*domain.Anchor{
+		{FinancialAccountID: "FAC1", AccountID: "acc1", Date: day(time.August, 1), Balance: 1000},
+	}, nil)
+	repo.On("GetFlows", mock.Anything, "acc1").Return([]*domain.Flow{
+		{FinancialAccountID: "FAC1", Date: day(time.July, 15), Net: 200},
+		{FinancialAccountID: "FAC1", Date: day(time.August, 10), Net: -300},
+		{FinancialAccountID: "FAC2", Date: day(time.August, 20), Net: -400},
+		{FinancialAccountID: "FAC1", Date: day(time.September, 5), Net: 100},
+	}, nil)
+
+	return repo
+}
+
+func TestCreateFinancialAccount(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+		account := newFinancialAccounts()[0]
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("CreateFinancialAccount", ctx, account).Return(nil).Once()
+		repo.On("LinkMovements", ctx, account).Return(int64(4), nil).Once()
+
+		c.NoError(newUsecase(repo).CreateFinancialAccount(ctx, account))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("link error", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+		account := newFinancialAccounts()[0]
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("CreateFinancialAccount", ctx, account).Return(nil).Once()
+		repo.On("LinkMovements", ctx, account).Return(int64(0), errors.New("db error")).Once()
+
+		c.Error(newUsecase(repo).CreateFinancialAccount(ctx, account))
+	})
+}
+
+func TestUpdateFinancialAccount(t *testing.T) {
+	t.Run("keeps the institution", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByID", ctx, "FAC1", "acc1").Return(newFinancialAccounts()[0], nil).Once()
+		repo.On("UpdateFinancialAccount", ctx, mock.Anything).Return(nil).Once()
+
+		account := &domain.FinancialAccount{ID: "FAC1", AccountID: "acc1", Name: "Nómina", Kind: domain.Checking}
+		c.NoError(newUsecase(repo).UpdateFinancialAccount(ctx, account))
+		c.Equal("davivienda", account.InstitutionID)
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByID", ctx, "FAC1", "acc1").Return(newFinancialAccounts()[0], nil).Once()
+
+		account := &domain.FinancialAccount{ID: "FAC1", AccountID: "acc1", Name: "Savings", Kind: "crypto"}
+		c.ErrorIs(newUsecase(repo).UpdateFinancialAccount(ctx, account), domain.ErrInvalidFinancialAccount)
+		repo.AssertNotCalled(t, "UpdateFinancialAccount", mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByID", ctx, "FAC1", "acc1").Return(nil, ErrFinancialAccountNotFound).Once()
+
+		account := &domain.FinancialAccount{ID: "FAC1", AccountID: "acc1", Name: "Savings", Kind: domain.Savings}
+		c.ErrorIs(newUsecase(repo).UpdateFinancialAccount(ctx, account), ErrFinancialAccountNotFound)
+	})
+}
+
+func TestDeleteFinancialAccount(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockFinancialAccountRepository)
+	repo.On("UnlinkMovements", ctx, "FAC1", "acc1").Return(nil).Once()
+	repo.On("DeleteFinancialAccount", ctx, "FAC1", "acc1").Return(nil).Once()
+
+	c.NoError(newUsecase(repo).DeleteFinancialAccount(ctx, "FAC1", "acc1"))
+	repo.AssertExpectations(t)
+}
+
+func TestSaveAnchor(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByID", ctx, "FAC1", "acc1").Return(newFinancialAccounts()[0], nil).Once()
+		repo.On("SaveAnchor", ctx, mock.Anything).Return(nil).Once()
+
+		anchor, err := newUsecase(repo).SaveAnchor(ctx, "FAC1", "acc1", "2025-09-15", 500)
+		c.NoError(err)
+		c.Equal(day(time.September, 16), anchor.Date)
+		c.Equal(domain.ManualSource, anchor.Source)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByID", ctx, "FAC1", "acc1").Return(newFinancialAccounts()[0], nil).Once()
+
+		_, err := newUsecase(repo).SaveAnchor(ctx, "FAC1", "acc1", "15/09/2025", 500)
+		c.ErrorIs(err, domain.ErrInvalidAnchor)
+	})
+}
+
+func TestResolveFinancialAccount(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockFinancialAccountRepository)
+	repo.On("GetFinancialAccountByInstitution", ctx, "acc1", "davivienda").Return(newFinancialAccounts()[0], nil).Once()
+	repo.On("GetFinancialAccountByInstitution", ctx, "acc1", "manual").Return(nil, repository.ErrFinancialAccountNotFound).Once()
+	repo.On("GetFinancialAccountByInstitution", ctx, "acc1", "visa").Return(nil, errors.New("db error")).Once()
+
+	u := newUsecase(repo)
+
+	id, err := u.ResolveFinancialAccount(ctx, "acc1", "davivienda")
+	c.NoError(err)
+	c.Equal("FAC1", id)
+
+	id, err = u.ResolveFinancialAccount(ctx, "acc1", "manual")
+	c.NoError(err)
+	c.Empty(id)
+
+	_, err = u.ResolveFinancialAccount(ctx, "acc1", "visa")
+	c.Error(err)
+}
+
+func TestAnchorStatement(t *testing.T) {
+	closing := 947232.64
+
+	t.Run("saves the closing balance", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		extract := &extractsDomain.Extract{ID: "EXI1", AccountID: "acc1", InstitutionID: "davivienda", Month: time.March, Year: 2021, ClosingBalance: &closing}
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByInstitution", ctx, "acc1", "davivienda").Return(newFinancialAccounts()[0], nil).Once()
+		repo.On("SaveAnchor", ctx, mock.MatchedBy(func(anchor *domain.Anchor) bool {
+			return anchor.FinancialAccountID == "FAC1" &&
+				anchor.ExtractID == "EXI1" &&
+				anchor.Balance == closing &&
+				anchor.Date.Equal(time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC))
+		})).Return(nil).Once()
+
+		c.NoError(newUsecase(repo).AnchorStatement(ctx, extract))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("skips extracts without closing balance", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockFinancialAccountRepository)
+
+		extract := &extractsDomain.Extract{ID: "EXI1", AccountID: "acc1", InstitutionID: "davivienda"}
+		c.NoError(newUsecase(repo).AnchorStatement(context.Background(), extract))
+		repo.AssertNotCalled(t, "GetFinancialAccountByInstitution", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("skips institutions without financial account", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccountByInstitution", ctx, "acc1", "davivienda").Return(nil, repository.ErrFinancialAccountNotFound).Once()
+
+		extract := &extractsDomain.Extract{ID: "EXI1", AccountID: "acc1", InstitutionID: "davivienda", ClosingBalance: &closing}
+		c.NoError(newUsecase(repo).AnchorStatement(ctx, extract))
+		repo.AssertNotCalled(t, "SaveAnchor", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetSummaries(t *testing.T) {
+	c := require.New(t)
+
+	summaries, err := newUsecase(newLedgerRepo()).GetSummaries(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(summaries, 2)
+	c.Equal(800.0, summaries[0].Balance)
+	c.Equal(-400.0, summaries[1].Balance)
+}
+
+func TestGetNetWorth(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		r := &reportsDomain.Range{From: day(time.July, 1), To: day(time.November, 1), Granularity: reportsDomain.Month}
+
+		points, err := newUsecase(newLedgerRepo()).GetNetWorth(context.Background(), "acc1", r)
+		c.NoError(err)
+		c.Len(points, 3)
+
+		c.Equal(day(time.July, 31), points[0].Date)
+		c.Equal(1000.0, points[0].NetWorth)
+
+		c.Equal(700.0, points[1].Assets)
+		c.Equal(-400.0, points[1].Liabilities)
+		c.Equal(300.0, points[1].NetWorth)
+
+		c.Equal(day(time.September, 20), points[2].Date)
+		c.Equal(400.0, points[2].NetWorth)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockFinancialAccountRepository)
+		repo.On("GetFinancialAccounts", mock.Anything, "acc1").Return(nil, errors.New("db error"))
+
+		r := &reportsDomain.Range{From: day(time.July, 1), To: day(time.October, 1), Granularity: reportsDomain.Month}
+
+		_, err := newUsecase(repo).GetNetWorth(context.Background(), "acc1", r)
+		c.Error(err)
+	})
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_goal_prefix = "GOA"
+
+	// DateLayout is the layout target dates are written with in requests.
+	DateLayout = "2006-01-02"
+
+	maxNameLength = 100
+)
+
+var (
+	// ErrInvalidGoal is returned when a goal has invalid fields.
+	ErrInvalidGoal = errors.New("invalid goal")
+	// ErrCategoryTaken is returned when another goal of the account already collects the category.
+	ErrCategoryTaken = errors.New("category is already collected by another goal")
+)
+
+// Goal is an amount an account wants to save by a date. Movements are allocated to it
+// manually or, when it has a Category, automatically: every movement of the category made
+// since the goal was created counts towards it.
+type Goal struct {
+	ID           string
+	AccountID    string
+	Name         string
+	TargetAmount float64
+	TargetDate   time.Time
+	Category     movementsDomain.MovementCategory
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (g *Goal) LogProperties() map[string]string {
+	return map[string]string{
+		"goal_id":       g.ID,
+		"account_id":    g.AccountID,
+		"name":          g.Name,
+		"target_amount": strconv.FormatFloat(g.TargetAmount, 'f', 2, 64),
+		"target_date":   g.TargetDate.Format(DateLayout),
+		"category":      string(g.Category),
+	}
+}
+
+// NewGoal creates a goal of the account with a target date written as DateLayout.
+func NewGoal(accountID string, name string, targetAmount float64, targetDate string, category string) (*Goal, error) {
+	date, err := ParseDate(targetDate)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := &Goal{
+		ID:           _goal_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:    accountID,
+		Name:         strings.TrimSpace(name),
+		TargetAmount: targetAmount,
+		TargetDate:   date,
+		Category:     movementsDomain.MovementCategory(category),
+	}
+
+	err = goal.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return goal, nil
+}
+
+// Validate checks the fields of the goal.
+func (g *Goal) Validate() error {
+	if g.Name == "" || len(g.Name) > maxNameLength {
+		return fmt.Errorf("%w: name is required and must have at most %d characters", ErrInvalidGoal, maxNameLength)
+	}
+
+	if g.TargetAmount <= 0 {
+		return fmt.Errorf("%w: target amount must be greater than zero", ErrInvalidGoal)
+	}
+
+	if g.TargetDate.IsZero() {
+		return fmt.Errorf("%w: target date is required", ErrInvalidGoal)
+	}
+
+	return nil
+}
+
+// ParseDate returns the date written as DateLayout, in UTC.
+func ParseDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q must look like %s", ErrInvalidGoal, date, DateLayout)
+	}
+
+	return parsed, nil
+}
+
+// Contribution is what was saved towards a goal during a month. Withdrawals make it negative.
+type Contribution struct {
+	Month  time.Time
+	Amount float64
+}
+
+// Progress is how far a goal is from its target. AverageMonthly is the average contribution
+// of the months since the goal started, and ProjectedAt is when the target is reached at
+// that pace, nil when nothing is being saved. RequiredMonthly is what it takes per month to
+// reach the target on time.
+type Progress struct {
+	Goal            *Goal
+	Saved           float64
+	Remaining       float64
+	Percent         float64
+	AverageMonthly  float64
+	RequiredMonthly float64
+	ProjectedAt     *time.Time
+	Completed       bool
+	OnTrack         bool
+}
+
+// NewProgress computes the progress of the goal from its monthly contributions as of now.
+func NewProgress(goal *Goal, contributions []*Contribution, now time.Time) *Progress {
+	progress := &Progress{Goal: goal}
+
+	start := monthOf(goal.CreatedAt)
+	for _, contribution := range contributions {
+		progress.Saved += contribution.Amount
+
+		if contribution.Month.Before(start) {
+			start = monthOf(contribution.Month)
+		}
+	}
+
+	progress.Remaining = math.Max(goal.TargetAmount-progress.Saved, 0)
+	progress.Percent = math.Round(progress.Saved/goal.TargetAmount*10000) / 100
+	progress.Completed = progress.Remaining == 0
+
+	months := monthsBetween(start, now) + 1
+	progress.AverageMonthly = math.Round(progress.Saved/float64(months)*100) / 100
+
+	if progress.Completed {
+		progress.OnTrack = true
+		return progress
+	}
+
+	if left := monthsBetween(now, goal.TargetDate); left > 0 {
+		progress.RequiredMonthly = math.Round(progress.Remaining/float64(left)*100) / 100
+	} else {
+		progress.RequiredMonthly = progress.Remaining
+	}
+
+	if progress.AverageMonthly > 0 {
+		projected := now.AddDate(0, int(math.Ceil(progress.Remaining/progress.AverageMonthly)), 0)
+		progress.ProjectedAt = &projected
+		progress.OnTrack = !projected.After(goal.TargetDate)
+	}
+
+	return progress
+}
+
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthsBetween returns the calendar months from the month of from to the month of to.
+func monthsBetween(from time.Time, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewGoal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		goal, err := NewGoal("acc1", " Emergency fund ", 10000000, "2025-12-31", "savings")
+		c.NoError(err)
+		c.Contains(goal.ID, _goal_prefix)
+		c.Equal("Emergency fund", goal.Name)
+		c.Equal(time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), goal.TargetDate)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := require.New(t)
+
+		_, err := NewGoal("acc1", "", 10000000, "2025-12-31", "")
+		c.ErrorIs(err, ErrInvalidGoal)
+
+		_, err = NewGoal("acc1", "Trip", 0, "2025-12-31", "")
+		c.ErrorIs(err, ErrInvalidGoal)
+
+		_, err = NewGoal("acc1", "Trip", 100, "December", "")
+		c.ErrorIs(err, ErrInvalidGoal)
+	})
+}
+
+func TestNewProgress(t *testing.T) {
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	goal := &Goal{
+		TargetAmount: 10000000,
+		TargetDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		CreatedAt:    time.Date(2025, 7, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("on track", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(goal, []*Contribution{
+			{Month: month(2025, 7), Amount: 2000000},
+			{Month: month(2025, 8), Amount: 2500000},
+			{Month: month(2025, 9), Amount: 1500000},
+		}, now)
+
+		c.Equal(6000000.0, progress.Saved)
+		c.Equal(4000000.0, progress.Remaining)
+		c.Equal(60.0, progress.Percent)
+		c.Equal(2000000.0, progress.AverageMonthly)
+		c.InDelta(1333333.33, progress.RequiredMonthly, 0.01)
+		c.Equal(time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC), *progress.ProjectedAt)
+		c.True(progress.OnTrack)
+		c.False(progress.Completed)
+	})
+
+	t.Run("behind", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(goal, []*Contribution{
+			{Month: month(2025, 8), Amount: 900000},
+		}, now)
+
+		c.Equal(300000.0, progress.AverageMonthly)
+		c.False(progress.OnTrack)
+		c.True(progress.ProjectedAt.After(goal.TargetDate))
+	})
+
+	t.Run("nothing saved", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(goal, nil, now)
+		c.Nil(progress.ProjectedAt)
+		c.False(progress.OnTrack)
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		c := require.New(t)
+
+		progress := NewProgress(goal, []*Contribution{{Month: month(2025, 9), Amount: 11000000}}, now)
+		c.True(progress.Completed)
+		c.True(progress.OnTrack)
+		c.Zero(progress.Remaining)
+		c.Equal(110.0, progress.Percent)
+	})
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/goals/domain"
+)
+
+// GoalRepository stores the savings goals of each account and the movements allocated to them.
+type GoalRepository interface {
+	CreateGoal(ctx context.Context, goal *domain.Goal) error
+	GetGoalByID(ctx context.Context, id string, accountID string) (*domain.Goal, error)
+	GetGoalsByAccountID(ctx context.Context, accountID string) ([]*domain.Goal, error)
+	UpdateGoal(ctx context.Context, goal *domain.Goal) error
+	DeleteGoal(ctx context.Context, id string, accountID string) error
+	AllocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error
+	DeallocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error
+	GetContributions(ctx context.Context, goal *domain.Goal) ([]*domain.Contribution, error)
+}
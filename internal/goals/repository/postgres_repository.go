@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/goals/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	goalColumns = `id, account_id, name, target_amount, target_date, category, created_at, updated_at`
+)
+
+var (
+	ErrGoalNotFound = errors.New("goal not found")
+	// ErrMovementNotFound is returned when allocating a movement the account does not have.
+	ErrMovementNotFound = errors.New("movement not found")
+	// ErrAllocationNotFound is returned when deallocating a movement that is not allocated to the goal.
+	ErrAllocationNotFound = errors.New("movement is not allocated to the goal")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the goals repository.
+func NewPostgresRepository(db *pgxpool.Pool) GoalRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// categoryTaken maps the unique index on the goal category to domain.ErrCategoryTaken.
+func categoryTaken(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return domain.ErrCategoryTaken
+	}
+
+	return err
+}
+
+// CreateGoal inserts a new goal. It returns domain.ErrCategoryTaken when another goal of the
+// account collects the same category.
+func (r *postgresRepository) CreateGoal(ctx context.Context, goal *domain.Goal) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO goals (` + goalColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $7)`
+
+	_, err := r.db.Exec(ctx, query,
+		goal.ID,
+		goal.AccountID,
+		goal.Name,
+		goal.TargetAmount,
+		goal.TargetDate,
+		goal.Category,
+		now)
+	if err != nil {
+		return categoryTaken(err)
+	}
+
+	goal.CreatedAt = now
+	goal.UpdatedAt = now
+
+	return nil
+}
+
+// GetGoalByID returns a goal of the account.
+func (r *postgresRepository) GetGoalByID(ctx context.Context, id string, accountID string) (*domain.Goal, error) {
+	query := `SELECT ` + goalColumns + `
+	FROM goals
+	WHERE id = $1 AND account_id = $2`
+
+	goal, err := scanToGoal(r.db.QueryRow(ctx, query, id, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGoalNotFound
+	}
+
+	return goal, err
+}
+
+// GetGoalsByAccountID returns the goals of the account, the closest target date first.
+func (r *postgresRepository) GetGoalsByAccountID(ctx context.Context, accountID string) ([]*domain.Goal, error) {
+	query := `SELECT ` + goalColumns + `
+	FROM goals
+	WHERE account_id = $1
+	ORDER BY target_date, name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	goals := []*domain.Goal{}
+	for rows.Next() {
+		goal, err := scanToGoal(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		goals = append(goals, goal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+// UpdateGoal saves the editable fields of a goal.
+func (r *postgresRepository) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	now := r.nowFunc()
+
+	query := `UPDATE goals
+	SET name = $1, target_amount = $2, target_date = $3, category = $4, updated_at = $5
+	WHERE id = $6 AND account_id = $7`
+
+	tag, err := r.db.Exec(ctx, query, goal.Name, goal.TargetAmount, goal.TargetDate, goal.Category, now, goal.ID, goal.AccountID)
+	if err != nil {
+		return categoryTaken(err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrGoalNotFound
+	}
+
+	goal.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteGoal removes a goal of the account together with its allocations.
+func (r *postgresRepository) DeleteGoal(ctx context.Context, id string, accountID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM goals WHERE id = $1 AND account_id = $2`, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrGoalNotFound
+	}
+
+	return nil
+}
+
+// AllocateMovement counts a movement of the account towards the goal. A movement belongs to
+// a single goal, so it is moved from the goal it was allocated to, if any.
+func (r *postgresRepository) AllocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error {
+	query := `INSERT INTO goal_allocations (movement_id, goal_id, account_id, created_at)
+	SELECT id, $2, account_id, $4 FROM movements WHERE id = $1 AND account_id = $3
+	ON CONFLICT (movement_id) DO UPDATE SET goal_id = EXCLUDED.goal_id, created_at = EXCLUDED.created_at`
+
+	tag, err := r.db.Exec(ctx, query, movementID, goal.ID, goal.AccountID, r.nowFunc())
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMovementNotFound
+	}
+
+	return nil
+}
+
+// DeallocateMovement removes a manual allocation of the goal.
+func (r *postgresRepository) DeallocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error {
+	query := `DELETE FROM goal_allocations WHERE movement_id = $1 AND goal_id = $2 AND account_id = $3`
+
+	tag, err := r.db.Exec(ctx, query, movementID, goal.ID, goal.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrAllocationNotFound
+	}
+
+	return nil
+}
+
+// GetContributions returns what was saved towards the goal per month, oldest first. Movements
+// allocated manually count wherever they are allocated; the rest count towards the goal of
+// their category when made since the goal was created, split movements by the category of
+// each part. Incomes count as withdrawals.
+func (r *postgresRepository) GetContributions(ctx context.Context, goal *domain.Goal) ([]*domain.Contribution, error) {
+	query := `SELECT date_trunc('month', m.date) AS month,
+	SUM(CASE WHEN m.type = $3 THEN -COALESCE(sp.amount, m.amount) ELSE COALESCE(sp.amount, m.amount) END)
+	FROM movements m
+	LEFT JOIN movement_splits sp ON sp.movement_id = m.id
+	LEFT JOIN goal_allocations a ON a.movement_id = m.id
+	WHERE m.account_id = $1
+	AND (a.goal_id = $2 OR (a.goal_id IS NULL AND $4 <> '' AND COALESCE(sp.category, m.category) = $4 AND m.date >= $5))
+	GROUP BY month
+	ORDER BY month`
+
+	rows, err := r.db.Query(ctx, query, goal.AccountID, goal.ID, string(movementsDomain.Income), string(goal.Category), goal.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	contributions := []*domain.Contribution{}
+	for rows.Next() {
+		contribution := &domain.Contribution{}
+
+		err := rows.Scan(&contribution.Month, &contribution.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		contributions = append(contributions, contribution)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contributions, nil
+}
+
+func scanToGoal(scanFn func(...any) error) (*domain.Goal, error) {
+	goal := &domain.Goal{}
+
+	var category string
+
+	err := scanFn(&goal.ID, &goal.AccountID, &goal.Name, &goal.TargetAmount, &goal.TargetDate, &category, &goal.CreatedAt, &goal.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	goal.Category = movementsDomain.MovementCategory(category)
+
+	return goal, nil
+}
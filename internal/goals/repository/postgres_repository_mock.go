@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/goals/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGoalRepository is a mock of the repository interface.
+type MockGoalRepository struct {
+	mock.Mock
+}
+
+func (m *MockGoalRepository) CreateGoal(ctx context.Context, goal *domain.Goal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) GetGoalByID(ctx context.Context, id string, accountID string) (*domain.Goal, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockGoalRepository) GetGoalsByAccountID(ctx context.Context, accountID string) ([]*domain.Goal, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Goal), args.Error(1)
+}
+
+func (m *MockGoalRepository) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) DeleteGoal(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) AllocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error {
+	args := m.Called(ctx, goal, movementID)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) DeallocateMovement(ctx context.Context, goal *domain.Goal, movementID string) error {
+	args := m.Called(ctx, goal, movementID)
+	return args.Error(0)
+}
+
+func (m *MockGoalRepository) GetContributions(ctx context.Context, goal *domain.Goal) ([]*domain.Contribution, error) {
+	args := m.Called(ctx, goal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Contribution), args.Error(1)
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/goals/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime  = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	targetDate = time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+func setupMockDB(t *testing.T) (GoalRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newGoal() *domain.Goal {
+	return &domain.Goal{
+		ID:           "GOA1",
+		AccountID:    "acc1",
+		Name:         "Trip",
+		TargetAmount: 3000000,
+		TargetDate:   targetDate,
+		Category:     movementsDomain.Savings,
+		CreatedAt:    fixedTime,
+	}
+}
+
+func TestCreateGoal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		goal := newGoal()
+		goal.CreatedAt = time.Time{}
+
+		mock.ExpectExec(`INSERT INTO goals`).
+			WithArgs("GOA1", "acc1", "Trip", 3000000.0, targetDate, movementsDomain.Savings, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateGoal(context.Background(), goal))
+		c.Equal(fixedTime, goal.CreatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("category taken", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO goals`).
+			WithArgs("GOA1", "acc1", "Trip", 3000000.0, targetDate, movementsDomain.Savings, fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateGoal(context.Background(), newGoal())
+		require.ErrorIs(t, err, domain.ErrCategoryTaken)
+	})
+}
+
+func TestGetGoalByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows([]string{"id", "account_id", "name", "target_amount", "target_date", "category", "created_at", "updated_at"}).
+			AddRow("GOA1", "acc1", "Trip", 3000000.0, targetDate, "savings", fixedTime, fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM goals WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("GOA1", "acc1").
+			WillReturnRows(rows)
+
+		goal, err := repo.GetGoalByID(context.Background(), "GOA1", "acc1")
+		c.NoError(err)
+		c.Equal(movementsDomain.Savings, goal.Category)
+		c.Equal(targetDate, goal.TargetDate)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM goals`).
+			WithArgs("GOA1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetGoalByID(context.Background(), "GOA1", "acc1")
+		require.ErrorIs(t, err, ErrGoalNotFound)
+	})
+}
+
+func TestDeleteGoal_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`DELETE FROM goals`).
+		WithArgs("GOA1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	require.ErrorIs(t, repo.DeleteGoal(context.Background(), "GOA1", "acc1"), ErrGoalNotFound)
+}
+
+func TestAllocateMovement(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO goal_allocations (.+) ON CONFLICT \(movement_id\) DO UPDATE`).
+			WithArgs("MID1", "GOA1", "acc1", fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		require.NoError(t, repo.AllocateMovement(context.Background(), newGoal(), "MID1"))
+	})
+
+	t.Run("movement not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO goal_allocations`).
+			WithArgs("MID1", "GOA1", "acc1", fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		require.ErrorIs(t, repo.AllocateMovement(context.Background(), newGoal(), "MID1"), ErrMovementNotFound)
+	})
+}
+
+func TestDeallocateMovement_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`DELETE FROM goal_allocations`).
+		WithArgs("MID1", "GOA1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	require.ErrorIs(t, repo.DeallocateMovement(context.Background(), newGoal(), "MID1"), ErrAllocationNotFound)
+}
+
+func TestGetContributions(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	august := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	september := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"month", "sum"}).
+		AddRow(august, 500000.0).
+		AddRow(september, 250000.0)
+
+	mock.ExpectQuery(`SELECT date_trunc\('month', m.date\) (.+) LEFT JOIN goal_allocations`).
+		WithArgs("acc1", "GOA1", string(movementsDomain.Income), "savings", fixedTime).
+		WillReturnRows(rows)
+
+	contributions, err := repo.GetContributions(context.Background(), newGoal())
+	c.NoError(err)
+	c.Equal([]*domain.Contribution{{Month: august, Amount: 500000}, {Month: september, Amount: 250000}}, contributions)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetContributions_Splits(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	august := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := pgxmock.NewRows([]string{"month", "sum"}).
+		AddRow(august, 120000.0)
+
+	mock.ExpectQuery(`LEFT JOIN movement_splits sp ON sp.movement_id = m.id (.+) COALESCE\(sp.category, m.category\) = \$4`).
+		WithArgs("acc1", "GOA1", string(movementsDomain.Income), "savings", fixedTime).
+		WillReturnRows(rows)
+
+	contributions, err := repo.GetContributions(context.Background(), newGoal())
+	c.NoError(err)
+	c.Equal([]*domain.Contribution{{Month: august, Amount: 120000}}, contributions)
+	c.NoError(mock.ExpectationsWereMet())
+}
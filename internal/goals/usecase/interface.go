@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/goals/domain"
+)
+
+// GoalsUsecase manages the savings goals of an account, the movements allocated to them and
+// their progress.
+type GoalsUsecase interface {
+	CreateGoal(ctx context.Context, goal *domain.Goal) error
+	GetGoal(ctx context.Context, id string, accountID string) (*domain.Goal, error)
+	UpdateGoal(ctx context.Context, goal *domain.Goal) error
+	DeleteGoal(ctx context.Context, id string, accountID string) error
+	AllocateMovement(ctx context.Context, id string, accountID string, movementID string) error
+	DeallocateMovement(ctx context.Context, id string, accountID string, movementID string) error
+	GetProgress(ctx context.Context, id string, accountID string) (*domain.Progress, error)
+	GetProgressList(ctx context.Context, accountID string) ([]*domain.Progress, error)
+}
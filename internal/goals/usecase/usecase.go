@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	"transaction-tracker/internal/goals/domain"
+	"transaction-tracker/internal/goals/repository"
+)
+
+var (
+	ErrGoalNotFound       = repository.ErrGoalNotFound
+	ErrMovementNotFound   = repository.ErrMovementNotFound
+	ErrAllocationNotFound = repository.ErrAllocationNotFound
+)
+
+type goalsUsecase struct {
+	repo              repository.GoalRepository
+	categoriesUsecase categoriesUsecase.CategoriesUsecase
+	nowFunc           func() time.Time
+}
+
+// NewGoalsUsecase creates a new instance of GoalsUsecase. Goal categories are checked against
+// the tree in catUsecase.
+func NewGoalsUsecase(repo repository.GoalRepository, catUsecase categoriesUsecase.CategoriesUsecase) GoalsUsecase {
+	return &goalsUsecase{
+		repo:              repo,
+		categoriesUsecase: catUsecase,
+		nowFunc:           time.Now,
+	}
+}
+
+// CreateGoal validates and stores a new goal.
+func (u *goalsUsecase) CreateGoal(ctx context.Context, goal *domain.Goal) error {
+	err := u.validate(ctx, goal)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.CreateGoal(ctx, goal)
+}
+
+func (u *goalsUsecase) GetGoal(ctx context.Context, id string, accountID string) (*domain.Goal, error) {
+	return u.repo.GetGoalByID(ctx, id, accountID)
+}
+
+// UpdateGoal saves the name, target and category of a goal.
+func (u *goalsUsecase) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	err := u.validate(ctx, goal)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateGoal(ctx, goal)
+}
+
+func (u *goalsUsecase) DeleteGoal(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteGoal(ctx, id, accountID)
+}
+
+// AllocateMovement counts a movement towards the goal, whatever its category.
+func (u *goalsUsecase) AllocateMovement(ctx context.Context, id string, accountID string, movementID string) error {
+	goal, err := u.repo.GetGoalByID(ctx, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.AllocateMovement(ctx, goal, movementID)
+}
+
+// DeallocateMovement stops counting a manually allocated movement towards the goal.
+func (u *goalsUsecase) DeallocateMovement(ctx context.Context, id string, accountID string, movementID string) error {
+	goal, err := u.repo.GetGoalByID(ctx, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.DeallocateMovement(ctx, goal, movementID)
+}
+
+// GetProgress returns how far the goal is from its target and when it is projected to be
+// reached.
+func (u *goalsUsecase) GetProgress(ctx context.Context, id string, accountID string) (*domain.Progress, error) {
+	goal, err := u.repo.GetGoalByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.progress(ctx, goal)
+}
+
+// GetProgressList returns the progress of every goal of the account.
+func (u *goalsUsecase) GetProgressList(ctx context.Context, accountID string) ([]*domain.Progress, error) {
+	goals, err := u.repo.GetGoalsByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]*domain.Progress, 0, len(goals))
+	for _, goal := range goals {
+		p, err := u.progress(ctx, goal)
+		if err != nil {
+			return nil, err
+		}
+
+		progress = append(progress, p)
+	}
+
+	return progress, nil
+}
+
+func (u *goalsUsecase) progress(ctx context.Context, goal *domain.Goal) (*domain.Progress, error) {
+	contributions, err := u.repo.GetContributions(ctx, goal)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewProgress(goal, contributions, u.nowFunc()), nil
+}
+
+func (u *goalsUsecase) validate(ctx context.Context, goal *domain.Goal) error {
+	err := goal.Validate()
+	if err != nil {
+		return err
+	}
+
+	if goal.Category == "" {
+		return nil
+	}
+
+	return u.categoriesUsecase.ValidateCategory(ctx, goal.AccountID, goal.Category)
+}
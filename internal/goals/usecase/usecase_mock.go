@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/goals/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGoalsUsecase is a mock implementation of the GoalsUsecase interface.
+type MockGoalsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockGoalsUsecase) CreateGoal(ctx context.Context, goal *domain.Goal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockGoalsUsecase) GetGoal(ctx context.Context, id string, accountID string) (*domain.Goal, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Goal), args.Error(1)
+}
+
+func (m *MockGoalsUsecase) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockGoalsUsecase) DeleteGoal(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockGoalsUsecase) AllocateMovement(ctx context.Context, id string, accountID string, movementID string) error {
+	args := m.Called(ctx, id, accountID, movementID)
+	return args.Error(0)
+}
+
+func (m *MockGoalsUsecase) DeallocateMovement(ctx context.Context, id string, accountID string, movementID string) error {
+	args := m.Called(ctx, id, accountID, movementID)
+	return args.Error(0)
+}
+
+func (m *MockGoalsUsecase) GetProgress(ctx context.Context, id string, accountID string) (*domain.Progress, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Progress), args.Error(1)
+}
+
+func (m *MockGoalsUsecase) GetProgressList(ctx context.Context, accountID string) ([]*domain.Progress, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Progress), args.Error(1)
+}
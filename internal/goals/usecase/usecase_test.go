@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	"transaction-tracker/internal/goals/domain"
+	"transaction-tracker/internal/goals/repository"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newGoal() *domain.Goal {
+	return &domain.Goal{
+		ID:           "GOA1",
+		AccountID:    "acc1",
+		Name:         "Trip",
+		TargetAmount: 1000,
+		TargetDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		Category:     movementsDomain.Savings,
+		CreatedAt:    time.Date(2025, 7, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestCreateGoal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+		goal := newGoal()
+
+		categories := new(categoriesUsecase.MockCategoriesUsecase)
+		categories.On("ValidateCategory", ctx, "acc1", movementsDomain.Savings).Return(nil)
+
+		repo := new(repository.MockGoalRepository)
+		repo.On("CreateGoal", ctx, goal).Return(nil)
+
+		u := NewGoalsUsecase(repo, categories)
+
+		c.NoError(u.CreateGoal(ctx, goal))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		ctx := context.Background()
+		errUnknown := errors.New("unknown category")
+
+		categories := new(categoriesUsecase.MockCategoriesUsecase)
+		categories.On("ValidateCategory", ctx, "acc1", movementsDomain.Savings).Return(errUnknown)
+
+		repo := new(repository.MockGoalRepository)
+
+		u := NewGoalsUsecase(repo, categories)
+
+		require.ErrorIs(t, u.CreateGoal(ctx, newGoal()), errUnknown)
+		repo.AssertNotCalled(t, "CreateGoal", mock.Anything, mock.Anything)
+	})
+
+	t.Run("without category", func(t *testing.T) {
+		ctx := context.Background()
+		goal := newGoal()
+		goal.Category = ""
+
+		categories := new(categoriesUsecase.MockCategoriesUsecase)
+
+		repo := new(repository.MockGoalRepository)
+		repo.On("CreateGoal", ctx, goal).Return(nil)
+
+		u := NewGoalsUsecase(repo, categories)
+
+		require.NoError(t, u.CreateGoal(ctx, goal))
+		categories.AssertNotCalled(t, "ValidateCategory", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAllocateMovement_GoalNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(repository.MockGoalRepository)
+	repo.On("GetGoalByID", ctx, "GOA1", "acc1").Return(nil, ErrGoalNotFound)
+
+	u := NewGoalsUsecase(repo, new(categoriesUsecase.MockCategoriesUsecase))
+
+	require.ErrorIs(t, u.AllocateMovement(ctx, "GOA1", "acc1", "MID1"), ErrGoalNotFound)
+	repo.AssertNotCalled(t, "AllocateMovement", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetProgress(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+	goal := newGoal()
+	contributions := []*domain.Contribution{
+		{Month: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Amount: 100},
+		{Month: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), Amount: 100},
+		{Month: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), Amount: 100},
+	}
+
+	repo := new(repository.MockGoalRepository)
+	repo.On("GetGoalByID", ctx, "GOA1", "acc1").Return(goal, nil)
+	repo.On("GetContributions", ctx, goal).Return(contributions, nil)
+
+	u := &goalsUsecase{repo: repo, nowFunc: func() time.Time { return fixedTime }}
+
+	progress, err := u.GetProgress(ctx, "GOA1", "acc1")
+	c.NoError(err)
+	c.Equal(domain.NewProgress(goal, contributions, fixedTime), progress)
+	c.Equal(300.0, progress.Saved)
+	c.NotNil(progress.ProjectedAt)
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_merchant_prefix = "MER"
+)
+
+var (
+	// cardSuffixPattern matches card and terminal suffixes such as *COL, *1234 or #0042.
+	cardSuffixPattern = regexp.MustCompile(`[*#][A-Z0-9]*`)
+	// legalSuffixPattern matches legal entity suffixes written with dots, such as S.A.S.
+	legalSuffixPattern = regexp.MustCompile(`(^|\s)(S\.?\s?A\.?\s?S|S\.?\s?A|LTDA)\.?(\s|$)`)
+	// hostPattern matches web hosts such as HELP.UBER.COM, keeping the name of the site.
+	hostPattern    = regexp.MustCompile(`\b(?:[A-Z0-9-]+\.)*?([A-Z0-9-]+)\.(?:COM|NET|ORG|IO|CO)(?:\.[A-Z]{2})?\b`)
+	nonWordPattern = regexp.MustCompile(`[^A-Z0-9&]+`)
+	digitsPattern  = regexp.MustCompile(`\b[0-9]+\b`)
+
+	accents = strings.NewReplacer("Á", "A", "É", "E", "Í", "I", "Ó", "O", "Ú", "U", "Ü", "U", "Ñ", "N")
+
+	// bankPrefixes are the movement classes banks put before the merchant, as in the
+	// "Descuento COMPRA" of Davivienda alerts. They are only removed at the start.
+	bankPrefixes = []string{
+		"DESCUENTO", "ABONO", "COMPRA", "COMPRAS", "PAGO", "PAGOS", "EN", "DE", "A", "POS",
+		"PSE", "DEBITO", "AUTOMATICO", "RETIRO", "TRANSFERENCIA", "TRASLADO", "INTERNACIONAL",
+		"NACIONAL", "TARJETA", "TC", "TD", "AVANCE", "CARGO",
+	}
+
+	// noiseWords are dropped anywhere: countries and legal entity suffixes.
+	noiseWords = []string{"COL", "CO", "COLOMBIA", "SAS", "SA", "LTDA", "INC", "LLC", "BIC"}
+
+	// cities are the city names added by card processors, longest first so multi word
+	// names are removed before their parts.
+	cities = []string{
+		"SANTA MARTA", "SAN ANDRES", "BOGOTA DC", "BOGOTA D C", "BOGOTA", "MEDELLIN", "CALI",
+		"BARRANQUILLA", "CARTAGENA", "BUCARAMANGA", "PEREIRA", "MANIZALES", "CUCUTA", "IBAGUE",
+		"VILLAVICENCIO", "PASTO", "NEIVA", "ARMENIA", "MONTERIA", "POPAYAN", "TUNJA", "VALLEDUPAR",
+		"SINCELEJO", "ENVIGADO", "ITAGUI", "BELLO", "CHIA", "SOACHA", "RIONEGRO", "ZIPAQUIRA",
+	}
+)
+
+// Merchant is a business the movements of an account are made with. Aliases are the
+// normalized descriptions that resolve to it.
+type Merchant struct {
+	ID        string
+	AccountID string
+	Name      string
+	Aliases   []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (m *Merchant) LogProperties() map[string]string {
+	return map[string]string{
+		"merchant_id": m.ID,
+		"account_id":  m.AccountID,
+		"name":        m.Name,
+		"aliases":     strings.Join(m.Aliases, ","),
+	}
+}
+
+// NewMerchant creates the merchant of the account an alias resolves to, named after it.
+func NewMerchant(accountID string, alias string) *Merchant {
+	return &Merchant{
+		ID:        _merchant_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		Name:      DisplayName(alias),
+		Aliases:   []string{alias},
+	}
+}
+
+// Normalize returns the alias of the merchant in a movement description, or an empty
+// string when nothing is left after removing bank prefixes, cities, card suffixes, numbers
+// and legal suffixes. "Descuento COMPRA RAPPI*COL BOGOTA" and "RAPPI COLOMBIA SAS" are both
+// "rappi".
+func Normalize(description string) string {
+	text := accents.Replace(strings.ToUpper(description))
+	text = cardSuffixPattern.ReplaceAllString(text, " ")
+	text = legalSuffixPattern.ReplaceAllString(text, " ")
+	text = hostPattern.ReplaceAllString(text, "$1")
+	text = nonWordPattern.ReplaceAllString(text, " ")
+	text = digitsPattern.ReplaceAllString(text, " ")
+	text = " " + strings.Join(strings.Fields(text), " ") + " "
+
+	for _, city := range cities {
+		text = strings.ReplaceAll(text, " "+city+" ", " ")
+	}
+
+	words := strings.Fields(text)
+
+	for len(words) > 0 && slices.Contains(bankPrefixes, words[0]) {
+		words = words[1:]
+	}
+
+	words = slices.DeleteFunc(words, func(word string) bool {
+		return slices.Contains(noiseWords, word)
+	})
+	words = slices.Compact(words)
+
+	return strings.ToLower(strings.Join(words, " "))
+}
+
+// DisplayName capitalizes every word of an alias.
+func DisplayName(alias string) string {
+	words := strings.Fields(alias)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Summary is a merchant with the totals of its movements.
+type Summary struct {
+	Merchant       *Merchant
+	Movements      int
+	Spent          float64
+	Received       float64
+	LastMovementAt *time.Time
+}
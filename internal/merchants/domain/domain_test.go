@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+	}{
+		{"Descuento COMPRA RAPPI*COL BOGOTA", "rappi"},
+		{"RAPPI COLOMBIA SAS", "rappi"},
+		{"Descuento COMPRA EN UBER *TRIP HELP.UBER.COM", "uber"},
+		{"Descuento COMPRA NETFLIX.COM", "netflix"},
+		{"COMPRA POS EXITO CALLE 80 BOGOTA D.C.", "exito calle"},
+		{"Descuento PAGO PSE Éxito Santa Marta", "exito"},
+		{"Abono Pago de Nómina ACME S.A.S.", "nomina acme"},
+		{"DROGUERIA CRUZ VERDE MEDELLIN #0042", "drogueria cruz verde"},
+		{"COMPRA WWW.AMAZON.COM.CO", "amazon"},
+		{"Descuento COMPRA 123456", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			require.Equal(t, tt.expected, Normalize(tt.description))
+		})
+	}
+}
+
+func TestNewMerchant(t *testing.T) {
+	c := require.New(t)
+
+	merchant := NewMerchant("acc1", "cruz verde")
+	c.Contains(merchant.ID, _merchant_prefix)
+	c.Equal("Cruz Verde", merchant.Name)
+	c.Equal([]string{"cruz verde"}, merchant.Aliases)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/merchants/domain"
+)
+
+// MerchantRepository stores the merchants of each account and their aliases.
+type MerchantRepository interface {
+	CreateMerchant(ctx context.Context, merchant *domain.Merchant) error
+	GetMerchantByAlias(ctx context.Context, accountID string, alias string) (*domain.Merchant, error)
+	GetMerchantSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error)
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/merchants/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	merchantColumns = `m.id, m.account_id, m.name, m.created_at, m.updated_at,
+	(SELECT COALESCE(array_agg(a.alias ORDER BY a.alias), '{}') FROM merchant_aliases a WHERE a.merchant_id = m.id)`
+)
+
+var (
+	ErrMerchantNotFound = errors.New("merchant not found")
+	// ErrAliasExists is returned when an alias already resolves to a merchant of the account.
+	ErrAliasExists = errors.New("merchant alias already exists")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the merchants repository.
+func NewPostgresRepository(db *pgxpool.Pool) MerchantRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// CreateMerchant inserts a merchant together with its aliases in a single statement. It
+// returns ErrAliasExists when one of the aliases already belongs to a merchant.
+func (r *postgresRepository) CreateMerchant(ctx context.Context, merchant *domain.Merchant) error {
+	now := r.nowFunc()
+
+	query := `WITH merchant AS (
+		INSERT INTO merchants (id, account_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)
+		RETURNING id, account_id
+	)
+	INSERT INTO merchant_aliases (account_id, alias, merchant_id, created_at)
+	SELECT merchant.account_id, alias, merchant.id, $4 FROM merchant, unnest($5::text[]) AS alias`
+
+	_, err := r.db.Exec(ctx, query, merchant.ID, merchant.AccountID, merchant.Name, now, merchant.Aliases)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrAliasExists
+		}
+
+		return err
+	}
+
+	merchant.CreatedAt = now
+	merchant.UpdatedAt = now
+
+	return nil
+}
+
+// GetMerchantByAlias returns the merchant of the account the alias resolves to.
+func (r *postgresRepository) GetMerchantByAlias(ctx context.Context, accountID string, alias string) (*domain.Merchant, error) {
+	query := `SELECT ` + merchantColumns + `
+	FROM merchants m
+	JOIN merchant_aliases ma ON ma.merchant_id = m.id
+	WHERE ma.account_id = $1 AND ma.alias = $2`
+
+	merchant := &domain.Merchant{}
+
+	err := r.db.QueryRow(ctx, query, accountID, alias).Scan(&merchant.ID, &merchant.AccountID, &merchant.Name, &merchant.CreatedAt, &merchant.UpdatedAt, &merchant.Aliases)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMerchantNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return merchant, nil
+}
+
+// GetMerchantSummaries returns the merchants of the account with the totals of their
+// movements, the ones with the highest spend first.
+func (r *postgresRepository) GetMerchantSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	query := `SELECT ` + merchantColumns + `,
+	COUNT(mv.id),
+	COALESCE(SUM(mv.amount) FILTER (WHERE mv.type = $2), 0),
+	COALESCE(SUM(mv.amount) FILTER (WHERE mv.type = $3), 0),
+	MAX(mv.date)
+	FROM merchants m
+	LEFT JOIN movements mv ON mv.account_id = m.account_id AND mv.merchant_id = m.id
+	WHERE m.account_id = $1
+	GROUP BY m.id
+	ORDER BY 8 DESC, m.name`
+
+	rows, err := r.db.Query(ctx, query, accountID, string(movementsDomain.Expense), string(movementsDomain.Income))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	summaries := []*domain.Summary{}
+	for rows.Next() {
+		summary := &domain.Summary{Merchant: &domain.Merchant{}}
+		merchant := summary.Merchant
+
+		err := rows.Scan(&merchant.ID, &merchant.AccountID, &merchant.Name, &merchant.CreatedAt, &merchant.UpdatedAt, &merchant.Aliases,
+			&summary.Movements, &summary.Spent, &summary.Received, &summary.LastMovementAt)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/merchants/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMerchantRepository is a mock of the repository interface.
+type MockMerchantRepository struct {
+	mock.Mock
+}
+
+func (m *MockMerchantRepository) CreateMerchant(ctx context.Context, merchant *domain.Merchant) error {
+	args := m.Called(ctx, merchant)
+	return args.Error(0)
+}
+
+func (m *MockMerchantRepository) GetMerchantByAlias(ctx context.Context, accountID string, alias string) (*domain.Merchant, error) {
+	args := m.Called(ctx, accountID, alias)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Merchant), args.Error(1)
+}
+
+func (m *MockMerchantRepository) GetMerchantSummaries(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Summary), args.Error(1)
+}
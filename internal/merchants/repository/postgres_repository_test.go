@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/merchants/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func setupMockDB(t *testing.T) (MerchantRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateMerchant(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	merchant := &domain.Merchant{ID: "MER1", AccountID: "acc1", Name: "Rappi", Aliases: []string{"rappi"}}
+
+	mock.ExpectExec(`WITH merchant AS \(\s*INSERT INTO merchants`).
+		WithArgs("MER1", "acc1", "Rappi", fixedTime, []string{"rappi"}).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateMerchant(context.Background(), merchant))
+	c.Equal(fixedTime, merchant.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateMerchant_AliasExists(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`WITH merchant AS`).
+		WithArgs("MER1", "acc1", "Rappi", fixedTime, []string{"rappi"}).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+	err := repo.CreateMerchant(context.Background(), &domain.Merchant{ID: "MER1", AccountID: "acc1", Name: "Rappi", Aliases: []string{"rappi"}})
+	require.ErrorIs(t, err, ErrAliasExists)
+}
+
+func TestGetMerchantByAlias(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "account_id", "name", "created_at", "updated_at", "aliases"}).
+		AddRow("MER1", "acc1", "Rappi", fixedTime, fixedTime, []string{"rappi", "rappi pro"})
+
+	mock.ExpectQuery(`SELECT (.+) FROM merchants m JOIN merchant_aliases ma ON ma.merchant_id = m.id WHERE ma.account_id = \$1 AND ma.alias = \$2`).
+		WithArgs("acc1", "rappi").
+		WillReturnRows(rows)
+
+	merchant, err := repo.GetMerchantByAlias(context.Background(), "acc1", "rappi")
+	c.NoError(err)
+	c.Equal("MER1", merchant.ID)
+	c.Equal([]string{"rappi", "rappi pro"}, merchant.Aliases)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetMerchantByAlias_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM merchants m`).
+		WithArgs("acc1", "rappi").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetMerchantByAlias(context.Background(), "acc1", "rappi")
+	require.ErrorIs(t, err, ErrMerchantNotFound)
+}
+
+func TestGetMerchantSummaries(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "account_id", "name", "created_at", "updated_at", "aliases", "count", "spent", "received", "max"}).
+		AddRow("MER1", "acc1", "Rappi", fixedTime, fixedTime, []string{"rappi"}, 3, 120000.0, 0.0, &fixedTime).
+		AddRow("MER2", "acc1", "Cine Colombia", fixedTime, fixedTime, []string{"cine colombia"}, 0, 0.0, 0.0, nil)
+
+	mock.ExpectQuery(`SELECT (.+) FROM merchants m LEFT JOIN movements mv ON (.+) WHERE m.account_id = \$1 GROUP BY m.id`).
+		WithArgs("acc1", "expense", "income").
+		WillReturnRows(rows)
+
+	summaries, err := repo.GetMerchantSummaries(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(summaries, 2)
+	c.Equal("Rappi", summaries[0].Merchant.Name)
+	c.Equal(3, summaries[0].Movements)
+	c.Equal(120000.0, summaries[0].Spent)
+	c.Equal(&fixedTime, summaries[0].LastMovementAt)
+	c.Nil(summaries[1].LastMovementAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/merchants/domain"
+)
+
+// MerchantsUsecase resolves movement descriptions to the merchants of an account.
+type MerchantsUsecase interface {
+	ResolveMerchant(ctx context.Context, accountID string, description string) (*domain.Merchant, error)
+	GetMerchants(ctx context.Context, accountID string) ([]*domain.Summary, error)
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"transaction-tracker/internal/merchants/domain"
+	"transaction-tracker/internal/merchants/repository"
+)
+
+type merchantsUsecase struct {
+	repo repository.MerchantRepository
+}
+
+// NewMerchantsUsecase creates a new instance of MerchantsUsecase.
+func NewMerchantsUsecase(repo repository.MerchantRepository) MerchantsUsecase {
+	return &merchantsUsecase{
+		repo: repo,
+	}
+}
+
+// ResolveMerchant returns the merchant the normalized description belongs to, creating it
+// on first sight. It returns nil when nothing is left of the description after normalizing.
+func (u *merchantsUsecase) ResolveMerchant(ctx context.Context, accountID string, description string) (*domain.Merchant, error) {
+	alias := domain.Normalize(description)
+	if alias == "" {
+		return nil, nil
+	}
+
+	merchant, err := u.repo.GetMerchantByAlias(ctx, accountID, alias)
+	if err == nil {
+		return merchant, nil
+	}
+
+	if !errors.Is(err, repository.ErrMerchantNotFound) {
+		return nil, err
+	}
+
+	merchant = domain.NewMerchant(accountID, alias)
+
+	err = u.repo.CreateMerchant(ctx, merchant)
+	if errors.Is(err, repository.ErrAliasExists) {
+		// A concurrent movement created the merchant first.
+		return u.repo.GetMerchantByAlias(ctx, accountID, alias)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return merchant, nil
+}
+
+// GetMerchants returns the merchants of the account with their spend totals.
+func (u *merchantsUsecase) GetMerchants(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	return u.repo.GetMerchantSummaries(ctx, accountID)
+}
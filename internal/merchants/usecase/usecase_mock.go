@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/merchants/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMerchantsUsecase is a mock implementation of the MerchantsUsecase interface.
+type MockMerchantsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockMerchantsUsecase) ResolveMerchant(ctx context.Context, accountID string, description string) (*domain.Merchant, error) {
+	args := m.Called(ctx, accountID, description)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Merchant), args.Error(1)
+}
+
+func (m *MockMerchantsUsecase) GetMerchants(ctx context.Context, accountID string) ([]*domain.Summary, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Summary), args.Error(1)
+}
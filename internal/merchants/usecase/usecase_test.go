@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"transaction-tracker/internal/merchants/domain"
+	"transaction-tracker/internal/merchants/repository"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveMerchant_Existing(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	existing := &domain.Merchant{ID: "MER1", AccountID: "acc1", Name: "Rappi", Aliases: []string{"rappi"}}
+
+	repo := new(repository.MockMerchantRepository)
+	repo.On("GetMerchantByAlias", ctx, "acc1", "rappi").Return(existing, nil)
+
+	merchant, err := NewMerchantsUsecase(repo).ResolveMerchant(ctx, "acc1", "Descuento COMPRA RAPPI*COL BOGOTA")
+	c.NoError(err)
+	c.Equal(existing, merchant)
+
+	repo.AssertNotCalled(t, "CreateMerchant", mock.Anything, mock.Anything)
+}
+
+func TestResolveMerchant_Creates(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockMerchantRepository)
+	repo.On("GetMerchantByAlias", ctx, "acc1", "rappi").Return(nil, repository.ErrMerchantNotFound)
+	repo.On("CreateMerchant", ctx, mock.MatchedBy(func(merchant *domain.Merchant) bool {
+		return merchant.AccountID == "acc1" && merchant.Name == "Rappi"
+	})).Return(nil)
+
+	merchant, err := NewMerchantsUsecase(repo).ResolveMerchant(ctx, "acc1", "RAPPI COLOMBIA SAS")
+	c.NoError(err)
+	c.Equal([]string{"rappi"}, merchant.Aliases)
+
+	repo.AssertExpectations(t)
+}
+
+func TestResolveMerchant_ConcurrentCreate(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	existing := &domain.Merchant{ID: "MER1", AccountID: "acc1", Name: "Rappi"}
+
+	repo := new(repository.MockMerchantRepository)
+	repo.On("GetMerchantByAlias", ctx, "acc1", "rappi").Return(nil, repository.ErrMerchantNotFound).Once()
+	repo.On("CreateMerchant", ctx, mock.Anything).Return(repository.ErrAliasExists)
+	repo.On("GetMerchantByAlias", ctx, "acc1", "rappi").Return(existing, nil).Once()
+
+	merchant, err := NewMerchantsUsecase(repo).ResolveMerchant(ctx, "acc1", "RAPPI")
+	c.NoError(err)
+	c.Equal("MER1", merchant.ID)
+}
+
+func TestResolveMerchant_EmptyAlias(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockMerchantRepository)
+
+	merchant, err := NewMerchantsUsecase(repo).ResolveMerchant(context.Background(), "acc1", "COMPRA 1234")
+	c.NoError(err)
+	c.Nil(merchant)
+
+	repo.AssertExpectations(t)
+}
@@ -9,8 +9,11 @@ import (
 	"strings"
 	"time"
 	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
 	extractsDomain "transaction-tracker/internal/extracts/domain"
 	extractsUsecase "transaction-tracker/internal/extracts/usecase"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
 	"transaction-tracker/internal/messages/domain"
 	"transaction-tracker/internal/messages/repository"
 	movementsUsecase "transaction-tracker/internal/movements/usecase"
@@ -53,19 +56,24 @@ type messageUsecase struct {
 	messageRepo    repository.MessageRepository
 	mvmUsecase     movementsUsecase.MovementUsecase
 	extractUsecase extractsUsecase.ExtractsUsecase
+	eventsUsecase  eventsUsecase.EventsUsecase
+	finUsecase     financialAccountsUsecase.FinancialAccountsUsecase
 	googleClient   google.GoogleClientAPI
 	log            *loggerModels.Logger
 }
 
 // NewMessageUsecase is the constructor for the use case implementation.
-// It receives a repository interface as a dependency.
-func NewMessageUsecase(ctx context.Context, googleClient google.GoogleClientAPI, repo repository.MessageRepository, mvmUsecase movementsUsecase.MovementUsecase, extractUsecase extractsUsecase.ExtractsUsecase) MessageUsecase {
+// It receives a repository interface as a dependency. Closing balances of processed extracts
+// are anchored through finUsecase.
+func NewMessageUsecase(ctx context.Context, googleClient google.GoogleClientAPI, repo repository.MessageRepository, mvmUsecase movementsUsecase.MovementUsecase, extractUsecase extractsUsecase.ExtractsUsecase, evUsecase eventsUsecase.EventsUsecase, finUsecase financialAccountsUsecase.FinancialAccountsUsecase) MessageUsecase {
 	log, _ := logger.GetLogger(ctx, "messages-usecase")
 
 	return &messageUsecase{
 		messageRepo:    repo,
 		mvmUsecase:     mvmUsecase,
 		extractUsecase: extractUsecase,
+		eventsUsecase:  evUsecase,
+		finUsecase:     finUsecase,
 		googleClient:   googleClient,
 		log:            log,
 	}
@@ -217,8 +225,10 @@ func (u *messageUsecase) filterAndUpdateMovement(ctx context.Context, gmailServi
 		return err
 	}
 
+	var extract *extractsDomain.Extract
+
 	if messageType == messageextractor.Extract {
-		extract, err := u.GetExtract(ctx, gmailService, message)
+		extract, err = u.GetExtract(ctx, gmailService, message)
 		if err != nil {
 			u.log.Error(loggerModels.LogProperties{
 				Event: "download_attachments_failed",
@@ -251,6 +261,8 @@ func (u *messageUsecase) filterAndUpdateMovement(ctx context.Context, gmailServi
 		return err
 	}
 
+	created := 0
+
 	for _, m := range movements {
 		m.AccountID = message.AccountID
 		m.MessageID = message.ID
@@ -275,12 +287,37 @@ func (u *messageUsecase) filterAndUpdateMovement(ctx context.Context, gmailServi
 				m,
 			},
 		})
+
+		created++
 	}
 
 	if message.Status == domain.Pending {
 		message.Status = domain.Success
 	}
 
+	if extract != nil && message.Status == domain.Success {
+		err = u.extractUsecase.MarkProcessed(ctx, extract, created)
+		if err != nil {
+			u.log.Error(loggerModels.LogProperties{
+				Event: "mark_extract_processed_failed",
+				Error: err,
+			})
+
+			return err
+		}
+
+		err = u.finUsecase.AnchorStatement(ctx, extract)
+		if err != nil {
+			u.log.Error(loggerModels.LogProperties{
+				Event: "anchor_statement_failed",
+				Error: err,
+				AdditionalParams: []loggerModels.Properties{
+					extract,
+				},
+			})
+		}
+	}
+
 	return nil
 }
 
@@ -379,6 +416,10 @@ func isMessageFiltered(msg *gmail.Message) (messageextractor.MessageType, string
 }
 
 func (u *messageUsecase) updateMessage(ctx context.Context, message *domain.Message, err error) (*domain.Message, error) {
+	if message.Status == domain.Failure && err != nil {
+		message.FailureReason = err.Error()
+	}
+
 	errUpdate := u.messageRepo.UpdateMessage(ctx, message)
 	if errUpdate != nil {
 		u.log.Error(loggerModels.LogProperties{
@@ -389,6 +430,20 @@ func (u *messageUsecase) updateMessage(ctx context.Context, message *domain.Mess
 		return nil, errUpdate
 	}
 
+	if message.Status == domain.Failure {
+		// Messages live in Mongo, so the event cannot share a transaction with the update.
+		errEmit := u.eventsUsecase.Emit(ctx, eventsDomain.MessageFailed, message.AccountID, message.ID, eventsDomain.MessageFailedPayload{
+			ID:             message.ID,
+			AccountID:      message.AccountID,
+			ExternalID:     message.ExternalID,
+			NotificationID: message.NotificationID,
+			Reason:         message.FailureReason,
+		})
+		if errEmit != nil {
+			return nil, errEmit
+		}
+	}
+
 	return message, err
 }
 
@@ -10,7 +10,9 @@ import (
 	gmailv1 "google.golang.org/api/gmail/v1"
 
 	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
 	extractUsecase "transaction-tracker/internal/extracts/usecase"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
 	"transaction-tracker/internal/messages/domain"
 	repo "transaction-tracker/internal/messages/repository"
 	movementsUsecase "transaction-tracker/internal/movements/usecase"
@@ -32,7 +34,7 @@ func TestProcess_MissingGmailService(t *testing.T) {
 	mockExtractsUsecase.On("GetByMessageID", mock.Anything, "msg-1").Return(nil, nil)
 	mockExtractsUsecase.On("Update", mock.Anything, mock.Anything).Return(nil)
 
-	u := NewMessageUsecase(context.Background(), mockGoogle, mockMessages, mockMovementsUsecase, mockExtractsUsecase)
+	u := NewMessageUsecase(context.Background(), mockGoogle, mockMessages, mockMovementsUsecase, mockExtractsUsecase, new(eventsUsecase.MockEventsUsecase), new(financialAccountsUsecase.MockFinancialAccountsUsecase))
 
 	account := &accountsDomain.Account{ID: "acc1", GoogleAccount: &google.GoogleAccount{}}
 	msg, err := u.Process(context.Background(), "notif1", "ext-1", account)
@@ -2,12 +2,18 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/api/gmail/v1"
 
 	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	"transaction-tracker/internal/messages/domain"
+	repo "transaction-tracker/internal/messages/repository"
 	messageextractor "transaction-tracker/pkg/message-extractor"
 )
 
@@ -72,3 +78,53 @@ func TestProcess_MissingExternalID(t *testing.T) {
 	c.Nil(msg)
 	c.ErrorIs(err, ErrMissingExternalID)
 }
+
+func TestUpdateMessage_FailureEmitsEvent(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	message := &domain.Message{ID: "MSI1", AccountID: "acc1", ExternalID: "ext-1", NotificationID: "10", Status: domain.Failure}
+
+	repoMock := new(repo.MockMessageRepository)
+	repoMock.On("UpdateMessage", ctx, message).Return(nil)
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MessageFailed, "acc1", "MSI1", eventsDomain.MessageFailedPayload{
+		ID:             "MSI1",
+		AccountID:      "acc1",
+		ExternalID:     "ext-1",
+		NotificationID: "10",
+		Reason:         "extract failed",
+	}).Return(nil)
+
+	u := &messageUsecase{messageRepo: repoMock, eventsUsecase: events}
+
+	processErr := errors.New("extract failed")
+
+	got, err := u.updateMessage(ctx, message, processErr)
+	c.ErrorIs(err, processErr)
+	c.Equal(message, got)
+	c.Equal("extract failed", message.FailureReason)
+
+	events.AssertExpectations(t)
+}
+
+func TestUpdateMessage_SuccessDoesNotEmit(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	message := &domain.Message{ID: "MSI1", AccountID: "acc1", Status: domain.Success}
+
+	repoMock := new(repo.MockMessageRepository)
+	repoMock.On("UpdateMessage", ctx, message).Return(nil)
+
+	events := new(eventsUsecase.MockEventsUsecase)
+
+	u := &messageUsecase{messageRepo: repoMock, eventsUsecase: events}
+
+	got, err := u.updateMessage(ctx, message, nil)
+	c.NoError(err)
+	c.Equal(message, got)
+
+	events.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,84 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"transaction-tracker/internal/movements/domain"
+)
+
+// ClassifyBatch classifies the movements in a single call when the classifier supports
+// batches, and one by one otherwise. Movements without a match get a nil classification.
+func ClassifyBatch(ctx context.Context, classifier Classifier, movements []*domain.Movement) ([]*Classification, error) {
+	if batch, ok := classifier.(BatchClassifier); ok {
+		return batch.ClassifyBatch(ctx, movements)
+	}
+
+	classifications := make([]*Classification, len(movements))
+
+	var errs []error
+
+	for i, movement := range movements {
+		classification, err := classifier.Classify(ctx, movement)
+		if err == nil {
+			classifications[i] = classification
+			continue
+		}
+
+		if !errors.Is(err, ErrNoMatch) {
+			errs = append(errs, err)
+		}
+	}
+
+	return classifications, errors.Join(errs...)
+}
+
+// ClassifyBatch asks each classifier in order for the movements still without a category.
+// Like Classify, every movement gets a classification and failures are returned next to them.
+func (c *chainClassifier) ClassifyBatch(ctx context.Context, movements []*domain.Movement) ([]*Classification, error) {
+	classifications := make([]*Classification, len(movements))
+
+	pending := make([]int, len(movements))
+	for i := range movements {
+		pending[i] = i
+	}
+
+	var errs []error
+
+	for _, classifier := range c.classifiers {
+		if len(pending) == 0 {
+			break
+		}
+
+		batch := make([]*domain.Movement, len(pending))
+		for i, idx := range pending {
+			batch[i] = movements[idx]
+		}
+
+		results, err := ClassifyBatch(ctx, classifier, batch)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		unmatched := []int{}
+
+		for i, idx := range pending {
+			if i < len(results) && results[i] != nil {
+				classifications[idx] = results[i]
+				continue
+			}
+
+			unmatched = append(unmatched, idx)
+		}
+
+		pending = unmatched
+	}
+
+	for _, idx := range pending {
+		classifications[idx] = &Classification{
+			Category: domain.Unknown,
+			Source:   DefaultSource,
+		}
+	}
+
+	return classifications, errors.Join(errs...)
+}
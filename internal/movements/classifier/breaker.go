@@ -0,0 +1,104 @@
+package classifier
+
+import (
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState string
+
+const (
+	// CircuitClosed lets every call through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen rejects every call until the cooldown ends.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single trial call through after the cooldown.
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// CircuitBreaker stops calling a failing dependency. It opens after threshold consecutive
+// failures, and after cooldown lets one trial call decide whether it closes or stays open.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	nowFunc   func() time.Time
+}
+
+// NewCircuitBreaker creates a closed circuit breaker.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		state:     CircuitClosed,
+		nowFunc:   time.Now,
+	}
+}
+
+// Allow reports whether a call may go through. Every allowed call must be followed by
+// Success or Failure.
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if b.nowFunc().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+
+		b.state = CircuitHalfOpen
+
+		return true
+	case CircuitHalfOpen:
+		return false
+	}
+
+	return true
+}
+
+// Success closes the circuit.
+func (b *CircuitBreaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = CircuitClosed
+	b.failures = 0
+}
+
+// Failure counts a failed call and opens the circuit when the threshold is reached or the
+// trial call failed.
+func (b *CircuitBreaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+
+	if b.state == CircuitHalfOpen || b.failures >= b.threshold {
+		b.state = CircuitOpen
+		b.openedAt = b.nowFunc()
+	}
+}
+
+// Abort ends an allowed call that cannot tell whether the dependency works, such as one
+// cancelled by its caller. A trial call is given back so the next call becomes the trial.
+func (b *CircuitBreaker) Abort() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitHalfOpen {
+		b.state = CircuitOpen
+	}
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
@@ -0,0 +1,105 @@
+package classifier
+
+import (
+	"container/list"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	digitsPattern = regexp.MustCompile(`[0-9]+`)
+)
+
+// NormalizeDescription is the cache key of a description. Case, spacing and numbers such as
+// references or amounts do not change the prediction of the model, so they are dropped.
+func NormalizeDescription(description string) string {
+	description = digitsPattern.ReplaceAllString(strings.ToLower(description), "#")
+
+	return strings.Join(strings.Fields(description), " ")
+}
+
+// Cache stores the predictions of a classifier by normalized description. A nil
+// classification is a cached ErrNoMatch.
+type Cache interface {
+	Get(key string) (*Classification, bool)
+	Set(key string, classification *Classification)
+}
+
+type lruEntry struct {
+	key            string
+	classification *Classification
+	expiresAt      time.Time
+}
+
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	items   map[string]*list.Element
+	order   *list.List
+	nowFunc func() time.Time
+}
+
+// NewLRUCache creates an in-memory cache holding up to size predictions for ttl. The least
+// recently used prediction is evicted first.
+func NewLRUCache(size int, ttl time.Duration) Cache {
+	return &lruCache{
+		size:    size,
+		ttl:     ttl,
+		items:   make(map[string]*list.Element, size),
+		order:   list.New(),
+		nowFunc: time.Now,
+	}
+}
+
+func (c *lruCache) Get(key string) (*Classification, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if !c.nowFunc().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.items, key)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+
+	return entry.classification, true
+}
+
+func (c *lruCache) Set(key string, classification *Classification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.nowFunc().Add(c.ttl)
+
+	if element, ok := c.items[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.classification = classification
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry{
+		key:            key,
+		classification: classification,
+		expiresAt:      expiresAt,
+	})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
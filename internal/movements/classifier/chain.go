@@ -0,0 +1,56 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"transaction-tracker/internal/movements/domain"
+	"transaction-tracker/shared"
+)
+
+type chainClassifier struct {
+	classifiers []Classifier
+}
+
+// NewChainClassifier creates a classifier that asks each classifier in order and falls back
+// to Unknown when none of them has a category.
+func NewChainClassifier(classifiers ...Classifier) Classifier {
+	return &chainClassifier{
+		classifiers: classifiers,
+	}
+}
+
+// NewDefaultClassifier chains the default rules with the text-classifier at remoteURL,
+// protected by a resilient classifier reporting to DefaultMetrics. The model is skipped when
+// remoteURL is empty.
+func NewDefaultClassifier(remoteURL string) Classifier {
+	classifiers := []Classifier{NewRulesClassifier(DefaultRules())}
+
+	if remoteURL != "" {
+		remote := NewRemoteClassifier(remoteURL, &shared.Client)
+		classifiers = append(classifiers, NewResilientClassifier(remote, ResilienceOptions{Metrics: DefaultMetrics}))
+	}
+
+	return NewChainClassifier(classifiers...)
+}
+
+// Classify always returns a classification. Failures of the chained classifiers are
+// returned next to it so callers can log them.
+func (c *chainClassifier) Classify(ctx context.Context, movement *domain.Movement) (*Classification, error) {
+	var errs []error
+
+	for _, classifier := range c.classifiers {
+		classification, err := classifier.Classify(ctx, movement)
+		if err == nil {
+			return classification, nil
+		}
+
+		if !errors.Is(err, ErrNoMatch) {
+			errs = append(errs, err)
+		}
+	}
+
+	return &Classification{
+		Category: domain.Unknown,
+		Source:   DefaultSource,
+	}, errors.Join(errs...)
+}
@@ -0,0 +1,37 @@
+package classifier
+
+import (
+	"context"
+
+	"transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockClassifier is a mock implementation of the Classifier interface.
+type MockClassifier struct {
+	mock.Mock
+}
+
+func (m *MockClassifier) Classify(ctx context.Context, movement *domain.Movement) (*Classification, error) {
+	args := m.Called(ctx, movement)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*Classification), args.Error(1)
+}
+
+// MockBatchClassifier is a mock implementation of the BatchClassifier interface.
+type MockBatchClassifier struct {
+	MockClassifier
+}
+
+func (m *MockBatchClassifier) ClassifyBatch(ctx context.Context, movements []*domain.Movement) ([]*Classification, error) {
+	args := m.Called(ctx, movements)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*Classification), args.Error(1)
+}
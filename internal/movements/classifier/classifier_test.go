@@ -0,0 +1,267 @@
+package classifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulesClassifier(t *testing.T) {
+	ctx := context.Background()
+	classifier := NewRulesClassifier(DefaultRules())
+
+	tests := []struct {
+		description  string
+		movementType domain.MovementType
+		expected     domain.MovementCategory
+	}{
+		{"Compra en RAPPI COLOMBIA", domain.Expense, domain.Food},
+		{"DIDI FOOD BOGOTA", domain.Expense, domain.Food},
+		{"Pago UBER TRIP", domain.Expense, domain.Transport},
+		{"NETFLIX.COM", domain.Expense, domain.Entertainment},
+		{"Pago de Nómina ACME SAS", domain.Income, domain.Salary},
+		{"Traslado a bolsillo", domain.Expense, domain.Savings},
+		{"Traslado desde bolsillo", domain.Income, domain.Savings},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			c := require.New(t)
+
+			classification, err := classifier.Classify(ctx, &domain.Movement{Description: tt.description, Type: tt.movementType})
+			c.NoError(err)
+			c.Equal(tt.expected, classification.Category)
+			c.Equal(RulesSource, classification.Source)
+		})
+	}
+
+	t.Run("type must match", func(t *testing.T) {
+		_, err := classifier.Classify(ctx, &domain.Movement{Description: "Pago de nomina", Type: domain.Expense})
+		require.ErrorIs(t, err, ErrNoMatch)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, err := classifier.Classify(ctx, &domain.Movement{Description: "Transferencia a Juan", Type: domain.Expense})
+		require.ErrorIs(t, err, ErrNoMatch)
+	})
+}
+
+func TestRemoteClassifier(t *testing.T) {
+	ctx := context.Background()
+
+	newServer := func(t *testing.T, status int, body string) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+
+		t.Cleanup(server.Close)
+
+		return server
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		server := newServer(t, http.StatusOK, `{"category":"food","confidence":0.87}`)
+
+		classification, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "almuerzo"})
+		c.NoError(err)
+		c.Equal(domain.Food, classification.Category)
+		c.Equal(0.87, classification.Confidence)
+		c.Equal(ModelSource, classification.Source)
+	})
+
+	t.Run("unknown is no match", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, `{"category":"unknown","confidence":0.3}`)
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "algo"})
+		require.ErrorIs(t, err, ErrNoMatch)
+	})
+
+	t.Run("custom category slug", func(t *testing.T) {
+		c := require.New(t)
+
+		server := newServer(t, http.StatusOK, `{"category":"pets","confidence":0.9}`)
+
+		classification, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "Puppis concentrado"})
+		c.NoError(err)
+		c.Equal(domain.MovementCategory("pets"), classification.Category)
+		c.Equal(0.9, classification.Confidence)
+	})
+
+	t.Run("empty label is no match", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, `{"category":" ","confidence":0.9}`)
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "algo"})
+		require.ErrorIs(t, err, ErrNoMatch)
+	})
+
+	t.Run("server error is unavailable", func(t *testing.T) {
+		server := newServer(t, http.StatusServiceUnavailable, ``)
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "algo"})
+		require.ErrorIs(t, err, ErrUnavailable)
+		require.ErrorContains(t, err, "status 503")
+	})
+
+	t.Run("client error is not unavailable", func(t *testing.T) {
+		server := newServer(t, http.StatusUnprocessableEntity, ``)
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "algo"})
+		require.ErrorContains(t, err, "status 422")
+		require.NotErrorIs(t, err, ErrUnavailable)
+	})
+
+	t.Run("transport error is unavailable", func(t *testing.T) {
+		server := newServer(t, http.StatusOK, ``)
+		server.Close()
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).Classify(ctx, &domain.Movement{Description: "algo"})
+		require.ErrorIs(t, err, ErrUnavailable)
+	})
+}
+
+func TestChainClassifier(t *testing.T) {
+	ctx := context.Background()
+	movement := &domain.Movement{Description: "algo"}
+
+	t.Run("first match wins", func(t *testing.T) {
+		c := require.New(t)
+
+		first := new(MockClassifier)
+		first.On("Classify", ctx, movement).Return(nil, ErrNoMatch)
+
+		second := new(MockClassifier)
+		second.On("Classify", ctx, movement).Return(&Classification{Category: domain.Health, Source: ModelSource}, nil)
+
+		third := new(MockClassifier)
+
+		classification, err := NewChainClassifier(first, second, third).Classify(ctx, movement)
+		c.NoError(err)
+		c.Equal(domain.Health, classification.Category)
+		third.AssertNotCalled(t, "Classify", mock.Anything, mock.Anything)
+	})
+
+	t.Run("falls back to unknown and reports failures", func(t *testing.T) {
+		c := require.New(t)
+
+		expectedErr := errors.New("classifier down")
+
+		rules := new(MockClassifier)
+		rules.On("Classify", ctx, movement).Return(nil, ErrNoMatch)
+
+		model := new(MockClassifier)
+		model.On("Classify", ctx, movement).Return(nil, expectedErr)
+
+		classification, err := NewChainClassifier(rules, model).Classify(ctx, movement)
+		c.ErrorIs(err, expectedErr)
+		c.Equal(domain.Unknown, classification.Category)
+		c.Equal(DefaultSource, classification.Source)
+	})
+
+	t.Run("default classifier works without the model", func(t *testing.T) {
+		c := require.New(t)
+
+		classification, err := NewDefaultClassifier("").Classify(ctx, &domain.Movement{Description: "Transferencia", Type: domain.Expense})
+		c.NoError(err)
+		c.Equal(domain.Unknown, classification.Category)
+	})
+}
+
+func TestRemoteClassifier_ClassifyBatch(t *testing.T) {
+	ctx := context.Background()
+	movements := []*domain.Movement{{Description: "almuerzo"}, {Description: "algo"}}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.Equal("/classify/batch", r.URL.Path)
+
+			body := map[string][]string{}
+			c.NoError(json.NewDecoder(r.Body).Decode(&body))
+			c.Equal([]string{"almuerzo", "algo"}, body["descriptions"])
+
+			w.Write([]byte(`{"results":[{"category":"food","confidence":0.91},{"category":"unknown","confidence":0.2}]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		classifications, err := NewRemoteClassifier(server.URL+"/classify", server.Client()).(BatchClassifier).ClassifyBatch(ctx, movements)
+		c.NoError(err)
+		c.Len(classifications, 2)
+		c.Equal(domain.Food, classifications[0].Category)
+		c.Equal(0.91, classifications[0].Confidence)
+		c.Nil(classifications[1])
+	})
+
+	t.Run("custom category slugs", func(t *testing.T) {
+		c := require.New(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"results":[{"category":"pets","confidence":0.8},{"category":"","confidence":0.7}]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		classifications, err := NewRemoteClassifier(server.URL, server.Client()).(BatchClassifier).ClassifyBatch(ctx, movements)
+		c.NoError(err)
+		c.Equal(domain.MovementCategory("pets"), classifications[0].Category)
+		c.Nil(classifications[1])
+	})
+
+	t.Run("missing results", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"results":[{"category":"food","confidence":0.91}]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		_, err := NewRemoteClassifier(server.URL, server.Client()).(BatchClassifier).ClassifyBatch(ctx, movements)
+		require.ErrorContains(t, err, "expected 2 results, got 1")
+	})
+}
+
+func TestChainClassifier_ClassifyBatch(t *testing.T) {
+	ctx := context.Background()
+
+	rappi := &domain.Movement{Description: "RAPPI COLOMBIA", Type: domain.Expense}
+	almuerzo := &domain.Movement{Description: "almuerzo", Type: domain.Expense}
+	transfer := &domain.Movement{Description: "Transferencia", Type: domain.Expense}
+
+	t.Run("asks the next classifier only for unmatched movements", func(t *testing.T) {
+		c := require.New(t)
+
+		model := new(MockBatchClassifier)
+		model.On("ClassifyBatch", ctx, []*domain.Movement{almuerzo, transfer}).
+			Return([]*Classification{{Category: domain.Food, Confidence: 0.8, Source: ModelSource}, nil}, nil).Once()
+
+		classifications, err := NewChainClassifier(NewRulesClassifier(DefaultRules()), model).(BatchClassifier).ClassifyBatch(ctx, []*domain.Movement{rappi, almuerzo, transfer})
+		c.NoError(err)
+		c.Equal(RulesSource, classifications[0].Source)
+		c.Equal(ModelSource, classifications[1].Source)
+		c.Equal(domain.Unknown, classifications[2].Category)
+		c.Equal(DefaultSource, classifications[2].Source)
+		model.AssertExpectations(t)
+	})
+
+	t.Run("reports failures", func(t *testing.T) {
+		c := require.New(t)
+
+		expectedErr := errors.New("classifier down")
+
+		model := new(MockBatchClassifier)
+		model.On("ClassifyBatch", ctx, []*domain.Movement{almuerzo}).Return(nil, expectedErr).Once()
+
+		classifications, err := NewChainClassifier(NewRulesClassifier(DefaultRules()), model).(BatchClassifier).ClassifyBatch(ctx, []*domain.Movement{rappi, almuerzo})
+		c.ErrorIs(err, expectedErr)
+		c.Equal(domain.Food, classifications[0].Category)
+		c.Equal(domain.Unknown, classifications[1].Category)
+	})
+}
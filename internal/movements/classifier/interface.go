@@ -0,0 +1,50 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"transaction-tracker/internal/movements/domain"
+)
+
+// Source tells which classifier produced a classification.
+type Source string
+
+const (
+	// RulesSource is a classification made by a keyword or regex rule.
+	RulesSource Source = "rules"
+	// AccountRulesSource is a classification made by a rule defined by the account.
+	AccountRulesSource Source = "account_rules"
+	// ModelSource is a classification made by the text-classifier model.
+	ModelSource Source = "model"
+	// DefaultSource is the fallback used when nothing matched.
+	DefaultSource Source = "default"
+	// ManualSource is a category chosen by the user, which overrides any classifier.
+	ManualSource Source = "manual"
+)
+
+var (
+	// ErrNoMatch is returned when a classifier has no category for the movement.
+	ErrNoMatch = errors.New("no category matched")
+	// ErrUnavailable wraps the errors of a classifier that could not answer, as transport
+	// errors and 5xx responses of the remote one are. Only these open the circuit of a
+	// resilient classifier.
+	ErrUnavailable = errors.New("classifier unavailable")
+)
+
+// Classification is the category predicted for a movement.
+type Classification struct {
+	Category   domain.MovementCategory
+	Confidence float64
+	Source     Source
+}
+
+// Classifier predicts the category of a movement.
+type Classifier interface {
+	Classify(ctx context.Context, movement *domain.Movement) (*Classification, error)
+}
+
+// BatchClassifier predicts the categories of many movements in a single call. The result has
+// one classification per movement, in the same order, nil for movements without a match.
+type BatchClassifier interface {
+	ClassifyBatch(ctx context.Context, movements []*domain.Movement) ([]*Classification, error)
+}
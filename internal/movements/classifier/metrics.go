@@ -0,0 +1,91 @@
+package classifier
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// DefaultMetrics collects the metrics of the classifier built by NewDefaultClassifier.
+	DefaultMetrics = NewMetrics()
+)
+
+// Metrics counts the calls made through a resilient classifier. It is safe for concurrent use.
+type Metrics struct {
+	requests      atomic.Int64
+	cacheHits     atomic.Int64
+	cacheMisses   atomic.Int64
+	calls         atomic.Int64
+	failures      atomic.Int64
+	shortCircuits atomic.Int64
+	latencyTotal  atomic.Int64
+	latencyMax    atomic.Int64
+	circuit       atomic.Value
+}
+
+// MetricsSnapshot is a copy of the metrics at a point in time. Latencies are of the calls
+// made to the wrapped classifier, in milliseconds.
+type MetricsSnapshot struct {
+	Requests      int64
+	CacheHits     int64
+	CacheMisses   int64
+	HitRate       float64
+	Calls         int64
+	Failures      int64
+	ShortCircuits int64
+	AvgLatencyMs  float64
+	MaxLatencyMs  float64
+	CircuitState  CircuitState
+}
+
+// NewMetrics creates empty metrics.
+func NewMetrics() *Metrics {
+	m := &Metrics{}
+	m.circuit.Store(CircuitClosed)
+
+	return m
+}
+
+func (m *Metrics) observeCall(latency time.Duration, failed bool) {
+	m.calls.Add(1)
+	m.latencyTotal.Add(int64(latency))
+
+	for {
+		current := m.latencyMax.Load()
+		if int64(latency) <= current || m.latencyMax.CompareAndSwap(current, int64(latency)) {
+			break
+		}
+	}
+
+	if failed {
+		m.failures.Add(1)
+	}
+}
+
+func (m *Metrics) setCircuit(state CircuitState) {
+	m.circuit.Store(state)
+}
+
+// Snapshot returns the current value of the metrics.
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	snapshot := MetricsSnapshot{
+		Requests:      m.requests.Load(),
+		CacheHits:     m.cacheHits.Load(),
+		CacheMisses:   m.cacheMisses.Load(),
+		Calls:         m.calls.Load(),
+		Failures:      m.failures.Load(),
+		ShortCircuits: m.shortCircuits.Load(),
+		MaxLatencyMs:  float64(m.latencyMax.Load()) / float64(time.Millisecond),
+		CircuitState:  m.circuit.Load().(CircuitState),
+	}
+
+	if lookups := snapshot.CacheHits + snapshot.CacheMisses; lookups > 0 {
+		snapshot.HitRate = float64(snapshot.CacheHits) / float64(lookups)
+	}
+
+	if snapshot.Calls > 0 {
+		snapshot.AvgLatencyMs = float64(m.latencyTotal.Load()) / float64(snapshot.Calls) / float64(time.Millisecond)
+	}
+
+	return snapshot
+}
@@ -0,0 +1,141 @@
+package classifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"transaction-tracker/internal/movements/domain"
+)
+
+// ClassifyResponse is the body returned by the text-classifier service.
+type ClassifyResponse struct {
+	Category   string  `json:"category"`
+	Confidence float64 `json:"confidence"`
+}
+
+// BatchClassifyResponse is the body returned by the batch endpoint of the text-classifier,
+// one result per description.
+type BatchClassifyResponse struct {
+	Results []ClassifyResponse `json:"results"`
+}
+
+// HTTPClient sends requests to the text-classifier. shared.Client satisfies it.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type remoteClassifier struct {
+	url    string
+	client HTTPClient
+}
+
+// NewRemoteClassifier creates a classifier backed by the text-classifier service at url.
+func NewRemoteClassifier(url string, client HTTPClient) Classifier {
+	return &remoteClassifier{
+		url:    url,
+		client: client,
+	}
+}
+
+// Classify posts the description to the model. Predictions below the model threshold come
+// back as unknown and, as labels that are not a movement category, are reported as
+// ErrNoMatch.
+func (c *remoteClassifier) Classify(ctx context.Context, movement *domain.Movement) (*Classification, error) {
+	cr := ClassifyResponse{}
+
+	err := c.post(ctx, c.url, map[string]string{
+		"description": movement.Description,
+	}, &cr)
+	if err != nil {
+		return nil, err
+	}
+
+	classification := newModelClassification(cr)
+	if classification == nil {
+		return nil, ErrNoMatch
+	}
+
+	return classification, nil
+}
+
+// ClassifyBatch posts every description to the batch endpoint of the model, served under
+// the classify url. Movements predicted as unknown or with a label that is not a movement
+// category have no classification; the rest of the batch is kept.
+func (c *remoteClassifier) ClassifyBatch(ctx context.Context, movements []*domain.Movement) ([]*Classification, error) {
+	descriptions := make([]string, len(movements))
+	for i, movement := range movements {
+		descriptions[i] = movement.Description
+	}
+
+	br := BatchClassifyResponse{}
+
+	err := c.post(ctx, strings.TrimSuffix(c.url, "/")+"/batch", map[string][]string{
+		"descriptions": descriptions,
+	}, &br)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(br.Results) != len(movements) {
+		return nil, fmt.Errorf("error classifying categories: expected %d results, got %d", len(movements), len(br.Results))
+	}
+
+	classifications := make([]*Classification, len(movements))
+
+	for i, cr := range br.Results {
+		classifications[i] = newModelClassification(cr)
+	}
+
+	return classifications, nil
+}
+
+func (c *remoteClassifier) post(ctx context.Context, url string, body any, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%w: error classifying category: status %d", ErrUnavailable, res.StatusCode)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error classifying category: status %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// newModelClassification converts a prediction of the model. Empty and unknown predictions
+// have no classification. Any other label is kept as a category slug, since models trained
+// on the feedback of the accounts predict their own categories; whether the slug belongs to
+// the category tree of the account is checked by whoever applies it.
+func newModelClassification(cr ClassifyResponse) *Classification {
+	category := domain.MovementCategory(strings.TrimSpace(cr.Category))
+	if category == "" || category == domain.Unknown {
+		return nil
+	}
+
+	return &Classification{
+		Category:   category,
+		Confidence: cr.Confidence,
+		Source:     ModelSource,
+	}
+}
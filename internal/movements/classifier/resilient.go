@@ -0,0 +1,254 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/movements/domain"
+)
+
+const (
+	// DefaultTimeout bounds a single classification call.
+	DefaultTimeout = 3 * time.Second
+	// DefaultBatchTimeout bounds a batch classification call.
+	DefaultBatchTimeout = 30 * time.Second
+	// DefaultCacheSize is how many predictions the default cache holds.
+	DefaultCacheSize = 5000
+	// DefaultCacheTTL is how long a prediction is reused, so a retrained model is picked up
+	// by classification as usual. Reclassification does not wait for it, see WithoutCache.
+	DefaultCacheTTL = 24 * time.Hour
+	// DefaultFailureThreshold is how many consecutive failures open the circuit.
+	DefaultFailureThreshold = 5
+	// DefaultCooldown is how long the circuit stays open before a trial call.
+	DefaultCooldown = 30 * time.Second
+)
+
+var (
+	// ErrCircuitOpen is returned without calling the classifier while its circuit is open.
+	ErrCircuitOpen = errors.New("classifier circuit is open")
+)
+
+type noCacheKey struct{}
+
+// WithoutCache returns a context whose classifications skip the cached predictions, as a
+// reclassification after a retrain needs. Fresh predictions still replace the cached ones.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+func cacheSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(noCacheKey{}).(bool)
+	return skip
+}
+
+// ResilienceOptions configures a resilient classifier. Zero values use the defaults.
+type ResilienceOptions struct {
+	Timeout          time.Duration
+	BatchTimeout     time.Duration
+	Cache            Cache
+	FailureThreshold int
+	Cooldown         time.Duration
+	Metrics          *Metrics
+}
+
+type resilientClassifier struct {
+	classifier   Classifier
+	timeout      time.Duration
+	batchTimeout time.Duration
+	cache        Cache
+	breaker      *CircuitBreaker
+	metrics      *Metrics
+}
+
+// NewResilientClassifier protects a slow or unreliable classifier, usually the remote one.
+// Every call gets a deadline, predictions are cached by normalized description and, after
+// consecutive ErrUnavailable failures, calls are rejected with ErrCircuitOpen so a chain falls
+// back right away. Only descriptions are part of the cache key, so the wrapped classifier must not look
+// at other fields of the movement.
+func NewResilientClassifier(classifier Classifier, opts ResilienceOptions) Classifier {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	if opts.BatchTimeout <= 0 {
+		opts.BatchTimeout = DefaultBatchTimeout
+	}
+
+	if opts.Cache == nil {
+		opts.Cache = NewLRUCache(DefaultCacheSize, DefaultCacheTTL)
+	}
+
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = DefaultFailureThreshold
+	}
+
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = DefaultCooldown
+	}
+
+	if opts.Metrics == nil {
+		opts.Metrics = NewMetrics()
+	}
+
+	return &resilientClassifier{
+		classifier:   classifier,
+		timeout:      opts.Timeout,
+		batchTimeout: opts.BatchTimeout,
+		cache:        opts.Cache,
+		breaker:      NewCircuitBreaker(opts.FailureThreshold, opts.Cooldown),
+		metrics:      opts.Metrics,
+	}
+}
+
+func (c *resilientClassifier) Classify(ctx context.Context, movement *domain.Movement) (*Classification, error) {
+	c.metrics.requests.Add(1)
+
+	key := NormalizeDescription(movement.Description)
+
+	if classification, ok := c.lookup(ctx, key); ok {
+		if classification == nil {
+			return nil, ErrNoMatch
+		}
+
+		return classification, nil
+	}
+
+	if !c.allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	classification, err := c.classifier.Classify(callCtx, movement)
+	if err != nil && !errors.Is(err, ErrNoMatch) {
+		c.failed(ctx, time.Since(start), err)
+		return nil, err
+	}
+
+	c.succeeded(time.Since(start))
+	c.cache.Set(key, copyClassification(classification))
+
+	if classification == nil {
+		return nil, ErrNoMatch
+	}
+
+	return classification, nil
+}
+
+// ClassifyBatch only sends the descriptions missing from the cache.
+func (c *resilientClassifier) ClassifyBatch(ctx context.Context, movements []*domain.Movement) ([]*Classification, error) {
+	c.metrics.requests.Add(int64(len(movements)))
+
+	classifications := make([]*Classification, len(movements))
+
+	var (
+		misses  []*domain.Movement
+		indexes []int
+		keys    []string
+	)
+
+	for i, movement := range movements {
+		key := NormalizeDescription(movement.Description)
+
+		if classification, ok := c.lookup(ctx, key); ok {
+			classifications[i] = classification
+			continue
+		}
+
+		misses = append(misses, movement)
+		indexes = append(indexes, i)
+		keys = append(keys, key)
+	}
+
+	if len(misses) == 0 {
+		return classifications, nil
+	}
+
+	if !c.allow() {
+		return classifications, ErrCircuitOpen
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.batchTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	results, err := ClassifyBatch(callCtx, c.classifier, misses)
+	if err != nil {
+		c.failed(ctx, time.Since(start), err)
+		return classifications, err
+	}
+
+	c.succeeded(time.Since(start))
+
+	for i, idx := range indexes {
+		c.cache.Set(keys[i], copyClassification(results[i]))
+		classifications[idx] = results[i]
+	}
+
+	return classifications, nil
+}
+
+// lookup returns a copy of the cached prediction so callers cannot change the cache.
+func (c *resilientClassifier) lookup(ctx context.Context, key string) (*Classification, bool) {
+	if cacheSkipped(ctx) {
+		c.metrics.cacheMisses.Add(1)
+		return nil, false
+	}
+
+	classification, ok := c.cache.Get(key)
+	if !ok {
+		c.metrics.cacheMisses.Add(1)
+		return nil, false
+	}
+
+	c.metrics.cacheHits.Add(1)
+
+	return copyClassification(classification), true
+}
+
+func (c *resilientClassifier) allow() bool {
+	if c.breaker.Allow() {
+		return true
+	}
+
+	c.metrics.shortCircuits.Add(1)
+
+	return false
+}
+
+func (c *resilientClassifier) succeeded(latency time.Duration) {
+	c.metrics.observeCall(latency, false)
+	c.breaker.Success()
+	c.metrics.setCircuit(c.breaker.State())
+}
+
+// failed counts the failure against the circuit unless the caller gave up on the call. Errors
+// other than ErrUnavailable mean the classifier answered, so they close it like a success.
+func (c *resilientClassifier) failed(ctx context.Context, latency time.Duration, err error) {
+	c.metrics.observeCall(latency, true)
+
+	switch {
+	case ctx.Err() != nil:
+		c.breaker.Abort()
+	case errors.Is(err, ErrUnavailable):
+		c.breaker.Failure()
+	default:
+		c.breaker.Success()
+	}
+
+	c.metrics.setCircuit(c.breaker.State())
+}
+
+func copyClassification(classification *Classification) *Classification {
+	if classification == nil {
+		return nil
+	}
+
+	copied := *classification
+
+	return &copied
+}
@@ -0,0 +1,233 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDescription(t *testing.T) {
+	require.Equal(t, "compra rappi ref # $#.#", NormalizeDescription("  COMPRA   RAPPI ref 12345 $45.000 "))
+	require.Equal(t, NormalizeDescription("Pago 1234 UBER"), NormalizeDescription("pago 98 uber"))
+}
+
+func TestLRUCache(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	cache := NewLRUCache(2, time.Hour).(*lruCache)
+	cache.nowFunc = func() time.Time { return now }
+
+	cache.Set("a", &Classification{Category: domain.Food})
+	cache.Set("b", nil)
+
+	_, ok := cache.Get("a")
+	c.True(ok)
+
+	cache.Set("c", &Classification{Category: domain.Health})
+
+	_, ok = cache.Get("b")
+	c.False(ok, "least recently used entry is evicted")
+
+	classification, ok := cache.Get("a")
+	c.True(ok)
+	c.Equal(domain.Food, classification.Category)
+
+	now = now.Add(time.Hour)
+
+	_, ok = cache.Get("c")
+	c.False(ok, "expired entries are dropped")
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	breaker := NewCircuitBreaker(2, time.Minute)
+	breaker.nowFunc = func() time.Time { return now }
+
+	c.True(breaker.Allow())
+	breaker.Failure()
+	c.Equal(CircuitClosed, breaker.State())
+
+	c.True(breaker.Allow())
+	breaker.Failure()
+	c.Equal(CircuitOpen, breaker.State())
+	c.False(breaker.Allow())
+
+	now = now.Add(time.Minute)
+
+	c.True(breaker.Allow())
+	c.Equal(CircuitHalfOpen, breaker.State())
+	c.False(breaker.Allow(), "a single trial call goes through")
+
+	breaker.Failure()
+	c.Equal(CircuitOpen, breaker.State())
+
+	now = now.Add(time.Minute)
+
+	c.True(breaker.Allow())
+	breaker.Success()
+	c.Equal(CircuitClosed, breaker.State())
+}
+
+func TestResilientClassifier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches predictions and misses by normalized description", func(t *testing.T) {
+		c := require.New(t)
+
+		model := new(MockClassifier)
+		model.On("Classify", mock.Anything, mock.MatchedBy(func(m *domain.Movement) bool { return m.Description == "Almuerzo 123" })).
+			Return(&Classification{Category: domain.Food, Confidence: 0.9, Source: ModelSource}, nil).Once()
+		model.On("Classify", mock.Anything, mock.MatchedBy(func(m *domain.Movement) bool { return m.Description == "algo" })).
+			Return(nil, ErrNoMatch).Once()
+
+		metrics := NewMetrics()
+		cls := NewResilientClassifier(model, ResilienceOptions{Metrics: metrics})
+
+		for range 2 {
+			classification, err := cls.Classify(ctx, &domain.Movement{Description: "Almuerzo 123"})
+			c.NoError(err)
+			c.Equal(domain.Food, classification.Category)
+
+			_, err = cls.Classify(ctx, &domain.Movement{Description: "algo"})
+			c.ErrorIs(err, ErrNoMatch)
+		}
+
+		classification, err := cls.Classify(ctx, &domain.Movement{Description: "almuerzo 9"})
+		c.NoError(err)
+		c.Equal(domain.Food, classification.Category)
+
+		model.AssertExpectations(t)
+
+		snapshot := metrics.Snapshot()
+		c.Equal(int64(5), snapshot.Requests)
+		c.Equal(int64(3), snapshot.CacheHits)
+		c.Equal(int64(2), snapshot.Calls)
+		c.Equal(0.6, snapshot.HitRate)
+	})
+
+	t.Run("sets a deadline on every call", func(t *testing.T) {
+		c := require.New(t)
+
+		model := new(MockClassifier)
+		model.On("Classify", mock.MatchedBy(func(ctx context.Context) bool {
+			deadline, ok := ctx.Deadline()
+			return ok && time.Until(deadline) <= time.Second
+		}), mock.Anything).Return(&Classification{Category: domain.Food}, nil).Once()
+
+		_, err := NewResilientClassifier(model, ResilienceOptions{Timeout: time.Second}).Classify(ctx, &domain.Movement{Description: "almuerzo"})
+		c.NoError(err)
+		model.AssertExpectations(t)
+	})
+
+	t.Run("opens the circuit after consecutive failures", func(t *testing.T) {
+		c := require.New(t)
+
+		expectedErr := fmt.Errorf("%w: classifier down", ErrUnavailable)
+
+		model := new(MockClassifier)
+		model.On("Classify", mock.Anything, mock.Anything).Return(nil, expectedErr).Twice()
+
+		metrics := NewMetrics()
+		cls := NewResilientClassifier(model, ResilienceOptions{FailureThreshold: 2, Metrics: metrics})
+
+		for range 2 {
+			_, err := cls.Classify(ctx, &domain.Movement{Description: "almuerzo"})
+			c.ErrorIs(err, expectedErr)
+		}
+
+		_, err := cls.Classify(ctx, &domain.Movement{Description: "almuerzo"})
+		c.ErrorIs(err, ErrCircuitOpen)
+
+		model.AssertExpectations(t)
+
+		snapshot := metrics.Snapshot()
+		c.Equal(int64(2), snapshot.Failures)
+		c.Equal(int64(1), snapshot.ShortCircuits)
+		c.Equal(CircuitOpen, snapshot.CircuitState)
+	})
+
+	t.Run("answers of the classifier do not open the circuit", func(t *testing.T) {
+		c := require.New(t)
+
+		expectedErr := errors.New("status 422")
+
+		model := new(MockClassifier)
+		model.On("Classify", mock.Anything, mock.Anything).Return(nil, expectedErr).Times(3)
+
+		metrics := NewMetrics()
+		cls := NewResilientClassifier(model, ResilienceOptions{FailureThreshold: 2, Metrics: metrics})
+
+		for range 3 {
+			_, err := cls.Classify(ctx, &domain.Movement{Description: "almuerzo"})
+			c.ErrorIs(err, expectedErr)
+		}
+
+		model.AssertExpectations(t)
+
+		snapshot := metrics.Snapshot()
+		c.Equal(int64(3), snapshot.Failures)
+		c.Equal(CircuitClosed, snapshot.CircuitState)
+	})
+
+	t.Run("without cache asks the classifier and refreshes the cache", func(t *testing.T) {
+		c := require.New(t)
+
+		movement := &domain.Movement{Description: "almuerzo"}
+
+		cache := NewLRUCache(10, time.Hour)
+		cache.Set(NormalizeDescription(movement.Description), &Classification{Category: domain.Food, Source: ModelSource})
+
+		model := new(MockBatchClassifier)
+		model.On("ClassifyBatch", mock.Anything, []*domain.Movement{movement}).
+			Return([]*Classification{{Category: domain.Health, Source: ModelSource}}, nil).Once()
+
+		cls := NewResilientClassifier(model, ResilienceOptions{Cache: cache}).(BatchClassifier)
+
+		classifications, err := cls.ClassifyBatch(WithoutCache(ctx), []*domain.Movement{movement})
+		c.NoError(err)
+		c.Equal(domain.Health, classifications[0].Category)
+
+		stored, ok := cache.Get(NormalizeDescription(movement.Description))
+		c.True(ok)
+		c.Equal(domain.Health, stored.Category)
+		model.AssertExpectations(t)
+	})
+
+	t.Run("batch only sends cache misses", func(t *testing.T) {
+		c := require.New(t)
+
+		cached := &domain.Movement{Description: "almuerzo"}
+		missing := &domain.Movement{Description: "farmacia"}
+
+		cache := NewLRUCache(10, time.Hour)
+		cache.Set(NormalizeDescription(cached.Description), &Classification{Category: domain.Food, Source: ModelSource})
+
+		model := new(MockBatchClassifier)
+		model.On("ClassifyBatch", mock.Anything, []*domain.Movement{missing}).
+			Return([]*Classification{{Category: domain.Health, Source: ModelSource}}, nil).Once()
+
+		cls := NewResilientClassifier(model, ResilienceOptions{Cache: cache}).(BatchClassifier)
+
+		classifications, err := cls.ClassifyBatch(ctx, []*domain.Movement{cached, missing})
+		c.NoError(err)
+		c.Equal(domain.Food, classifications[0].Category)
+		c.Equal(domain.Health, classifications[1].Category)
+
+		stored, ok := cache.Get(NormalizeDescription(missing.Description))
+		c.True(ok)
+		c.Equal(domain.Health, stored.Category)
+		model.AssertExpectations(t)
+	})
+}
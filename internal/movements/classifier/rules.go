@@ -0,0 +1,67 @@
+package classifier
+
+import (
+	"context"
+	"regexp"
+	"transaction-tracker/internal/movements/domain"
+)
+
+// Rule assigns a category to movements whose description matches the pattern. An empty
+// Type matches both incomes and expenses.
+type Rule struct {
+	Category domain.MovementCategory
+	Type     domain.MovementType
+	Pattern  *regexp.Regexp
+}
+
+// Matches reports whether the rule applies to the movement.
+func (r Rule) Matches(movement *domain.Movement) bool {
+	if r.Type != "" && r.Type != movement.Type {
+		return false
+	}
+
+	return r.Pattern.MatchString(movement.Description)
+}
+
+// DefaultRules are keyword rules for merchants and concepts common in bank alerts.
+func DefaultRules() []Rule {
+	return []Rule{
+		{Category: domain.Salary, Type: domain.Income, Pattern: regexp.MustCompile(`(?i)\b(n[oó]mina|salario|payroll|pago de sueldo)\b`)},
+		{Category: domain.Investment, Type: domain.Income, Pattern: regexp.MustCompile(`(?i)\b(dividendos?|intereses|rendimientos?)\b`)},
+		{Category: domain.Food, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(rappi|ifood|didi food|restaurante|domicilios|exito|carulla|jumbo|d1|ara|olimpica|panaderia)\b`)},
+		{Category: domain.Transport, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(uber|didi|cabify|indriver|taxi|terpel|primax|texaco|peaje|transmilenio|metro)\b`)},
+		{Category: domain.Entertainment, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(netflix|spotify|disney|hbo|prime video|youtube|cine ?colombia|cinemark|steam|playstation)\b`)},
+		{Category: domain.Housing, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(arriendo|administracion|epm|codensa|enel|vanti|acueducto|gas natural|claro hogar|etb)\b`)},
+		{Category: domain.Health, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(drogueria|farmacia|cruz verde|farmatodo|eps|clinica|hospital|laboratorio)\b`)},
+		{Category: domain.Education, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(universidad|colegio|platzi|udemy|coursera|matricula)\b`)},
+		{Category: domain.Travel, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(avianca|latam|viva ?air|wingo|airbnb|booking|despegar|hotel)\b`)},
+		{Category: domain.Shopping, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(amazon|mercado ?libre|falabella|zara|h&m|alkosto|ktronix|homecenter)\b`)},
+		{Category: domain.Debt, Type: domain.Expense, Pattern: regexp.MustCompile(`(?i)\b(pago tarjeta|pago tc|abono credito|cuota credito|prestamo)\b`)},
+		{Category: domain.Savings, Pattern: regexp.MustCompile(`(?i)\b(bolsillo|cdt|ahorro programado|fiducuenta)\b`)},
+	}
+}
+
+type rulesClassifier struct {
+	rules []Rule
+}
+
+// NewRulesClassifier creates a classifier that returns the category of the first matching rule.
+func NewRulesClassifier(rules []Rule) Classifier {
+	return &rulesClassifier{
+		rules: rules,
+	}
+}
+
+func (c *rulesClassifier) Classify(_ context.Context, movement *domain.Movement) (*Classification, error) {
+	for _, rule := range c.rules {
+		if rule.Matches(movement) {
+			return &Classification{
+				Category:   rule.Category,
+				Confidence: 1,
+				Source:     RulesSource,
+			}, nil
+		}
+	}
+
+	return nil, ErrNoMatch
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -63,6 +65,13 @@ const (
 
 	// Unknown represents an uncategorized movement.
 	Unknown MovementCategory = "unknown"
+
+	// MaxTags is the most tags a movement can have.
+	MaxTags = 20
+	// MaxTagLength is the longest tag, in characters.
+	MaxTagLength = 50
+	// MaxNoteLength is the longest note, in characters, a movement can have.
+	MaxNoteLength = 1000
 )
 
 var (
@@ -70,41 +79,68 @@ var (
 	ErrInvalidMovementType = errors.New("invalid movement type")
 	// ErrInvalidSource
 	ErrInvalidMovementCategory = errors.New("invalid movement category")
+	// ErrInvalidTags is returned when the tags of a movement are not valid.
+	ErrInvalidTags = errors.New("invalid tags")
+	// ErrInvalidNote is returned when the note of a movement is too long.
+	ErrInvalidNote = errors.New("invalid note")
+
+	tagRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
 )
 
 // Movement represents a single financial transaction. It's the central business entity.
+// CategorySource tells whether a classifier or the user set the category, and
+// CategoryConfidence is how sure the classifier was, 1 for categories chosen by the user.
+// MerchantID is the merchant the description resolves to, empty when it resolves to none.
+// FinancialAccountID is the savings account, card or wallet the money moved in, empty when
+// the account has none for the institution. TransferID is the transfer between own accounts
+// the movement is one side of; transfers are neither income nor expenses. Tags group
+// movements freely, e.g. the ones of a trip, and Note is whatever the user wrote about it.
+// CreatedBy is the member who added the movement to a shared workspace, empty otherwise.
 type Movement struct {
-	ID            string           `json:"id" bson:"_id,omitempty"`
-	AccountID     string           `json:"account_id" bson:"account_id"`
-	InstitutionID string           `json:"institution_id" bson:"institution_id"`
-	MessageID     string           `json:"message_id" bson:"message_id"`
-	ExtractID     string           `json:"extract_id" bson:"extract_id"`
-	Description   string           `json:"description" bson:"description"`
-	Amount        float64          `json:"amount" bson:"amount"`
-	Type          MovementType     `json:"type" bson:"type"`
-	Date          time.Time        `json:"date" bson:"date"`
-	Source        Source           `json:"source" bson:"source"`
-	Category      MovementCategory `json:"category" bson:"category"`
-	CreatedAt     time.Time        `json:"created_at" bson:"created_at"`
-	UpdatedAt     time.Time        `json:"updated_at" bson:"updated_at"`
+	ID                 string           `json:"id" bson:"_id,omitempty"`
+	AccountID          string           `json:"account_id" bson:"account_id"`
+	InstitutionID      string           `json:"institution_id" bson:"institution_id"`
+	MessageID          string           `json:"message_id" bson:"message_id"`
+	ExtractID          string           `json:"extract_id" bson:"extract_id"`
+	Description        string           `json:"description" bson:"description"`
+	MerchantID         string           `json:"merchant_id" bson:"merchant_id"`
+	FinancialAccountID string           `json:"financial_account_id" bson:"financial_account_id"`
+	TransferID         string           `json:"transfer_id" bson:"transfer_id"`
+	Tags               []string         `json:"tags" bson:"tags"`
+	Note               string           `json:"note" bson:"note"`
+	CreatedBy          string           `json:"created_by" bson:"created_by"`
+	Amount             float64          `json:"amount" bson:"amount"`
+	Type               MovementType     `json:"type" bson:"type"`
+	Date               time.Time        `json:"date" bson:"date"`
+	Source             Source           `json:"source" bson:"source"`
+	Category           MovementCategory `json:"category" bson:"category"`
+	CategoryConfidence float64          `json:"category_confidence" bson:"category_confidence"`
+	CategorySource     string           `json:"category_source" bson:"category_source"`
+	CreatedAt          time.Time        `json:"created_at" bson:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at" bson:"updated_at"`
 }
 
 // LogProperties is the map to logger attibutes
 func (m *Movement) LogProperties() map[string]string {
 	return map[string]string{
-		"id":             m.ID,
-		"account_id":     m.AccountID,
-		"institution_id": m.InstitutionID,
-		"message_id":     m.MessageID,
-		"extract_id":     m.ExtractID,
-		"description":    m.Description,
-		"amount":         strconv.FormatFloat(m.Amount, 'f', 2, 64),
-		"type":           string(m.Type),
-		"date":           m.Date.Local().String(),
-		"source":         string(m.Source),
-		"category":       string(m.Category),
-		"created_at":     m.CreatedAt.Local().String(),
-		"updated_at":     m.UpdatedAt.Local().String(),
+		"id":                   m.ID,
+		"account_id":           m.AccountID,
+		"institution_id":       m.InstitutionID,
+		"message_id":           m.MessageID,
+		"extract_id":           m.ExtractID,
+		"description":          m.Description,
+		"merchant_id":          m.MerchantID,
+		"financial_account_id": m.FinancialAccountID,
+		"transfer_id":          m.TransferID,
+		"tags":                 strings.Join(m.Tags, ","),
+		"amount":               strconv.FormatFloat(m.Amount, 'f', 2, 64),
+		"type":                 string(m.Type),
+		"date":                 m.Date.Local().String(),
+		"source":               string(m.Source),
+		"category":             string(m.Category),
+		"category_source":      m.CategorySource,
+		"created_at":           m.CreatedAt.Local().String(),
+		"updated_at":           m.UpdatedAt.Local().String(),
 	}
 }
 
@@ -154,3 +190,47 @@ func ParseMovementCategory(t string) (MovementCategory, error) {
 		return "", ErrInvalidMovementCategory
 	}
 }
+
+// NormalizeTags returns the tags trimmed and in lower case, without empty or repeated ones,
+// in the order given.
+func NormalizeTags(tags []string) []string {
+	normalized := []string{}
+	seen := map[string]bool{}
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+// ValidateTags checks the tags are normalized words made of letters, digits, dashes and
+// underscores, like trip-cartagena-2026.
+func ValidateTags(tags []string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("%w: a movement can have up to %d tags", ErrInvalidTags, MaxTags)
+	}
+
+	for _, tag := range tags {
+		if len(tag) > MaxTagLength || !tagRegex.MatchString(tag) {
+			return fmt.Errorf("%w: %q must be up to %d lowercase letters, digits, dashes or underscores", ErrInvalidTags, tag, MaxTagLength)
+		}
+	}
+
+	return nil
+}
+
+// ValidateNote checks the note is not longer than MaxNoteLength.
+func ValidateNote(note string) error {
+	if len([]rune(note)) > MaxNoteLength {
+		return fmt.Errorf("%w: notes can't be longer than %d characters", ErrInvalidNote, MaxNoteLength)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -97,3 +99,33 @@ func TestParseMovementType(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeTags(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal([]string{"trip-cartagena-2026", "reimbursable"}, NormalizeTags([]string{" Trip-Cartagena-2026", "", "reimbursable", "REIMBURSABLE "}))
+	c.Equal([]string{}, NormalizeTags(nil))
+}
+
+func TestValidateTags(t *testing.T) {
+	c := require.New(t)
+
+	c.NoError(ValidateTags([]string{"trip-cartagena-2026", "work_lunch"}))
+	c.ErrorIs(ValidateTags([]string{"two words"}), ErrInvalidTags)
+	c.ErrorIs(ValidateTags([]string{"-leading"}), ErrInvalidTags)
+	c.ErrorIs(ValidateTags([]string{strings.Repeat("a", MaxTagLength+1)}), ErrInvalidTags)
+
+	tooMany := []string{}
+	for i := 0; i <= MaxTags; i++ {
+		tooMany = append(tooMany, "tag"+strconv.Itoa(i))
+	}
+
+	c.ErrorIs(ValidateTags(tooMany), ErrInvalidTags)
+}
+
+func TestValidateNote(t *testing.T) {
+	c := require.New(t)
+
+	c.NoError(ValidateNote(strings.Repeat("ñ", MaxNoteLength)))
+	c.ErrorIs(ValidateNote(strings.Repeat("a", MaxNoteLength+1)), ErrInvalidNote)
+}
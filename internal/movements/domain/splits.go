@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_split_prefix = "SPL"
+
+	// MaxSplits is the most allocations a movement can be split into.
+	MaxSplits = 20
+	// MaxSplitNoteLength is the longest note, in characters, an allocation can have.
+	MaxSplitNoteLength = 255
+)
+
+var (
+	// ErrInvalidSplits is returned when the allocations of a movement are not valid.
+	ErrInvalidSplits = errors.New("invalid splits")
+)
+
+// Split is the part of a movement allocated to a category. The splits of a movement add up
+// to its amount and replace its category in category reports and budgets.
+type Split struct {
+	ID         string           `json:"id"`
+	MovementID string           `json:"movement_id"`
+	AccountID  string           `json:"account_id"`
+	Category   MovementCategory `json:"category"`
+	Amount     float64          `json:"amount"`
+	Note       string           `json:"note"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// LogProperties is the map to logger attibutes
+func (s *Split) LogProperties() map[string]string {
+	return map[string]string{
+		"id":          s.ID,
+		"movement_id": s.MovementID,
+		"account_id":  s.AccountID,
+		"category":    string(s.Category),
+		"amount":      strconv.FormatFloat(s.Amount, 'f', 2, 64),
+		"created_at":  s.CreatedAt.Local().String(),
+	}
+}
+
+// NewSplit creates an allocation of the movement.
+func NewSplit(movement *Movement, category MovementCategory, amount float64, note string) *Split {
+	return &Split{
+		ID:         _split_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		MovementID: movement.ID,
+		AccountID:  movement.AccountID,
+		Category:   category,
+		Amount:     amount,
+		Note:       strings.TrimSpace(note),
+	}
+}
+
+// ValidateSplits checks the allocations of the movement. No allocations means the movement
+// is not split; otherwise there must be at least two, each one positive, whose amounts add
+// up to the cent to the movement amount.
+func ValidateSplits(movement *Movement, splits []*Split) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	if len(splits) < 2 || len(splits) > MaxSplits {
+		return fmt.Errorf("%w: a movement is split into 2 to %d parts", ErrInvalidSplits, MaxSplits)
+	}
+
+	var total int64
+	for _, split := range splits {
+		if split.Amount <= 0 {
+			return fmt.Errorf("%w: amounts must be greater than zero", ErrInvalidSplits)
+		}
+
+		if len([]rune(split.Note)) > MaxSplitNoteLength {
+			return fmt.Errorf("%w: notes can't be longer than %d characters", ErrInvalidSplits, MaxSplitNoteLength)
+		}
+
+		total += cents(split.Amount)
+	}
+
+	if total != cents(movement.Amount) {
+		return fmt.Errorf("%w: amounts add up to %.2f instead of %.2f", ErrInvalidSplits, float64(total)/100, movement.Amount)
+	}
+
+	return nil
+}
+
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
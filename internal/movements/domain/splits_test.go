@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSplit(t *testing.T) {
+	c := require.New(t)
+
+	movement := &Movement{ID: "MID1", AccountID: "acc1", Amount: 100}
+
+	split := NewSplit(movement, Food, 60, "  groceries ")
+	c.True(strings.HasPrefix(split.ID, _split_prefix))
+	c.Equal("MID1", split.MovementID)
+	c.Equal("acc1", split.AccountID)
+	c.Equal(Food, split.Category)
+	c.Equal("groceries", split.Note)
+}
+
+func TestValidateSplits(t *testing.T) {
+	movement := &Movement{ID: "MID1", AccountID: "acc1", Amount: 100.30}
+
+	tests := []struct {
+		name    string
+		amounts []float64
+		note    string
+		wantErr bool
+	}{
+		{name: "not split", amounts: nil},
+		{name: "adds up", amounts: []float64{60.10, 30.10, 10.10}},
+		{name: "single part", amounts: []float64{100.30}, wantErr: true},
+		{name: "short by a cent", amounts: []float64{60.10, 40.19}, wantErr: true},
+		{name: "over the amount", amounts: []float64{80, 30}, wantErr: true},
+		{name: "zero part", amounts: []float64{100.30, 0}, wantErr: true},
+		{name: "note too long", amounts: []float64{50.15, 50.15}, note: strings.Repeat("a", MaxSplitNoteLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			splits := []*Split{}
+			for _, amount := range tt.amounts {
+				splits = append(splits, NewSplit(movement, Food, amount, tt.note))
+			}
+
+			err := ValidateSplits(movement, splits)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidSplits)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
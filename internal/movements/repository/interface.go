@@ -8,9 +8,12 @@ import (
 // MovementRepository defines the contract for a data store to handle movements.
 type MovementRepository interface {
 	CreateMovement(ctx context.Context, movement *domain.Movement) error
-	GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error)
+	UpdateMovement(ctx context.Context, movement *domain.Movement) error
+	GetMovementByID(ctx context.Context, id string, accountIDs []string) (*domain.Movement, error)
 	Delete(ctx context.Context, id string, accountID string) error
-	GetTotalMovementsByAccountID(ctx context.Context, accountID string) (int, error)
-	GetMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) ([]*domain.Movement, error)
-	DeleteMovementsByExtractID(ctx context.Context, extractID string) error
+	GetTotalMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string) (int, error)
+	GetMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string, limit int, offset int) ([]*domain.Movement, error)
+	DeleteMovementsByExtractID(ctx context.Context, extractID string) ([]*domain.Movement, error)
+	GetSplits(ctx context.Context, movementID string, accountID string) ([]*domain.Split, error)
+	ReplaceSplits(ctx context.Context, movementID string, accountID string, splits []*domain.Split) error
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 	"transaction-tracker/internal/movements/domain"
+	"transaction-tracker/pkg/databases/postgres"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -14,6 +15,9 @@ import (
 
 var (
 	ErrMovementNotFound = errors.New("movement not found")
+	// ErrSplitsOwed is returned when the splits of a movement can't be replaced because a
+	// contact owes some of them.
+	ErrSplitsOwed = errors.New("splits of the movement are owed by a contact")
 )
 
 // DBQuerier is the interface that abstracts the database methods we need.
@@ -28,10 +32,25 @@ type postgresRepository struct {
 	nowFunc func() time.Time
 }
 
+const (
+	movementColumns = `id, account_id, institution_id, message_id, notification_id, description, merchant_id, financial_account_id, transfer_id, tags, note, created_by, amount, type, date, source, category, category_confidence, category_source, created_at, updated_at`
+	splitColumns    = `id, movement_id, account_id, category, amount, note, created_at`
+)
+
 func NewPostgresRepository(db *pgxpool.Pool) MovementRepository {
 	return &postgresRepository{db: db, nowFunc: time.Now}
 }
 
+// querier returns the transaction stored in the context, if any, so movement changes are
+// committed together with the events they raise.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
 // CreateMovement saves a movement using database/sql.
 func (r *postgresRepository) CreateMovement(ctx context.Context, movement *domain.Movement) error {
 	now := r.nowFunc()
@@ -48,49 +67,113 @@ func (r *postgresRepository) CreateMovement(ctx context.Context, movement *domai
 	message_id,
 	notification_id,
 	description,
+	merchant_id,
+	financial_account_id,
+	tags,
+	note,
+	created_by,
 	amount,
 	type,
 	date,
 	source,
 	category,
+	category_confidence,
+	category_source,
 	created_at,
 	updated_at)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
-	_, err := r.db.Exec(ctx, query,
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)`
+	_, err := r.querier(ctx).Exec(ctx, query,
 		movement.ID,
 		movement.AccountID,
 		movement.InstitutionID,
 		movement.MessageID,
 		movement.ExtractID,
 		movement.Description,
+		movement.MerchantID,
+		movement.FinancialAccountID,
+		tagsOrEmpty(movement.Tags),
+		movement.Note,
+		movement.CreatedBy,
 		movement.Amount,
 		movement.Type,
 		movement.Date,
 		movement.Source,
 		movement.Category,
+		movement.CategoryConfidence,
+		movement.CategorySource,
 		movement.CreatedAt,
 		movement.UpdatedAt)
 
 	return err
 }
 
-// GetMovementByID gets a movement by ID.
-func (r *postgresRepository) GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error) {
-	query := `SELECT
-	id, account_id, institution_id, message_id, notification_id, description, amount, type, date, source, category, created_at, updated_at
+// UpdateMovement saves the editable fields of a movement.
+func (r *postgresRepository) UpdateMovement(ctx context.Context, movement *domain.Movement) error {
+	movement.UpdatedAt = r.nowFunc()
+
+	query := `UPDATE movements SET
+	institution_id = $1,
+	description = $2,
+	merchant_id = $3,
+	financial_account_id = $4,
+	tags = $5,
+	note = $6,
+	amount = $7,
+	type = $8,
+	date = $9,
+	category = $10,
+	category_confidence = $11,
+	category_source = $12,
+	updated_at = $13
+	WHERE id = $14 AND account_id = $15`
+
+	tag, err := r.querier(ctx).Exec(ctx, query,
+		movement.InstitutionID,
+		movement.Description,
+		movement.MerchantID,
+		movement.FinancialAccountID,
+		tagsOrEmpty(movement.Tags),
+		movement.Note,
+		movement.Amount,
+		movement.Type,
+		movement.Date,
+		movement.Category,
+		movement.CategoryConfidence,
+		movement.CategorySource,
+		movement.UpdatedAt,
+		movement.ID,
+		movement.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMovementNotFound
+	}
+
+	return nil
+}
+
+// GetMovementByID gets a movement by ID, when it belongs to one of the accounts.
+func (r *postgresRepository) GetMovementByID(ctx context.Context, id string, accountIDs []string) (*domain.Movement, error) {
+	query := `SELECT ` + movementColumns + `
 	FROM movements
-	WHERE id = $1 AND account_id = $2`
+	WHERE id = $1 AND account_id = ANY($2)`
 
-	row := r.db.QueryRow(ctx, query, id, accountID)
+	row := r.db.QueryRow(ctx, query, id, accountIDs)
 
 	return scanToMovement(row.Scan)
 }
 
-// GetTotalMovementsByAccountID retrieves the total count of movements for a given account.
-func (r *postgresRepository) GetTotalMovementsByAccountID(ctx context.Context, accountID string) (int, error) {
-	countQuery := `SELECT COUNT(*) FROM movements WHERE account_id = $1`
+// GetTotalMovementsByAccountIDs retrieves the total count of movements of the given accounts,
+// of the given institutions and with all the given tags when any.
+func (r *postgresRepository) GetTotalMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string) (int, error) {
+	countQuery := `SELECT COUNT(*) FROM movements
+	WHERE account_id = ANY($1)
+	AND ($2::text[] IS NULL OR institution_id = ANY($2))
+	AND ($3::text[] IS NULL OR tags @> $3)`
 	var totalRecords int
-	err := r.db.QueryRow(ctx, countQuery, accountID).Scan(&totalRecords)
+	err := r.db.QueryRow(ctx, countQuery, accountIDs, institutionIDs, tags).Scan(&totalRecords)
 	if err != nil {
 		return 0, err
 	}
@@ -98,17 +181,18 @@ func (r *postgresRepository) GetTotalMovementsByAccountID(ctx context.Context, a
 	return totalRecords, nil
 }
 
-// GetMovementsByAccountID gets a user's movements with pagination.
-func (r *postgresRepository) GetMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) ([]*domain.Movement, error) {
-	query := `SELECT
-		id, account_id, institution_id, message_id, notification_id, description, amount, type, date, source, category, created_at, updated_at
+// GetMovementsByAccountIDs gets the movements of the given accounts with pagination, of the
+// given institutions and with all the given tags when any.
+func (r *postgresRepository) GetMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string, limit int, offset int) ([]*domain.Movement, error) {
+	query := `SELECT ` + movementColumns + `
 	FROM movements
-	WHERE account_id = $1
+	WHERE account_id = ANY($1)
     AND ($2::text[] IS NULL OR institution_id = ANY($2))
+	AND ($3::text[] IS NULL OR tags @> $3)
 	ORDER BY date DESC
-	LIMIT $3 OFFSET $4`
+	LIMIT $4 OFFSET $5`
 
-	rows, err := r.db.Query(ctx, query, accountID, institutionIDs, limit, offset*limit)
+	rows, err := r.db.Query(ctx, query, accountIDs, institutionIDs, tags, limit, offset*limit)
 	if err != nil {
 		return nil, err
 	}
@@ -140,8 +224,8 @@ func scanToMovement(scanFn func(...any) error) (*domain.Movement, error) {
 	var movementType, category string
 
 	err := scanFn(
-		&m.ID, &m.AccountID, &institutionID, &messageID, &extractID, &description, &m.Amount,
-		&movementType, &date, &source, &category, &createdAt, &updatedAt,
+		&m.ID, &m.AccountID, &institutionID, &messageID, &extractID, &description, &m.MerchantID, &m.FinancialAccountID, &m.TransferID, &m.Tags, &m.Note, &m.CreatedBy, &m.Amount,
+		&movementType, &date, &source, &category, &m.CategoryConfidence, &m.CategorySource, &createdAt, &updatedAt,
 	)
 
 	if err != nil {
@@ -190,16 +274,149 @@ func scanToMovement(scanFn func(...any) error) (*domain.Movement, error) {
 	return m, nil
 }
 
+// tagsOrEmpty returns tags, or no tags instead of nil since the column can't be NULL.
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+
+	return tags
+}
+
+// Delete removes a movement of the account. The other side of its transfer, if any, stops
+// being a transfer; the transfer itself is removed by the database.
 func (r *postgresRepository) Delete(ctx context.Context, id string, accountID string) error {
+	unlink := `UPDATE movements SET transfer_id = ''
+	WHERE account_id = $2 AND id <> $1 AND transfer_id <> ''
+	AND transfer_id = (SELECT transfer_id FROM movements WHERE id = $1 AND account_id = $2)`
+
+	_, err := r.querier(ctx).Exec(ctx, unlink, id, accountID)
+	if err != nil {
+		return err
+	}
+
 	query := `DELETE FROM movements WHERE id = $1 AND account_id = $2`
-	_, err := r.db.Exec(ctx, query, id, accountID)
+	_, err = r.querier(ctx).Exec(ctx, query, id, accountID)
 	return err
 }
 
-func (r *postgresRepository) DeleteMovementsByExtractID(ctx context.Context, extractID string) error {
-	query := `DELETE FROM movements WHERE extract_id = $1`
+// DeleteMovementsByExtractID deletes the movements of a statement and returns them.
+// The extract ID is stored in the notification_id column. Movements of other statements
+// that were transfers with them stop being transfers.
+func (r *postgresRepository) DeleteMovementsByExtractID(ctx context.Context, extractID string) ([]*domain.Movement, error) {
+	unlink := `UPDATE movements SET transfer_id = ''
+	WHERE notification_id <> $1 AND transfer_id <> ''
+	AND transfer_id IN (SELECT transfer_id FROM movements WHERE notification_id = $1 AND transfer_id <> '')`
+
+	_, err := r.querier(ctx).Exec(ctx, unlink, extractID)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := r.db.Exec(ctx, query, extractID)
+	query := `DELETE FROM movements WHERE notification_id = $1
+	RETURNING ` + movementColumns
 
-	return err
+	rows, err := r.querier(ctx).Query(ctx, query, extractID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	movements := []*domain.Movement{}
+	for rows.Next() {
+		m, err := scanToMovement(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// GetSplits returns the allocations of a movement of the account in the order they were given.
+func (r *postgresRepository) GetSplits(ctx context.Context, movementID string, accountID string) ([]*domain.Split, error) {
+	query := `SELECT ` + splitColumns + `
+	FROM movement_splits
+	WHERE movement_id = $1 AND account_id = $2
+	ORDER BY position`
+
+	rows, err := r.querier(ctx).Query(ctx, query, movementID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	splits := []*domain.Split{}
+	for rows.Next() {
+		split := &domain.Split{}
+
+		var category string
+
+		err := rows.Scan(&split.ID, &split.MovementID, &split.AccountID, &category, &split.Amount, &split.Note, &split.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		split.Category = domain.MovementCategory(category)
+		splits = append(splits, split)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return splits, nil
+}
+
+// ReplaceSplits removes the allocations of a movement of the account and stores the given
+// ones instead. Allocations owed by a contact are kept, failing with ErrSplitsOwed. It must
+// run in a transaction so a failure keeps the previous allocations.
+func (r *postgresRepository) ReplaceSplits(ctx context.Context, movementID string, accountID string, splits []*domain.Split) error {
+	var owed bool
+
+	err := r.querier(ctx).QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM debts WHERE movement_id = $1 AND split_id <> '')`, movementID).Scan(&owed)
+	if err != nil {
+		return err
+	}
+
+	if owed {
+		return ErrSplitsOwed
+	}
+
+	_, err = r.querier(ctx).Exec(ctx, `DELETE FROM movement_splits WHERE movement_id = $1 AND account_id = $2`, movementID, accountID)
+	if err != nil {
+		return err
+	}
+
+	now := r.nowFunc()
+
+	query := `INSERT INTO movement_splits (` + splitColumns + `, position)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	for i, split := range splits {
+		split.CreatedAt = now
+
+		_, err := r.querier(ctx).Exec(ctx, query,
+			split.ID,
+			movementID,
+			accountID,
+			string(split.Category),
+			split.Amount,
+			split.Note,
+			split.CreatedAt,
+			i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
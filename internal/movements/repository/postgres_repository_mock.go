@@ -19,18 +19,24 @@ func (m *MockMovementRepository) CreateMovement(ctx context.Context, movement *d
 	return args.Error(0)
 }
 
+// UpdateMovement simulates the update of a movement.
+func (m *MockMovementRepository) UpdateMovement(ctx context.Context, movement *domain.Movement) error {
+	args := m.Called(ctx, movement)
+	return args.Error(0)
+}
+
 // GetMovementByID simulates retrieving a movement by its ID.
-func (m *MockMovementRepository) GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error) {
-	args := m.Called(ctx, id, accountID)
+func (m *MockMovementRepository) GetMovementByID(ctx context.Context, id string, accountIDs []string) (*domain.Movement, error) {
+	args := m.Called(ctx, id, accountIDs)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.Movement), args.Error(1)
 }
 
-// GetMovementsByAccountID simulates retrieving movements by account ID.
-func (m *MockMovementRepository) GetMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) ([]*domain.Movement, error) {
-	args := m.Called(ctx, accountID, institutionIDs, limit, offset)
+// GetMovementsByAccountIDs simulates retrieving movements by account IDs.
+func (m *MockMovementRepository) GetMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string, limit int, offset int) ([]*domain.Movement, error) {
+	args := m.Called(ctx, accountIDs, institutionIDs, tags, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -38,9 +44,9 @@ func (m *MockMovementRepository) GetMovementsByAccountID(ctx context.Context, ac
 	return args.Get(0).([]*domain.Movement), args.Error(1)
 }
 
-// GetTotalMovementsByAccountID simulates retrieving the total number of movements for an account ID.
-func (m *MockMovementRepository) GetTotalMovementsByAccountID(ctx context.Context, accountID string) (int, error) {
-	args := m.Called(ctx, accountID)
+// GetTotalMovementsByAccountIDs simulates retrieving the total number of movements for account IDs.
+func (m *MockMovementRepository) GetTotalMovementsByAccountIDs(ctx context.Context, accountIDs []string, institutionIDs []string, tags []string) (int, error) {
+	args := m.Called(ctx, accountIDs, institutionIDs, tags)
 	if args.Get(0) == nil {
 		return 0, args.Error(1)
 	}
@@ -55,7 +61,27 @@ func (m *MockMovementRepository) Delete(ctx context.Context, id string, accountI
 }
 
 // DeleteMovementsByExtractID simulates deleting movements by extract ID.
-func (m *MockMovementRepository) DeleteMovementsByExtractID(ctx context.Context, extractID string) error {
+func (m *MockMovementRepository) DeleteMovementsByExtractID(ctx context.Context, extractID string) ([]*domain.Movement, error) {
 	args := m.Called(ctx, extractID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Movement), args.Error(1)
+}
+
+// GetSplits simulates retrieving the allocations of a movement.
+func (m *MockMovementRepository) GetSplits(ctx context.Context, movementID string, accountID string) ([]*domain.Split, error) {
+	args := m.Called(ctx, movementID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Split), args.Error(1)
+}
+
+// ReplaceSplits simulates replacing the allocations of a movement.
+func (m *MockMovementRepository) ReplaceSplits(ctx context.Context, movementID string, accountID string, splits []*domain.Split) error {
+	args := m.Called(ctx, movementID, accountID, splits)
 	return args.Error(0)
 }
@@ -28,16 +28,16 @@ func TestMockMovementRepository_GetMovementByID(t *testing.T) {
 
 	expectedMovement := &domain.Movement{ID: "mov1", AccountID: "acc1"}
 
-	mockRepo.On("GetMovementByID", ctx, "mov1", "acc1").Return(expectedMovement, nil)
+	mockRepo.On("GetMovementByID", ctx, "mov1", []string{"acc1"}).Return(expectedMovement, nil)
 
-	result, err := mockRepo.GetMovementByID(ctx, "mov1", "acc1")
+	result, err := mockRepo.GetMovementByID(ctx, "mov1", []string{"acc1"})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedMovement, result)
 	mockRepo.AssertExpectations(t)
 }
 
-func TestMockMovementRepository_GetMovementsByAccountID(t *testing.T) {
+func TestMockMovementRepository_GetMovementsByAccountIDs(t *testing.T) {
 	mockRepo := new(MockMovementRepository)
 	ctx := context.Background()
 
@@ -46,9 +46,9 @@ func TestMockMovementRepository_GetMovementsByAccountID(t *testing.T) {
 		{ID: "mov2"},
 	}
 
-	mockRepo.On("GetMovementsByAccountID", ctx, "acc1", []string{}, 10, 0).Return(expectedMovements, nil)
+	mockRepo.On("GetMovementsByAccountIDs", ctx, []string{"acc1"}, []string{}, []string{}, 10, 0).Return(expectedMovements, nil)
 
-	result, err := mockRepo.GetMovementsByAccountID(ctx, "acc1", []string{}, 10, 0)
+	result, err := mockRepo.GetMovementsByAccountIDs(ctx, []string{"acc1"}, []string{}, []string{}, 10, 0)
 
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
@@ -56,15 +56,44 @@ func TestMockMovementRepository_GetMovementsByAccountID(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
-func TestMockMovementRepository_GetTotalMovementsByAccountID(t *testing.T) {
+func TestMockMovementRepository_GetTotalMovementsByAccountIDs(t *testing.T) {
 	mockRepo := new(MockMovementRepository)
 	ctx := context.Background()
 
-	mockRepo.On("GetTotalMovementsByAccountID", ctx, "acc1").Return(5, nil)
+	mockRepo.On("GetTotalMovementsByAccountIDs", ctx, []string{"acc1"}, []string{}, []string{}).Return(5, nil)
 
-	total, err := mockRepo.GetTotalMovementsByAccountID(ctx, "acc1")
+	total, err := mockRepo.GetTotalMovementsByAccountIDs(ctx, []string{"acc1"}, []string{}, []string{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 5, total)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestMockMovementRepository_UpdateMovement(t *testing.T) {
+	mockRepo := new(MockMovementRepository)
+	ctx := context.Background()
+
+	movement := &domain.Movement{ID: "mov1"}
+
+	mockRepo.On("UpdateMovement", ctx, movement).Return(nil)
+
+	err := mockRepo.UpdateMovement(ctx, movement)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMockMovementRepository_DeleteMovementsByExtractID(t *testing.T) {
+	mockRepo := new(MockMovementRepository)
+	ctx := context.Background()
+
+	expectedMovements := []*domain.Movement{{ID: "mov1"}}
+
+	mockRepo.On("DeleteMovementsByExtractID", ctx, "exi1").Return(expectedMovements, nil)
+
+	result, err := mockRepo.DeleteMovementsByExtractID(ctx, "exi1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMovements, result)
+	mockRepo.AssertExpectations(t)
+}
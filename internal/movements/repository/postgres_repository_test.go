@@ -42,19 +42,25 @@ func TestCreateMovement(t *testing.T) {
 	now := time.Now()
 
 	movement := &domain.Movement{
-		ID:            uuid.New().String(),
-		AccountID:     "acc1",
-		InstitutionID: "inst1",
-		ExtractID:     "exi1",
-		MessageID:     "mid1",
-		Description:   "Test Description",
-		Amount:        1000.0,
-		Type:          "expense",
-		Date:          now,
-		Source:        "card",
-		Category:      "groceries",
-		CreatedAt:     fixedTime,
-		UpdatedAt:     fixedTime,
+		ID:                 uuid.New().String(),
+		AccountID:          "acc1",
+		InstitutionID:      "inst1",
+		ExtractID:          "exi1",
+		MessageID:          "mid1",
+		Description:        "Test Description",
+		MerchantID:         "MER1",
+		FinancialAccountID: "FAC1",
+		Tags:               []string{"trip-cartagena-2026"},
+		Note:               "dinner with the team",
+		Amount:             1000.0,
+		Type:               "expense",
+		Date:               now,
+		Source:             "card",
+		Category:           "groceries",
+		CategoryConfidence: 0.87,
+		CategorySource:     "model",
+		CreatedAt:          fixedTime,
+		UpdatedAt:          fixedTime,
 	}
 
 	mock.ExpectExec(`INSERT INTO movements`).
@@ -65,11 +71,18 @@ func TestCreateMovement(t *testing.T) {
 			movement.MessageID,
 			movement.ExtractID,
 			movement.Description,
+			movement.MerchantID,
+			movement.FinancialAccountID,
+			movement.Tags,
+			movement.Note,
+			movement.CreatedBy,
 			movement.Amount,
 			movement.Type,
 			movement.Date,
 			movement.Source,
 			movement.Category,
+			movement.CategoryConfidence,
+			movement.CategorySource,
 			movement.CreatedAt,
 			movement.UpdatedAt,
 		).WillReturnResult(pgxmock.NewResult("INSERT", 1))
@@ -96,23 +109,30 @@ func TestGetMovementByID(t *testing.T) {
 	source := "card"
 	cat := "groceries"
 
-	columns := []string{"id", "account_id", "institution_id", "message_id", "notification_id", "description", "amount", "type", "date", "source", "category", "created_at", "updated_at"}
+	columns := []string{"id", "account_id", "institution_id", "message_id", "notification_id", "description", "merchant_id", "financial_account_id", "transfer_id", "tags", "note", "created_by", "amount", "type", "date", "source", "category", "category_confidence", "category_source", "created_at", "updated_at"}
 	rows := pgxmock.NewRows(columns).
-		AddRow("mov1", "acc1", &instID, &messaID, &notificaaationID, &desc, amount, "expense", &date, &source, cat, &now, &now)
+		AddRow("mov1", "acc1", &instID, &messaID, &notificaaationID, &desc, "MER1", "FAC1", "", []string{"trip"}, "paid by card", "acc2", amount, "expense", &date, &source, cat, 0.93, "model", &now, &now)
 
-	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE id = \$1 AND account_id = \$2`).
-		WithArgs("mov1", "acc1").
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE id = \$1 AND account_id = ANY\(\$2\)`).
+		WithArgs("mov1", []string{"acc1", "acc2"}).
 		WillReturnRows(rows)
 
-	m, err := repo.GetMovementByID(context.Background(), "mov1", "acc1")
+	m, err := repo.GetMovementByID(context.Background(), "mov1", []string{"acc1", "acc2"})
 	c.NoError(err)
 	c.Equal("mov1", m.ID)
 	c.Equal("acc1", m.AccountID)
+	c.Equal(0.93, m.CategoryConfidence)
+	c.Equal("model", m.CategorySource)
+	c.Equal("MER1", m.MerchantID)
+	c.Equal("FAC1", m.FinancialAccountID)
+	c.Equal([]string{"trip"}, m.Tags)
+	c.Equal("paid by card", m.Note)
+	c.Equal("acc2", m.CreatedBy)
 	c.Equal(1000.0, m.Amount)
 	c.NoError(mock.ExpectationsWereMet())
 }
 
-func TestGetMovementsByAccountID(t *testing.T) {
+func TestGetMovementsByAccountIDs(t *testing.T) {
 	c := require.New(t)
 
 	repo, mock, cleanup := setupMockDB(t)
@@ -138,20 +158,184 @@ func TestGetMovementsByAccountID(t *testing.T) {
 	source2 := "transfer"
 	cat2 := "salary"
 
-	columns := []string{"id", "account_id", "institution_id", "message_id", "notification_id", "description", "amount", "type", "date", "source", "category", "created_at", "updated_at"}
+	columns := []string{"id", "account_id", "institution_id", "message_id", "notification_id", "description", "merchant_id", "financial_account_id", "transfer_id", "tags", "note", "created_by", "amount", "type", "date", "source", "category", "category_confidence", "category_source", "created_at", "updated_at"}
 	rows := pgxmock.NewRows(columns).
-		AddRow("mov1", "acc1", &instID1, &notiID1, &messaID1, &desc1, amount1, "expense", &date1, &source1, cat1, &now, &now).
-		AddRow("mov2", "acc1", &instID2, &notiID2, &messaID2, &desc2, amount2, "income", &date2, &source2, cat2, &now, &now)
+		AddRow("mov1", "acc1", &instID1, &notiID1, &messaID1, &desc1, "MER1", "FAC1", "TRF1", []string{"trip"}, "", "", amount1, "expense", &date1, &source1, cat1, 0.93, "model", &now, &now).
+		AddRow("mov2", "acc1", &instID2, &notiID2, &messaID2, &desc2, "", "", "", []string{"trip", "work"}, "", "", amount2, "income", &date2, &source2, cat2, 1.0, "manual", &now, &now)
 
-	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE account_id = \$1 AND \(\$2::text\[\] IS NULL OR institution_id = ANY\(\$2\)\) ORDER BY date DESC LIMIT \$3 OFFSET \$4`).
-		WithArgs("acc1", pgxmock.AnyArg(), 1, 10).
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE account_id = ANY\(\$1\) AND \(\$2::text\[\] IS NULL OR institution_id = ANY\(\$2\)\) AND \(\$3::text\[\] IS NULL OR tags @> \$3\) ORDER BY date DESC LIMIT \$4 OFFSET \$5`).
+		WithArgs([]string{"acc1"}, pgxmock.AnyArg(), []string{"trip"}, 1, 10).
 		WillReturnRows(rows)
 
-	movements, err := repo.GetMovementsByAccountID(context.Background(), "acc1", []string{}, 1, 10)
+	movements, err := repo.GetMovementsByAccountIDs(context.Background(), []string{"acc1"}, []string{}, []string{"trip"}, 1, 10)
 	c.NoError(err)
 
 	c.Len(movements, 2)
 	c.Equal("mov1", movements[0].ID)
+	c.Equal("TRF1", movements[0].TransferID)
 	c.Equal(2000.0, movements[1].Amount)
 	c.NoError(mock.ExpectationsWereMet())
 }
+
+func TestUpdateMovement(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		movement := &domain.Movement{
+			ID:                 "mov1",
+			AccountID:          "acc1",
+			InstitutionID:      "inst1",
+			Description:        "Updated",
+			MerchantID:         "MER1",
+			FinancialAccountID: "FAC1",
+			Amount:             500,
+			Type:               domain.Expense,
+			Date:               fixedTime,
+			Category:           domain.Food,
+			CategoryConfidence: 1,
+			CategorySource:     "manual",
+		}
+
+		mock.ExpectExec(`UPDATE movements SET`).
+			WithArgs("inst1", "Updated", "MER1", "FAC1", []string{}, "", 500.0, domain.Expense, fixedTime, domain.Food, 1.0, "manual", fixedTime, "mov1", "acc1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := repo.UpdateMovement(context.Background(), movement)
+		c.NoError(err)
+		c.Equal(fixedTime, movement.UpdatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		mock.ExpectExec(`UPDATE movements SET`).
+			WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "mov1", "acc1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := repo.UpdateMovement(context.Background(), &domain.Movement{ID: "mov1", AccountID: "acc1"})
+		c.ErrorIs(err, ErrMovementNotFound)
+	})
+}
+
+func TestDeleteMovementsByExtractID(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	now := time.Now()
+	instID := "inst1"
+	messageID := "mid1"
+	extractID := "exi1"
+	desc := "Desc"
+	source := "extract"
+
+	columns := []string{"id", "account_id", "institution_id", "message_id", "notification_id", "description", "merchant_id", "financial_account_id", "transfer_id", "tags", "note", "created_by", "amount", "type", "date", "source", "category", "category_confidence", "category_source", "created_at", "updated_at"}
+	rows := pgxmock.NewRows(columns).
+		AddRow("mov1", "acc1", &instID, &messageID, &extractID, &desc, "", "", "", []string{}, "", "", 100.0, "expense", &now, &source, "food", 1.0, "rules", &now, &now)
+
+	mock.ExpectExec(`UPDATE movements SET transfer_id = '' WHERE notification_id <> \$1`).
+		WithArgs("exi1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectQuery(`DELETE FROM movements WHERE notification_id = \$1 RETURNING`).
+		WithArgs("exi1").
+		WillReturnRows(rows)
+
+	movements, err := repo.DeleteMovementsByExtractID(context.Background(), "exi1")
+	c.NoError(err)
+	c.Len(movements, 1)
+	c.Equal("mov1", movements[0].ID)
+	c.Equal("acc1", movements[0].AccountID)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDelete(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectExec(`UPDATE movements SET transfer_id = '' WHERE account_id = \$2 AND id <> \$1`).
+		WithArgs("mov1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(`DELETE FROM movements WHERE id = \$1 AND account_id = \$2`).
+		WithArgs("mov1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+	c.NoError(repo.Delete(context.Background(), "mov1", "acc1"))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetSplits(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	rows := pgxmock.NewRows([]string{"id", "movement_id", "account_id", "category", "amount", "note", "created_at"}).
+		AddRow("SPL1", "MID1", "acc1", "food", 60.0, "groceries", fixedTime).
+		AddRow("SPL2", "MID1", "acc1", "housing", 40.0, "", fixedTime)
+
+	mock.ExpectQuery(`SELECT id, movement_id, account_id, category, amount, note, created_at FROM movement_splits WHERE movement_id = \$1 AND account_id = \$2 ORDER BY position`).
+		WithArgs("MID1", "acc1").
+		WillReturnRows(rows)
+
+	splits, err := repo.GetSplits(context.Background(), "MID1", "acc1")
+	c.NoError(err)
+	c.Len(splits, 2)
+	c.Equal(domain.Food, splits[0].Category)
+	c.Equal("groceries", splits[0].Note)
+	c.Equal(domain.Housing, splits[1].Category)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestReplaceSplits(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	splits := []*domain.Split{
+		{ID: "SPL1", Category: domain.Food, Amount: 60, Note: "groceries"},
+		{ID: "SPL2", Category: domain.Housing, Amount: 40},
+	}
+
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM debts WHERE movement_id = \$1 AND split_id <> ''\)`).
+		WithArgs("MID1").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec(`DELETE FROM movement_splits WHERE movement_id = \$1 AND account_id = \$2`).
+		WithArgs("MID1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 3))
+	mock.ExpectExec(`INSERT INTO movement_splits`).
+		WithArgs("SPL1", "MID1", "acc1", "food", 60.0, "groceries", fixedTime, 0).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO movement_splits`).
+		WithArgs("SPL2", "MID1", "acc1", "housing", 40.0, "", fixedTime, 1).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.ReplaceSplits(context.Background(), "MID1", "acc1", splits))
+	c.Equal(fixedTime, splits[1].CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestReplaceSplits_Owed(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM debts`).
+		WithArgs("MID1").
+		WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+
+	err := repo.ReplaceSplits(context.Background(), "MID1", "acc1", nil)
+	c.ErrorIs(err, ErrSplitsOwed)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -2,16 +2,22 @@ package usecase
 
 import (
 	"context"
+	"transaction-tracker/internal/movements/classifier"
 	"transaction-tracker/internal/movements/domain"
 )
 
 // MovementUsecase define bussiness logic to manage movements.
 type MovementUsecase interface {
 	CreateMovement(ctx context.Context, movement *domain.Movement) error
+	UpdateMovement(ctx context.Context, movement *domain.Movement) error
 	GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error)
 	DeleteMovement(ctx context.Context, id string, accountID string) error
-	GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) (*domain.PaginatedMovements, error)
+	GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, tags []string, limit int, offset int) (*domain.PaginatedMovements, error)
 	GetMovementsByYear(ctx context.Context, accountID string, institutionIDs []string, year int) ([]*domain.Movement, error)
 	GetMovementsByMonth(ctx context.Context, accountID string, institutionIDs []string, year int, month int) ([]*domain.Movement, error)
 	DeleteMovementsByExtractID(ctx context.Context, extractID string) error
+	GetAllMovementsByAccountID(ctx context.Context, accountID string) ([]*domain.Movement, error)
+	SetCategory(ctx context.Context, movement *domain.Movement, classification classifier.Classification) error
+	GetSplits(ctx context.Context, id string, accountID string) ([]*domain.Split, error)
+	SetSplits(ctx context.Context, id string, accountID string, splits []*domain.Split) ([]*domain.Split, error)
 }
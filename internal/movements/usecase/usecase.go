@@ -1,49 +1,87 @@
 package usecase
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
-	"os"
 	"time"
+	anomaliesUsecase "transaction-tracker/internal/anomalies/usecase"
+	budgetsUsecase "transaction-tracker/internal/budgets/usecase"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	feedbackUsecase "transaction-tracker/internal/feedback/usecase"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
+	merchantsUsecase "transaction-tracker/internal/merchants/usecase"
+	"transaction-tracker/internal/movements/classifier"
 	"transaction-tracker/internal/movements/domain"
 	"transaction-tracker/internal/movements/repository"
+	workspacesDomain "transaction-tracker/internal/workspaces/domain"
+	workspacesUsecase "transaction-tracker/internal/workspaces/usecase"
 	"transaction-tracker/logger"
 	loggerModels "transaction-tracker/logger/models"
-	"transaction-tracker/shared"
+	"transaction-tracker/pkg/databases/postgres"
 )
 
-type ClassifyResponse struct {
-	Category   string  `json:"category"`
-	Confidence float64 `json:"confidence"`
-}
-
 var (
 	ErrMovementNotFound      = errors.New("movement not found")
 	ErrMustBeGreaterThanZero = errors.New("amount must be greater than zero")
+	ErrSplitsOwed            = repository.ErrSplitsOwed
+
+	ErrFinancialAccountNotFound = financialAccountsUsecase.ErrFinancialAccountNotFound
+	ErrWorkspaceNotFound        = workspacesUsecase.ErrWorkspaceNotFound
+	ErrForbidden                = workspacesUsecase.ErrForbidden
+)
+
+const (
+	// allMovementsPageSize is the page size used to walk every movement of an account.
+	allMovementsPageSize = 500
 )
 
 type movementUsecase struct {
-	movementRepo repository.MovementRepository
-	log          *loggerModels.Logger
+	movementRepo      repository.MovementRepository
+	transactor        postgres.Transactor
+	eventsUsecase     eventsUsecase.EventsUsecase
+	classifier        classifier.Classifier
+	categoriesUsecase categoriesUsecase.CategoriesUsecase
+	feedbackUsecase   feedbackUsecase.FeedbackUsecase
+	merchantsUsecase  merchantsUsecase.MerchantsUsecase
+	budgetsUsecase    budgetsUsecase.BudgetsUsecase
+	finUsecase        financialAccountsUsecase.FinancialAccountsUsecase
+	anomaliesUsecase  anomaliesUsecase.AnomaliesUsecase
+	workspacesUsecase workspacesUsecase.WorkspacesUsecase
+	log               *loggerModels.Logger
 }
 
 // NewMovementUsecase is the constructor for the use case implementation.
-// It receives a repository interface as a dependency.
-func NewMovementUsecase(ctx context.Context, repo repository.MovementRepository) MovementUsecase {
+// It receives a repository interface as a dependency. Changes are written together with
+// their domain events inside a transaction started by transactor. New movements are
+// categorized by cls, categories are checked against the account's tree in catUsecase and
+// manual category changes are recorded as classifier feedback in fbUsecase. Descriptions
+// are resolved to the merchants of the account by merchUsecase and new expenses are checked
+// against the budgets of budUsecase and for anomalies by anmUsecase. Movements are linked to
+// the financial accounts of finUsecase. When the context names a workspace, the movements of
+// all its members are worked on, as far as the role of the account in wsUsecase allows.
+func NewMovementUsecase(ctx context.Context, repo repository.MovementRepository, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase, cls classifier.Classifier, catUsecase categoriesUsecase.CategoriesUsecase, fbUsecase feedbackUsecase.FeedbackUsecase, merchUsecase merchantsUsecase.MerchantsUsecase, budUsecase budgetsUsecase.BudgetsUsecase, finUsecase financialAccountsUsecase.FinancialAccountsUsecase, anmUsecase anomaliesUsecase.AnomaliesUsecase, wsUsecase workspacesUsecase.WorkspacesUsecase) MovementUsecase {
 	log, _ := logger.GetLogger(ctx, "movements-usecase")
 
 	return &movementUsecase{
-		movementRepo: repo,
-		log:          log,
+		movementRepo:      repo,
+		transactor:        transactor,
+		eventsUsecase:     evUsecase,
+		classifier:        cls,
+		categoriesUsecase: catUsecase,
+		feedbackUsecase:   fbUsecase,
+		merchantsUsecase:  merchUsecase,
+		budgetsUsecase:    budUsecase,
+		finUsecase:        finUsecase,
+		anomaliesUsecase:  anmUsecase,
+		workspacesUsecase: wsUsecase,
+		log:               log,
 	}
 }
 
-// CreateMovement contains the business logic for creating a movement.
-func (u *movementUsecase) CreateMovement(ctx context.Context, movement *domain.Movement) error {
+func validateMovement(movement *domain.Movement) error {
 	if movement == nil {
 		return errors.New("movement cannot be nil")
 	}
@@ -60,41 +98,191 @@ func (u *movementUsecase) CreateMovement(ctx context.Context, movement *domain.M
 		return ErrMustBeGreaterThanZero
 	}
 
-	_, err := domain.ParseMovementCategory(string(movement.Category))
+	_, err := domain.ParseMovementType(string(movement.Type))
 	if err != nil {
 		return err
 	}
 
-	_, err = domain.ParseMovementType(string(movement.Type))
+	if movement.Date.After(time.Now()) {
+		return errors.New("movement date cannot be in the future")
+	}
+
+	err = domain.ValidateTags(movement.Tags)
 	if err != nil {
 		return err
 	}
 
-	if movement.Date.After(time.Now()) {
-		return errors.New("movement date cannot be in the future")
+	return domain.ValidateNote(movement.Note)
+}
+
+// validateCategory checks the movement category against the category tree of its account.
+func (u *movementUsecase) validateCategory(ctx context.Context, movement *domain.Movement) error {
+	return u.categoriesUsecase.ValidateCategory(ctx, movement.AccountID, movement.Category)
+}
+
+// CreateMovement contains the business logic for creating a movement. Its tags are stored
+// normalized. Movements added to a workspace are attributed to the account that adds them.
+func (u *movementUsecase) CreateMovement(ctx context.Context, movement *domain.Movement) error {
+	if movement != nil {
+		movement.Tags = domain.NormalizeTags(movement.Tags)
+	}
+
+	err := validateMovement(movement)
+	if err != nil {
+		return err
+	}
+
+	workspaceID := workspacesUsecase.WorkspaceFromContext(ctx)
+	if workspaceID != "" {
+		_, err = u.workspacesUsecase.Authorize(ctx, workspaceID, movement.AccountID, workspacesDomain.Write)
+		if err != nil {
+			return err
+		}
+
+		movement.CreatedBy = movement.AccountID
+	}
+
+	err = u.validateCategory(ctx, movement)
+	if err != nil {
+		return err
+	}
+
+	err = u.linkFinancialAccount(ctx, movement)
+	if err != nil {
+		return err
 	}
 
 	movement.Category = domain.Unknown
+	movement.CategoryConfidence = 0
+	movement.CategorySource = string(classifier.DefaultSource)
 
 	if movement.Description != "" {
-		err = u.ClassifyCategoryFromDescription(ctx, movement)
+		u.resolveMerchant(ctx, movement)
+		u.classify(ctx, movement)
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.movementRepo.CreateMovement(ctx, movement)
 		if err != nil {
-			u.log.Error(loggerModels.LogProperties{
-				Event: "error_classifying_category",
-				Error: err,
-				AdditionalParams: []loggerModels.Properties{
-					movement,
-				},
-			})
+			return err
 		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.MovementCreated, movement.AccountID, movement.ID, newMovementPayload(movement))
+	})
+	if err != nil {
+		return err
 	}
 
-	return u.movementRepo.CreateMovement(ctx, movement)
+	u.checkBudgets(ctx, movement, nil)
+	u.checkAnomalies(ctx, movement)
+
+	return nil
+}
+
+// UpdateMovement saves the editable fields of an existing movement. The category is kept
+// as given, so it works as a manual override of the classifier. Category changes are
+// recorded as feedback with the prediction they replace. The amount of a split movement
+// can't change, since its splits would no longer add up to it. In a workspace, the movement
+// stays with the member it belongs to.
+func (u *movementUsecase) UpdateMovement(ctx context.Context, movement *domain.Movement) error {
+	if movement == nil {
+		return errors.New("movement cannot be nil")
+	}
+
+	current, err := u.getMovement(ctx, movement.ID, movement.AccountID, workspacesDomain.Write)
+	if err != nil {
+		return err
+	}
+
+	movement.AccountID = current.AccountID
+
+	if movement.InstitutionID == "" {
+		movement.InstitutionID = current.InstitutionID
+	}
+
+	movement.Tags = domain.NormalizeTags(movement.Tags)
+
+	err = validateMovement(movement)
+	if err != nil {
+		return err
+	}
+
+	err = u.validateCategory(ctx, movement)
+	if err != nil {
+		return err
+	}
+
+	if movement.Amount != current.Amount {
+		err = u.checkNotSplit(ctx, current)
+		if err != nil {
+			return err
+		}
+	}
+
+	if movement.FinancialAccountID == "" {
+		movement.FinancialAccountID = current.FinancialAccountID
+	} else if movement.FinancialAccountID != current.FinancialAccountID {
+		err = u.linkFinancialAccount(ctx, movement)
+		if err != nil {
+			return err
+		}
+	}
+
+	movement.MessageID = current.MessageID
+	movement.ExtractID = current.ExtractID
+	movement.Source = current.Source
+	movement.CreatedBy = current.CreatedBy
+	movement.CreatedAt = current.CreatedAt
+
+	movement.MerchantID = current.MerchantID
+	if movement.Description != current.Description {
+		movement.MerchantID = ""
+		u.resolveMerchant(ctx, movement)
+	}
+
+	movement.CategoryConfidence = current.CategoryConfidence
+	movement.CategorySource = current.CategorySource
+
+	corrected := movement.Category != current.Category
+	if corrected {
+		movement.CategoryConfidence = 1
+		movement.CategorySource = string(classifier.ManualSource)
+	}
+
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.movementRepo.UpdateMovement(ctx, movement)
+		if err != nil {
+			if errors.Is(err, repository.ErrMovementNotFound) {
+				return ErrMovementNotFound
+			}
+
+			return err
+		}
+
+		if corrected {
+			err := u.feedbackUsecase.RecordCorrection(ctx, current, movement.Category)
+			if err != nil {
+				return err
+			}
+		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.MovementUpdated, movement.AccountID, movement.ID, newMovementPayload(movement))
+	})
 }
 
 // GetMovementByID is a sample method to get a movement.
 func (u *movementUsecase) GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error) {
-	movement, err := u.movementRepo.GetMovementByID(ctx, id, accountID)
+	return u.getMovement(ctx, id, accountID, workspacesDomain.Read)
+}
+
+// getMovement returns a movement of the scope of the account when it has the permission on it.
+func (u *movementUsecase) getMovement(ctx context.Context, id string, accountID string, permission workspacesDomain.Permission) (*domain.Movement, error) {
+	accountIDs, err := u.scope(ctx, accountID, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := u.movementRepo.GetMovementByID(ctx, id, accountIDs)
 	if err != nil {
 		if errors.Is(err, repository.ErrMovementNotFound) {
 			return nil, ErrMovementNotFound
@@ -107,7 +295,7 @@ func (u *movementUsecase) GetMovementByID(ctx context.Context, id string, accoun
 }
 
 // GetMovementsByUserID is a sample method to get a user's movements.
-func (u *movementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) (*domain.PaginatedMovements, error) {
+func (u *movementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, tags []string, limit int, offset int) (*domain.PaginatedMovements, error) {
 	if limit <= 0 {
 		limit = 10
 	}
@@ -121,7 +309,12 @@ func (u *movementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context,
 		offset = 0
 	}
 
-	totalRecords, err := u.movementRepo.GetTotalMovementsByAccountID(ctx, accountID)
+	accountIDs, err := u.scope(ctx, accountID, workspacesDomain.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRecords, err := u.movementRepo.GetTotalMovementsByAccountIDs(ctx, accountIDs, institutionIDs, tags)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +324,7 @@ func (u *movementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context,
 		totalPages = (totalRecords + limit - 1) / limit
 	}
 
-	movements, err := u.movementRepo.GetMovementsByAccountID(ctx, accountID, institutionIDs, limit, offset)
+	movements, err := u.movementRepo.GetMovementsByAccountIDs(ctx, accountIDs, institutionIDs, tags, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -147,16 +340,28 @@ func (u *movementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context,
 }
 
 func (u *movementUsecase) DeleteMovement(ctx context.Context, id string, accountID string) error {
-	_, err := u.GetMovementByID(ctx, id, accountID)
+	movement, err := u.getMovement(ctx, id, accountID, workspacesDomain.Write)
 	if err != nil {
 		return err
 	}
 
-	return u.movementRepo.Delete(ctx, id, accountID)
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.movementRepo.Delete(ctx, id, movement.AccountID)
+		if err != nil {
+			return err
+		}
+
+		return u.emitDeleted(ctx, id, movement.AccountID)
+	})
 }
 
 func (u *movementUsecase) GetMovementsByYear(ctx context.Context, accountID string, institutionIDs []string, year int) ([]*domain.Movement, error) {
-	movements, err := u.movementRepo.GetMovementsByAccountID(ctx, accountID, institutionIDs, 1000, 0)
+	accountIDs, err := u.scope(ctx, accountID, workspacesDomain.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := u.movementRepo.GetMovementsByAccountIDs(ctx, accountIDs, institutionIDs, nil, 1000, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +377,12 @@ func (u *movementUsecase) GetMovementsByYear(ctx context.Context, accountID stri
 }
 
 func (u *movementUsecase) GetMovementsByMonth(ctx context.Context, accountID string, institutionIDs []string, year int, month int) ([]*domain.Movement, error) {
-	movements, err := u.movementRepo.GetMovementsByAccountID(ctx, accountID, institutionIDs, 1000, 0)
+	accountIDs, err := u.scope(ctx, accountID, workspacesDomain.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, err := u.movementRepo.GetMovementsByAccountIDs(ctx, accountIDs, institutionIDs, nil, 1000, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -188,54 +398,311 @@ func (u *movementUsecase) GetMovementsByMonth(ctx context.Context, accountID str
 }
 
 func (u *movementUsecase) DeleteMovementsByExtractID(ctx context.Context, extractID string) error {
-	return u.movementRepo.DeleteMovementsByExtractID(ctx, extractID)
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		movements, err := u.movementRepo.DeleteMovementsByExtractID(ctx, extractID)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range movements {
+			err := u.emitDeleted(ctx, m.ID, m.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetAllMovementsByAccountID returns every movement of the account, reading the repository page by page.
+func (u *movementUsecase) GetAllMovementsByAccountID(ctx context.Context, accountID string) ([]*domain.Movement, error) {
+	accountIDs, err := u.scope(ctx, accountID, workspacesDomain.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := []*domain.Movement{}
+
+	for page := 0; ; page++ {
+		batch, err := u.movementRepo.GetMovementsByAccountIDs(ctx, accountIDs, nil, nil, allMovementsPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+
+		movements = append(movements, batch...)
+
+		if len(batch) < allMovementsPageSize {
+			return movements, nil
+		}
+	}
 }
 
-func (u *movementUsecase) ClassifyCategoryFromDescription(ctx context.Context, movement *domain.Movement) error {
-	payload := map[string]interface{}{
-		"description": movement.Description,
+// SetCategory changes only the category of a stored movement to the one of the classification,
+// as done when a rule is applied retroactively.
+func (u *movementUsecase) SetCategory(ctx context.Context, movement *domain.Movement, classification classifier.Classification) error {
+	if movement == nil {
+		return errors.New("movement cannot be nil")
 	}
 
-	data, err := json.Marshal(payload)
+	err := u.categoriesUsecase.ValidateCategory(ctx, movement.AccountID, classification.Category)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", os.Getenv("CLASSIFY_CATEGORY_URL"), bytes.NewBuffer(data))
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		previous := *movement
+		setClassification(movement, &classification)
+
+		err := u.movementRepo.UpdateMovement(ctx, movement)
+		if err != nil {
+			*movement = previous
+
+			if errors.Is(err, repository.ErrMovementNotFound) {
+				return ErrMovementNotFound
+			}
+
+			return err
+		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.MovementUpdated, movement.AccountID, movement.ID, newMovementPayload(movement))
+	})
+}
+
+// GetSplits returns the allocations of a movement of the account, none when it is not split.
+func (u *movementUsecase) GetSplits(ctx context.Context, id string, accountID string) ([]*domain.Split, error) {
+	movement, err := u.GetMovementByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.movementRepo.GetSplits(ctx, id, movement.AccountID)
+}
+
+// SetSplits replaces the allocations of a movement of the account with the categories,
+// amounts and notes given, which must add up to its amount. No splits leaves the movement
+// unsplit. The budgets of the new categories are checked. Splits owed by a contact can't be
+// replaced until their debts are deleted. It returns the allocations stored.
+func (u *movementUsecase) SetSplits(ctx context.Context, id string, accountID string, splits []*domain.Split) ([]*domain.Split, error) {
+	movement, err := u.getMovement(ctx, id, accountID, workspacesDomain.Write)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	allocations := make([]*domain.Split, 0, len(splits))
+	for _, split := range splits {
+		err := u.categoriesUsecase.ValidateCategory(ctx, movement.AccountID, split.Category)
+		if err != nil {
+			return nil, err
+		}
+
+		allocations = append(allocations, domain.NewSplit(movement, split.Category, split.Amount, split.Note))
+	}
+
+	err = domain.ValidateSplits(movement, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.movementRepo.ReplaceSplits(ctx, id, movement.AccountID, allocations)
+		if err != nil {
+			return err
+		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.MovementUpdated, movement.AccountID, movement.ID, newMovementPayload(movement))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	u.checkBudgets(ctx, movement, allocations)
 
-	res, err := shared.Client.Do(req)
+	return allocations, nil
+}
+
+// scope returns the accounts whose movements the request works on: the account alone, or
+// every member of the workspace in the context when the role of the account there allows the
+// permission.
+func (u *movementUsecase) scope(ctx context.Context, accountID string, permission workspacesDomain.Permission) ([]string, error) {
+	workspaceID := workspacesUsecase.WorkspaceFromContext(ctx)
+	if workspaceID == "" {
+		return []string{accountID}, nil
+	}
+
+	return u.workspacesUsecase.GetAccountIDs(ctx, workspaceID, accountID, permission)
+}
+
+// checkNotSplit fails when the movement has allocations.
+func (u *movementUsecase) checkNotSplit(ctx context.Context, movement *domain.Movement) error {
+	splits, err := u.movementRepo.GetSplits(ctx, movement.ID, movement.AccountID)
 	if err != nil {
 		return err
 	}
 
-	defer res.Body.Close()
+	if len(splits) > 0 {
+		return fmt.Errorf("%w: remove the splits of the movement before changing its amount", domain.ErrInvalidSplits)
+	}
+
+	return nil
+}
+
+func setClassification(movement *domain.Movement, classification *classifier.Classification) {
+	movement.Category = classification.Category
+	movement.CategoryConfidence = classification.Confidence
+	movement.CategorySource = string(classification.Source)
+}
+
+func (u *movementUsecase) emitDeleted(ctx context.Context, id string, accountID string) error {
+	return u.eventsUsecase.Emit(ctx, eventsDomain.MovementDeleted, accountID, id, eventsDomain.MovementDeletedPayload{
+		ID:        id,
+		AccountID: accountID,
+	})
+}
+
+func newMovementPayload(m *domain.Movement) eventsDomain.MovementPayload {
+	return eventsDomain.MovementPayload{
+		ID:                 m.ID,
+		AccountID:          m.AccountID,
+		InstitutionID:      m.InstitutionID,
+		MessageID:          m.MessageID,
+		ExtractID:          m.ExtractID,
+		Description:        m.Description,
+		MerchantID:         m.MerchantID,
+		FinancialAccountID: m.FinancialAccountID,
+		Tags:               m.Tags,
+		Note:               m.Note,
+		CreatedBy:          m.CreatedBy,
+		Amount:             m.Amount,
+		Type:               string(m.Type),
+		Category:           string(m.Category),
+		Source:             string(m.Source),
+		Date:               m.Date,
+	}
+}
+
+// checkBudgets notifies the budget thresholds the stored movement made its category, or the
+// categories of its splits, reach. Failures are logged, the movement is already stored.
+func (u *movementUsecase) checkBudgets(ctx context.Context, movement *domain.Movement, splits []*domain.Split) {
+	err := u.budgetsUsecase.CheckThresholds(ctx, movement, splits)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "error_checking_budgets",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
+	}
+}
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("error classifying category: status %d", res.StatusCode)
+// checkAnomalies flags the stored movement when it is out of the pattern of its account.
+// Failures are logged, the movement is already stored.
+func (u *movementUsecase) checkAnomalies(ctx context.Context, movement *domain.Movement) {
+	_, err := u.anomaliesUsecase.CheckMovement(ctx, movement)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "error_checking_anomalies",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
 	}
+}
 
-	cr := ClassifyResponse{}
-	err = json.NewDecoder(res.Body).Decode(&cr)
+// resolveMerchant sets the merchant the movement description resolves to. Failures are
+// logged and leave the movement without merchant.
+func (u *movementUsecase) resolveMerchant(ctx context.Context, movement *domain.Movement) {
+	merchant, err := u.merchantsUsecase.ResolveMerchant(ctx, movement.AccountID, movement.Description)
 	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "error_resolving_merchant",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
+
+		return
+	}
+
+	if merchant != nil {
+		movement.MerchantID = merchant.ID
+	}
+}
+
+// linkFinancialAccount checks the financial account the movement is linked to belongs to its
+// account. Movements given none are linked to the financial account of their institution,
+// if any; failures resolving it are logged and leave the movement unlinked.
+func (u *movementUsecase) linkFinancialAccount(ctx context.Context, movement *domain.Movement) error {
+	if movement.FinancialAccountID != "" {
+		_, err := u.finUsecase.GetFinancialAccount(ctx, movement.FinancialAccountID, movement.AccountID)
 		return err
 	}
 
+	id, err := u.finUsecase.ResolveFinancialAccount(ctx, movement.AccountID, movement.InstitutionID)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "error_resolving_financial_account",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
+
+		return nil
+	}
+
+	movement.FinancialAccountID = id
+
+	return nil
+}
+
+// classify sets the category predicted for the movement. Classifier failures are logged
+// and leave whatever category the classifier fell back to.
+func (u *movementUsecase) classify(ctx context.Context, movement *domain.Movement) {
+	classification, err := u.classifier.Classify(ctx, movement)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "error_classifying_category",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				movement,
+			},
+		})
+	}
+
+	if classification == nil {
+		return
+	}
+
+	// The account may have removed the predicted category from its tree.
+	if classification.Category != domain.Unknown {
+		err := u.categoriesUsecase.ValidateCategory(ctx, movement.AccountID, classification.Category)
+		if err != nil {
+			u.log.Error(loggerModels.LogProperties{
+				Event: "classified_category_not_allowed",
+				Error: err,
+				AdditionalParams: []loggerModels.Properties{
+					movement,
+				},
+			})
+
+			return
+		}
+	}
+
+	setClassification(movement, classification)
+
 	u.log.Info(loggerModels.LogProperties{
 		Event: "category_classified",
 		AdditionalParams: []loggerModels.Properties{
 			logger.MapToProperties(map[string]string{
-				"confidence": string(fmt.Sprintf("%.2f", cr.Confidence)),
-				"category":   cr.Category,
+				"confidence": fmt.Sprintf("%.2f", classification.Confidence),
+				"category":   string(classification.Category),
+				"source":     string(classification.Source),
 			}),
 		},
 	})
-
-	movement.Category = domain.MovementCategory(cr.Category)
-
-	return nil
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"transaction-tracker/internal/movements/classifier"
 	"transaction-tracker/internal/movements/domain"
 
 	"github.com/stretchr/testify/mock"
@@ -22,6 +23,17 @@ func (m *MockMovementUsecase) CreateMovement(ctx context.Context, movement *doma
 	return args.Error(0)
 }
 
+func (m *MockMovementUsecase) UpdateMovement(ctx context.Context, movement *domain.Movement) error {
+	if m == nil {
+		return nil
+	}
+	args := m.Called(ctx, movement)
+	if len(args) == 0 {
+		return nil
+	}
+	return args.Error(0)
+}
+
 func (m *MockMovementUsecase) GetMovementByID(ctx context.Context, id string, accountID string) (*domain.Movement, error) {
 	if m == nil {
 		return nil, nil
@@ -123,12 +135,12 @@ func (m *MockMovementUsecase) GetMovementsByMonth(ctx context.Context, accountID
 	return movements, args.Error(1)
 }
 
-func (m *MockMovementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, limit int, offset int) (*domain.PaginatedMovements, error) {
+func (m *MockMovementUsecase) GetPaginatedMovementsByAccountID(ctx context.Context, accountID string, institutionIDs []string, tags []string, limit int, offset int) (*domain.PaginatedMovements, error) {
 	if m == nil {
 		return nil, nil
 	}
 
-	args := m.Called(ctx, accountID, institutionIDs, limit, offset)
+	args := m.Called(ctx, accountID, institutionIDs, tags, limit, offset)
 
 	var movements *domain.PaginatedMovements
 	if val := args.Get(0); val != nil {
@@ -139,3 +151,47 @@ func (m *MockMovementUsecase) GetPaginatedMovementsByAccountID(ctx context.Conte
 
 	return movements, args.Error(1)
 }
+
+func (m *MockMovementUsecase) GetAllMovementsByAccountID(ctx context.Context, accountID string) ([]*domain.Movement, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	args := m.Called(ctx, accountID)
+
+	var movements []*domain.Movement
+	if val := args.Get(0); val != nil {
+		if cast, ok := val.([]*domain.Movement); ok {
+			movements = cast
+		}
+	}
+
+	return movements, args.Error(1)
+}
+
+func (m *MockMovementUsecase) SetCategory(ctx context.Context, movement *domain.Movement, classification classifier.Classification) error {
+	if m == nil {
+		return nil
+	}
+
+	args := m.Called(ctx, movement, classification)
+	return args.Error(0)
+}
+
+func (m *MockMovementUsecase) GetSplits(ctx context.Context, id string, accountID string) ([]*domain.Split, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Split), args.Error(1)
+}
+
+func (m *MockMovementUsecase) SetSplits(ctx context.Context, id string, accountID string, splits []*domain.Split) ([]*domain.Split, error) {
+	args := m.Called(ctx, id, accountID, splits)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Split), args.Error(1)
+}
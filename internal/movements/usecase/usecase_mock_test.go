@@ -41,6 +41,19 @@ func TestCreateMovement_NilRepo(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMockUpdateMovement(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockMovementUsecase)
+	movement := &domain.Movement{ID: "123"}
+
+	mockRepo.On("UpdateMovement", ctx, movement).Return(nil)
+
+	err := mockRepo.UpdateMovement(ctx, movement)
+	require.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestGetMovementByID_Success(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(MockMovementUsecase)
@@ -7,17 +7,86 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	anomaliesUsecase "transaction-tracker/internal/anomalies/usecase"
+	budgetsUsecase "transaction-tracker/internal/budgets/usecase"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	feedbackUsecase "transaction-tracker/internal/feedback/usecase"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
+	merchantsDomain "transaction-tracker/internal/merchants/domain"
+	merchantsUsecase "transaction-tracker/internal/merchants/usecase"
+	"transaction-tracker/internal/movements/classifier"
 	"transaction-tracker/internal/movements/domain"
 	"transaction-tracker/internal/movements/repository"
+	workspacesDomain "transaction-tracker/internal/workspaces/domain"
+	workspacesUsecase "transaction-tracker/internal/workspaces/usecase"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/databases/postgres"
 )
 
+type noopLogService struct{}
+
+func (noopLogService) Log(string, loggerModels.LogProperties) {}
+func (noopLogService) SetService(string)                      {}
+
+func newMockCategories() *categoriesUsecase.MockCategoriesUsecase {
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("ValidateCategory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return categories
+}
+
+func newMockFeedback() *feedbackUsecase.MockFeedbackUsecase {
+	feedback := new(feedbackUsecase.MockFeedbackUsecase)
+	feedback.On("RecordCorrection", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return feedback
+}
+
+func newMockMerchants() *merchantsUsecase.MockMerchantsUsecase {
+	merchants := new(merchantsUsecase.MockMerchantsUsecase)
+	merchants.On("ResolveMerchant", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+
+	return merchants
+}
+
+func newMockBudgets() *budgetsUsecase.MockBudgetsUsecase {
+	budgets := new(budgetsUsecase.MockBudgetsUsecase)
+	budgets.On("CheckThresholds", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return budgets
+}
+
+func newMockAnomalies() *anomaliesUsecase.MockAnomaliesUsecase {
+	anomalies := new(anomaliesUsecase.MockAnomaliesUsecase)
+	anomalies.On("CheckMovement", mock.Anything, mock.Anything).Return(nil, nil)
+
+	return anomalies
+}
+
+func newMockFinancialAccounts() *financialAccountsUsecase.MockFinancialAccountsUsecase {
+	financialAccounts := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+	financialAccounts.On("ResolveFinancialAccount", mock.Anything, mock.Anything, mock.Anything).Return("", nil)
+
+	return financialAccounts
+}
+
+func newMockEvents() *eventsUsecase.MockEventsUsecase {
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return events
+}
+
 func TestCreateMovement(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
 
-	u := NewMovementUsecase(context.Background(), mockRepo)
+	u := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 
 	t.Run("success", func(t *testing.T) {
@@ -111,10 +180,109 @@ func TestCreateMovement(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+func TestCreateMovement_Classification(t *testing.T) {
+	ctx := context.Background()
+
+	newMovement := func() *domain.Movement {
+		return &domain.Movement{
+			AccountID:     "acc1",
+			InstitutionID: "iid",
+			Description:   "RAPPI COLOMBIA",
+			Type:          domain.Expense,
+			Category:      domain.Unknown,
+			Amount:        35000,
+			Date:          time.Now(),
+		}
+	}
+
+	setup := func(cls classifier.Classifier, categories categoriesUsecase.CategoriesUsecase) (*movementUsecase, *repository.MockMovementRepository) {
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil)
+
+		return &movementUsecase{
+			movementRepo:      mockRepo,
+			transactor:        postgres.NewMockTransactor(),
+			eventsUsecase:     newMockEvents(),
+			classifier:        cls,
+			categoriesUsecase: categories,
+			merchantsUsecase:  newMockMerchants(),
+			budgetsUsecase:    newMockBudgets(),
+			finUsecase:        newMockFinancialAccounts(),
+			anomaliesUsecase:  newMockAnomalies(),
+			log:               &loggerModels.Logger{Service: noopLogService{}},
+		}, mockRepo
+	}
+
+	t.Run("uses the predicted category", func(t *testing.T) {
+		c := require.New(t)
+
+		u, _ := setup(classifier.NewDefaultClassifier(""), newMockCategories())
+
+		movement := newMovement()
+		c.NoError(u.CreateMovement(ctx, movement))
+		c.Equal(domain.Food, movement.Category)
+		c.Equal(string(classifier.RulesSource), movement.CategorySource)
+		c.NotZero(movement.CategoryConfidence)
+	})
+
+	t.Run("classifier failure keeps the fallback", func(t *testing.T) {
+		c := require.New(t)
+
+		movement := newMovement()
+
+		cls := new(classifier.MockClassifier)
+		cls.On("Classify", ctx, movement).Return(&classifier.Classification{Category: domain.Unknown, Source: classifier.DefaultSource}, errors.New("classifier down"))
+
+		u, mockRepo := setup(cls, newMockCategories())
+
+		c.NoError(u.CreateMovement(ctx, movement))
+		c.Equal(domain.Unknown, movement.Category)
+		mockRepo.AssertCalled(t, "CreateMovement", mock.Anything, movement)
+	})
+
+	t.Run("predicted category outside the account tree", func(t *testing.T) {
+		c := require.New(t)
+
+		categories := new(categoriesUsecase.MockCategoriesUsecase)
+		categories.On("ValidateCategory", ctx, "acc1", domain.Unknown).Return(nil)
+		categories.On("ValidateCategory", ctx, "acc1", domain.Food).Return(domain.ErrInvalidMovementCategory)
+
+		u, _ := setup(classifier.NewDefaultClassifier(""), categories)
+
+		movement := newMovement()
+		c.NoError(u.CreateMovement(ctx, movement))
+		c.Equal(domain.Unknown, movement.Category)
+	})
+}
+
+func TestCreateMovement_CategoryNotInTree(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	movement := &domain.Movement{
+		AccountID:     "acc1",
+		InstitutionID: "iid",
+		Type:          domain.Expense,
+		Category:      "pets",
+		Amount:        100,
+		Date:          time.Now(),
+	}
+
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("ValidateCategory", ctx, "acc1", movement.Category).Return(domain.ErrInvalidMovementCategory)
+
+	mockRepo := new(repository.MockMovementRepository)
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), categories, newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.ErrorIs(u.CreateMovement(ctx, movement), domain.ErrInvalidMovementCategory)
+	mockRepo.AssertNotCalled(t, "CreateMovement", mock.Anything, mock.Anything)
+}
+
 func TestCreateMovementWithRepositoryError(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
-	usecase := NewMovementUsecase(context.Background(), mockRepo)
+	usecase := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 
 	testMovement := &domain.Movement{
@@ -138,12 +306,12 @@ func TestCreateMovementWithRepositoryError(t *testing.T) {
 func TestGetMovementByID(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
-	usecase := NewMovementUsecase(context.Background(), mockRepo)
+	usecase := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 	testID := uuid.New().String()
 	expectedMovement := &domain.Movement{ID: testID, AccountID: "acc1"}
 
-	mockRepo.On("GetMovementByID", ctx, testID, "acc1").Return(expectedMovement, nil).Once()
+	mockRepo.On("GetMovementByID", ctx, testID, []string{"acc1"}).Return(expectedMovement, nil).Once()
 	foundMovement, err := usecase.GetMovementByID(ctx, testID, "acc1")
 	c.NoError(err)
 	c.NotNil(foundMovement)
@@ -155,11 +323,11 @@ func TestGetMovementByID(t *testing.T) {
 func TestGetMovementByIDWithRepositoryError(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
-	usecase := NewMovementUsecase(context.Background(), mockRepo)
+	usecase := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 	testID := uuid.New().String()
 
-	mockRepo.On("GetMovementByID", ctx, testID, "acc1").Return(nil, errors.New("db error")).Once()
+	mockRepo.On("GetMovementByID", ctx, testID, []string{"acc1"}).Return(nil, errors.New("db error")).Once()
 	foundMovement, err := usecase.GetMovementByID(ctx, testID, "acc1")
 	c.Error(err)
 	c.Nil(foundMovement)
@@ -169,7 +337,7 @@ func TestGetMovementByIDWithRepositoryError(t *testing.T) {
 func TestGetMovementsByAccountID(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
-	usecase := NewMovementUsecase(context.Background(), mockRepo)
+	usecase := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 
 	testAccountID := uuid.New().String()
@@ -182,13 +350,13 @@ func TestGetMovementsByAccountID(t *testing.T) {
 	limit := 10
 	offset := 0
 
-	mockRepo.On("GetMovementsByAccountID", ctx, testAccountID, []string{}, limit, offset).
+	mockRepo.On("GetMovementsByAccountIDs", ctx, []string{testAccountID}, []string{}, []string{"trip"}, limit, offset).
 		Return(expectedMovements, nil).Once()
 
-	mockRepo.On("GetTotalMovementsByAccountID", ctx, testAccountID).
+	mockRepo.On("GetTotalMovementsByAccountIDs", ctx, []string{testAccountID}, []string{}, []string{"trip"}).
 		Return(len(expectedMovements), nil)
 
-	foundMovements, err := usecase.GetPaginatedMovementsByAccountID(ctx, testAccountID, []string{}, limit, offset)
+	foundMovements, err := usecase.GetPaginatedMovementsByAccountID(ctx, testAccountID, []string{}, []string{"trip"}, limit, offset)
 	c.NoError(err)
 	c.NotNil(foundMovements)
 	c.Equal(len(expectedMovements), len(foundMovements.Movements))
@@ -199,22 +367,708 @@ func TestGetMovementsByAccountID(t *testing.T) {
 func TestGetMovementsByAccountIDWithRepositoryError(t *testing.T) {
 	c := require.New(t)
 	mockRepo := new(repository.MockMovementRepository)
-	usecase := NewMovementUsecase(context.Background(), mockRepo)
+	usecase := NewMovementUsecase(context.Background(), mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
 	ctx := context.Background()
 	testAccountID := uuid.New().String()
 
 	limit := 10
 	offset := 0
 
-	mockRepo.On("GetMovementsByAccountID", ctx, testAccountID, []string{}, limit, offset).
+	mockRepo.On("GetMovementsByAccountIDs", ctx, []string{testAccountID}, []string{}, []string{"trip"}, limit, offset).
 		Return(nil, errors.New("db error")).Once()
 
-	mockRepo.On("GetTotalMovementsByAccountID", ctx, testAccountID).
+	mockRepo.On("GetTotalMovementsByAccountIDs", ctx, []string{testAccountID}, []string{}, []string{"trip"}).
 		Return(1, nil)
 
-	foundMovements, err := usecase.GetPaginatedMovementsByAccountID(ctx, testAccountID, []string{}, limit, offset)
+	foundMovements, err := usecase.GetPaginatedMovementsByAccountID(ctx, testAccountID, []string{}, []string{"trip"}, limit, offset)
 	c.Error(err)
 	c.Nil(foundMovements)
 
 	mockRepo.AssertExpectations(t)
 }
+
+func TestCreateMovement_EmitsEvent(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	movement := &domain.Movement{
+		ID:            "MID1",
+		AccountID:     "acc1",
+		InstitutionID: "iid",
+		Type:          domain.Expense,
+		Category:      domain.Food,
+		Amount:        100,
+		Date:          time.Now(),
+	}
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("CreateMovement", ctx, movement).Return(nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MovementCreated, "acc1", "MID1", mock.AnythingOfType("domain.MovementPayload")).Return(nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.NoError(u.CreateMovement(ctx, movement))
+
+	mockRepo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestCreateMovement_EmitError(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	movement := &domain.Movement{
+		ID:            "MID1",
+		AccountID:     "acc1",
+		InstitutionID: "iid",
+		Type:          domain.Expense,
+		Category:      domain.Food,
+		Amount:        100,
+		Date:          time.Now(),
+	}
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("CreateMovement", ctx, movement).Return(nil).Once()
+
+	expectedErr := errors.New("outbox failure")
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MovementCreated, "acc1", "MID1", mock.Anything).Return(expectedErr).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.ErrorIs(u.CreateMovement(ctx, movement), expectedErr)
+}
+
+func TestUpdateMovement(t *testing.T) {
+	ctx := context.Background()
+
+	current := &domain.Movement{
+		ID:            "MID1",
+		AccountID:     "acc1",
+		InstitutionID: "iid",
+		MessageID:     "MSI1",
+		Source:        domain.EmailSource,
+		Type:          domain.Expense,
+		Category:      domain.Unknown,
+		Amount:        100,
+		Date:          time.Now(),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		movement := &domain.Movement{
+			ID:        "MID1",
+			AccountID: "acc1",
+			Type:      domain.Expense,
+			Category:  domain.Food,
+			Amount:    120,
+			Date:      time.Now(),
+		}
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{}, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, movement).Return(nil).Once()
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", ctx, eventsDomain.MovementUpdated, "acc1", "MID1", mock.AnythingOfType("domain.MovementPayload")).Return(nil).Once()
+
+		feedback := new(feedbackUsecase.MockFeedbackUsecase)
+		feedback.On("RecordCorrection", ctx, current, domain.Food).Return(nil).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), feedback, newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		c.NoError(u.UpdateMovement(ctx, movement))
+		c.Equal("iid", movement.InstitutionID)
+		c.Equal("MSI1", movement.MessageID)
+		c.Equal(domain.EmailSource, movement.Source)
+		c.Equal(domain.Food, movement.Category)
+		c.Equal(1.0, movement.CategoryConfidence)
+		c.Equal(string(classifier.ManualSource), movement.CategorySource)
+
+		mockRepo.AssertExpectations(t)
+		events.AssertExpectations(t)
+		feedback.AssertExpectations(t)
+	})
+
+	t.Run("same category keeps the prediction", func(t *testing.T) {
+		c := require.New(t)
+
+		predicted := *current
+		predicted.CategoryConfidence = 0.8
+		predicted.CategorySource = string(classifier.ModelSource)
+
+		movement := &domain.Movement{
+			ID:        "MID1",
+			AccountID: "acc1",
+			Type:      domain.Expense,
+			Category:  domain.Unknown,
+			Amount:    120,
+			Date:      time.Now(),
+		}
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(&predicted, nil).Once()
+		mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{}, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, movement).Return(nil).Once()
+
+		feedback := new(feedbackUsecase.MockFeedbackUsecase)
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), feedback, newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		c.NoError(u.UpdateMovement(ctx, movement))
+		c.Equal(0.8, movement.CategoryConfidence)
+		c.Equal(string(classifier.ModelSource), movement.CategorySource)
+		feedback.AssertNotCalled(t, "RecordCorrection", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID2", []string{"acc1"}).Return(nil, repository.ErrMovementNotFound).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		err := u.UpdateMovement(ctx, &domain.Movement{ID: "MID2", AccountID: "acc1"})
+		c.ErrorIs(err, ErrMovementNotFound)
+	})
+
+	t.Run("invalid movement", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		err := u.UpdateMovement(ctx, &domain.Movement{ID: "MID1", AccountID: "acc1", Type: domain.Expense, Category: domain.Food})
+		c.ErrorIs(err, ErrMustBeGreaterThanZero)
+	})
+
+	t.Run("nil movement", func(t *testing.T) {
+		u := NewMovementUsecase(ctx, new(repository.MockMovementRepository), postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		require.Error(t, u.UpdateMovement(ctx, nil))
+	})
+}
+
+func TestMovementMerchant(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(merchants merchantsUsecase.MerchantsUsecase, mockRepo *repository.MockMovementRepository) *movementUsecase {
+		return &movementUsecase{
+			movementRepo:      mockRepo,
+			transactor:        postgres.NewMockTransactor(),
+			eventsUsecase:     newMockEvents(),
+			classifier:        new(classifier.MockClassifier),
+			categoriesUsecase: newMockCategories(),
+			feedbackUsecase:   newMockFeedback(),
+			merchantsUsecase:  merchants,
+			budgetsUsecase:    newMockBudgets(),
+			finUsecase:        newMockFinancialAccounts(),
+			anomaliesUsecase:  newMockAnomalies(),
+			log:               &loggerModels.Logger{Service: noopLogService{}},
+		}
+	}
+
+	t.Run("create resolves the merchant", func(t *testing.T) {
+		c := require.New(t)
+
+		merchants := new(merchantsUsecase.MockMerchantsUsecase)
+		merchants.On("ResolveMerchant", ctx, "acc1", "RAPPI COLOMBIA").Return(&merchantsDomain.Merchant{ID: "MER1"}, nil).Once()
+
+		cls := new(classifier.MockClassifier)
+		cls.On("Classify", mock.Anything, mock.Anything).Return(nil, nil)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+		u := setup(merchants, mockRepo)
+		u.classifier = cls
+
+		movement := &domain.Movement{AccountID: "acc1", InstitutionID: "iid", Description: "RAPPI COLOMBIA", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.NoError(u.CreateMovement(ctx, movement))
+		c.Equal("MER1", movement.MerchantID)
+
+		merchants.AssertExpectations(t)
+	})
+
+	t.Run("create is not blocked by merchant failures", func(t *testing.T) {
+		c := require.New(t)
+
+		merchants := new(merchantsUsecase.MockMerchantsUsecase)
+		merchants.On("ResolveMerchant", ctx, "acc1", "RAPPI COLOMBIA").Return(nil, errors.New("db down")).Once()
+
+		cls := new(classifier.MockClassifier)
+		cls.On("Classify", mock.Anything, mock.Anything).Return(nil, nil)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+		u := setup(merchants, mockRepo)
+		u.classifier = cls
+
+		movement := &domain.Movement{AccountID: "acc1", InstitutionID: "iid", Description: "RAPPI COLOMBIA", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.NoError(u.CreateMovement(ctx, movement))
+		c.Empty(movement.MerchantID)
+	})
+
+	t.Run("update keeps the merchant of an unchanged description", func(t *testing.T) {
+		c := require.New(t)
+
+		current := &domain.Movement{ID: "MID1", AccountID: "acc1", InstitutionID: "iid", Description: "RAPPI", MerchantID: "MER1", Type: domain.Expense, Amount: 100, Date: time.Now()}
+
+		merchants := new(merchantsUsecase.MockMerchantsUsecase)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{}, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, mock.Anything).Return(nil).Once()
+
+		movement := &domain.Movement{ID: "MID1", AccountID: "acc1", Description: "RAPPI", Type: domain.Expense, Amount: 120, Date: time.Now()}
+		c.NoError(setup(merchants, mockRepo).UpdateMovement(ctx, movement))
+		c.Equal("MER1", movement.MerchantID)
+
+		merchants.AssertNotCalled(t, "ResolveMerchant", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("update resolves a changed description", func(t *testing.T) {
+		c := require.New(t)
+
+		current := &domain.Movement{ID: "MID1", AccountID: "acc1", InstitutionID: "iid", Description: "RAPPI", MerchantID: "MER1", Type: domain.Expense, Amount: 100, Date: time.Now()}
+
+		merchants := new(merchantsUsecase.MockMerchantsUsecase)
+		merchants.On("ResolveMerchant", ctx, "acc1", "UBER TRIP").Return(&merchantsDomain.Merchant{ID: "MER2"}, nil).Once()
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{}, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, mock.Anything).Return(nil).Once()
+
+		movement := &domain.Movement{ID: "MID1", AccountID: "acc1", Description: "UBER TRIP", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.NoError(setup(merchants, mockRepo).UpdateMovement(ctx, movement))
+		c.Equal("MER2", movement.MerchantID)
+
+		merchants.AssertExpectations(t)
+	})
+}
+
+func TestMovementFinancialAccount(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(financialAccounts financialAccountsUsecase.FinancialAccountsUsecase, mockRepo *repository.MockMovementRepository) *movementUsecase {
+		return &movementUsecase{
+			movementRepo:      mockRepo,
+			transactor:        postgres.NewMockTransactor(),
+			eventsUsecase:     newMockEvents(),
+			classifier:        new(classifier.MockClassifier),
+			categoriesUsecase: newMockCategories(),
+			feedbackUsecase:   newMockFeedback(),
+			merchantsUsecase:  newMockMerchants(),
+			budgetsUsecase:    newMockBudgets(),
+			finUsecase:        financialAccounts,
+			anomaliesUsecase:  newMockAnomalies(),
+			log:               &loggerModels.Logger{Service: noopLogService{}},
+		}
+	}
+
+	t.Run("create links the financial account of the institution", func(t *testing.T) {
+		c := require.New(t)
+
+		financialAccounts := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		financialAccounts.On("ResolveFinancialAccount", ctx, "acc1", "davivienda").Return("FAC1", nil).Once()
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+		movement := &domain.Movement{AccountID: "acc1", InstitutionID: "davivienda", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.NoError(setup(financialAccounts, mockRepo).CreateMovement(ctx, movement))
+		c.Equal("FAC1", movement.FinancialAccountID)
+	})
+
+	t.Run("create is not blocked by resolution failures", func(t *testing.T) {
+		c := require.New(t)
+
+		financialAccounts := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		financialAccounts.On("ResolveFinancialAccount", ctx, "acc1", "davivienda").Return("", errors.New("db down")).Once()
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+		movement := &domain.Movement{AccountID: "acc1", InstitutionID: "davivienda", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.NoError(setup(financialAccounts, mockRepo).CreateMovement(ctx, movement))
+		c.Empty(movement.FinancialAccountID)
+	})
+
+	t.Run("create rejects a financial account of another account", func(t *testing.T) {
+		c := require.New(t)
+
+		financialAccounts := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		financialAccounts.On("GetFinancialAccount", ctx, "FAC9", "acc1").Return(nil, ErrFinancialAccountNotFound).Once()
+
+		mockRepo := new(repository.MockMovementRepository)
+
+		movement := &domain.Movement{AccountID: "acc1", InstitutionID: "manual", FinancialAccountID: "FAC9", Type: domain.Expense, Amount: 100, Date: time.Now()}
+		c.ErrorIs(setup(financialAccounts, mockRepo).CreateMovement(ctx, movement), ErrFinancialAccountNotFound)
+
+		mockRepo.AssertNotCalled(t, "CreateMovement", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update keeps the financial account when none is given", func(t *testing.T) {
+		c := require.New(t)
+
+		current := &domain.Movement{ID: "MID1", AccountID: "acc1", InstitutionID: "davivienda", FinancialAccountID: "FAC1", Type: domain.Expense, Amount: 100, Date: time.Now()}
+
+		financialAccounts := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{}, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, mock.Anything).Return(nil).Once()
+
+		movement := &domain.Movement{ID: "MID1", AccountID: "acc1", Type: domain.Expense, Amount: 120, Date: time.Now()}
+		c.NoError(setup(financialAccounts, mockRepo).UpdateMovement(ctx, movement))
+		c.Equal("FAC1", movement.FinancialAccountID)
+
+		financialAccounts.AssertExpectations(t)
+	})
+}
+
+func TestCreateMovement_ChecksBudgets(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	budgets := new(budgetsUsecase.MockBudgetsUsecase)
+	budgets.On("CheckThresholds", ctx, mock.Anything, []*domain.Split(nil)).Return(errors.New("db down")).Once()
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+	u := &movementUsecase{
+		movementRepo:      mockRepo,
+		transactor:        postgres.NewMockTransactor(),
+		eventsUsecase:     newMockEvents(),
+		classifier:        new(classifier.MockClassifier),
+		categoriesUsecase: newMockCategories(),
+		merchantsUsecase:  newMockMerchants(),
+		budgetsUsecase:    budgets,
+		finUsecase:        newMockFinancialAccounts(),
+		anomaliesUsecase:  newMockAnomalies(),
+		log:               &loggerModels.Logger{Service: noopLogService{}},
+	}
+
+	movement := &domain.Movement{AccountID: "acc1", InstitutionID: "iid", Type: domain.Expense, Amount: 100, Date: time.Now()}
+	c.NoError(u.CreateMovement(ctx, movement))
+
+	budgets.AssertExpectations(t)
+}
+
+func TestCreateMovement_ChecksAnomalies(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	anomalies := new(anomaliesUsecase.MockAnomaliesUsecase)
+	anomalies.On("CheckMovement", ctx, mock.AnythingOfType("*domain.Movement")).Return(nil, errors.New("db down")).Once()
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("CreateMovement", mock.Anything, mock.Anything).Return(nil).Once()
+
+	u := &movementUsecase{
+		movementRepo:      mockRepo,
+		transactor:        postgres.NewMockTransactor(),
+		eventsUsecase:     newMockEvents(),
+		classifier:        new(classifier.MockClassifier),
+		categoriesUsecase: newMockCategories(),
+		merchantsUsecase:  newMockMerchants(),
+		budgetsUsecase:    newMockBudgets(),
+		finUsecase:        newMockFinancialAccounts(),
+		anomaliesUsecase:  anomalies,
+		log:               &loggerModels.Logger{Service: noopLogService{}},
+	}
+
+	movement := &domain.Movement{AccountID: "acc1", InstitutionID: "iid", Type: domain.Expense, Source: domain.EmailSource, Amount: 100, Date: time.Now()}
+	c.NoError(u.CreateMovement(ctx, movement))
+
+	anomalies.AssertExpectations(t)
+}
+
+func TestDeleteMovement_EmitsEvent(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(&domain.Movement{ID: "MID1", AccountID: "acc1"}, nil).Once()
+	mockRepo.On("Delete", ctx, "MID1", "acc1").Return(nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MovementDeleted, "acc1", "MID1", eventsDomain.MovementDeletedPayload{ID: "MID1", AccountID: "acc1"}).Return(nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.NoError(u.DeleteMovement(ctx, "MID1", "acc1"))
+
+	mockRepo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestDeleteMovementsByExtractID_EmitsEvents(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("DeleteMovementsByExtractID", ctx, "EXI1").Return([]*domain.Movement{
+		{ID: "MID1", AccountID: "acc1"},
+		{ID: "MID2", AccountID: "acc1"},
+	}, nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MovementDeleted, "acc1", "MID1", mock.Anything).Return(nil).Once()
+	events.On("Emit", ctx, eventsDomain.MovementDeleted, "acc1", "MID2", mock.Anything).Return(nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.NoError(u.DeleteMovementsByExtractID(ctx, "EXI1"))
+
+	mockRepo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestGetAllMovementsByAccountID_Pages(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	firstPage := make([]*domain.Movement, allMovementsPageSize)
+	for i := range firstPage {
+		firstPage[i] = &domain.Movement{ID: uuid.NewString()}
+	}
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("GetMovementsByAccountIDs", ctx, []string{"acc1"}, []string(nil), []string(nil), allMovementsPageSize, 0).Return(firstPage, nil).Once()
+	mockRepo.On("GetMovementsByAccountIDs", ctx, []string{"acc1"}, []string(nil), []string(nil), allMovementsPageSize, 1).Return([]*domain.Movement{{ID: "MID1"}}, nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	movements, err := u.GetAllMovementsByAccountID(ctx, "acc1")
+	c.NoError(err)
+	c.Len(movements, allMovementsPageSize+1)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetCategory(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	movement := &domain.Movement{ID: "MID1", AccountID: "acc1", Category: domain.Unknown}
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("UpdateMovement", ctx, movement).Return(nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.MovementUpdated, "acc1", "MID1", mock.AnythingOfType("domain.MovementPayload")).Return(nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.NoError(u.SetCategory(ctx, movement, classifier.Classification{Category: domain.Food, Confidence: 1, Source: classifier.AccountRulesSource}))
+	c.Equal(domain.Food, movement.Category)
+	c.Equal(string(classifier.AccountRulesSource), movement.CategorySource)
+
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("ValidateCategory", ctx, "acc1", domain.MovementCategory("nope")).Return(domain.ErrInvalidMovementCategory)
+
+	u = NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), categories, newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	c.ErrorIs(u.SetCategory(ctx, movement, classifier.Classification{Category: "nope"}), domain.ErrInvalidMovementCategory)
+
+	mockRepo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestSetSplits(t *testing.T) {
+	ctx := context.Background()
+
+	current := &domain.Movement{ID: "MID1", AccountID: "acc1", InstitutionID: "iid", Type: domain.Expense, Category: domain.Food, Amount: 150000, Date: time.Now()}
+
+	newSplits := func(amounts ...float64) []*domain.Split {
+		categories := []domain.MovementCategory{domain.Food, domain.Housing, domain.Shopping}
+
+		splits := []*domain.Split{}
+		for i, amount := range amounts {
+			splits = append(splits, &domain.Split{Category: categories[i], Amount: amount, Note: "part"})
+		}
+
+		return splits
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("ReplaceSplits", ctx, "MID1", "acc1", mock.MatchedBy(func(splits []*domain.Split) bool {
+			return len(splits) == 3 && splits[0].MovementID == "MID1" && splits[2].Category == domain.Shopping
+		})).Return(nil).Once()
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", ctx, eventsDomain.MovementUpdated, "acc1", "MID1", mock.AnythingOfType("domain.MovementPayload")).Return(nil).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), events, new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		splits, err := u.SetSplits(ctx, "MID1", "acc1", newSplits(90000, 40000, 20000))
+		c.NoError(err)
+		c.Len(splits, 3)
+		c.NotEmpty(splits[0].ID)
+		c.Equal("acc1", splits[1].AccountID)
+
+		mockRepo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("amounts don't add up", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		_, err := u.SetSplits(ctx, "MID1", "acc1", newSplits(90000, 40000))
+		c.ErrorIs(err, domain.ErrInvalidSplits)
+		mockRepo.AssertNotCalled(t, "ReplaceSplits", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("category not in tree", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+
+		categories := new(categoriesUsecase.MockCategoriesUsecase)
+		categories.On("ValidateCategory", ctx, "acc1", domain.Food).Return(nil)
+		categories.On("ValidateCategory", ctx, "acc1", domain.Housing).Return(domain.ErrInvalidMovementCategory)
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), categories, newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		_, err := u.SetSplits(ctx, "MID1", "acc1", newSplits(90000, 60000))
+		c.ErrorIs(err, domain.ErrInvalidMovementCategory)
+	})
+
+	t.Run("no splits unsplits the movement", func(t *testing.T) {
+		c := require.New(t)
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil).Once()
+		mockRepo.On("ReplaceSplits", ctx, "MID1", "acc1", []*domain.Split{}).Return(nil).Once()
+
+		u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+		splits, err := u.SetSplits(ctx, "MID1", "acc1", nil)
+		c.NoError(err)
+		c.Empty(splits)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestUpdateMovement_Split(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	current := &domain.Movement{ID: "MID1", AccountID: "acc1", InstitutionID: "iid", Type: domain.Expense, Category: domain.Food, Amount: 150000, Date: time.Now()}
+
+	mockRepo := new(repository.MockMovementRepository)
+	mockRepo.On("GetMovementByID", ctx, "MID1", []string{"acc1"}).Return(current, nil)
+	mockRepo.On("GetSplits", ctx, "MID1", "acc1").Return([]*domain.Split{
+		{ID: "SPL1", Category: domain.Food, Amount: 100000},
+		{ID: "SPL2", Category: domain.Housing, Amount: 50000},
+	}, nil).Once()
+	mockRepo.On("UpdateMovement", ctx, mock.Anything).Return(nil).Once()
+
+	u := NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), new(workspacesUsecase.MockWorkspacesUsecase))
+
+	movement := &domain.Movement{ID: "MID1", AccountID: "acc1", Type: domain.Expense, Category: domain.Food, Amount: 160000, Date: time.Now()}
+	c.ErrorIs(u.UpdateMovement(ctx, movement), domain.ErrInvalidSplits)
+
+	movement = &domain.Movement{ID: "MID1", AccountID: "acc1", Type: domain.Expense, Category: domain.Food, Description: "Supermarket", Amount: 150000, Date: time.Now()}
+	c.NoError(u.UpdateMovement(ctx, movement))
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestWorkspaceLedger(t *testing.T) {
+	ctx := workspacesUsecase.WithWorkspace(context.Background(), "WSP1")
+
+	newUsecase := func(mockRepo *repository.MockMovementRepository, workspaces *workspacesUsecase.MockWorkspacesUsecase) MovementUsecase {
+		return NewMovementUsecase(ctx, mockRepo, postgres.NewMockTransactor(), newMockEvents(), new(classifier.MockClassifier), newMockCategories(), newMockFeedback(), newMockMerchants(), newMockBudgets(), newMockFinancialAccounts(), newMockAnomalies(), workspaces)
+	}
+
+	t.Run("lists the movements of every member", func(t *testing.T) {
+		c := require.New(t)
+
+		workspaces := new(workspacesUsecase.MockWorkspacesUsecase)
+		workspaces.On("GetAccountIDs", ctx, "WSP1", "acc1", workspacesDomain.Read).Return([]string{"acc1", "acc2"}, nil).Once()
+
+		movements := []*domain.Movement{{ID: "MID1", AccountID: "acc1"}, {ID: "MID2", AccountID: "acc2"}}
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetTotalMovementsByAccountIDs", ctx, []string{"acc1", "acc2"}, []string(nil), []string(nil)).Return(2, nil).Once()
+		mockRepo.On("GetMovementsByAccountIDs", ctx, []string{"acc1", "acc2"}, []string(nil), []string(nil), 10, 0).Return(movements, nil).Once()
+
+		page, err := newUsecase(mockRepo, workspaces).GetPaginatedMovementsByAccountID(ctx, "acc1", nil, nil, 10, 1)
+		c.NoError(err)
+		c.Equal(movements, page.Movements)
+		workspaces.AssertExpectations(t)
+	})
+
+	t.Run("editor updates the movement of another member", func(t *testing.T) {
+		c := require.New(t)
+
+		workspaces := new(workspacesUsecase.MockWorkspacesUsecase)
+		workspaces.On("GetAccountIDs", ctx, "WSP1", "acc1", workspacesDomain.Write).Return([]string{"acc1", "acc2"}, nil).Once()
+
+		current := &domain.Movement{ID: "MID2", AccountID: "acc2", InstitutionID: "iid", Type: domain.Expense, Category: domain.Food, Amount: 100, Date: time.Now()}
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("GetMovementByID", ctx, "MID2", []string{"acc1", "acc2"}).Return(current, nil).Once()
+		mockRepo.On("UpdateMovement", ctx, mock.MatchedBy(func(m *domain.Movement) bool {
+			return m.AccountID == "acc2"
+		})).Return(nil).Once()
+
+		movement := &domain.Movement{ID: "MID2", AccountID: "acc1", Type: domain.Expense, Category: domain.Food, Note: "shared dinner", Amount: 100, Date: time.Now()}
+		c.NoError(newUsecase(mockRepo, workspaces).UpdateMovement(ctx, movement))
+		c.Equal("acc2", movement.AccountID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("viewer can't delete", func(t *testing.T) {
+		c := require.New(t)
+
+		workspaces := new(workspacesUsecase.MockWorkspacesUsecase)
+		workspaces.On("GetAccountIDs", ctx, "WSP1", "acc1", workspacesDomain.Write).Return(nil, ErrForbidden).Once()
+
+		mockRepo := new(repository.MockMovementRepository)
+
+		c.ErrorIs(newUsecase(mockRepo, workspaces).DeleteMovement(ctx, "MID2", "acc1"), ErrForbidden)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("created movements are attributed to the member", func(t *testing.T) {
+		c := require.New(t)
+
+		workspaces := new(workspacesUsecase.MockWorkspacesUsecase)
+		workspaces.On("Authorize", ctx, "WSP1", "acc1", workspacesDomain.Write).Return(&workspacesDomain.Member{WorkspaceID: "WSP1", AccountID: "acc1", Role: workspacesDomain.Editor}, nil).Once()
+
+		movement := &domain.Movement{ID: "MID3", AccountID: "acc1", InstitutionID: "manual", Type: domain.Expense, Amount: 100, Date: time.Now()}
+
+		mockRepo := new(repository.MockMovementRepository)
+		mockRepo.On("CreateMovement", ctx, movement).Return(nil).Once()
+
+		c.NoError(newUsecase(mockRepo, workspaces).CreateMovement(ctx, movement))
+		c.Equal("acc1", movement.AccountID)
+		c.Equal("acc1", movement.CreatedBy)
+	})
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMissingEmailAddress is returned when a notification does not include the mailbox address.
+	ErrMissingEmailAddress = errors.New("missing email address")
+	// ErrMissingHistoryID is returned when a notification does not include the history ID.
+	ErrMissingHistoryID = errors.New("missing history id")
+)
+
+// GmailNotification is the payload Gmail publishes when a watched mailbox changes.
+type GmailNotification struct {
+	EmailAddress string `json:"emailAddress"`
+	HistoryID    uint64 `json:"historyId"`
+}
+
+// LogProperties is the map to logger attibutes
+func (n *GmailNotification) LogProperties() map[string]string {
+	return map[string]string{
+		"email":      n.EmailAddress,
+		"history_id": fmt.Sprintf("%d", n.HistoryID),
+	}
+}
+
+// ParseGmailNotification decodes and validates a Gmail notification payload.
+func ParseGmailNotification(data []byte) (*GmailNotification, error) {
+	notification := &GmailNotification{}
+
+	err := json.Unmarshal(data, notification)
+	if err != nil {
+		return nil, err
+	}
+
+	if notification.EmailAddress == "" {
+		return nil, ErrMissingEmailAddress
+	}
+
+	if notification.HistoryID == 0 {
+		return nil, ErrMissingHistoryID
+	}
+
+	return notification, nil
+}
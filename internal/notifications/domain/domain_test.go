@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGmailNotification(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		notification, err := ParseGmailNotification([]byte(`{"emailAddress":"user@gmail.com","historyId":1234}`))
+		c.NoError(err)
+		c.Equal("user@gmail.com", notification.EmailAddress)
+		c.Equal(uint64(1234), notification.HistoryID)
+
+		props := notification.LogProperties()
+		c.Equal("user@gmail.com", props["email"])
+		c.Equal("1234", props["history_id"])
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseGmailNotification([]byte(`not-json`))
+		require.Error(t, err)
+	})
+
+	t.Run("missing email", func(t *testing.T) {
+		_, err := ParseGmailNotification([]byte(`{"historyId":1234}`))
+		require.ErrorIs(t, err, ErrMissingEmailAddress)
+	})
+
+	t.Run("missing history", func(t *testing.T) {
+		_, err := ParseGmailNotification([]byte(`{"emailAddress":"user@gmail.com"}`))
+		require.ErrorIs(t, err, ErrMissingHistoryID)
+	})
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	messagesDomain "transaction-tracker/internal/messages/domain"
+	"transaction-tracker/internal/notifications/domain"
+)
+
+// NotificationUsecase processes the mailbox notifications sent by Gmail, whether they
+// arrive through a pull subscription or an HTTP push.
+type NotificationUsecase interface {
+	ProcessGmailNotification(ctx context.Context, notification *domain.GmailNotification) ([]*messagesDomain.Message, error)
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	accountsUsecase "transaction-tracker/internal/accounts/usecase"
+	messagesDomain "transaction-tracker/internal/messages/domain"
+	messagesUsecase "transaction-tracker/internal/messages/usecase"
+	"transaction-tracker/internal/notifications/domain"
+)
+
+const (
+	gmailNotFoundError = "googleapi: Error 404: Requested entity was not found"
+)
+
+type notificationUsecase struct {
+	accountsUsecase accountsUsecase.AccountsUsecase
+	messagesUsecase messagesUsecase.MessageUsecase
+}
+
+// NewNotificationUsecase creates a new instance of NotificationUsecase.
+func NewNotificationUsecase(accUsecase accountsUsecase.AccountsUsecase, msgUsecase messagesUsecase.MessageUsecase) NotificationUsecase {
+	return &notificationUsecase{
+		accountsUsecase: accUsecase,
+		messagesUsecase: msgUsecase,
+	}
+}
+
+// ProcessGmailNotification resolves the account of the mailbox, refreshes its Google token
+// and processes every message referenced by the notification history.
+func (u *notificationUsecase) ProcessGmailNotification(ctx context.Context, notification *domain.GmailNotification) ([]*messagesDomain.Message, error) {
+	account, err := u.accountsUsecase.GetAccountByEmail(ctx, notification.EmailAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account: %w", err)
+	}
+
+	err = u.accountsUsecase.RefreshGoogleToken(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing google token: %w", err)
+	}
+
+	messages, err := u.messagesUsecase.ProcessByNotification(ctx, account, notification.HistoryID)
+	if err != nil {
+		return nil, fmt.Errorf("error processing messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// IsPermanentError reports whether retrying the notification can never succeed,
+// e.g. when the Gmail entity it references no longer exists.
+func IsPermanentError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), gmailNotFoundError)
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	messagesDomain "transaction-tracker/internal/messages/domain"
+	"transaction-tracker/internal/notifications/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotificationUsecase is a mock implementation of the NotificationUsecase interface.
+type MockNotificationUsecase struct {
+	mock.Mock
+}
+
+func (m *MockNotificationUsecase) ProcessGmailNotification(ctx context.Context, notification *domain.GmailNotification) ([]*messagesDomain.Message, error) {
+	args := m.Called(ctx, notification)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*messagesDomain.Message), args.Error(1)
+}
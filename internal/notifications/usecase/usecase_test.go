@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	accountsUsecase "transaction-tracker/internal/accounts/usecase"
+	messagesDomain "transaction-tracker/internal/messages/domain"
+	messagesUsecase "transaction-tracker/internal/messages/usecase"
+	"transaction-tracker/internal/notifications/domain"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessGmailNotification(t *testing.T) {
+	ctx := context.Background()
+	notification := &domain.GmailNotification{EmailAddress: "user@gmail.com", HistoryID: 10}
+	account := &accountsDomain.Account{ID: "acc1", Email: "user@gmail.com"}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		accMock := new(accountsUsecase.MockAccountsUsecase)
+		accMock.On("GetAccountByEmail", mock.Anything, "user@gmail.com").Return(account, nil)
+		accMock.On("RefreshGoogleToken", mock.Anything, account).Return(nil)
+
+		messages := []*messagesDomain.Message{{ID: "msg1"}}
+
+		msgMock := new(messagesUsecase.MockMessageUsecase)
+		msgMock.On("ProcessByNotification", mock.Anything, account, uint64(10)).Return(messages, nil)
+
+		u := NewNotificationUsecase(accMock, msgMock)
+
+		got, err := u.ProcessGmailNotification(ctx, notification)
+		c.NoError(err)
+		c.Equal(messages, got)
+
+		accMock.AssertExpectations(t)
+		msgMock.AssertExpectations(t)
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		c := require.New(t)
+
+		accMock := new(accountsUsecase.MockAccountsUsecase)
+		accMock.On("GetAccountByEmail", mock.Anything, "user@gmail.com").Return(nil, errors.New("not found"))
+
+		u := NewNotificationUsecase(accMock, new(messagesUsecase.MockMessageUsecase))
+
+		_, err := u.ProcessGmailNotification(ctx, notification)
+		c.ErrorContains(err, "error getting account")
+	})
+
+	t.Run("refresh token failed", func(t *testing.T) {
+		c := require.New(t)
+
+		accMock := new(accountsUsecase.MockAccountsUsecase)
+		accMock.On("GetAccountByEmail", mock.Anything, "user@gmail.com").Return(account, nil)
+		accMock.On("RefreshGoogleToken", mock.Anything, account).Return(errors.New("expired"))
+
+		u := NewNotificationUsecase(accMock, new(messagesUsecase.MockMessageUsecase))
+
+		_, err := u.ProcessGmailNotification(ctx, notification)
+		c.ErrorContains(err, "error refreshing google token")
+	})
+
+	t.Run("process failed", func(t *testing.T) {
+		c := require.New(t)
+
+		accMock := new(accountsUsecase.MockAccountsUsecase)
+		accMock.On("GetAccountByEmail", mock.Anything, "user@gmail.com").Return(account, nil)
+		accMock.On("RefreshGoogleToken", mock.Anything, account).Return(nil)
+
+		msgMock := new(messagesUsecase.MockMessageUsecase)
+		msgMock.On("ProcessByNotification", mock.Anything, account, uint64(10)).Return(nil, errors.New(gmailNotFoundError))
+
+		u := NewNotificationUsecase(accMock, msgMock)
+
+		_, err := u.ProcessGmailNotification(ctx, notification)
+		c.ErrorContains(err, "error processing messages")
+		c.True(IsPermanentError(err))
+	})
+}
+
+func TestIsPermanentError(t *testing.T) {
+	c := require.New(t)
+
+	c.False(IsPermanentError(nil))
+	c.False(IsPermanentError(errors.New("timeout")))
+	c.True(IsPermanentError(errors.New(gmailNotFoundError + ", notFound")))
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+// Status is the stage of a reclassification job.
+type Status string
+
+const (
+	_job_prefix = "RCJ"
+
+	// DateLayout is the format of the dates given to NewFilter.
+	DateLayout = "2006-01-02"
+
+	// StatusPending is a job created but not started yet.
+	StatusPending Status = "pending"
+	// StatusRunning is a job classifying movements.
+	StatusRunning Status = "running"
+	// StatusSucceeded is a job that went through every movement.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed is a job stopped by an error. Movements changed before the error keep
+	// their new category.
+	StatusFailed Status = "failed"
+)
+
+var (
+	// ErrInvalidFilter is returned when the filter of a job cannot select movements.
+	ErrInvalidFilter = errors.New("invalid reclassification filter")
+)
+
+// Filter selects the movements of the account a job reclassifies. Empty fields do not
+// filter. From is inclusive and To is exclusive.
+type Filter struct {
+	From        *time.Time                         `json:"from,omitempty"`
+	To          *time.Time                         `json:"to,omitempty"`
+	Categories  []movementsDomain.MovementCategory `json:"categories,omitempty"`
+	OnlyUnknown bool                               `json:"only_unknown,omitempty"`
+}
+
+// NewFilter parses a filter from dates in DateLayout, both inclusive, and category slugs.
+// Empty values do not filter.
+func NewFilter(from string, to string, categories []string, onlyUnknown bool) (Filter, error) {
+	filter := Filter{OnlyUnknown: onlyUnknown}
+
+	if from != "" {
+		date, err := time.Parse(DateLayout, from)
+		if err != nil {
+			return Filter{}, fmt.Errorf("%w: from must be a %s date", ErrInvalidFilter, DateLayout)
+		}
+
+		filter.From = &date
+	}
+
+	if to != "" {
+		date, err := time.Parse(DateLayout, to)
+		if err != nil {
+			return Filter{}, fmt.Errorf("%w: to must be a %s date", ErrInvalidFilter, DateLayout)
+		}
+
+		date = date.AddDate(0, 0, 1)
+		filter.To = &date
+	}
+
+	for _, category := range categories {
+		category = strings.TrimSpace(category)
+		if category != "" {
+			filter.Categories = append(filter.Categories, movementsDomain.MovementCategory(category))
+		}
+	}
+
+	return filter, filter.Validate()
+}
+
+// Validate checks the date range of the filter.
+func (f Filter) Validate() error {
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidFilter)
+	}
+
+	return nil
+}
+
+// Matches reports whether the movement is selected by the filter.
+func (f Filter) Matches(movement *movementsDomain.Movement) bool {
+	if f.From != nil && movement.Date.Before(*f.From) {
+		return false
+	}
+
+	if f.To != nil && !movement.Date.Before(*f.To) {
+		return false
+	}
+
+	if f.OnlyUnknown && movement.Category != movementsDomain.Unknown {
+		return false
+	}
+
+	if len(f.Categories) > 0 && !slices.Contains(f.Categories, movement.Category) {
+		return false
+	}
+
+	return true
+}
+
+// IsManual reports whether the user chose the category of the movement. Jobs never change
+// manual categories.
+func IsManual(movement *movementsDomain.Movement) bool {
+	return movement.CategorySource == string(classifier.ManualSource)
+}
+
+// Job reclassifies the movements of an account selected by its filter. Total is the number
+// of movements selected, Processed how many were already classified, Changed how many got a
+// new category, Skipped how many were left as they were because of a manual category or
+// a category missing from the account's tree, and Failed how many could not be classified
+// because a classifier failed.
+type Job struct {
+	ID         string
+	AccountID  string
+	Filter     Filter
+	Status     Status
+	Total      int
+	Processed  int
+	Changed    int
+	Skipped    int
+	Failed     int
+	Error      string
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (j *Job) LogProperties() map[string]string {
+	return map[string]string{
+		"job_id":     j.ID,
+		"account_id": j.AccountID,
+		"status":     string(j.Status),
+		"total":      strconv.Itoa(j.Total),
+		"processed":  strconv.Itoa(j.Processed),
+		"changed":    strconv.Itoa(j.Changed),
+		"skipped":    strconv.Itoa(j.Skipped),
+		"failed":     strconv.Itoa(j.Failed),
+		"error":      j.Error,
+	}
+}
+
+// NewJob creates a pending job for the account.
+func NewJob(accountID string, filter Filter) (*Job, error) {
+	err := filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Job{
+		ID:        _job_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		Filter:    filter,
+		Status:    StatusPending,
+	}, nil
+}
+
+// Start marks the job as running over total movements.
+func (j *Job) Start(total int, now time.Time) {
+	j.Status = StatusRunning
+	j.Total = total
+	j.StartedAt = &now
+}
+
+// Finish marks the job as succeeded, or as failed when err is not nil.
+func (j *Job) Finish(err error, now time.Time) {
+	j.Status = StatusSucceeded
+	j.FinishedAt = &now
+
+	if err != nil {
+		j.Status = StatusFailed
+		j.Error = err.Error()
+	}
+}
+
+// Progress is the fraction of the selected movements already processed, from 0 to 1.
+func (j *Job) Progress() float64 {
+	if j.Status == StatusSucceeded {
+		return 1
+	}
+
+	if j.Total == 0 {
+		return 0
+	}
+
+	return float64(j.Processed) / float64(j.Total)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		movement *movementsDomain.Movement
+		expected bool
+	}{
+		{"empty filter", Filter{}, &movementsDomain.Movement{Category: movementsDomain.Food}, true},
+		{"inside range", Filter{From: &from, To: &to}, &movementsDomain.Movement{Date: from}, true},
+		{"before range", Filter{From: &from}, &movementsDomain.Movement{Date: from.Add(-time.Second)}, false},
+		{"to is exclusive", Filter{To: &to}, &movementsDomain.Movement{Date: to}, false},
+		{"only unknown", Filter{OnlyUnknown: true}, &movementsDomain.Movement{Category: movementsDomain.Food}, false},
+		{"only unknown match", Filter{OnlyUnknown: true}, &movementsDomain.Movement{Category: movementsDomain.Unknown}, true},
+		{"category", Filter{Categories: []movementsDomain.MovementCategory{movementsDomain.Food, movementsDomain.Travel}}, &movementsDomain.Movement{Category: movementsDomain.Travel}, true},
+		{"other category", Filter{Categories: []movementsDomain.MovementCategory{movementsDomain.Food}}, &movementsDomain.Movement{Category: movementsDomain.Health}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.filter.Matches(tt.movement))
+		})
+	}
+}
+
+func TestNewJob(t *testing.T) {
+	c := require.New(t)
+
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewJob("acc1", Filter{From: &from, To: &from})
+	c.ErrorIs(err, ErrInvalidFilter)
+
+	job, err := NewJob("acc1", Filter{OnlyUnknown: true})
+	c.NoError(err)
+	c.Equal(StatusPending, job.Status)
+	c.Contains(job.ID, _job_prefix)
+}
+
+func TestJob_Lifecycle(t *testing.T) {
+	c := require.New(t)
+
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	job, err := NewJob("acc1", Filter{})
+	c.NoError(err)
+
+	job.Start(4, now)
+	job.Processed = 1
+	c.Equal(StatusRunning, job.Status)
+	c.Equal(0.25, job.Progress())
+
+	job.Finish(errors.New("classifier down"), now)
+	c.Equal(StatusFailed, job.Status)
+	c.Equal("classifier down", job.Error)
+	c.Equal(&now, job.FinishedAt)
+}
+
+func TestIsManual(t *testing.T) {
+	require.True(t, IsManual(&movementsDomain.Movement{CategorySource: string(classifier.ManualSource)}))
+	require.False(t, IsManual(&movementsDomain.Movement{CategorySource: string(classifier.ModelSource)}))
+}
+
+func TestNewFilter(t *testing.T) {
+	c := require.New(t)
+
+	filter, err := NewFilter("2025-09-01", "2025-09-30", []string{"food", " ", "unknown"}, true)
+	c.NoError(err)
+	c.Equal(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), *filter.From)
+	c.Equal(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), *filter.To)
+	c.Equal([]movementsDomain.MovementCategory{movementsDomain.Food, movementsDomain.Unknown}, filter.Categories)
+	c.True(filter.OnlyUnknown)
+
+	filter, err = NewFilter("2025-09-01", "2025-09-01", nil, false)
+	c.NoError(err)
+	c.True(filter.Matches(&movementsDomain.Movement{Date: time.Date(2025, 9, 1, 23, 0, 0, 0, time.UTC)}))
+
+	_, err = NewFilter("09/01/2025", "", nil, false)
+	c.ErrorIs(err, ErrInvalidFilter)
+
+	_, err = NewFilter("2025-09-30", "2025-09-01", nil, false)
+	c.ErrorIs(err, ErrInvalidFilter)
+}
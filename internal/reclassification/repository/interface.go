@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/reclassification/domain"
+)
+
+// JobRepository stores the reclassification jobs of each account.
+type JobRepository interface {
+	CreateJob(ctx context.Context, job *domain.Job) error
+	GetJobByID(ctx context.Context, id string, accountID string) (*domain.Job, error)
+	GetJobsByAccountID(ctx context.Context, accountID string) ([]*domain.Job, error)
+	UpdateJob(ctx context.Context, job *domain.Job) error
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	"transaction-tracker/internal/reclassification/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrJobNotFound = errors.New("reclassification job not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the reclassification jobs repository.
+func NewPostgresRepository(db *pgxpool.Pool) JobRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+const (
+	jobColumns = `id, account_id, filter, status, total, processed, changed, skipped, failed, error, started_at, finished_at, created_at, updated_at`
+
+	// maxJobs caps the history of jobs listed for an account.
+	maxJobs = 50
+)
+
+// CreateJob inserts a new job.
+func (r *postgresRepository) CreateJob(ctx context.Context, job *domain.Job) error {
+	filter, err := json.Marshal(job.Filter)
+	if err != nil {
+		return err
+	}
+
+	now := r.nowFunc()
+
+	query := `INSERT INTO reclassification_jobs (` + jobColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+
+	_, err = r.db.Exec(ctx, query,
+		job.ID,
+		job.AccountID,
+		filter,
+		string(job.Status),
+		job.Total,
+		job.Processed,
+		job.Changed,
+		job.Skipped,
+		job.Failed,
+		job.Error,
+		job.StartedAt,
+		job.FinishedAt,
+		now,
+		now)
+	if err != nil {
+		return err
+	}
+
+	job.CreatedAt = now
+	job.UpdatedAt = now
+
+	return nil
+}
+
+// GetJobByID returns a job of the account.
+func (r *postgresRepository) GetJobByID(ctx context.Context, id string, accountID string) (*domain.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM reclassification_jobs WHERE id = $1 AND account_id = $2`
+
+	job, err := scanJob(r.db.QueryRow(ctx, query, id, accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+
+	return job, err
+}
+
+// GetJobsByAccountID returns the latest jobs of the account, newest first.
+func (r *postgresRepository) GetJobsByAccountID(ctx context.Context, accountID string) ([]*domain.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM reclassification_jobs
+	WHERE account_id = $1
+	ORDER BY created_at DESC
+	LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, accountID, maxJobs)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	jobs := []*domain.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// UpdateJob saves the status and progress of a job.
+func (r *postgresRepository) UpdateJob(ctx context.Context, job *domain.Job) error {
+	now := r.nowFunc()
+
+	query := `UPDATE reclassification_jobs
+	SET status = $1, total = $2, processed = $3, changed = $4, skipped = $5, failed = $6, error = $7, started_at = $8, finished_at = $9, updated_at = $10
+	WHERE id = $11 AND account_id = $12`
+
+	tag, err := r.db.Exec(ctx, query,
+		string(job.Status),
+		job.Total,
+		job.Processed,
+		job.Changed,
+		job.Skipped,
+		job.Failed,
+		job.Error,
+		job.StartedAt,
+		job.FinishedAt,
+		now,
+		job.ID,
+		job.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrJobNotFound
+	}
+
+	job.UpdatedAt = now
+
+	return nil
+}
+
+func scanJob(row pgx.Row) (*domain.Job, error) {
+	job := &domain.Job{}
+
+	var (
+		filter []byte
+		status string
+	)
+
+	err := row.Scan(&job.ID, &job.AccountID, &filter, &status, &job.Total, &job.Processed, &job.Changed, &job.Skipped, &job.Failed, &job.Error, &job.StartedAt, &job.FinishedAt, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(filter, &job.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Status = domain.Status(status)
+
+	return job, nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/reclassification/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockJobRepository is a mock of the repository interface.
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) CreateJob(ctx context.Context, job *domain.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) GetJobByID(ctx context.Context, id string, accountID string) (*domain.Job, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) GetJobsByAccountID(ctx context.Context, accountID string) ([]*domain.Job, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) UpdateJob(ctx context.Context, job *domain.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reclassification/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	jobRowColumns = []string{"id", "account_id", "filter", "status", "total", "processed", "changed", "skipped", "failed", "error", "started_at", "finished_at", "created_at", "updated_at"}
+)
+
+func setupMockDB(t *testing.T) (JobRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateJob(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	job := &domain.Job{
+		ID:        "RCJ1",
+		AccountID: "acc1",
+		Filter:    domain.Filter{OnlyUnknown: true},
+		Status:    domain.StatusPending,
+	}
+
+	mock.ExpectExec(`INSERT INTO reclassification_jobs`).
+		WithArgs("RCJ1", "acc1", []byte(`{"only_unknown":true}`), "pending", 0, 0, 0, 0, 0, "", (*time.Time)(nil), (*time.Time)(nil), fixedTime, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateJob(context.Background(), job))
+	c.Equal(fixedTime, job.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetJobsByAccountID(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(jobRowColumns).
+		AddRow("RCJ2", "acc1", []byte(`{"categories":["food"]}`), "running", 10, 4, 2, 1, 1, "", &fixedTime, nil, fixedTime, fixedTime).
+		AddRow("RCJ1", "acc1", []byte(`{"only_unknown":true}`), "succeeded", 3, 3, 3, 0, 0, "", &fixedTime, &fixedTime, fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM reclassification_jobs WHERE account_id = \$1 ORDER BY created_at DESC LIMIT \$2`).
+		WithArgs("acc1", maxJobs).
+		WillReturnRows(rows)
+
+	jobs, err := repo.GetJobsByAccountID(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(jobs, 2)
+	c.Equal(domain.StatusRunning, jobs[0].Status)
+	c.Equal([]movementsDomain.MovementCategory{movementsDomain.Food}, jobs[0].Filter.Categories)
+	c.Nil(jobs[0].FinishedAt)
+	c.Equal(1, jobs[0].Failed)
+	c.True(jobs[1].Filter.OnlyUnknown)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetJobByID_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM reclassification_jobs WHERE id = \$1 AND account_id = \$2`).
+		WithArgs("RCJ1", "acc1").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetJobByID(context.Background(), "RCJ1", "acc1")
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestUpdateJob(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	job := &domain.Job{ID: "RCJ1", AccountID: "acc1", Status: domain.StatusRunning, Total: 10, Processed: 5, Changed: 3, Skipped: 1, Failed: 1, StartedAt: &fixedTime}
+
+	mock.ExpectExec(`UPDATE reclassification_jobs`).
+		WithArgs("running", 10, 5, 3, 1, 1, "", &fixedTime, (*time.Time)(nil), fixedTime, "RCJ1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	c.NoError(repo.UpdateJob(context.Background(), job))
+	c.Equal(fixedTime, job.UpdatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateJob_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE reclassification_jobs`).
+		WithArgs("", 0, 0, 0, 0, 0, "", (*time.Time)(nil), (*time.Time)(nil), fixedTime, "RCJ1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	err := repo.UpdateJob(context.Background(), &domain.Job{ID: "RCJ1", AccountID: "acc1"})
+	require.ErrorIs(t, err, ErrJobNotFound)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/reclassification/domain"
+)
+
+// ReclassificationUsecase runs the jobs that classify again the stored movements of an account.
+type ReclassificationUsecase interface {
+	CreateJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error)
+	StartJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error)
+	RunJob(ctx context.Context, job *domain.Job) error
+	GetJob(ctx context.Context, id string, accountID string) (*domain.Job, error)
+	GetJobs(ctx context.Context, accountID string) ([]*domain.Job, error)
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/reclassification/domain"
+	"transaction-tracker/internal/reclassification/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+)
+
+const (
+	// batchSize is how many descriptions are sent to the classifier at once. Progress is
+	// saved after every batch.
+	batchSize = 100
+)
+
+var (
+	ErrJobNotFound = repository.ErrJobNotFound
+)
+
+type reclassificationUsecase struct {
+	ctx              context.Context
+	repo             repository.JobRepository
+	movementsUsecase movementsUsecase.MovementUsecase
+	classifier       classifier.Classifier
+	nowFunc          func() time.Time
+	goFunc           func(func())
+	log              *loggerModels.Logger
+}
+
+// NewReclassificationUsecase creates a new instance of ReclassificationUsecase. Jobs started
+// in the background run with ctx, so they outlive the request that started them.
+func NewReclassificationUsecase(ctx context.Context, repo repository.JobRepository, movementsUsecase movementsUsecase.MovementUsecase, cls classifier.Classifier) ReclassificationUsecase {
+	log, _ := logger.GetLogger(ctx, "reclassification-usecase")
+
+	return &reclassificationUsecase{
+		ctx:              ctx,
+		repo:             repo,
+		movementsUsecase: movementsUsecase,
+		classifier:       cls,
+		nowFunc:          time.Now,
+		goFunc:           func(f func()) { go f() },
+		log:              log,
+	}
+}
+
+// CreateJob stores a pending job for the account without running it.
+func (u *reclassificationUsecase) CreateJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error) {
+	job, err := domain.NewJob(accountID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.CreateJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// StartJob creates a job and runs it in the background. The returned job is pending; its
+// progress is read with GetJob.
+func (u *reclassificationUsecase) StartJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error) {
+	job, err := u.CreateJob(ctx, accountID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	running := *job
+
+	u.goFunc(func() {
+		// RunJob logs its own failures.
+		_ = u.RunJob(u.ctx, &running)
+	})
+
+	return job, nil
+}
+
+// RunJob classifies again the movements selected by the job and saves its progress after
+// every batch. Manual categories are never changed, and predictions that are unknown or equal
+// to the current category are ignored. Movements a classifier fails on are counted as failed
+// and the job goes on with the rest.
+func (u *reclassificationUsecase) RunJob(ctx context.Context, job *domain.Job) error {
+	err := u.run(ctx, job)
+
+	job.Finish(err, u.nowFunc())
+
+	updateErr := u.repo.UpdateJob(ctx, job)
+	if updateErr != nil {
+		err = errors.Join(err, updateErr)
+	}
+
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event:            "reclassification_job_failed",
+			Error:            err,
+			AdditionalParams: []loggerModels.Properties{job},
+		})
+
+		return err
+	}
+
+	u.log.Info(loggerModels.LogProperties{
+		Event:            "reclassification_job_finished",
+		AdditionalParams: []loggerModels.Properties{job},
+	})
+
+	return nil
+}
+
+func (u *reclassificationUsecase) run(ctx context.Context, job *domain.Job) error {
+	movements, err := u.movementsUsecase.GetAllMovementsByAccountID(ctx, job.AccountID)
+	if err != nil {
+		return err
+	}
+
+	selected := []*movementsDomain.Movement{}
+	for _, m := range movements {
+		if job.Filter.Matches(m) {
+			selected = append(selected, m)
+		}
+	}
+
+	job.Start(len(selected), u.nowFunc())
+
+	err = u.repo.UpdateJob(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(selected); start += batchSize {
+		batch := selected[start:min(start+batchSize, len(selected))]
+
+		err := u.reclassify(ctx, job, batch)
+		if err != nil {
+			return err
+		}
+
+		job.Processed += len(batch)
+
+		err = u.repo.UpdateJob(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *reclassificationUsecase) reclassify(ctx context.Context, job *domain.Job, batch []*movementsDomain.Movement) error {
+	candidates := []*movementsDomain.Movement{}
+
+	for _, m := range batch {
+		if domain.IsManual(m) {
+			job.Skipped++
+			continue
+		}
+
+		candidates = append(candidates, m)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Jobs usually follow a retrain, so cached predictions of the previous model are skipped.
+	classifyCtx := classifier.WithoutCache(ctx)
+
+	classifications, err := classifier.ClassifyBatch(classifyCtx, u.classifier, candidates)
+	if err != nil {
+		classifications = u.classifyEach(classifyCtx, job, candidates, classifications)
+	}
+
+	for i, m := range candidates {
+		classification := classifications[i]
+		if classification == nil || classification.Category == movementsDomain.Unknown || classification.Category == m.Category {
+			continue
+		}
+
+		err := u.movementsUsecase.SetCategory(ctx, m, *classification)
+		if errors.Is(err, movementsDomain.ErrInvalidMovementCategory) {
+			job.Skipped++
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		job.Changed++
+	}
+
+	return nil
+}
+
+// classifyEach classifies one by one the movements a failed batch left without a category,
+// since the error of a batch does not tell which movements it belongs to. Movements the
+// classifier fails on again are logged and counted as failed.
+func (u *reclassificationUsecase) classifyEach(ctx context.Context, job *domain.Job, movements []*movementsDomain.Movement, classifications []*classifier.Classification) []*classifier.Classification {
+	results := make([]*classifier.Classification, len(movements))
+
+	for i, m := range movements {
+		if i < len(classifications) && classifications[i] != nil && classifications[i].Category != movementsDomain.Unknown {
+			results[i] = classifications[i]
+			continue
+		}
+
+		classification, err := u.classifier.Classify(ctx, m)
+		if errors.Is(err, classifier.ErrNoMatch) {
+			continue
+		}
+
+		if err != nil {
+			job.Failed++
+
+			u.log.Error(loggerModels.LogProperties{
+				Event:            "reclassification_movement_failed",
+				Error:            err,
+				AdditionalParams: []loggerModels.Properties{job, m},
+			})
+
+			continue
+		}
+
+		results[i] = classification
+	}
+
+	return results
+}
+
+func (u *reclassificationUsecase) GetJob(ctx context.Context, id string, accountID string) (*domain.Job, error) {
+	return u.repo.GetJobByID(ctx, id, accountID)
+}
+
+func (u *reclassificationUsecase) GetJobs(ctx context.Context, accountID string) ([]*domain.Job, error) {
+	return u.repo.GetJobsByAccountID(ctx, accountID)
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/reclassification/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReclassificationUsecase is a mock of the ReclassificationUsecase interface.
+type MockReclassificationUsecase struct {
+	mock.Mock
+}
+
+func (m *MockReclassificationUsecase) CreateJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error) {
+	args := m.Called(ctx, accountID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *MockReclassificationUsecase) StartJob(ctx context.Context, accountID string, filter domain.Filter) (*domain.Job, error) {
+	args := m.Called(ctx, accountID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *MockReclassificationUsecase) RunJob(ctx context.Context, job *domain.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockReclassificationUsecase) GetJob(ctx context.Context, id string, accountID string) (*domain.Job, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Job), args.Error(1)
+}
+
+func (m *MockReclassificationUsecase) GetJobs(ctx context.Context, accountID string) ([]*domain.Job, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Job), args.Error(1)
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/reclassification/domain"
+	"transaction-tracker/internal/reclassification/repository"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+type noopLogService struct{}
+
+func (noopLogService) Log(string, loggerModels.LogProperties) {}
+func (noopLogService) SetService(string)                      {}
+
+func newTestUsecase(repo repository.JobRepository, movements movementsUsecase.MovementUsecase, cls classifier.Classifier) *reclassificationUsecase {
+	return &reclassificationUsecase{
+		ctx:              context.Background(),
+		repo:             repo,
+		movementsUsecase: movements,
+		classifier:       cls,
+		nowFunc:          func() time.Time { return fixedTime },
+		goFunc:           func(f func()) { f() },
+		log:              &loggerModels.Logger{Service: noopLogService{}},
+	}
+}
+
+func TestRunJob(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	unknown := &movementsDomain.Movement{ID: "MID1", AccountID: "acc1", Description: "almuerzo", Category: movementsDomain.Unknown}
+	manual := &movementsDomain.Movement{ID: "MID2", AccountID: "acc1", Description: "rappi", Category: movementsDomain.Unknown, CategorySource: string(classifier.ManualSource)}
+	same := &movementsDomain.Movement{ID: "MID3", AccountID: "acc1", Description: "carulla", Category: movementsDomain.Food}
+	missing := &movementsDomain.Movement{ID: "MID4", AccountID: "acc1", Description: "veterinaria", Category: movementsDomain.Unknown}
+
+	movements := new(movementsUsecase.MockMovementUsecase)
+	movements.On("GetAllMovementsByAccountID", ctx, "acc1").Return([]*movementsDomain.Movement{unknown, manual, same, missing}, nil).Once()
+
+	food := &classifier.Classification{Category: movementsDomain.Food, Confidence: 0.9, Source: classifier.ModelSource}
+	pets := &classifier.Classification{Category: "pets", Confidence: 0.8, Source: classifier.ModelSource}
+
+	cls := new(classifier.MockBatchClassifier)
+	cls.On("ClassifyBatch", classifier.WithoutCache(ctx), []*movementsDomain.Movement{unknown, same, missing}).
+		Return([]*classifier.Classification{food, food, pets}, nil).Once()
+
+	movements.On("SetCategory", ctx, unknown, *food).Return(nil).Once()
+	movements.On("SetCategory", ctx, missing, *pets).Return(movementsDomain.ErrInvalidMovementCategory).Once()
+
+	repo := new(repository.MockJobRepository)
+	repo.On("UpdateJob", ctx, mock.AnythingOfType("*domain.Job")).Return(nil).Times(3)
+
+	job := &domain.Job{ID: "RCJ1", AccountID: "acc1", Status: domain.StatusPending}
+
+	c.NoError(newTestUsecase(repo, movements, cls).RunJob(ctx, job))
+	c.Equal(domain.StatusSucceeded, job.Status)
+	c.Equal(4, job.Total)
+	c.Equal(4, job.Processed)
+	c.Equal(1, job.Changed)
+	c.Equal(2, job.Skipped)
+	c.Equal(&fixedTime, job.FinishedAt)
+
+	movements.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestRunJob_ClassifierFails(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	almuerzo := &movementsDomain.Movement{ID: "MID1", AccountID: "acc1", Description: "almuerzo", Category: movementsDomain.Unknown}
+	rappi := &movementsDomain.Movement{ID: "MID3", AccountID: "acc1", Description: "rappi", Category: movementsDomain.Unknown}
+
+	movements := new(movementsUsecase.MockMovementUsecase)
+	movements.On("GetAllMovementsByAccountID", ctx, "acc1").Return([]*movementsDomain.Movement{
+		almuerzo,
+		{ID: "MID2", AccountID: "acc1", Category: movementsDomain.Food},
+		rappi,
+	}, nil).Once()
+
+	expectedErr := errors.New("classifier down")
+	food := &classifier.Classification{Category: movementsDomain.Food, Confidence: 0.9, Source: classifier.ModelSource}
+
+	cls := new(classifier.MockBatchClassifier)
+	cls.On("ClassifyBatch", classifier.WithoutCache(ctx), []*movementsDomain.Movement{almuerzo, rappi}).Return(nil, expectedErr).Once()
+	cls.On("Classify", classifier.WithoutCache(ctx), almuerzo).Return(food, nil).Once()
+	cls.On("Classify", classifier.WithoutCache(ctx), rappi).Return(nil, expectedErr).Once()
+
+	movements.On("SetCategory", ctx, almuerzo, *food).Return(nil).Once()
+
+	repo := new(repository.MockJobRepository)
+	repo.On("UpdateJob", ctx, mock.AnythingOfType("*domain.Job")).Return(nil).Times(3)
+
+	job := &domain.Job{ID: "RCJ1", AccountID: "acc1", Filter: domain.Filter{OnlyUnknown: true}}
+
+	c.NoError(newTestUsecase(repo, movements, cls).RunJob(ctx, job))
+	c.Equal(domain.StatusSucceeded, job.Status)
+	c.Equal(2, job.Total)
+	c.Equal(2, job.Processed)
+	c.Equal(1, job.Changed)
+	c.Equal(1, job.Failed)
+	c.Empty(job.Error)
+
+	cls.AssertExpectations(t)
+	movements.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestStartJob(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockJobRepository)
+	repo.On("CreateJob", ctx, mock.AnythingOfType("*domain.Job")).Return(nil).Once()
+	repo.On("UpdateJob", ctx, mock.AnythingOfType("*domain.Job")).Return(nil).Twice()
+
+	movements := new(movementsUsecase.MockMovementUsecase)
+	movements.On("GetAllMovementsByAccountID", ctx, "acc1").Return([]*movementsDomain.Movement{}, nil).Once()
+
+	job, err := newTestUsecase(repo, movements, new(classifier.MockBatchClassifier)).StartJob(ctx, "acc1", domain.Filter{OnlyUnknown: true})
+	c.NoError(err)
+	c.Equal(domain.StatusPending, job.Status)
+	c.Equal("acc1", job.AccountID)
+
+	repo.AssertExpectations(t)
+	movements.AssertExpectations(t)
+}
+
+func TestCreateJob_InvalidFilter(t *testing.T) {
+	ctx := context.Background()
+	from := fixedTime
+
+	repo := new(repository.MockJobRepository)
+	u := NewReclassificationUsecase(ctx, repo, new(movementsUsecase.MockMovementUsecase), new(classifier.MockClassifier))
+
+	_, err := u.CreateJob(ctx, "acc1", domain.Filter{From: &from, To: &from})
+	require.ErrorIs(t, err, domain.ErrInvalidFilter)
+
+	repo.AssertNotCalled(t, "CreateJob", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,311 @@
+package domain
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_recurrence_prefix = "REC"
+
+	// MinOccurrences is how many charges it takes to call a series recurring.
+	MinOccurrences = 3
+	// MinYearlyOccurrences is how many charges it takes to call a yearly series recurring, so
+	// a little more than a year of history is enough to find it.
+	MinYearlyOccurrences = 2
+
+	// amountTolerance is how far, relative to the smallest one, the amounts of a series can be.
+	// It lets price changes stay in the series they belong to.
+	amountTolerance = 0.25
+	// priceChangeTolerance is the relative difference between two charges taken as a new price.
+	priceChangeTolerance = 0.01
+	// regularityRatio is the share of intervals that must match the period.
+	regularityRatio = 0.75
+	// endedAfterPeriods is how many periods a missed recurrence waits before it is ended.
+	endedAfterPeriods = 2
+)
+
+// Period is how often a recurring charge happens.
+type Period string
+
+const (
+	Weekly    Period = "weekly"
+	Biweekly  Period = "biweekly"
+	Monthly   Period = "monthly"
+	Quarterly Period = "quarterly"
+	Yearly    Period = "yearly"
+)
+
+// Status tells whether the charges of a recurrence keep arriving.
+type Status string
+
+const (
+	// Active recurrences were charged when expected.
+	Active Status = "active"
+	// Missed recurrences are past their expected date and grace days without a charge.
+	Missed Status = "missed"
+	// Ended recurrences were missed for several periods, as cancelled subscriptions are.
+	Ended Status = "ended"
+)
+
+// periodSpec describes the intervals, in days, accepted for a period and how many charges a
+// series of the period needs.
+type periodSpec struct {
+	period      Period
+	days        float64
+	tolerance   float64
+	occurrences int
+}
+
+var periods = []periodSpec{
+	{period: Weekly, days: 7, tolerance: 2, occurrences: MinOccurrences},
+	{period: Biweekly, days: 14, tolerance: 3, occurrences: MinOccurrences},
+	{period: Monthly, days: 30.4, tolerance: 5, occurrences: MinOccurrences},
+	{period: Quarterly, days: 91.3, tolerance: 10, occurrences: MinOccurrences},
+	{period: Yearly, days: 365.25, tolerance: 15, occurrences: MinYearlyOccurrences},
+}
+
+// Charge is an expense movement made with a merchant, the input of the detection.
+type Charge struct {
+	MerchantID   string
+	MerchantName string
+	Amount       float64
+	Date         time.Time
+}
+
+// Recurrence is a series of charges made with a merchant at a regular interval. Amount is
+// the last charge, the one expected next, and PreviousAmount the one before it.
+type Recurrence struct {
+	ID             string
+	AccountID      string
+	MerchantID     string
+	Name           string
+	Period         Period
+	Amount         float64
+	PreviousAmount float64
+	Occurrences    int
+	FirstChargeAt  time.Time
+	LastChargeAt   time.Time
+	NextChargeAt   time.Time
+	Status         Status
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (r *Recurrence) LogProperties() map[string]string {
+	return map[string]string{
+		"recurrence_id":  r.ID,
+		"account_id":     r.AccountID,
+		"merchant_id":    r.MerchantID,
+		"period":         string(r.Period),
+		"amount":         strconv.FormatFloat(r.Amount, 'f', 2, 64),
+		"occurrences":    strconv.Itoa(r.Occurrences),
+		"next_charge_at": r.NextChargeAt.Local().String(),
+		"status":         string(r.Status),
+	}
+}
+
+// Key identifies a recurrence across detections.
+func (r *Recurrence) Key() string {
+	return r.MerchantID + ":" + string(r.Period)
+}
+
+// PriceChanged reports whether the last charge differs from the one before it.
+func (r *Recurrence) PriceChanged() bool {
+	if r.PreviousAmount == 0 {
+		return false
+	}
+
+	return math.Abs(r.Amount-r.PreviousAmount)/r.PreviousAmount > priceChangeTolerance
+}
+
+// Detect finds the recurring charges of an account. Charges are grouped by merchant and by
+// similar amount, and a group is recurring when it has at least MinOccurrences charges
+// whose intervals match one of the known periods, or MinYearlyOccurrences for yearly ones. Only one recurrence is kept per merchant
+// and period, the one with more charges. The status is computed as of now.
+func Detect(accountID string, charges []*Charge, now time.Time) []*Recurrence {
+	byMerchant := map[string][]*Charge{}
+	for _, charge := range charges {
+		if charge.MerchantID == "" {
+			continue
+		}
+
+		byMerchant[charge.MerchantID] = append(byMerchant[charge.MerchantID], charge)
+	}
+
+	detected := map[string]*Recurrence{}
+	for _, merchantCharges := range byMerchant {
+		for _, series := range groupByAmount(merchantCharges) {
+			recurrence := detectSeries(accountID, series, now)
+			if recurrence == nil {
+				continue
+			}
+
+			current, ok := detected[recurrence.Key()]
+			if !ok || recurrence.Occurrences > current.Occurrences {
+				detected[recurrence.Key()] = recurrence
+			}
+		}
+	}
+
+	recurrences := make([]*Recurrence, 0, len(detected))
+	for _, recurrence := range detected {
+		recurrences = append(recurrences, recurrence)
+	}
+
+	slices.SortFunc(recurrences, func(a, b *Recurrence) int {
+		return a.NextChargeAt.Compare(b.NextChargeAt)
+	})
+
+	return recurrences
+}
+
+// groupByAmount splits the charges of a merchant into series of similar amounts, each one
+// sorted by date.
+func groupByAmount(charges []*Charge) [][]*Charge {
+	sorted := slices.Clone(charges)
+	slices.SortFunc(sorted, func(a, b *Charge) int {
+		if a.Amount < b.Amount {
+			return -1
+		}
+
+		if a.Amount > b.Amount {
+			return 1
+		}
+
+		return 0
+	})
+
+	groups := [][]*Charge{}
+	for _, charge := range sorted {
+		last := len(groups) - 1
+		if last >= 0 && charge.Amount <= groups[last][0].Amount*(1+amountTolerance) {
+			groups[last] = append(groups[last], charge)
+			continue
+		}
+
+		groups = append(groups, []*Charge{charge})
+	}
+
+	for _, group := range groups {
+		slices.SortFunc(group, func(a, b *Charge) int {
+			return a.Date.Compare(b.Date)
+		})
+	}
+
+	return groups
+}
+
+// detectSeries returns the recurrence of a series of charges sorted by date, or nil when
+// the charges are not regular.
+func detectSeries(accountID string, series []*Charge, now time.Time) *Recurrence {
+	if len(series) < MinYearlyOccurrences {
+		return nil
+	}
+
+	intervals := make([]float64, 0, len(series)-1)
+	for i := 1; i < len(series); i++ {
+		intervals = append(intervals, series[i].Date.Sub(series[i-1].Date).Hours()/24)
+	}
+
+	spec, ok := matchPeriod(intervals)
+	if !ok || len(series) < spec.occurrences {
+		return nil
+	}
+
+	first := series[0]
+	last := series[len(series)-1]
+
+	recurrence := &Recurrence{
+		ID:             _recurrence_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:      accountID,
+		MerchantID:     last.MerchantID,
+		Name:           last.MerchantName,
+		Period:         spec.period,
+		Amount:         last.Amount,
+		PreviousAmount: series[len(series)-2].Amount,
+		Occurrences:    len(series),
+		FirstChargeAt:  first.Date,
+		LastChargeAt:   last.Date,
+		NextChargeAt:   NextCharge(spec.period, last.Date),
+	}
+
+	recurrence.UpdateStatus(now)
+
+	return recurrence
+}
+
+// matchPeriod returns the period the median interval belongs to, as long as most of the
+// intervals belong to it too.
+func matchPeriod(intervals []float64) (periodSpec, bool) {
+	sorted := slices.Clone(intervals)
+	slices.Sort(sorted)
+	median := sorted[len(sorted)/2]
+
+	for _, spec := range periods {
+		if math.Abs(median-spec.days) > spec.tolerance {
+			continue
+		}
+
+		regular := 0
+		for _, interval := range intervals {
+			if math.Abs(interval-spec.days) <= spec.tolerance {
+				regular++
+			}
+		}
+
+		if float64(regular)/float64(len(intervals)) >= regularityRatio {
+			return spec, true
+		}
+
+		return periodSpec{}, false
+	}
+
+	return periodSpec{}, false
+}
+
+// NextCharge returns when the charge after the one made at last is expected.
+func NextCharge(period Period, last time.Time) time.Time {
+	switch period {
+	case Weekly:
+		return last.AddDate(0, 0, 7)
+	case Biweekly:
+		return last.AddDate(0, 0, 14)
+	case Quarterly:
+		return last.AddDate(0, 3, 0)
+	case Yearly:
+		return last.AddDate(1, 0, 0)
+	default:
+		return last.AddDate(0, 1, 0)
+	}
+}
+
+// UpdateStatus sets whether the charge expected at NextChargeAt arrived as of now, giving
+// it the tolerance of the period as grace days.
+func (r *Recurrence) UpdateStatus(now time.Time) {
+	i := slices.IndexFunc(periods, func(spec periodSpec) bool {
+		return spec.period == r.Period
+	})
+	if i < 0 {
+		return
+	}
+
+	spec := periods[i]
+	grace := time.Duration(spec.tolerance*24) * time.Hour
+	overdue := now.Sub(r.NextChargeAt.Add(grace))
+
+	switch {
+	case overdue <= 0:
+		r.Status = Active
+	case overdue.Hours()/24 > spec.days*endedAfterPeriods:
+		r.Status = Ended
+	default:
+		r.Status = Missed
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("monthly subscription", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := []*Charge{
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 38900, Date: date(2025, 6, 3)},
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 38900, Date: date(2025, 7, 3)},
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 38900, Date: date(2025, 8, 4)},
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 44900, Date: date(2025, 9, 3)},
+		}
+
+		recurrences := Detect("acc1", charges, date(2025, 9, 20))
+		c.Len(recurrences, 1)
+
+		recurrence := recurrences[0]
+		c.Equal(Monthly, recurrence.Period)
+		c.Equal("Netflix", recurrence.Name)
+		c.Equal(4, recurrence.Occurrences)
+		c.Equal(44900.0, recurrence.Amount)
+		c.Equal(date(2025, 10, 3), recurrence.NextChargeAt)
+		c.Equal(Active, recurrence.Status)
+		c.True(recurrence.PriceChanged())
+	})
+
+	t.Run("yearly charge", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := []*Charge{
+			{MerchantID: "MER5", MerchantName: "Seguros Bolívar", Amount: 1250000, Date: date(2024, 10, 2)},
+			{MerchantID: "MER5", MerchantName: "Seguros Bolívar", Amount: 1310000, Date: date(2025, 9, 28)},
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 38900, Date: date(2025, 8, 3)},
+			{MerchantID: "MER1", MerchantName: "Netflix", Amount: 38900, Date: date(2025, 9, 3)},
+		}
+
+		recurrences := Detect("acc1", charges, date(2025, 10, 15))
+		c.Len(recurrences, 1, "two monthly charges are not enough")
+
+		recurrence := recurrences[0]
+		c.Equal(Yearly, recurrence.Period)
+		c.Equal(2, recurrence.Occurrences)
+		c.Equal(1310000.0, recurrence.Amount)
+		c.Equal(date(2026, 9, 28), recurrence.NextChargeAt)
+		c.Equal(Active, recurrence.Status)
+	})
+
+	t.Run("irregular purchases are not recurring", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := []*Charge{
+			{MerchantID: "MER2", Amount: 35000, Date: date(2025, 9, 1)},
+			{MerchantID: "MER2", Amount: 32000, Date: date(2025, 9, 3)},
+			{MerchantID: "MER2", Amount: 30000, Date: date(2025, 9, 18)},
+			{MerchantID: "MER2", Amount: 33000, Date: date(2025, 9, 19)},
+		}
+
+		c.Empty(Detect("acc1", charges, date(2025, 9, 20)))
+	})
+
+	t.Run("amounts are split into series", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := []*Charge{}
+		for i, day := range []int{2, 25, 9, 28} {
+			month := time.May + time.Month(i)
+			charges = append(charges,
+				&Charge{MerchantID: "MER3", Amount: 1200000, Date: date(2025, month, 1)},
+				&Charge{MerchantID: "MER3", Amount: 5000, Date: date(2025, month, day)},
+			)
+		}
+
+		recurrences := Detect("acc1", charges, date(2025, 8, 20))
+		c.Len(recurrences, 1)
+		c.Equal(1200000.0, recurrences[0].Amount)
+	})
+
+	t.Run("missed and ended charges", func(t *testing.T) {
+		c := require.New(t)
+
+		charges := []*Charge{
+			{MerchantID: "MER4", Amount: 90000, Date: date(2025, 5, 5)},
+			{MerchantID: "MER4", Amount: 90000, Date: date(2025, 5, 12)},
+			{MerchantID: "MER4", Amount: 90000, Date: date(2025, 5, 19)},
+		}
+
+		c.Equal(Active, Detect("acc1", charges, date(2025, 5, 26))[0].Status)
+		c.Equal(Missed, Detect("acc1", charges, date(2025, 5, 30))[0].Status)
+		c.Equal(Ended, Detect("acc1", charges, date(2025, 7, 1))[0].Status)
+	})
+
+	t.Run("charges without merchant are ignored", func(t *testing.T) {
+		charges := []*Charge{
+			{Amount: 1000, Date: date(2025, 6, 1)},
+			{Amount: 1000, Date: date(2025, 7, 1)},
+			{Amount: 1000, Date: date(2025, 8, 1)},
+		}
+
+		require.Empty(t, Detect("acc1", charges, date(2025, 8, 20)))
+	})
+}
+
+func TestPriceChanged(t *testing.T) {
+	c := require.New(t)
+
+	c.False((&Recurrence{Amount: 100}).PriceChanged())
+	c.False((&Recurrence{Amount: 100.5, PreviousAmount: 100}).PriceChanged())
+	c.True((&Recurrence{Amount: 120, PreviousAmount: 100}).PriceChanged())
+}
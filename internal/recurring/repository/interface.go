@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/recurring/domain"
+)
+
+// RecurrenceRepository stores the recurrences detected for each account and reads the
+// charges they are detected from.
+type RecurrenceRepository interface {
+	GetCharges(ctx context.Context, accountID string, since time.Time) ([]*domain.Charge, error)
+	GetAccountIDs(ctx context.Context) ([]string, error)
+	GetRecurrencesByAccountID(ctx context.Context, accountID string) ([]*domain.Recurrence, error)
+	SaveRecurrence(ctx context.Context, recurrence *domain.Recurrence) error
+}
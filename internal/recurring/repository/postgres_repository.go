@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/recurring/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	recurrenceColumns = `id, account_id, merchant_id, name, period, amount, previous_amount, occurrences, first_charge_at, last_charge_at, next_charge_at, status, created_at, updated_at`
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the recurrences repository.
+func NewPostgresRepository(db *pgxpool.Pool) RecurrenceRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so recurrence changes are
+// committed together with the events they raise.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// GetCharges returns the expenses of the account made with a merchant since the given date,
+// oldest first.
+func (r *postgresRepository) GetCharges(ctx context.Context, accountID string, since time.Time) ([]*domain.Charge, error) {
+	query := `SELECT mv.merchant_id, m.name, mv.amount, mv.date
+	FROM movements mv
+	JOIN merchants m ON m.id = mv.merchant_id
+	WHERE mv.account_id = $1 AND mv.type = $2 AND mv.date >= $3
+	ORDER BY mv.date`
+
+	rows, err := r.db.Query(ctx, query, accountID, string(movementsDomain.Expense), since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	charges := []*domain.Charge{}
+	for rows.Next() {
+		charge := &domain.Charge{}
+
+		err := rows.Scan(&charge.MerchantID, &charge.MerchantName, &charge.Amount, &charge.Date)
+		if err != nil {
+			return nil, err
+		}
+
+		charges = append(charges, charge)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return charges, nil
+}
+
+// GetAccountIDs returns the accounts with merchants, the only ones recurrences can be
+// detected for.
+func (r *postgresRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT DISTINCT account_id FROM merchants ORDER BY account_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accountIDs := []string{}
+	for rows.Next() {
+		var accountID string
+
+		err := rows.Scan(&accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+// GetRecurrencesByAccountID returns the recurrences of the account by their next charge.
+func (r *postgresRepository) GetRecurrencesByAccountID(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	query := `SELECT ` + recurrenceColumns + `
+	FROM recurrences
+	WHERE account_id = $1
+	ORDER BY next_charge_at, name`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	recurrences := []*domain.Recurrence{}
+	for rows.Next() {
+		recurrence, err := scanToRecurrence(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		recurrences = append(recurrences, recurrence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recurrences, nil
+}
+
+// SaveRecurrence inserts the recurrence or updates the one stored for the same merchant and
+// period, keeping its ID and creation date.
+func (r *postgresRepository) SaveRecurrence(ctx context.Context, recurrence *domain.Recurrence) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO recurrences (` + recurrenceColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $13)
+	ON CONFLICT (account_id, merchant_id, period) DO UPDATE SET
+	name = EXCLUDED.name,
+	amount = EXCLUDED.amount,
+	previous_amount = EXCLUDED.previous_amount,
+	occurrences = EXCLUDED.occurrences,
+	first_charge_at = EXCLUDED.first_charge_at,
+	last_charge_at = EXCLUDED.last_charge_at,
+	next_charge_at = EXCLUDED.next_charge_at,
+	status = EXCLUDED.status,
+	updated_at = EXCLUDED.updated_at
+	RETURNING id, created_at`
+
+	err := r.querier(ctx).QueryRow(ctx, query,
+		recurrence.ID,
+		recurrence.AccountID,
+		recurrence.MerchantID,
+		recurrence.Name,
+		recurrence.Period,
+		recurrence.Amount,
+		recurrence.PreviousAmount,
+		recurrence.Occurrences,
+		recurrence.FirstChargeAt,
+		recurrence.LastChargeAt,
+		recurrence.NextChargeAt,
+		recurrence.Status,
+		now,
+	).Scan(&recurrence.ID, &recurrence.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	recurrence.UpdatedAt = now
+
+	return nil
+}
+
+func scanToRecurrence(scanFn func(...any) error) (*domain.Recurrence, error) {
+	recurrence := &domain.Recurrence{}
+
+	var period, status string
+
+	err := scanFn(
+		&recurrence.ID, &recurrence.AccountID, &recurrence.MerchantID, &recurrence.Name, &period,
+		&recurrence.Amount, &recurrence.PreviousAmount, &recurrence.Occurrences, &recurrence.FirstChargeAt,
+		&recurrence.LastChargeAt, &recurrence.NextChargeAt, &status, &recurrence.CreatedAt, &recurrence.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence.Period = domain.Period(period)
+	recurrence.Status = domain.Status(status)
+
+	return recurrence, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/recurring/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRecurrenceRepository is a mock of the repository interface.
+type MockRecurrenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecurrenceRepository) GetCharges(ctx context.Context, accountID string, since time.Time) ([]*domain.Charge, error) {
+	args := m.Called(ctx, accountID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Charge), args.Error(1)
+}
+
+func (m *MockRecurrenceRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRecurrenceRepository) GetRecurrencesByAccountID(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Recurrence), args.Error(1)
+}
+
+func (m *MockRecurrenceRepository) SaveRecurrence(ctx context.Context, recurrence *domain.Recurrence) error {
+	args := m.Called(ctx, recurrence)
+	return args.Error(0)
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/recurring/domain"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func setupMockDB(t *testing.T) (RecurrenceRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestGetCharges(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	since := fixedTime.AddDate(-1, 0, 0)
+
+	rows := pgxmock.NewRows([]string{"merchant_id", "name", "amount", "date"}).
+		AddRow("MER1", "Netflix", 38900.0, fixedTime.AddDate(0, -1, 0)).
+		AddRow("MER1", "Netflix", 38900.0, fixedTime)
+
+	mock.ExpectQuery(`SELECT mv.merchant_id, m.name, mv.amount, mv.date FROM movements mv JOIN merchants m`).
+		WithArgs("acc1", "expense", since).
+		WillReturnRows(rows)
+
+	charges, err := repo.GetCharges(context.Background(), "acc1", since)
+	c.NoError(err)
+	c.Len(charges, 2)
+	c.Equal("Netflix", charges[1].MerchantName)
+	c.Equal(fixedTime, charges[1].Date)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetAccountIDs(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT DISTINCT account_id FROM merchants`).
+		WillReturnRows(pgxmock.NewRows([]string{"account_id"}).AddRow("acc1").AddRow("acc2"))
+
+	accountIDs, err := repo.GetAccountIDs(context.Background())
+	c.NoError(err)
+	c.Equal([]string{"acc1", "acc2"}, accountIDs)
+}
+
+func TestGetRecurrencesByAccountID(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "account_id", "merchant_id", "name", "period", "amount", "previous_amount", "occurrences", "first_charge_at", "last_charge_at", "next_charge_at", "status", "created_at", "updated_at"}).
+		AddRow("REC1", "acc1", "MER1", "Netflix", "monthly", 44900.0, 38900.0, 4, fixedTime, fixedTime, fixedTime.AddDate(0, 1, 0), "active", fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM recurrences WHERE account_id = \$1`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	recurrences, err := repo.GetRecurrencesByAccountID(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(recurrences, 1)
+	c.Equal(domain.Monthly, recurrences[0].Period)
+	c.Equal(domain.Active, recurrences[0].Status)
+	c.Equal(38900.0, recurrences[0].PreviousAmount)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestSaveRecurrence(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	createdAt := fixedTime.AddDate(0, -2, 0)
+	recurrence := &domain.Recurrence{
+		ID:            "REC2",
+		AccountID:     "acc1",
+		MerchantID:    "MER1",
+		Name:          "Netflix",
+		Period:        domain.Monthly,
+		Amount:        44900,
+		Occurrences:   4,
+		FirstChargeAt: fixedTime,
+		LastChargeAt:  fixedTime,
+		NextChargeAt:  fixedTime,
+		Status:        domain.Active,
+	}
+
+	mock.ExpectQuery(`INSERT INTO recurrences (.+) ON CONFLICT \(account_id, merchant_id, period\) DO UPDATE`).
+		WithArgs("REC2", "acc1", "MER1", "Netflix", domain.Monthly, 44900.0, 0.0, 4, fixedTime, fixedTime, fixedTime, domain.Active, fixedTime).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "created_at"}).AddRow("REC1", createdAt))
+
+	c.NoError(repo.SaveRecurrence(context.Background(), recurrence))
+	c.Equal("REC1", recurrence.ID)
+	c.Equal(createdAt, recurrence.CreatedAt)
+	c.Equal(fixedTime, recurrence.UpdatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
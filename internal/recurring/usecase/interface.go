@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/recurring/domain"
+)
+
+// RecurringUsecase detects the recurring charges of the accounts and alerts about the ones
+// that did not arrive or changed price.
+type RecurringUsecase interface {
+	GetRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error)
+	DetectRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error)
+	RunDetection(ctx context.Context, interval time.Duration)
+}
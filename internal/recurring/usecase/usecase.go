@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	"transaction-tracker/internal/recurring/domain"
+	"transaction-tracker/internal/recurring/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/databases/postgres"
+	"transaction-tracker/shared"
+)
+
+const (
+	// DefaultDetectionInterval is how often the recurrences of every account are detected.
+	DefaultDetectionInterval = 6 * time.Hour
+
+	// historyMonths is how far back charges are read: two years and a month hold the
+	// MinYearlyOccurrences charges of a yearly series all year round, not only right after
+	// it is charged.
+	historyMonths = 25
+)
+
+type recurringUsecase struct {
+	repo          repository.RecurrenceRepository
+	transactor    postgres.Transactor
+	eventsUsecase eventsUsecase.EventsUsecase
+	nowFunc       func() time.Time
+	log           *loggerModels.Logger
+}
+
+// NewRecurringUsecase creates a new instance of RecurringUsecase. Alerts are emitted as
+// domain events in evUsecase, in the same transaction that stores the recurrence.
+func NewRecurringUsecase(ctx context.Context, repo repository.RecurrenceRepository, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase) RecurringUsecase {
+	log, _ := logger.GetLogger(ctx, "recurring-usecase")
+
+	return &recurringUsecase{
+		repo:          repo,
+		transactor:    transactor,
+		eventsUsecase: evUsecase,
+		nowFunc:       time.Now,
+		log:           log,
+	}
+}
+
+func (u *recurringUsecase) GetRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	return u.repo.GetRecurrencesByAccountID(ctx, accountID)
+}
+
+// DetectRecurrences analyzes the charge history of the account and stores the recurrences
+// found. Stored recurrences that are no longer detected get their status refreshed. An
+// alert is raised when a known recurrence is missed or charged with a new amount; newly
+// detected ones raise none, since nothing was expected from them yet.
+func (u *recurringUsecase) DetectRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	now := u.nowFunc()
+
+	charges, err := u.repo.GetCharges(ctx, accountID, now.AddDate(0, -historyMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := u.repo.GetRecurrencesByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := map[string]*domain.Recurrence{}
+	for _, recurrence := range stored {
+		previous[recurrence.Key()] = recurrence
+	}
+
+	detected := domain.Detect(accountID, charges, now)
+
+	found := map[string]bool{}
+	for _, recurrence := range detected {
+		found[recurrence.Key()] = true
+	}
+
+	for _, recurrence := range stored {
+		if found[recurrence.Key()] {
+			continue
+		}
+
+		refreshed := *recurrence
+		refreshed.UpdateStatus(now)
+
+		if refreshed.Status != recurrence.Status {
+			detected = append(detected, &refreshed)
+		}
+	}
+
+	for _, recurrence := range detected {
+		err := u.save(ctx, recurrence, previous[recurrence.Key()])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return detected, nil
+}
+
+// save stores the recurrence together with the alerts its change from previous raises.
+func (u *recurringUsecase) save(ctx context.Context, recurrence *domain.Recurrence, previous *domain.Recurrence) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.repo.SaveRecurrence(ctx, recurrence)
+		if err != nil {
+			return err
+		}
+
+		if previous == nil {
+			return nil
+		}
+
+		if recurrence.Status == domain.Missed && previous.Status != domain.Missed {
+			err := u.emit(ctx, eventsDomain.RecurringMissed, recurrence)
+			if err != nil {
+				return err
+			}
+		}
+
+		if recurrence.LastChargeAt.After(previous.LastChargeAt) && recurrence.PriceChanged() {
+			return u.emit(ctx, eventsDomain.RecurringPriceChanged, recurrence)
+		}
+
+		return nil
+	})
+}
+
+func (u *recurringUsecase) emit(ctx context.Context, eventType eventsDomain.EventType, recurrence *domain.Recurrence) error {
+	return u.eventsUsecase.Emit(ctx, eventType, recurrence.AccountID, recurrence.ID, eventsDomain.RecurringPayload{
+		ID:             recurrence.ID,
+		AccountID:      recurrence.AccountID,
+		MerchantID:     recurrence.MerchantID,
+		Name:           recurrence.Name,
+		Period:         string(recurrence.Period),
+		Amount:         recurrence.Amount,
+		PreviousAmount: recurrence.PreviousAmount,
+		LastChargeAt:   recurrence.LastChargeAt,
+		NextChargeAt:   recurrence.NextChargeAt,
+	})
+}
+
+// RunDetection detects the recurrences of every account every interval until the context
+// is done.
+func (u *recurringUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	shared.RunPerAccount(ctx, u.log, "recurrences", interval, u.repo.GetAccountIDs, func(ctx context.Context, accountID string) error {
+		_, err := u.DetectRecurrences(ctx, accountID)
+		return err
+	})
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/recurring/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRecurringUsecase is a mock implementation of the RecurringUsecase interface.
+type MockRecurringUsecase struct {
+	mock.Mock
+}
+
+func (m *MockRecurringUsecase) GetRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Recurrence), args.Error(1)
+}
+
+func (m *MockRecurringUsecase) DetectRecurrences(ctx context.Context, accountID string) ([]*domain.Recurrence, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Recurrence), args.Error(1)
+}
+
+func (m *MockRecurringUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	"transaction-tracker/internal/recurring/domain"
+	"transaction-tracker/internal/recurring/repository"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type noopLogService struct{}
+
+func (noopLogService) Log(string, loggerModels.LogProperties) {}
+func (noopLogService) SetService(string)                      {}
+
+var now = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newTestUsecase(repo repository.RecurrenceRepository, events eventsUsecase.EventsUsecase) *recurringUsecase {
+	transactor := new(postgres.MockTransactor)
+	transactor.On("WithinTransaction", mock.Anything).Return(nil)
+
+	return &recurringUsecase{
+		repo:          repo,
+		transactor:    transactor,
+		eventsUsecase: events,
+		nowFunc:       func() time.Time { return now },
+		log:           &loggerModels.Logger{Service: noopLogService{}},
+	}
+}
+
+func monthlyCharges(amounts ...float64) []*domain.Charge {
+	charges := []*domain.Charge{}
+	for i, amount := range amounts {
+		charges = append(charges, &domain.Charge{
+			MerchantID:   "MER1",
+			MerchantName: "Netflix",
+			Amount:       amount,
+			Date:         time.Date(2025, time.Month(9-len(amounts)+i+1), 3, 12, 0, 0, 0, time.UTC),
+		})
+	}
+
+	return charges
+}
+
+func TestDetectRecurrences_NewRecurrence(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockRecurrenceRepository)
+	repo.On("GetCharges", ctx, "acc1", now.AddDate(0, -historyMonths, 0)).Return(monthlyCharges(38900, 38900, 44900), nil)
+	repo.On("GetRecurrencesByAccountID", ctx, "acc1").Return([]*domain.Recurrence{}, nil)
+	repo.On("SaveRecurrence", ctx, mock.Anything).Return(nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+
+	recurrences, err := newTestUsecase(repo, events).DetectRecurrences(ctx, "acc1")
+	c.NoError(err)
+	c.Len(recurrences, 1)
+	c.Equal(domain.Monthly, recurrences[0].Period)
+
+	repo.AssertExpectations(t)
+	events.AssertNotCalled(t, "Emit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDetectRecurrences_PriceChanged(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	stored := &domain.Recurrence{
+		ID:           "REC1",
+		AccountID:    "acc1",
+		MerchantID:   "MER1",
+		Period:       domain.Monthly,
+		Amount:       38900,
+		LastChargeAt: time.Date(2025, 8, 3, 12, 0, 0, 0, time.UTC),
+		Status:       domain.Active,
+	}
+
+	repo := new(repository.MockRecurrenceRepository)
+	repo.On("GetCharges", ctx, "acc1", mock.Anything).Return(monthlyCharges(38900, 38900, 44900), nil)
+	repo.On("GetRecurrencesByAccountID", ctx, "acc1").Return([]*domain.Recurrence{stored}, nil)
+	repo.On("SaveRecurrence", ctx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.Recurrence).ID = "REC1"
+	}).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.RecurringPriceChanged, "acc1", "REC1", mock.MatchedBy(func(payload eventsDomain.RecurringPayload) bool {
+		return payload.Amount == 44900 && payload.PreviousAmount == 38900
+	})).Return(nil).Once()
+
+	_, err := newTestUsecase(repo, events).DetectRecurrences(ctx, "acc1")
+	c.NoError(err)
+
+	events.AssertExpectations(t)
+}
+
+func TestDetectRecurrences_Missed(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	stored := &domain.Recurrence{
+		ID:           "REC1",
+		AccountID:    "acc1",
+		MerchantID:   "MER1",
+		Period:       domain.Monthly,
+		Amount:       38900,
+		LastChargeAt: time.Date(2025, 7, 3, 12, 0, 0, 0, time.UTC),
+		NextChargeAt: time.Date(2025, 8, 3, 12, 0, 0, 0, time.UTC),
+		Status:       domain.Active,
+	}
+
+	repo := new(repository.MockRecurrenceRepository)
+	repo.On("GetCharges", ctx, "acc1", mock.Anything).Return([]*domain.Charge{}, nil)
+	repo.On("GetRecurrencesByAccountID", ctx, "acc1").Return([]*domain.Recurrence{stored}, nil)
+	repo.On("SaveRecurrence", ctx, mock.MatchedBy(func(recurrence *domain.Recurrence) bool {
+		return recurrence.ID == "REC1" && recurrence.Status == domain.Missed
+	})).Return(nil).Once()
+
+	events := new(eventsUsecase.MockEventsUsecase)
+	events.On("Emit", ctx, eventsDomain.RecurringMissed, "acc1", "REC1", mock.AnythingOfType("domain.RecurringPayload")).Return(nil).Once()
+
+	recurrences, err := newTestUsecase(repo, events).DetectRecurrences(ctx, "acc1")
+	c.NoError(err)
+	c.Len(recurrences, 1)
+	c.Equal(domain.Active, stored.Status)
+
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestDetectRecurrences_AlreadyMissed(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	stored := &domain.Recurrence{
+		ID:           "REC1",
+		AccountID:    "acc1",
+		MerchantID:   "MER1",
+		Period:       domain.Monthly,
+		NextChargeAt: time.Date(2025, 8, 3, 12, 0, 0, 0, time.UTC),
+		Status:       domain.Missed,
+	}
+
+	repo := new(repository.MockRecurrenceRepository)
+	repo.On("GetCharges", ctx, "acc1", mock.Anything).Return([]*domain.Charge{}, nil)
+	repo.On("GetRecurrencesByAccountID", ctx, "acc1").Return([]*domain.Recurrence{stored}, nil)
+
+	events := new(eventsUsecase.MockEventsUsecase)
+
+	recurrences, err := newTestUsecase(repo, events).DetectRecurrences(ctx, "acc1")
+	c.NoError(err)
+	c.Empty(recurrences)
+
+	repo.AssertNotCalled(t, "SaveRecurrence", mock.Anything, mock.Anything)
+}
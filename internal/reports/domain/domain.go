@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+const (
+	// DateLayout is the layout dates are written with in requests, e.g. 2025-09-20.
+	DateLayout = "2006-01-02"
+
+	// DefaultPeriods is how many periods a report covers when no start date is given.
+	DefaultPeriods = 6
+	// MaxPeriods is the most periods a report can cover.
+	MaxPeriods = 366
+)
+
+// Granularity is the length of the periods a report is split into. The values are the
+// fields Postgres date_trunc takes.
+type Granularity string
+
+const (
+	Day     Granularity = "day"
+	Week    Granularity = "week"
+	Month   Granularity = "month"
+	Quarter Granularity = "quarter"
+	Year    Granularity = "year"
+)
+
+// ErrInvalidReport is returned when the range or granularity of a report is invalid.
+var ErrInvalidReport = errors.New("invalid report")
+
+// ParseGranularity returns the granularity written in s, Month when empty.
+func ParseGranularity(s string) (Granularity, error) {
+	switch g := Granularity(s); g {
+	case Day, Week, Month, Quarter, Year:
+		return g, nil
+	case "":
+		return Month, nil
+	default:
+		return "", fmt.Errorf("%w: granularity %q must be one of day, week, month, quarter or year", ErrInvalidReport, s)
+	}
+}
+
+// Start returns the start of the period t belongs to, in UTC. Weeks start on Monday.
+func (g Granularity) Start(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	switch g {
+	case Week:
+		start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	case Month:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case Quarter:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	case Year:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Add moves the start of a period n periods forward, or backward when n is negative.
+func (g Granularity) Add(start time.Time, n int) time.Time {
+	switch g {
+	case Week:
+		return start.AddDate(0, 0, 7*n)
+	case Month:
+		return start.AddDate(0, n, 0)
+	case Quarter:
+		return start.AddDate(0, 3*n, 0)
+	case Year:
+		return start.AddDate(n, 0, 0)
+	default:
+		return start.AddDate(0, 0, n)
+	}
+}
+
+// Interval is the length of a period as a Postgres interval.
+func (g Granularity) Interval() string {
+	if g == Quarter {
+		return "3 months"
+	}
+
+	return "1 " + string(g)
+}
+
+// Range is the span a report covers, aligned to whole periods: From is the start of the first
+// period and To the end of the last one, exclusive.
+type Range struct {
+	From        time.Time
+	To          time.Time
+	Granularity Granularity
+}
+
+// NewRange creates the range of the periods between the dates from and to, written as
+// DateLayout, both included. to defaults to now and from to DefaultPeriods periods before to.
+func NewRange(from string, to string, granularity string, now time.Time) (*Range, error) {
+	g, err := ParseGranularity(granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	end := now
+	if to != "" {
+		end, err = parseDate(to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Range{Granularity: g, To: g.Add(g.Start(end), 1)}
+	r.From = g.Add(r.To, -DefaultPeriods)
+
+	if from != "" {
+		start, err := parseDate(from)
+		if err != nil {
+			return nil, err
+		}
+
+		r.From = g.Start(start)
+	}
+
+	if !r.From.Before(r.To) {
+		return nil, fmt.Errorf("%w: from must not be after to", ErrInvalidReport)
+	}
+
+	if len(r.Periods()) > MaxPeriods {
+		return nil, fmt.Errorf("%w: the range covers more than %d periods", ErrInvalidReport, MaxPeriods)
+	}
+
+	return r, nil
+}
+
+// Periods returns the start of every period of the range.
+func (r *Range) Periods() []time.Time {
+	periods := []time.Time{}
+	for start := r.From; start.Before(r.To) && len(periods) <= MaxPeriods; start = r.Granularity.Add(start, 1) {
+		periods = append(periods, start)
+	}
+
+	return periods
+}
+
+func parseDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date %q must look like %s", ErrInvalidReport, date, DateLayout)
+	}
+
+	return parsed, nil
+}
+
+// CategorySpend is what was spent in a category. Share is its percentage of the spend of all
+// categories and Change how much it grew since the previous period, in percent, nil when
+// nothing was spent in the category then.
+type CategorySpend struct {
+	Category       movementsDomain.MovementCategory
+	Amount         float64
+	Share          float64
+	PreviousAmount float64
+	Change         *float64
+}
+
+// PeriodSpend is the spend of a category in the period starting at Period.
+type PeriodSpend struct {
+	Period time.Time
+	CategorySpend
+}
+
+// Period is the spend of every category in a period, the highest first.
+type Period struct {
+	Start      time.Time
+	Total      float64
+	Categories []*CategorySpend
+}
+
+// CategoryReport is the spend per category of a range, in total and per period.
+type CategoryReport struct {
+	*Range
+	Total      float64
+	Categories []*CategorySpend
+	Periods    []*Period
+}
+
+// NewCategoryReport groups the spend of the range per period, with a period for every one the
+// range covers even when nothing was spent, and adds it up per category. spend must be sorted
+// by period and amount, the highest first.
+func NewCategoryReport(r *Range, spend []*PeriodSpend) *CategoryReport {
+	report := &CategoryReport{Range: r, Categories: []*CategorySpend{}, Periods: []*Period{}}
+
+	byPeriod := map[time.Time]*Period{}
+	for _, start := range r.Periods() {
+		period := &Period{Start: start, Categories: []*CategorySpend{}}
+		byPeriod[start] = period
+		report.Periods = append(report.Periods, period)
+	}
+
+	totals := map[movementsDomain.MovementCategory]*CategorySpend{}
+	for _, s := range spend {
+		period, ok := byPeriod[s.Period.UTC()]
+		if !ok {
+			continue
+		}
+
+		category := s.CategorySpend
+		period.Categories = append(period.Categories, &category)
+		period.Total += s.Amount
+		report.Total += s.Amount
+
+		total, ok := totals[s.Category]
+		if !ok {
+			total = &CategorySpend{Category: s.Category}
+			totals[s.Category] = total
+			report.Categories = append(report.Categories, total)
+		}
+
+		total.Amount += s.Amount
+	}
+
+	for _, total := range report.Categories {
+		total.Amount = round(total.Amount)
+		if report.Total > 0 {
+			total.Share = round(total.Amount / report.Total * 100)
+		}
+	}
+
+	slices.SortFunc(report.Categories, func(a, b *CategorySpend) int {
+		if c := cmp.Compare(b.Amount, a.Amount); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	return report
+}
+
+func round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGranularityStart(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(date(2025, 9, 20), Day.Start(now))
+	c.Equal(date(2025, 9, 15), Week.Start(now))
+	c.Equal(date(2025, 9, 15), Week.Start(date(2025, 9, 15)))
+	c.Equal(date(2025, 9, 1), Month.Start(now))
+	c.Equal(date(2025, 7, 1), Quarter.Start(now))
+	c.Equal(date(2025, 1, 1), Year.Start(now))
+}
+
+func TestNewRange(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		c := require.New(t)
+
+		r, err := NewRange("", "", "", now)
+		c.NoError(err)
+		c.Equal(Month, r.Granularity)
+		c.Equal(date(2025, 4, 1), r.From)
+		c.Equal(date(2025, 10, 1), r.To)
+		c.Len(r.Periods(), DefaultPeriods)
+	})
+
+	t.Run("aligned to whole periods", func(t *testing.T) {
+		c := require.New(t)
+
+		r, err := NewRange("2025-02-10", "2025-08-05", "quarter", now)
+		c.NoError(err)
+		c.Equal(date(2025, 1, 1), r.From)
+		c.Equal(date(2025, 10, 1), r.To)
+		c.Equal([]time.Time{date(2025, 1, 1), date(2025, 4, 1), date(2025, 7, 1)}, r.Periods())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, args := range map[string][3]string{
+			"granularity":   {"", "", "hour"},
+			"date":          {"2025/01/01", "", ""},
+			"from after to": {"2025-09-01", "2025-08-01", ""},
+			"too long":      {"2020-01-01", "2025-01-01", "day"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewRange(args[0], args[1], args[2], now)
+				require.ErrorIs(t, err, ErrInvalidReport)
+			})
+		}
+	})
+}
+
+func TestNewCategoryReport(t *testing.T) {
+	c := require.New(t)
+
+	r := &Range{From: date(2025, 7, 1), To: date(2025, 10, 1), Granularity: Month}
+	change := 50.0
+
+	report := NewCategoryReport(r, []*PeriodSpend{
+		{Period: date(2025, 7, 1), CategorySpend: CategorySpend{Category: movementsDomain.Food, Amount: 200, Share: 100}},
+		{Period: date(2025, 9, 1), CategorySpend: CategorySpend{Category: movementsDomain.Food, Amount: 300, Share: 60}},
+		{Period: date(2025, 9, 1), CategorySpend: CategorySpend{Category: movementsDomain.Transport, Amount: 200, Share: 40, PreviousAmount: 200 / 1.5, Change: &change}},
+	})
+
+	c.Equal(700.0, report.Total)
+	c.Len(report.Periods, 3)
+	c.Equal(200.0, report.Periods[0].Total)
+	c.Empty(report.Periods[1].Categories)
+	c.Equal(500.0, report.Periods[2].Total)
+	c.Equal(&change, report.Periods[2].Categories[1].Change)
+
+	c.Len(report.Categories, 2)
+	c.Equal(movementsDomain.Food, report.Categories[0].Category)
+	c.Equal(500.0, report.Categories[0].Amount)
+	c.Equal(71.43, report.Categories[0].Share)
+	c.Equal(28.57, report.Categories[1].Share)
+}
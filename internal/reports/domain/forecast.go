@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+	financialAccountsDomain "transaction-tracker/internal/financialaccounts/domain"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	recurringDomain "transaction-tracker/internal/recurring/domain"
+)
+
+const (
+	// DefaultForecastDays is how many days a forecast covers when not given.
+	DefaultForecastDays = 30
+	// MaxForecastDays is the most days a forecast can cover.
+	MaxForecastDays = 365
+
+	// ForecastHistoryMonths is how far back the movements a forecast is based on go. Two years
+	// and a month hold the last two charges of a yearly expense before the next one is due.
+	ForecastHistoryMonths = 25
+	// discretionaryDays is how many of the last days the average discretionary spend is
+	// taken from.
+	discretionaryDays = 90
+	// confidenceZ is the z-score of the confidence band: about 95% of the outcomes fall in it
+	// when the daily discretionary spend is normally distributed.
+	confidenceZ = 1.96
+)
+
+// ForecastKinds are the kinds of financial accounts whose balances a forecast starts from:
+// the money at hand and what is owed on credit cards. Investments and loans are left out.
+var ForecastKinds = []financialAccountsDomain.Kind{
+	financialAccountsDomain.Savings,
+	financialAccountsDomain.Checking,
+	financialAccountsDomain.Wallet,
+	financialAccountsDomain.CreditCard,
+}
+
+// ForecastBalance adds up the balances of the summaries of ForecastKinds. ok is false when
+// there is none of those financial accounts.
+func ForecastBalance(summaries []*financialAccountsDomain.Summary) (balance float64, ok bool) {
+	for _, summary := range summaries {
+		if !slices.Contains(ForecastKinds, summary.Kind) {
+			continue
+		}
+
+		balance += summary.Balance
+		ok = true
+	}
+
+	return round(balance), ok
+}
+
+// Flow is a movement of the history a forecast is based on.
+type Flow struct {
+	MerchantID   string
+	MerchantName string
+	Type         movementsDomain.MovementType
+	Category     movementsDomain.MovementCategory
+	Amount       float64
+	Date         time.Time
+}
+
+// ForecastCharge is a recurring income or expense expected on a day of the forecast.
+type ForecastCharge struct {
+	Name   string
+	Type   movementsDomain.MovementType
+	Period recurringDomain.Period
+	Amount float64
+}
+
+// ForecastDay is the expected balance at the end of a day, with the confidence band Lower to
+// Upper. Income and Expenses are what is expected to come in and go out that day.
+type ForecastDay struct {
+	Date     time.Time
+	Balance  float64
+	Lower    float64
+	Upper    float64
+	Income   float64
+	Expenses float64
+	Charges  []*ForecastCharge
+}
+
+// DiscretionarySpend is the average spend per day of a category, leaving the recurring
+// charges out.
+type DiscretionarySpend struct {
+	Category     movementsDomain.MovementCategory
+	DailyAverage float64
+}
+
+// Forecast projects the balance of an account day by day from the recurring incomes and
+// expenses detected in its history plus the average discretionary spend. FirstNegativeAt is
+// the first day the expected balance goes below zero, nil when it does not.
+type Forecast struct {
+	StartBalance    float64
+	Days            []*ForecastDay
+	Discretionary   []*DiscretionarySpend
+	EndBalance      float64
+	MinBalance      float64
+	MinBalanceAt    time.Time
+	FirstNegativeAt *time.Time
+}
+
+// ParseForecastDays returns the number of days written in s, DefaultForecastDays when empty.
+func ParseForecastDays(s string) (int, error) {
+	if s == "" {
+		return DefaultForecastDays, nil
+	}
+
+	days, err := strconv.Atoi(s)
+	if err != nil || days < 1 || days > MaxForecastDays {
+		return 0, fmt.Errorf("%w: days must be a number between 1 and %d", ErrInvalidReport, MaxForecastDays)
+	}
+
+	return days, nil
+}
+
+// NewForecast projects balance for the days after now. flows are the movements of the last
+// ForecastHistoryMonths, sorted by date.
+func NewForecast(balance float64, flows []*Flow, now time.Time, days int) *Forecast {
+	today := Day.Start(now)
+	end := today.AddDate(0, 0, days+1)
+
+	recurrences := detectRecurrences(flows, now)
+
+	recurringMerchants := map[string]bool{}
+	for _, recurrence := range recurrences {
+		recurringMerchants[recurrence.MerchantID] = true
+	}
+
+	discretionary, daily, deviation := discretionarySpend(flows, recurringMerchants, today)
+
+	forecast := &Forecast{
+		StartBalance:  round(balance),
+		Days:          make([]*ForecastDay, 0, days),
+		Discretionary: discretionary,
+		MinBalance:    round(balance),
+		MinBalanceAt:  today,
+	}
+
+	byDate := map[time.Time]*ForecastDay{}
+	for i := 1; i <= days; i++ {
+		day := &ForecastDay{Date: today.AddDate(0, 0, i), Expenses: daily, Charges: []*ForecastCharge{}}
+		byDate[day.Date] = day
+		forecast.Days = append(forecast.Days, day)
+	}
+
+	for _, recurrence := range recurrences {
+		if recurrence.Status != recurringDomain.Active {
+			continue
+		}
+
+		for next := recurrence.NextChargeAt; next.Before(end); next = recurringDomain.NextCharge(recurrence.Period, next) {
+			date := Day.Start(next)
+			if !date.After(today) {
+				// Due and within its grace days, so still expected.
+				date = today.AddDate(0, 0, 1)
+			}
+
+			day, ok := byDate[date]
+			if !ok {
+				continue
+			}
+
+			charge := &ForecastCharge{Name: recurrence.Name, Type: recurrence.Type, Period: recurrence.Period, Amount: recurrence.Amount}
+			day.Charges = append(day.Charges, charge)
+
+			if charge.Type == movementsDomain.Income {
+				day.Income += charge.Amount
+			} else {
+				day.Expenses += charge.Amount
+			}
+		}
+	}
+
+	for i, day := range forecast.Days {
+		balance += day.Income - day.Expenses
+		band := confidenceZ * deviation * math.Sqrt(float64(i+1))
+
+		day.Income = round(day.Income)
+		day.Expenses = round(day.Expenses)
+		day.Balance = round(balance)
+		day.Lower = round(balance - band)
+		day.Upper = round(balance + band)
+
+		if day.Balance < forecast.MinBalance {
+			forecast.MinBalance = day.Balance
+			forecast.MinBalanceAt = day.Date
+		}
+
+		if day.Balance < 0 && forecast.FirstNegativeAt == nil {
+			date := day.Date
+			forecast.FirstNegativeAt = &date
+		}
+	}
+
+	forecast.EndBalance = forecast.StartBalance
+	if len(forecast.Days) > 0 {
+		forecast.EndBalance = forecast.Days[len(forecast.Days)-1].Balance
+	}
+
+	return forecast
+}
+
+// typedRecurrence is a recurrence detected among the incomes or the expenses.
+type typedRecurrence struct {
+	*recurringDomain.Recurrence
+	Type movementsDomain.MovementType
+}
+
+// detectRecurrences finds the recurring incomes and expenses of the flows.
+func detectRecurrences(flows []*Flow, now time.Time) []*typedRecurrence {
+	charges := map[movementsDomain.MovementType][]*recurringDomain.Charge{}
+	for _, flow := range flows {
+		charges[flow.Type] = append(charges[flow.Type], &recurringDomain.Charge{
+			MerchantID:   flow.MerchantID,
+			MerchantName: flow.MerchantName,
+			Amount:       flow.Amount,
+			Date:         flow.Date,
+		})
+	}
+
+	recurrences := []*typedRecurrence{}
+	for _, movementType := range []movementsDomain.MovementType{movementsDomain.Income, movementsDomain.Expense} {
+		for _, recurrence := range recurringDomain.Detect("", charges[movementType], now) {
+			recurrences = append(recurrences, &typedRecurrence{Recurrence: recurrence, Type: movementType})
+		}
+	}
+
+	return recurrences
+}
+
+// discretionarySpend averages per day the expenses of the last discretionaryDays before today
+// made with merchants without recurring charges. It returns the average of every category,
+// the highest first, with their sum and the standard deviation of the spend per day.
+func discretionarySpend(flows []*Flow, recurringMerchants map[string]bool, today time.Time) ([]*DiscretionarySpend, float64, float64) {
+	start := today.AddDate(0, 0, -discretionaryDays)
+	if len(flows) > 0 && Day.Start(flows[0].Date).After(start) {
+		start = Day.Start(flows[0].Date)
+	}
+
+	observed := int(today.Sub(start).Hours() / 24)
+	if observed <= 0 {
+		return []*DiscretionarySpend{}, 0, 0
+	}
+
+	totals := map[movementsDomain.MovementCategory]float64{}
+	perDay := make([]float64, observed)
+	for _, flow := range flows {
+		if flow.Type != movementsDomain.Expense || recurringMerchants[flow.MerchantID] {
+			continue
+		}
+
+		date := Day.Start(flow.Date)
+		if date.Before(start) || !date.Before(today) {
+			continue
+		}
+
+		totals[flow.Category] += flow.Amount
+		perDay[int(date.Sub(start).Hours()/24)] += flow.Amount
+	}
+
+	discretionary := make([]*DiscretionarySpend, 0, len(totals))
+	var daily float64
+	for category, total := range totals {
+		average := total / float64(observed)
+		daily += average
+		discretionary = append(discretionary, &DiscretionarySpend{Category: category, DailyAverage: round(average)})
+	}
+
+	slices.SortFunc(discretionary, func(a, b *DiscretionarySpend) int {
+		if c := cmp.Compare(b.DailyAverage, a.DailyAverage); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	var variance float64
+	for _, spent := range perDay {
+		variance += (spent - daily) * (spent - daily)
+	}
+
+	return discretionary, daily, math.Sqrt(variance / float64(observed))
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	recurringDomain "transaction-tracker/internal/recurring/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func forecastFlows() []*Flow {
+	flows := []*Flow{}
+	for m := time.June; m <= time.September; m++ {
+		flows = append(flows,
+			&Flow{MerchantID: "MER1", MerchantName: "ACME", Type: movementsDomain.Income, Category: movementsDomain.Salary, Amount: 3000, Date: date(2025, m, 1)},
+			&Flow{MerchantID: "MER2", MerchantName: "Netflix", Type: movementsDomain.Expense, Category: movementsDomain.Entertainment, Amount: 15, Date: date(2025, m, 5)},
+		)
+	}
+
+	for day := date(2025, 6, 22); day.Before(date(2025, 9, 20)); day = day.AddDate(0, 0, 1) {
+		flows = append(flows, &Flow{MerchantID: "MER3", MerchantName: "Market", Type: movementsDomain.Expense, Category: movementsDomain.Food, Amount: 10, Date: day.Add(10 * time.Hour)})
+	}
+
+	slices.SortFunc(flows, func(a, b *Flow) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return flows
+}
+
+func TestNewForecast(t *testing.T) {
+	t.Run("recurring and discretionary", func(t *testing.T) {
+		c := require.New(t)
+
+		forecast := NewForecast(95, forecastFlows(), now, 30)
+
+		c.Len(forecast.Days, 30)
+		c.Equal(date(2025, 9, 21), forecast.Days[0].Date)
+		c.Equal([]*DiscretionarySpend{{Category: movementsDomain.Food, DailyAverage: 10}}, forecast.Discretionary)
+
+		c.Equal(85.0, forecast.Days[0].Balance)
+		c.Equal(forecast.Days[0].Balance, forecast.Days[0].Lower)
+
+		salary := forecast.Days[10]
+		c.Equal(date(2025, 10, 1), salary.Date)
+		c.Equal(3000.0, salary.Income)
+		c.Equal([]*ForecastCharge{{Name: "ACME", Type: movementsDomain.Income, Period: recurringDomain.Monthly, Amount: 3000}}, salary.Charges)
+		c.Equal(2985.0, salary.Balance)
+
+		netflix := forecast.Days[14]
+		c.Equal(date(2025, 10, 5), netflix.Date)
+		c.Equal(25.0, netflix.Expenses)
+
+		c.Equal(date(2025, 9, 30), *forecast.FirstNegativeAt)
+		c.Equal(-5.0, forecast.MinBalance)
+		c.Equal(2985.0-19*10-15, forecast.EndBalance)
+	})
+
+	t.Run("yearly expense", func(t *testing.T) {
+		c := require.New(t)
+
+		flows := append([]*Flow{
+			{MerchantID: "MER5", MerchantName: "SOAT", Type: movementsDomain.Expense, Category: movementsDomain.Transport, Amount: 950, Date: date(2023, 10, 2)},
+			{MerchantID: "MER5", MerchantName: "SOAT", Type: movementsDomain.Expense, Category: movementsDomain.Transport, Amount: 990, Date: date(2024, 10, 2)},
+		}, forecastFlows()...)
+
+		forecast := NewForecast(5000, flows, now, 30)
+
+		soat := forecast.Days[11]
+		c.Equal(date(2025, 10, 2), soat.Date)
+		c.Equal([]*ForecastCharge{{Name: "SOAT", Type: movementsDomain.Expense, Period: recurringDomain.Yearly, Amount: 990}}, soat.Charges)
+		c.Equal(1000.0, soat.Expenses)
+	})
+
+	t.Run("confidence band widens", func(t *testing.T) {
+		c := require.New(t)
+
+		flows := []*Flow{
+			{MerchantID: "MER3", Type: movementsDomain.Expense, Category: movementsDomain.Food, Amount: 40, Date: date(2025, 9, 10)},
+			{MerchantID: "MER4", Type: movementsDomain.Expense, Category: movementsDomain.Transport, Amount: 60, Date: date(2025, 9, 15)},
+		}
+
+		forecast := NewForecast(1000, flows, now, 5)
+
+		c.Equal(10.0, forecast.Days[0].Expenses)
+		c.Less(forecast.Days[0].Lower, forecast.Days[0].Balance)
+		c.Greater(forecast.Days[4].Upper-forecast.Days[4].Lower, forecast.Days[0].Upper-forecast.Days[0].Lower)
+		c.Nil(forecast.FirstNegativeAt)
+	})
+
+	t.Run("without history", func(t *testing.T) {
+		c := require.New(t)
+
+		forecast := NewForecast(500, nil, now, 3)
+
+		c.Len(forecast.Days, 3)
+		c.Equal(500.0, forecast.EndBalance)
+		c.Empty(forecast.Discretionary)
+	})
+}
+
+func TestParseForecastDays(t *testing.T) {
+	c := require.New(t)
+
+	days, err := ParseForecastDays("")
+	c.NoError(err)
+	c.Equal(DefaultForecastDays, days)
+
+	days, err = ParseForecastDays("90")
+	c.NoError(err)
+	c.Equal(90, days)
+
+	for _, s := range []string{"0", "366", "month"} {
+		_, err := ParseForecastDays(s)
+		c.ErrorIs(err, ErrInvalidReport)
+	}
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// TagTotal is what the movements with a tag add up to. A movement with several tags counts in
+// each of them.
+type TagTotal struct {
+	Tag      string
+	Income   float64
+	Expenses float64
+	Net      float64
+	Count    int
+}
+
+// TagReport is the totals per tag of a range.
+type TagReport struct {
+	*Range
+	Tags []*TagTotal
+}
+
+// NewTagReport rounds the totals of the range and sorts them by expenses, the highest first.
+func NewTagReport(r *Range, totals []*TagTotal) *TagReport {
+	report := &TagReport{Range: r, Tags: make([]*TagTotal, 0, len(totals))}
+
+	for _, total := range totals {
+		report.Tags = append(report.Tags, &TagTotal{
+			Tag:      total.Tag,
+			Income:   round(total.Income),
+			Expenses: round(total.Expenses),
+			Net:      round(total.Income - total.Expenses),
+			Count:    total.Count,
+		})
+	}
+
+	slices.SortFunc(report.Tags, func(a, b *TagTotal) int {
+		if c := cmp.Compare(b.Expenses, a.Expenses); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Tag, b.Tag)
+	})
+
+	return report
+}
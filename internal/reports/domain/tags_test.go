@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTagReport(t *testing.T) {
+	c := require.New(t)
+
+	r := &Range{From: date(2025, 8, 1), To: date(2025, 10, 1), Granularity: Month}
+	report := NewTagReport(r, []*TagTotal{
+		{Tag: "reimbursable", Income: 100, Expenses: 250.555, Count: 3},
+		{Tag: "trip-cartagena-2026", Expenses: 1200, Count: 8},
+		{Tag: "gifts", Income: 50, Count: 1},
+	})
+
+	c.Equal(r, report.Range)
+	c.Len(report.Tags, 3)
+	c.Equal("trip-cartagena-2026", report.Tags[0].Tag)
+	c.Equal(-1200.0, report.Tags[0].Net)
+	c.Equal(250.56, report.Tags[1].Expenses)
+	c.Equal(-150.56, report.Tags[1].Net)
+	c.Equal("gifts", report.Tags[2].Tag)
+	c.Equal(50.0, report.Tags[2].Net)
+}
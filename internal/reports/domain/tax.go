@@ -0,0 +1,304 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+)
+
+const (
+	// MinTaxYear is the oldest year a tax report can be built for.
+	MinTaxYear = 2000
+
+	// MortgageInterestTag is the tag of the expenses that pay the interest of a mortgage,
+	// deductible in the income declaration.
+	MortgageInterestTag = "mortgage-interest"
+
+	// OtherIncome is the concept the income of the categories that are not taxed apart
+	// adds up to.
+	OtherIncome = "other"
+	// MortgageInterest is the concept of the expenses tagged as MortgageInterestTag.
+	MortgageInterest = "mortgage_interest"
+	// Total is the concept of the row that adds up a section.
+	Total = "total"
+)
+
+var (
+	// IncomeCategories are the income categories the declaration asks for apart.
+	IncomeCategories = []movementsDomain.MovementCategory{
+		movementsDomain.Salary,
+		movementsDomain.Freelance,
+		movementsDomain.Investment,
+	}
+
+	// DeductibleCategories are the expense categories that reduce the taxable income.
+	DeductibleCategories = []movementsDomain.MovementCategory{
+		movementsDomain.Health,
+		movementsDomain.Education,
+	}
+)
+
+// ParseTaxYear returns the year written in s, the previous one when empty since taxes are
+// declared for the year that ended.
+func ParseTaxYear(s string, now time.Time) (int, error) {
+	if s == "" {
+		return now.Year() - 1, nil
+	}
+
+	year, err := strconv.Atoi(s)
+	if err != nil || year < MinTaxYear || year > now.Year() {
+		return 0, fmt.Errorf("%w: year must be between %d and %d", ErrInvalidReport, MinTaxYear, now.Year())
+	}
+
+	return year, nil
+}
+
+// TaxYearRange is the range of the whole year, as a single period.
+func TaxYearRange(year int) *Range {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return &Range{From: from, To: Year.Add(from, 1), Granularity: Year}
+}
+
+// CategoryIncome is what was received in a category.
+type CategoryIncome struct {
+	Category movementsDomain.MovementCategory
+	Amount   float64
+}
+
+// AccountBalance is the balance of a financial account at the end of the year.
+type AccountBalance struct {
+	InstitutionID string
+	Name          string
+	Kind          string
+	Balance       float64
+}
+
+// InstitutionBalance is what the financial accounts of an institution add up to.
+type InstitutionBalance struct {
+	InstitutionID string
+	Balance       float64
+	Accounts      []*AccountBalance
+}
+
+// TaxItem is an amount of a section of the tax report.
+type TaxItem struct {
+	Concept string
+	Amount  float64
+}
+
+// TaxReport is what the income declaration of a year asks for: the income per category, the
+// deductible expenses and the balances per institution at the end of the year. BalanceDate
+// is the last day counted in the balances, today for the current year.
+type TaxReport struct {
+	Year            int
+	Income          []*TaxItem
+	TotalIncome     float64
+	Deductions      []*TaxItem
+	TotalDeductions float64
+	BalanceDate     time.Time
+	Balances        []*InstitutionBalance
+	TotalBalance    float64
+}
+
+// NewTaxReport builds the tax report of the year from its income per category, the yearly
+// spend per category, the totals per tag and the balances of the financial accounts. The
+// IncomeCategories and deductions are always listed, even when zero; the rest of the income
+// is added up as OtherIncome.
+func NewTaxReport(year int, income []*CategoryIncome, spend []*PeriodSpend, tags []*TagTotal, balanceDate time.Time, balances []*AccountBalance) *TaxReport {
+	report := &TaxReport{
+		Year:        year,
+		Income:      []*TaxItem{},
+		Deductions:  []*TaxItem{},
+		BalanceDate: balanceDate,
+		Balances:    []*InstitutionBalance{},
+	}
+
+	received := map[movementsDomain.MovementCategory]float64{}
+	var other float64
+	for _, i := range income {
+		if slices.Contains(IncomeCategories, i.Category) {
+			received[i.Category] += i.Amount
+			continue
+		}
+
+		other += i.Amount
+	}
+
+	for _, category := range IncomeCategories {
+		report.Income = append(report.Income, &TaxItem{Concept: string(category), Amount: round(received[category])})
+	}
+
+	report.Income = append(report.Income, &TaxItem{Concept: OtherIncome, Amount: round(other)})
+
+	spent := map[movementsDomain.MovementCategory]float64{}
+	for _, s := range spend {
+		spent[s.Category] += s.Amount
+	}
+
+	for _, category := range DeductibleCategories {
+		report.Deductions = append(report.Deductions, &TaxItem{Concept: string(category), Amount: round(spent[category])})
+	}
+
+	var interest float64
+	for _, tag := range tags {
+		if tag.Tag == MortgageInterestTag {
+			interest += tag.Expenses
+		}
+	}
+
+	report.Deductions = append(report.Deductions, &TaxItem{Concept: MortgageInterest, Amount: round(interest)})
+
+	report.TotalIncome = sumItems(report.Income)
+	report.TotalDeductions = sumItems(report.Deductions)
+
+	byInstitution := map[string]*InstitutionBalance{}
+	for _, balance := range balances {
+		institution, ok := byInstitution[balance.InstitutionID]
+		if !ok {
+			institution = &InstitutionBalance{InstitutionID: balance.InstitutionID, Accounts: []*AccountBalance{}}
+			byInstitution[balance.InstitutionID] = institution
+			report.Balances = append(report.Balances, institution)
+		}
+
+		institution.Accounts = append(institution.Accounts, balance)
+		institution.Balance = round(institution.Balance + balance.Balance)
+		report.TotalBalance = round(report.TotalBalance + balance.Balance)
+	}
+
+	slices.SortFunc(report.Balances, func(a, b *InstitutionBalance) int {
+		return cmp.Compare(a.InstitutionID, b.InstitutionID)
+	})
+
+	return report
+}
+
+// WriteCSV writes the report as section, concept and amount rows, each section closed by its
+// Total row.
+func (r *TaxReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{{"section", "concept", "amount"}}
+	section := func(name string, items []*TaxItem, total float64) {
+		for _, item := range items {
+			rows = append(rows, []string{name, item.Concept, formatCSVAmount(item.Amount)})
+		}
+
+		rows = append(rows, []string{name, Total, formatCSVAmount(total)})
+	}
+
+	balances := make([]*TaxItem, 0, len(r.Balances))
+	for _, balance := range r.Balances {
+		balances = append(balances, &TaxItem{Concept: balance.InstitutionID, Amount: balance.Balance})
+	}
+
+	section("income", r.Income, r.TotalIncome)
+	section("deductions", r.Deductions, r.TotalDeductions)
+	section("balances", balances, r.TotalBalance)
+
+	err := writer.WriteAll(rows)
+	if err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+// WriteHTML writes the report as a standalone HTML document laid out to be printed, or saved
+// as PDF from the browser.
+func (r *TaxReport) WriteHTML(w io.Writer) error {
+	return taxTemplate.Execute(w, r)
+}
+
+var taxTemplate = template.Must(template.New("tax").Funcs(template.FuncMap{
+	"amount": formatAmount,
+	"date":   func(t time.Time) string { return t.Format(DateLayout) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Tax report {{.Year}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.5em; }
+h2 { font-size: 1.1em; margin-top: 2em; border-bottom: 1px solid #999; }
+table { width: 100%; border-collapse: collapse; }
+td, th { padding: 0.3em 0.5em; text-align: left; }
+td.amount, th.amount { text-align: right; font-variant-numeric: tabular-nums; }
+tr.total td { font-weight: bold; border-top: 1px solid #999; }
+tr.account td:first-child { padding-left: 2em; color: #555; }
+@media print { body { margin: 0; } h2 { break-after: avoid; } tr { break-inside: avoid; } }
+</style>
+</head>
+<body>
+<h1>Tax report {{.Year}}</h1>
+<h2>Income</h2>
+<table>
+{{- range .Income}}
+<tr><td>{{.Concept}}</td><td class="amount">{{amount .Amount}}</td></tr>
+{{- end}}
+<tr class="total"><td>Total</td><td class="amount">{{amount .TotalIncome}}</td></tr>
+</table>
+<h2>Deductions</h2>
+<table>
+{{- range .Deductions}}
+<tr><td>{{.Concept}}</td><td class="amount">{{amount .Amount}}</td></tr>
+{{- end}}
+<tr class="total"><td>Total</td><td class="amount">{{amount .TotalDeductions}}</td></tr>
+</table>
+<h2>Balances as of {{date .BalanceDate}}</h2>
+<table>
+{{- range .Balances}}
+<tr><td>{{.InstitutionID}}</td><td class="amount">{{amount .Balance}}</td></tr>
+{{- range .Accounts}}
+<tr class="account"><td>{{.Name}} ({{.Kind}})</td><td class="amount">{{amount .Balance}}</td></tr>
+{{- end}}
+{{- end}}
+<tr class="total"><td>Total</td><td class="amount">{{amount .TotalBalance}}</td></tr>
+</table>
+</body>
+</html>
+`))
+
+func sumItems(items []*TaxItem) float64 {
+	var total float64
+	for _, item := range items {
+		total += item.Amount
+	}
+
+	return round(total)
+}
+
+func formatCSVAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// formatAmount writes the amount the way Colombian pesos are, e.g. $ 1.234.567,89.
+func formatAmount(amount float64) string {
+	cents := int64(math.Round(math.Abs(amount) * 100))
+	whole := strconv.FormatInt(cents/100, 10)
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+
+		grouped.WriteRune(digit)
+	}
+
+	sign := ""
+	if amount < 0 && cents > 0 {
+		sign = "-"
+	}
+
+	return fmt.Sprintf("%s$ %s,%02d", sign, grouped.String(), cents%100)
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"bytes"
+	"testing"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxYear(t *testing.T) {
+	c := require.New(t)
+
+	year, err := ParseTaxYear("", now)
+	c.NoError(err)
+	c.Equal(2024, year)
+
+	year, err = ParseTaxYear("2025", now)
+	c.NoError(err)
+	c.Equal(2025, year)
+
+	for _, raw := range []string{"2026", "1999", "last"} {
+		_, err = ParseTaxYear(raw, now)
+		c.ErrorIs(err, ErrInvalidReport, raw)
+	}
+}
+
+func TestTaxYearRange(t *testing.T) {
+	c := require.New(t)
+
+	r := TaxYearRange(2024)
+	c.Equal(date(2024, 1, 1), r.From)
+	c.Equal(date(2025, 1, 1), r.To)
+	c.Len(r.Periods(), 1)
+}
+
+func taxReport() *TaxReport {
+	income := []*CategoryIncome{
+		{Category: movementsDomain.Salary, Amount: 60000000},
+		{Category: movementsDomain.Investment, Amount: 1200000.555},
+		{Category: movementsDomain.Unknown, Amount: 300000},
+		{Category: movementsDomain.Savings, Amount: 200000},
+	}
+	spend := []*PeriodSpend{
+		{Period: date(2024, 1, 1), CategorySpend: CategorySpend{Category: movementsDomain.Food, Amount: 9000000}},
+		{Period: date(2024, 1, 1), CategorySpend: CategorySpend{Category: movementsDomain.Health, Amount: 2500000}},
+	}
+	tags := []*TagTotal{
+		{Tag: "reimbursable", Expenses: 100000},
+		{Tag: MortgageInterestTag, Expenses: 8000000, Income: 10},
+	}
+	balances := []*AccountBalance{
+		{InstitutionID: "nequi", Name: "Wallet", Kind: "wallet", Balance: 150000},
+		{InstitutionID: "bancolombia", Name: "Savings", Kind: "savings", Balance: 4000000},
+		{InstitutionID: "bancolombia", Name: "Visa", Kind: "credit_card", Balance: -1250000.5},
+	}
+
+	return NewTaxReport(2024, income, spend, tags, date(2024, 12, 31), balances)
+}
+
+func TestNewTaxReport(t *testing.T) {
+	c := require.New(t)
+
+	report := taxReport()
+
+	c.Equal([]*TaxItem{
+		{Concept: "salary", Amount: 60000000},
+		{Concept: "freelance", Amount: 0},
+		{Concept: "investment", Amount: 1200000.56},
+		{Concept: OtherIncome, Amount: 500000},
+	}, report.Income)
+	c.Equal(61700000.56, report.TotalIncome)
+
+	c.Equal([]*TaxItem{
+		{Concept: "health", Amount: 2500000},
+		{Concept: "education", Amount: 0},
+		{Concept: MortgageInterest, Amount: 8000000},
+	}, report.Deductions)
+	c.Equal(10500000.0, report.TotalDeductions)
+
+	c.Len(report.Balances, 2)
+	c.Equal("bancolombia", report.Balances[0].InstitutionID)
+	c.Equal(2749999.5, report.Balances[0].Balance)
+	c.Len(report.Balances[0].Accounts, 2)
+	c.Equal("nequi", report.Balances[1].InstitutionID)
+	c.Equal(2899999.5, report.TotalBalance)
+}
+
+func TestTaxReportWriteCSV(t *testing.T) {
+	c := require.New(t)
+
+	var out bytes.Buffer
+	c.NoError(taxReport().WriteCSV(&out))
+
+	c.Equal(`section,concept,amount
+income,salary,60000000.00
+income,freelance,0.00
+income,investment,1200000.56
+income,other,500000.00
+income,total,61700000.56
+deductions,health,2500000.00
+deductions,education,0.00
+deductions,mortgage_interest,8000000.00
+deductions,total,10500000.00
+balances,bancolombia,2749999.50
+balances,nequi,150000.00
+balances,total,2899999.50
+`, out.String())
+}
+
+func TestTaxReportWriteHTML(t *testing.T) {
+	c := require.New(t)
+
+	var out bytes.Buffer
+	c.NoError(taxReport().WriteHTML(&out))
+
+	html := out.String()
+	c.Contains(html, "<title>Tax report 2024</title>")
+	c.Contains(html, "<td>salary</td><td class=\"amount\">$ 60.000.000,00</td>")
+	c.Contains(html, "<td>Visa (credit_card)</td><td class=\"amount\">-$ 1.250.000,50</td>")
+	c.Contains(html, "Balances as of 2024-12-31")
+}
+
+func TestFormatAmount(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal("$ 0,00", formatAmount(0))
+	c.Equal("$ 999,99", formatAmount(999.99))
+	c.Equal("$ 1.000,00", formatAmount(1000))
+	c.Equal("-$ 123.456,70", formatAmount(-123456.7))
+}
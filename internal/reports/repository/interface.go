@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/reports/domain"
+)
+
+// ReportRepository aggregates the movements of an account into reports.
+type ReportRepository interface {
+	GetCategorySpend(ctx context.Context, accountID string, r *domain.Range) ([]*domain.PeriodSpend, error)
+	GetBalance(ctx context.Context, accountID string) (float64, error)
+	GetFlows(ctx context.Context, accountID string, since time.Time) ([]*domain.Flow, error)
+	GetTagTotals(ctx context.Context, accountID string, r *domain.Range) ([]*domain.TagTotal, error)
+	GetCategoryIncome(ctx context.Context, accountID string, r *domain.Range) ([]*domain.CategoryIncome, error)
+}
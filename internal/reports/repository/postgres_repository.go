@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reports/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db DBQuerier
+}
+
+// NewPostgresRepository creates the reports repository.
+func NewPostgresRepository(db *pgxpool.Pool) ReportRepository {
+	return &postgresRepository{db: db}
+}
+
+// GetCategorySpend returns the expenses of the account per period of the range and category,
+// each one with its share of the period and the change since the previous period. Periods are
+// truncated in UTC, uncategorized movements count as unknown and transfers are left out. Split
+// movements count in the categories of their splits. The rows are sorted by period and amount,
+// the highest first.
+func (r *postgresRepository) GetCategorySpend(ctx context.Context, accountID string, rng *domain.Range) ([]*domain.PeriodSpend, error) {
+	query := `WITH spend AS (
+		SELECT date_trunc($2, m.date AT TIME ZONE 'UTC') AS period,
+		COALESCE(sp.category, NULLIF(m.category, ''), $4) AS category,
+		SUM(COALESCE(sp.amount, m.amount)) AS amount
+		FROM movements m
+		LEFT JOIN movement_splits sp ON sp.movement_id = m.id
+		WHERE m.account_id = $1 AND m.type = $3 AND m.transfer_id = '' AND m.date >= $5 AND m.date < $6
+		GROUP BY 1, 2
+	)
+	SELECT s.period, s.category, s.amount,
+	ROUND(s.amount * 100 / SUM(s.amount) OVER (PARTITION BY s.period), 2),
+	COALESCE(p.amount, 0),
+	CASE WHEN p.amount > 0 THEN ROUND((s.amount - p.amount) * 100 / p.amount, 2) END
+	FROM spend s
+	LEFT JOIN spend p ON p.category = s.category AND p.period = s.period - $7::interval
+	WHERE s.period >= $8::timestamp
+	ORDER BY s.period, s.amount DESC, s.category`
+
+	previous := rng.Granularity.Add(rng.From, -1)
+
+	rows, err := r.db.Query(ctx, query,
+		accountID,
+		string(rng.Granularity),
+		string(movementsDomain.Expense),
+		string(movementsDomain.Unknown),
+		previous,
+		rng.To,
+		rng.Granularity.Interval(),
+		rng.From)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	spend := []*domain.PeriodSpend{}
+	for rows.Next() {
+		s := &domain.PeriodSpend{}
+
+		var category string
+
+		err := rows.Scan(&s.Period, &category, &s.Amount, &s.Share, &s.PreviousAmount, &s.Change)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Period = s.Period.UTC()
+		s.Category = movementsDomain.MovementCategory(category)
+		spend = append(spend, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return spend, nil
+}
+
+// GetBalance returns what the account received minus what it spent across all its movements.
+func (r *postgresRepository) GetBalance(ctx context.Context, accountID string) (float64, error) {
+	query := `SELECT COALESCE(SUM(CASE WHEN type = $2 THEN amount ELSE -amount END), 0)
+	FROM movements
+	WHERE account_id = $1`
+
+	var balance float64
+
+	err := r.db.QueryRow(ctx, query, accountID, string(movementsDomain.Income)).Scan(&balance)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// GetFlows returns the movements of the account since the given date with the names of their
+// merchants, oldest first. Uncategorized movements count as unknown and transfers are left out.
+func (r *postgresRepository) GetFlows(ctx context.Context, accountID string, since time.Time) ([]*domain.Flow, error) {
+	query := `SELECT mv.merchant_id, COALESCE(m.name, ''), mv.type, COALESCE(NULLIF(mv.category, ''), $3), mv.amount, mv.date
+	FROM movements mv
+	LEFT JOIN merchants m ON m.id = mv.merchant_id
+	WHERE mv.account_id = $1 AND mv.transfer_id = '' AND mv.date >= $2
+	ORDER BY mv.date`
+
+	rows, err := r.db.Query(ctx, query, accountID, since, string(movementsDomain.Unknown))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	flows := []*domain.Flow{}
+	for rows.Next() {
+		flow := &domain.Flow{}
+
+		var movementType, category string
+
+		err := rows.Scan(&flow.MerchantID, &flow.MerchantName, &movementType, &category, &flow.Amount, &flow.Date)
+		if err != nil {
+			return nil, err
+		}
+
+		flow.Type = movementsDomain.MovementType(movementType)
+		flow.Category = movementsDomain.MovementCategory(category)
+		flows = append(flows, flow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return flows, nil
+}
+
+// GetTagTotals returns the income and expenses of the movements of the account with each tag
+// made in the range. A movement counts in every tag it has and transfers are left out.
+func (r *postgresRepository) GetTagTotals(ctx context.Context, accountID string, rng *domain.Range) ([]*domain.TagTotal, error) {
+	query := `SELECT t.tag,
+	COALESCE(SUM(m.amount) FILTER (WHERE m.type = $2), 0),
+	COALESCE(SUM(m.amount) FILTER (WHERE m.type = $3), 0),
+	COUNT(*)
+	FROM movements m
+	CROSS JOIN LATERAL unnest(m.tags) AS t(tag)
+	WHERE m.account_id = $1 AND m.transfer_id = '' AND m.date >= $4 AND m.date < $5
+	GROUP BY t.tag
+	ORDER BY t.tag`
+
+	rows, err := r.db.Query(ctx, query,
+		accountID,
+		string(movementsDomain.Income),
+		string(movementsDomain.Expense),
+		rng.From,
+		rng.To)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	totals := []*domain.TagTotal{}
+	for rows.Next() {
+		total := &domain.TagTotal{}
+
+		err := rows.Scan(&total.Tag, &total.Income, &total.Expenses, &total.Count)
+		if err != nil {
+			return nil, err
+		}
+
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// GetCategoryIncome returns what the account received per category in the range, the highest
+// first. Uncategorized movements count as unknown, transfers are left out and split movements
+// count in the categories of their splits.
+func (r *postgresRepository) GetCategoryIncome(ctx context.Context, accountID string, rng *domain.Range) ([]*domain.CategoryIncome, error) {
+	query := `SELECT COALESCE(sp.category, NULLIF(m.category, ''), $3) AS category,
+	SUM(COALESCE(sp.amount, m.amount)) AS amount
+	FROM movements m
+	LEFT JOIN movement_splits sp ON sp.movement_id = m.id
+	WHERE m.account_id = $1 AND m.type = $2 AND m.transfer_id = '' AND m.date >= $4 AND m.date < $5
+	GROUP BY 1
+	ORDER BY 2 DESC, 1`
+
+	rows, err := r.db.Query(ctx, query,
+		accountID,
+		string(movementsDomain.Income),
+		string(movementsDomain.Unknown),
+		rng.From,
+		rng.To)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	income := []*domain.CategoryIncome{}
+	for rows.Next() {
+		i := &domain.CategoryIncome{}
+
+		var category string
+
+		err := rows.Scan(&category, &i.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		i.Category = movementsDomain.MovementCategory(category)
+		income = append(income, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return income, nil
+}
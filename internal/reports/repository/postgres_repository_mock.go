@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/reports/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReportRepository is a mock of the repository interface.
+type MockReportRepository struct {
+	mock.Mock
+}
+
+func (m *MockReportRepository) GetCategorySpend(ctx context.Context, accountID string, r *domain.Range) ([]*domain.PeriodSpend, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.PeriodSpend), args.Error(1)
+}
+
+func (m *MockReportRepository) GetBalance(ctx context.Context, accountID string) (float64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func (m *MockReportRepository) GetFlows(ctx context.Context, accountID string, since time.Time) ([]*domain.Flow, error) {
+	args := m.Called(ctx, accountID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Flow), args.Error(1)
+}
+
+func (m *MockReportRepository) GetTagTotals(ctx context.Context, accountID string, r *domain.Range) ([]*domain.TagTotal, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.TagTotal), args.Error(1)
+}
+
+func (m *MockReportRepository) GetCategoryIncome(ctx context.Context, accountID string, r *domain.Range) ([]*domain.CategoryIncome, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.CategoryIncome), args.Error(1)
+}
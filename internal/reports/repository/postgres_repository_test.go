@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reports/domain"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	july      = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	august    = time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	september = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	october   = time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func setupMockDB(t *testing.T) (ReportRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool}, mockPool
+}
+
+func TestGetCategorySpend(t *testing.T) {
+	rng := &domain.Range{From: august, To: october, Granularity: domain.Month}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		change := 25.0
+		rows := pgxmock.NewRows([]string{"period", "category", "amount", "share", "previous", "change"}).
+			AddRow(august, "food", 500.0, 100.0, 400.0, &change).
+			AddRow(september, "food", 300.0, 75.0, 500.0, nil).
+			AddRow(september, "unknown", 100.0, 25.0, 0.0, nil)
+
+		mock.ExpectQuery(`WITH spend AS \( SELECT date_trunc\(\$2, (.+) FROM movements m LEFT JOIN movement_splits sp ON sp.movement_id = m.id (.+) LEFT JOIN spend p ON p.category = s.category AND p.period = s.period - \$7::interval`).
+			WithArgs("acc1", "month", string(movementsDomain.Expense), string(movementsDomain.Unknown), july, october, "1 month", august).
+			WillReturnRows(rows)
+
+		spend, err := repo.GetCategorySpend(context.Background(), "acc1", rng)
+		c.NoError(err)
+		c.Len(spend, 3)
+		c.Equal(movementsDomain.Food, spend[0].Category)
+		c.Equal(&change, spend[0].Change)
+		c.Nil(spend[1].Change)
+		c.Equal(movementsDomain.Unknown, spend[2].Category)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`WITH spend AS`).
+			WithArgs("acc1", "month", string(movementsDomain.Expense), string(movementsDomain.Unknown), july, october, "1 month", august).
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetCategorySpend(context.Background(), "acc1", rng)
+		require.Error(t, err)
+	})
+}
+
+func TestGetBalance(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(CASE WHEN type = \$2 THEN amount ELSE -amount END\), 0\) FROM movements`).
+		WithArgs("acc1", string(movementsDomain.Income)).
+		WillReturnRows(pgxmock.NewRows([]string{"balance"}).AddRow(1250.5))
+
+	balance, err := repo.GetBalance(context.Background(), "acc1")
+	c.NoError(err)
+	c.Equal(1250.5, balance)
+}
+
+func TestGetFlows(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"merchant_id", "name", "type", "category", "amount", "date"}).
+		AddRow("MER1", "ACME", "income", "salary", 3000.0, august).
+		AddRow("", "", "expense", "unknown", 20.0, september)
+
+	mock.ExpectQuery(`SELECT mv.merchant_id, (.+) FROM movements mv LEFT JOIN merchants m ON m.id = mv.merchant_id WHERE mv.account_id = \$1 AND mv.transfer_id = ''`).
+		WithArgs("acc1", july, string(movementsDomain.Unknown)).
+		WillReturnRows(rows)
+
+	flows, err := repo.GetFlows(context.Background(), "acc1", july)
+	c.NoError(err)
+	c.Equal([]*domain.Flow{
+		{MerchantID: "MER1", MerchantName: "ACME", Type: movementsDomain.Income, Category: movementsDomain.Salary, Amount: 3000, Date: august},
+		{Type: movementsDomain.Expense, Category: movementsDomain.Unknown, Amount: 20, Date: september},
+	}, flows)
+}
+
+func TestGetTagTotals(t *testing.T) {
+	rng := &domain.Range{From: august, To: october, Granularity: domain.Month}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows([]string{"tag", "income", "expenses", "count"}).
+			AddRow("reimbursable", 100.0, 250.0, 3).
+			AddRow("trip-cartagena-2026", 0.0, 1200.0, 8)
+
+		mock.ExpectQuery(`SELECT t.tag, (.+) FROM movements m CROSS JOIN LATERAL unnest\(m.tags\) AS t\(tag\) WHERE m.account_id = \$1 AND m.transfer_id = '' AND m.date >= \$4 AND m.date < \$5 GROUP BY t.tag`).
+			WithArgs("acc1", string(movementsDomain.Income), string(movementsDomain.Expense), august, october).
+			WillReturnRows(rows)
+
+		totals, err := repo.GetTagTotals(context.Background(), "acc1", rng)
+		c.NoError(err)
+		c.Len(totals, 2)
+		c.Equal("reimbursable", totals[0].Tag)
+		c.Equal(100.0, totals[0].Income)
+		c.Equal(8, totals[1].Count)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT t.tag`).
+			WithArgs("acc1", string(movementsDomain.Income), string(movementsDomain.Expense), august, october).
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetTagTotals(context.Background(), "acc1", rng)
+		require.Error(t, err)
+	})
+}
+
+func TestGetCategoryIncome(t *testing.T) {
+	rng := &domain.Range{From: july, To: october, Granularity: domain.Year}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows([]string{"category", "amount"}).
+			AddRow("salary", 9000.0).
+			AddRow("unknown", 150.0)
+
+		mock.ExpectQuery(`SELECT COALESCE\(sp.category, NULLIF\(m.category, ''\), \$3\) AS category, (.+) FROM movements m LEFT JOIN movement_splits sp ON sp.movement_id = m.id WHERE m.account_id = \$1 AND m.type = \$2 AND m.transfer_id = '' AND m.date >= \$4 AND m.date < \$5 GROUP BY 1`).
+			WithArgs("acc1", string(movementsDomain.Income), string(movementsDomain.Unknown), july, october).
+			WillReturnRows(rows)
+
+		income, err := repo.GetCategoryIncome(context.Background(), "acc1", rng)
+		c.NoError(err)
+		c.Equal([]*domain.CategoryIncome{
+			{Category: movementsDomain.Salary, Amount: 9000},
+			{Category: movementsDomain.Unknown, Amount: 150},
+		}, income)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT COALESCE`).
+			WithArgs("acc1", string(movementsDomain.Income), string(movementsDomain.Unknown), july, october).
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetCategoryIncome(context.Background(), "acc1", rng)
+		require.Error(t, err)
+	})
+}
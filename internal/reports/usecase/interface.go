@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/reports/domain"
+)
+
+// ReportsUsecase builds the reports of an account.
+type ReportsUsecase interface {
+	GetCategoryReport(ctx context.Context, accountID string, r *domain.Range) (*domain.CategoryReport, error)
+	GetForecast(ctx context.Context, accountID string, days int, balance *float64) (*domain.Forecast, error)
+	GetTagReport(ctx context.Context, accountID string, r *domain.Range) (*domain.TagReport, error)
+	GetTaxReport(ctx context.Context, accountID string, year int) (*domain.TaxReport, error)
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+	categoriesDomain "transaction-tracker/internal/categories/domain"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reports/domain"
+	"transaction-tracker/internal/reports/repository"
+)
+
+type reportsUsecase struct {
+	repo              repository.ReportRepository
+	finUsecase        financialAccountsUsecase.FinancialAccountsUsecase
+	categoriesUsecase categoriesUsecase.CategoriesUsecase
+	nowFunc           func() time.Time
+}
+
+// NewReportsUsecase creates a new instance of ReportsUsecase. Balances are read from the
+// financial accounts of finUsecase and the category tree of the account from catUsecase.
+func NewReportsUsecase(repo repository.ReportRepository, finUsecase financialAccountsUsecase.FinancialAccountsUsecase, catUsecase categoriesUsecase.CategoriesUsecase) ReportsUsecase {
+	return &reportsUsecase{
+		repo:              repo,
+		finUsecase:        finUsecase,
+		categoriesUsecase: catUsecase,
+		nowFunc:           time.Now,
+	}
+}
+
+// GetCategoryReport returns the spend per category of the range, in total and per period.
+func (u *reportsUsecase) GetCategoryReport(ctx context.Context, accountID string, r *domain.Range) (*domain.CategoryReport, error) {
+	spend, err := u.repo.GetCategorySpend(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewCategoryReport(r, spend), nil
+}
+
+// GetForecast projects the balance of the account for the next days. It starts from balance
+// when given, from the balances of its domain.ForecastKinds financial accounts otherwise, and
+// from what the account received minus what it spent when it has none of them.
+func (u *reportsUsecase) GetForecast(ctx context.Context, accountID string, days int, balance *float64) (*domain.Forecast, error) {
+	now := u.nowFunc()
+
+	start := 0.0
+	if balance != nil {
+		start = *balance
+	} else {
+		var err error
+
+		start, err = u.startBalance(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	flows, err := u.repo.GetFlows(ctx, accountID, now.AddDate(0, -domain.ForecastHistoryMonths, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewForecast(start, flows, now, days), nil
+}
+
+func (u *reportsUsecase) startBalance(ctx context.Context, accountID string) (float64, error) {
+	summaries, err := u.finUsecase.GetSummaries(ctx, accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	if balance, ok := domain.ForecastBalance(summaries); ok {
+		return balance, nil
+	}
+
+	return u.repo.GetBalance(ctx, accountID)
+}
+
+// GetTagReport returns the income and expenses per tag of the range.
+func (u *reportsUsecase) GetTagReport(ctx context.Context, accountID string, r *domain.Range) (*domain.TagReport, error) {
+	totals, err := u.repo.GetTagTotals(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewTagReport(r, totals), nil
+}
+
+// GetTaxReport returns what the income declaration of the year asks for: the income per
+// category, the deductible expenses of the yearly spend per category and of the mortgage
+// interest tag, and the balances of the financial accounts at the end of the year. The
+// subcategories of a tax category count under it.
+func (u *reportsUsecase) GetTaxReport(ctx context.Context, accountID string, year int) (*domain.TaxReport, error) {
+	r := domain.TaxYearRange(year)
+
+	income, err := u.repo.GetCategoryIncome(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	spend, err := u.repo.GetCategorySpend(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := u.repo.GetTagTotals(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := u.categoriesUsecase.GetCategories(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxCategories := map[movementsDomain.MovementCategory]movementsDomain.MovementCategory{}
+	for _, category := range slices.Concat(domain.IncomeCategories, domain.DeductibleCategories) {
+		for _, slug := range categoriesDomain.Subtree(categories, category) {
+			taxCategories[slug] = category
+		}
+	}
+
+	for _, i := range income {
+		if category, ok := taxCategories[i.Category]; ok {
+			i.Category = category
+		}
+	}
+
+	for _, s := range spend {
+		if category, ok := taxCategories[s.Category]; ok {
+			s.Category = category
+		}
+	}
+
+	summaries, err := u.finUsecase.GetSummaries(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := u.finUsecase.GetNetWorth(ctx, accountID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceDate := r.To.AddDate(0, 0, -1)
+	balances := []*domain.AccountBalance{}
+	if len(points) > 0 {
+		end := points[len(points)-1]
+		balanceDate = end.Date
+
+		accounts := map[string]*domain.AccountBalance{}
+		for _, summary := range summaries {
+			accounts[summary.ID] = &domain.AccountBalance{
+				InstitutionID: summary.InstitutionID,
+				Name:          summary.Name,
+				Kind:          string(summary.Kind),
+			}
+		}
+
+		for _, balance := range end.Balances {
+			account, ok := accounts[balance.FinancialAccountID]
+			if !ok {
+				continue
+			}
+
+			account.Balance = balance.Balance
+			balances = append(balances, account)
+		}
+	}
+
+	return domain.NewTaxReport(year, income, spend, tags, balanceDate, balances), nil
+}
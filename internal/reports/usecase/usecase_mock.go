@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/reports/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReportsUsecase is a mock implementation of the ReportsUsecase interface.
+type MockReportsUsecase struct {
+	mock.Mock
+}
+
+func (m *MockReportsUsecase) GetCategoryReport(ctx context.Context, accountID string, r *domain.Range) (*domain.CategoryReport, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.CategoryReport), args.Error(1)
+}
+
+func (m *MockReportsUsecase) GetForecast(ctx context.Context, accountID string, days int, balance *float64) (*domain.Forecast, error) {
+	args := m.Called(ctx, accountID, days, balance)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Forecast), args.Error(1)
+}
+
+func (m *MockReportsUsecase) GetTagReport(ctx context.Context, accountID string, r *domain.Range) (*domain.TagReport, error) {
+	args := m.Called(ctx, accountID, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.TagReport), args.Error(1)
+}
+
+func (m *MockReportsUsecase) GetTaxReport(ctx context.Context, accountID string, year int) (*domain.TaxReport, error) {
+	args := m.Called(ctx, accountID, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.TaxReport), args.Error(1)
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	categoriesDomain "transaction-tracker/internal/categories/domain"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	financialAccountsDomain "transaction-tracker/internal/financialaccounts/domain"
+	financialAccountsUsecase "transaction-tracker/internal/financialaccounts/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/reports/domain"
+	"transaction-tracker/internal/reports/repository"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var rng = &domain.Range{
+	From:        time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+	To:          time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+	Granularity: domain.Month,
+}
+
+func TestGetCategoryReport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetCategorySpend", ctx, "acc1", rng).Return([]*domain.PeriodSpend{
+			{Period: rng.From, CategorySpend: domain.CategorySpend{Category: movementsDomain.Food, Amount: 100, Share: 100}},
+		}, nil)
+
+		report, err := NewReportsUsecase(repo, nil, nil).GetCategoryReport(ctx, "acc1", rng)
+		c.NoError(err)
+		c.Equal(100.0, report.Total)
+		c.Len(report.Periods, 2)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetCategorySpend", ctx, "acc1", rng).Return(nil, errors.New("db error"))
+
+		_, err := NewReportsUsecase(repo, nil, nil).GetCategoryReport(ctx, "acc1", rng)
+		require.Error(t, err)
+	})
+}
+
+func TestGetForecast(t *testing.T) {
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	since := time.Date(2023, 8, 20, 12, 0, 0, 0, time.UTC)
+	flows := []*domain.Flow{
+		{Type: movementsDomain.Expense, Category: movementsDomain.Food, Amount: 100, Date: time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	t.Run("from the financial accounts balance", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetFlows", ctx, "acc1", since).Return(flows, nil)
+
+		fin := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		fin.On("GetSummaries", ctx, "acc1").Return([]*financialAccountsDomain.Summary{
+			{FinancialAccount: &financialAccountsDomain.FinancialAccount{ID: "FAC1", Kind: financialAccountsDomain.Savings}, Balance: 700},
+			{FinancialAccount: &financialAccountsDomain.FinancialAccount{ID: "FAC2", Kind: financialAccountsDomain.CreditCard}, Balance: -200},
+			{FinancialAccount: &financialAccountsDomain.FinancialAccount{ID: "FAC3", Kind: financialAccountsDomain.Investment}, Balance: 10000},
+		}, nil)
+
+		u := &reportsUsecase{repo: repo, finUsecase: fin, nowFunc: func() time.Time { return now }}
+
+		forecast, err := u.GetForecast(ctx, "acc1", 10, nil)
+		c.NoError(err)
+		c.Equal(500.0, forecast.StartBalance)
+		c.Equal(400.0, forecast.EndBalance)
+		repo.AssertNotCalled(t, "GetBalance", mock.Anything, mock.Anything)
+	})
+
+	t.Run("from the movements balance without financial accounts", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetBalance", ctx, "acc1").Return(500.0, nil)
+		repo.On("GetFlows", ctx, "acc1", since).Return(flows, nil)
+
+		fin := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		fin.On("GetSummaries", ctx, "acc1").Return([]*financialAccountsDomain.Summary{}, nil)
+
+		u := &reportsUsecase{repo: repo, finUsecase: fin, nowFunc: func() time.Time { return now }}
+
+		forecast, err := u.GetForecast(ctx, "acc1", 10, nil)
+		c.NoError(err)
+		c.Equal(500.0, forecast.StartBalance)
+		c.Equal(400.0, forecast.EndBalance)
+	})
+
+	t.Run("financial accounts error", func(t *testing.T) {
+		ctx := context.Background()
+
+		fin := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		fin.On("GetSummaries", ctx, "acc1").Return(nil, errors.New("db error"))
+
+		u := &reportsUsecase{repo: new(repository.MockReportRepository), finUsecase: fin, nowFunc: func() time.Time { return now }}
+
+		_, err := u.GetForecast(ctx, "acc1", 10, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("from the given balance", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+		balance := 50.0
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetFlows", ctx, "acc1", since).Return(flows, nil)
+
+		u := &reportsUsecase{repo: repo, nowFunc: func() time.Time { return now }}
+
+		forecast, err := u.GetForecast(ctx, "acc1", 10, &balance)
+		c.NoError(err)
+		c.Equal(date(2025, 9, 26), *forecast.FirstNegativeAt)
+		repo.AssertNotCalled(t, "GetBalance", mock.Anything, mock.Anything)
+	})
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGetTagReport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetTagTotals", ctx, "acc1", rng).Return([]*domain.TagTotal{
+			{Tag: "reimbursable", Income: 100, Expenses: 250, Count: 3},
+		}, nil)
+
+		report, err := NewReportsUsecase(repo, nil, nil).GetTagReport(ctx, "acc1", rng)
+		c.NoError(err)
+		c.Len(report.Tags, 1)
+		c.Equal(-150.0, report.Tags[0].Net)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetTagTotals", ctx, "acc1", rng).Return(nil, errors.New("db error"))
+
+		_, err := NewReportsUsecase(repo, nil, nil).GetTagReport(ctx, "acc1", rng)
+		require.Error(t, err)
+	})
+}
+
+func TestGetTaxReport(t *testing.T) {
+	year := domain.TaxYearRange(2024)
+	savings := &financialAccountsDomain.FinancialAccount{ID: "FAC1", InstitutionID: "bancolombia", Name: "Savings", Kind: financialAccountsDomain.Savings}
+	categories := []*categoriesDomain.Category{
+		{ID: "CAT1", Slug: movementsDomain.Salary},
+		{ID: "CAT2", Slug: "bonus", ParentID: "CAT1"},
+		{ID: "CAT3", Slug: movementsDomain.Health},
+		{ID: "CAT4", Slug: "dentist", ParentID: "CAT3"},
+		{ID: "CAT5", Slug: movementsDomain.Education},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetCategoryIncome", ctx, "acc1", year).Return([]*domain.CategoryIncome{
+			{Category: movementsDomain.Salary, Amount: 60000000},
+			{Category: "bonus", Amount: 5000000},
+		}, nil)
+		repo.On("GetCategorySpend", ctx, "acc1", year).Return([]*domain.PeriodSpend{
+			{Period: year.From, CategorySpend: domain.CategorySpend{Category: movementsDomain.Education, Amount: 3000000}},
+			{Period: year.From, CategorySpend: domain.CategorySpend{Category: "dentist", Amount: 2000000}},
+		}, nil)
+		repo.On("GetTagTotals", ctx, "acc1", year).Return([]*domain.TagTotal{
+			{Tag: domain.MortgageInterestTag, Expenses: 8000000},
+		}, nil)
+
+		cat := new(categoriesUsecase.MockCategoriesUsecase)
+		cat.On("GetCategories", ctx, "acc1").Return(categories, nil)
+
+		fin := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		fin.On("GetSummaries", ctx, "acc1").Return([]*financialAccountsDomain.Summary{{FinancialAccount: savings, Balance: 1}}, nil)
+		fin.On("GetNetWorth", ctx, "acc1", year).Return([]*financialAccountsDomain.NetWorthPoint{
+			{Period: year.From, Date: date(2024, 12, 31), Balances: []*financialAccountsDomain.Balance{{FinancialAccountID: "FAC1", Balance: 4000000}}},
+		}, nil)
+
+		report, err := NewReportsUsecase(repo, fin, cat).GetTaxReport(ctx, "acc1", 2024)
+		c.NoError(err)
+		c.Equal(65000000.0, report.TotalIncome)
+		c.Equal(&domain.TaxItem{Concept: string(movementsDomain.Salary), Amount: 65000000}, report.Income[0])
+		c.Equal(13000000.0, report.TotalDeductions)
+		c.Equal(&domain.TaxItem{Concept: string(movementsDomain.Health), Amount: 2000000}, report.Deductions[0])
+		c.Equal(date(2024, 12, 31), report.BalanceDate)
+		c.Len(report.Balances, 1)
+		c.Equal("bancolombia", report.Balances[0].InstitutionID)
+		c.Equal(4000000.0, report.Balances[0].Balance)
+		c.Equal("Savings", report.Balances[0].Accounts[0].Name)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctx := context.Background()
+
+		repo := new(repository.MockReportRepository)
+		repo.On("GetCategoryIncome", ctx, "acc1", year).Return(nil, errors.New("db error"))
+
+		fin := new(financialAccountsUsecase.MockFinancialAccountsUsecase)
+		cat := new(categoriesUsecase.MockCategoriesUsecase)
+
+		_, err := NewReportsUsecase(repo, fin, cat).GetTaxReport(ctx, "acc1", 2024)
+		require.Error(t, err)
+		fin.AssertNotCalled(t, "GetNetWorth", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,298 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+// ConditionField is the movement attribute a condition looks at.
+type ConditionField string
+
+// Operator is how a condition compares the field with its value.
+type Operator string
+
+const (
+	_rule_prefix = "RUL"
+
+	// FieldDescription matches on the movement description.
+	FieldDescription ConditionField = "description"
+	// FieldAmount matches on the movement amount.
+	FieldAmount ConditionField = "amount"
+	// FieldType matches on the movement type, income or expense.
+	FieldType ConditionField = "type"
+	// FieldInstitution matches on the institution that reported the movement.
+	FieldInstitution ConditionField = "institution_id"
+
+	// Contains matches descriptions containing the value, ignoring case.
+	Contains Operator = "contains"
+	// Equals matches fields equal to the value, ignoring case.
+	Equals Operator = "equals"
+	// Regex matches descriptions against a regular expression, ignoring case.
+	Regex Operator = "regex"
+	// GreaterThan matches amounts greater than the value.
+	GreaterThan Operator = "gt"
+	// GreaterOrEqual matches amounts greater than or equal to the value.
+	GreaterOrEqual Operator = "gte"
+	// LessThan matches amounts less than the value.
+	LessThan Operator = "lt"
+	// LessOrEqual matches amounts less than or equal to the value.
+	LessOrEqual Operator = "lte"
+
+	// maxPreviewChanges caps the sample of changes returned by a preview.
+	maxPreviewChanges = 20
+)
+
+var (
+	// ErrInvalidRule is returned when a rule cannot be evaluated.
+	ErrInvalidRule = errors.New("invalid rule")
+
+	operatorsByField = map[ConditionField][]Operator{
+		FieldDescription: {Contains, Equals, Regex},
+		FieldAmount:      {Equals, GreaterThan, GreaterOrEqual, LessThan, LessOrEqual},
+		FieldType:        {Equals},
+		FieldInstitution: {Equals},
+	}
+)
+
+// Condition is a single test over a movement field.
+type Condition struct {
+	Field    ConditionField `json:"field"`
+	Operator Operator       `json:"operator"`
+	Value    string         `json:"value"`
+
+	compiled bool
+	regex    *regexp.Regexp
+	amount   float64
+}
+
+// Rule assigns a category to the movements of an account matching every condition.
+// Rules with a lower priority are evaluated first.
+type Rule struct {
+	ID         string
+	AccountID  string
+	Name       string
+	Priority   int
+	Conditions []Condition
+	Category   movementsDomain.MovementCategory
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (r *Rule) LogProperties() map[string]string {
+	return map[string]string{
+		"rule_id":    r.ID,
+		"account_id": r.AccountID,
+		"name":       r.Name,
+		"priority":   strconv.Itoa(r.Priority),
+		"category":   string(r.Category),
+		"active":     strconv.FormatBool(r.Active),
+	}
+}
+
+// NewRule creates an active rule and validates it.
+func NewRule(accountID string, name string, priority int, category movementsDomain.MovementCategory, conditions []Condition) (*Rule, error) {
+	rule := &Rule{
+		ID:         _rule_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:  accountID,
+		Name:       name,
+		Priority:   priority,
+		Conditions: conditions,
+		Category:   category,
+		Active:     true,
+	}
+
+	err := rule.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// Validate checks the rule and prepares its conditions to be evaluated.
+func (r *Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRule)
+	}
+
+	if r.Category == "" {
+		return fmt.Errorf("%w: category is required", ErrInvalidRule)
+	}
+
+	if len(r.Conditions) == 0 {
+		return fmt.Errorf("%w: at least one condition is required", ErrInvalidRule)
+	}
+
+	for i := range r.Conditions {
+		err := r.Conditions[i].compile()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether the movement fulfills every condition of the rule.
+func (r *Rule) Matches(movement *movementsDomain.Movement) bool {
+	for i := range r.Conditions {
+		if !r.Conditions[i].matches(movement) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Condition) compile() error {
+	operators, ok := operatorsByField[c.Field]
+	if !ok {
+		return fmt.Errorf("%w: unknown field %q", ErrInvalidRule, c.Field)
+	}
+
+	if !slices.Contains(operators, c.Operator) {
+		return fmt.Errorf("%w: operator %q is not allowed on %s", ErrInvalidRule, c.Operator, c.Field)
+	}
+
+	if c.Value == "" {
+		return fmt.Errorf("%w: value of %s is required", ErrInvalidRule, c.Field)
+	}
+
+	switch c.Field {
+	case FieldAmount:
+		amount, err := strconv.ParseFloat(strings.ReplaceAll(c.Value, ",", ""), 64)
+		if err != nil {
+			return fmt.Errorf("%w: amount %q is not a number", ErrInvalidRule, c.Value)
+		}
+
+		c.amount = amount
+	case FieldType:
+		_, err := movementsDomain.ParseMovementType(c.Value)
+		if err != nil {
+			return err
+		}
+	case FieldDescription:
+		if c.Operator == Regex {
+			regex, err := regexp.Compile("(?i)" + c.Value)
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidRule, err)
+			}
+
+			c.regex = regex
+		}
+	}
+
+	c.compiled = true
+
+	return nil
+}
+
+func (c *Condition) matches(movement *movementsDomain.Movement) bool {
+	// Conditions read from storage are compiled on first use.
+	if !c.compiled {
+		if c.compile() != nil {
+			return false
+		}
+	}
+
+	switch c.Field {
+	case FieldDescription:
+		return matchText(c.Operator, movement.Description, c.Value, c.regex)
+	case FieldInstitution:
+		return strings.EqualFold(movement.InstitutionID, c.Value)
+	case FieldType:
+		return strings.EqualFold(string(movement.Type), c.Value)
+	case FieldAmount:
+		return matchAmount(c.Operator, movement.Amount, c.amount)
+	}
+
+	return false
+}
+
+func matchText(operator Operator, text string, value string, regex *regexp.Regexp) bool {
+	switch operator {
+	case Contains:
+		return strings.Contains(strings.ToLower(text), strings.ToLower(value))
+	case Equals:
+		return strings.EqualFold(strings.TrimSpace(text), strings.TrimSpace(value))
+	case Regex:
+		return regex.MatchString(text)
+	}
+
+	return false
+}
+
+func matchAmount(operator Operator, amount float64, value float64) bool {
+	switch operator {
+	case Equals:
+		return amount == value
+	case GreaterThan:
+		return amount > value
+	case GreaterOrEqual:
+		return amount >= value
+	case LessThan:
+		return amount < value
+	case LessOrEqual:
+		return amount <= value
+	}
+
+	return false
+}
+
+// Change is a movement whose category a rule would change.
+type Change struct {
+	MovementID  string
+	Description string
+	From        movementsDomain.MovementCategory
+	To          movementsDomain.MovementCategory
+}
+
+// Preview summarizes the effect of applying a rule to existing movements.
+type Preview struct {
+	Matched int
+	Changed int
+	Changes []Change
+}
+
+// PreviewRule evaluates the rule against the movements. Changes lists a sample of the movements
+// whose category would change.
+func PreviewRule(rule *Rule, movements []*movementsDomain.Movement) (*Preview, []*movementsDomain.Movement) {
+	preview := &Preview{Changes: []Change{}}
+	changed := []*movementsDomain.Movement{}
+
+	for _, m := range movements {
+		if !rule.Matches(m) {
+			continue
+		}
+
+		preview.Matched++
+
+		if m.Category == rule.Category {
+			continue
+		}
+
+		preview.Changed++
+		changed = append(changed, m)
+
+		if len(preview.Changes) < maxPreviewChanges {
+			preview.Changes = append(preview.Changes, Change{
+				MovementID:  m.ID,
+				Description: m.Description,
+				From:        m.Category,
+				To:          rule.Category,
+			})
+		}
+	}
+
+	return preview, changed
+}
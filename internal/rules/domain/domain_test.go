@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRule_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		ruleName   string
+		category   movementsDomain.MovementCategory
+		conditions []Condition
+		expected   error
+	}{
+		{"missing name", "", movementsDomain.Food, []Condition{{Field: FieldDescription, Operator: Contains, Value: "rappi"}}, ErrInvalidRule},
+		{"missing category", "rappi", "", []Condition{{Field: FieldDescription, Operator: Contains, Value: "rappi"}}, ErrInvalidRule},
+		{"without conditions", "rappi", movementsDomain.Food, nil, ErrInvalidRule},
+		{"unknown field", "rappi", movementsDomain.Food, []Condition{{Field: "merchant", Operator: Equals, Value: "rappi"}}, ErrInvalidRule},
+		{"operator not allowed", "rappi", movementsDomain.Food, []Condition{{Field: FieldAmount, Operator: Contains, Value: "10"}}, ErrInvalidRule},
+		{"invalid amount", "big", movementsDomain.Salary, []Condition{{Field: FieldAmount, Operator: GreaterThan, Value: "a lot"}}, ErrInvalidRule},
+		{"invalid regex", "regex", movementsDomain.Savings, []Condition{{Field: FieldDescription, Operator: Regex, Value: "("}}, ErrInvalidRule},
+		{"invalid type", "type", movementsDomain.Salary, []Condition{{Field: FieldType, Operator: Equals, Value: "other"}}, movementsDomain.ErrInvalidMovementType},
+		{"empty value", "empty", movementsDomain.Food, []Condition{{Field: FieldDescription, Operator: Contains}}, ErrInvalidRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRule("acc1", tt.ruleName, 1, tt.category, tt.conditions)
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	salary, err := NewRule("acc1", "salary", 1, movementsDomain.Salary, []Condition{
+		{Field: FieldAmount, Operator: GreaterThan, Value: "2,000,000"},
+		{Field: FieldType, Operator: Equals, Value: "income"},
+	})
+	require.NoError(t, err)
+
+	savings, err := NewRule("acc1", "savings", 2, movementsDomain.Savings, []Condition{
+		{Field: FieldInstitution, Operator: Equals, Value: "BANCOLOMBIA"},
+		{Field: FieldDescription, Operator: Regex, Value: `^traslado .* bolsillo$`},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		rule     *Rule
+		movement *movementsDomain.Movement
+		expected bool
+	}{
+		{"amount and type", salary, &movementsDomain.Movement{Amount: 3500000, Type: movementsDomain.Income}, true},
+		{"amount too low", salary, &movementsDomain.Movement{Amount: 2000000, Type: movementsDomain.Income}, false},
+		{"wrong type", salary, &movementsDomain.Movement{Amount: 3500000, Type: movementsDomain.Expense}, false},
+		{"institution and regex", savings, &movementsDomain.Movement{InstitutionID: "bancolombia", Description: "Traslado a bolsillo"}, true},
+		{"regex does not match", savings, &movementsDomain.Movement{InstitutionID: "bancolombia", Description: "Traslado a Juan"}, false},
+		{"other institution", savings, &movementsDomain.Movement{InstitutionID: "nequi", Description: "Traslado a bolsillo"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.rule.Matches(tt.movement))
+		})
+	}
+
+	t.Run("stored conditions are compiled on first use", func(t *testing.T) {
+		rule := &Rule{Conditions: []Condition{{Field: FieldDescription, Operator: Regex, Value: "rappi"}}}
+
+		require.True(t, rule.Matches(&movementsDomain.Movement{Description: "RAPPI SAS"}))
+	})
+
+	t.Run("contains ignores case", func(t *testing.T) {
+		rule := &Rule{Conditions: []Condition{{Field: FieldDescription, Operator: Contains, Value: "Rappi"}}}
+
+		require.True(t, rule.Matches(&movementsDomain.Movement{Description: "COMPRA RAPPI COLOMBIA"}))
+	})
+}
+
+func TestPreviewRule(t *testing.T) {
+	c := require.New(t)
+
+	rule, err := NewRule("acc1", "rappi", 1, movementsDomain.Food, []Condition{{Field: FieldDescription, Operator: Contains, Value: "rappi"}})
+	c.NoError(err)
+
+	movements := []*movementsDomain.Movement{
+		{ID: "MID1", Description: "RAPPI", Category: movementsDomain.Unknown},
+		{ID: "MID2", Description: "RAPPI", Category: movementsDomain.Food},
+		{ID: "MID3", Description: "UBER", Category: movementsDomain.Unknown},
+	}
+
+	preview, changed := PreviewRule(rule, movements)
+	c.Equal(2, preview.Matched)
+	c.Equal(1, preview.Changed)
+	c.Equal([]Change{{MovementID: "MID1", Description: "RAPPI", From: movementsDomain.Unknown, To: movementsDomain.Food}}, preview.Changes)
+	c.Len(changed, 1)
+}
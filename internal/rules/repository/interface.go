@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/rules/domain"
+)
+
+// RuleRepository stores the categorization rules of each account.
+type RuleRepository interface {
+	CreateRule(ctx context.Context, rule *domain.Rule) error
+	GetRuleByID(ctx context.Context, id string, accountID string) (*domain.Rule, error)
+	GetRulesByAccountID(ctx context.Context, accountID string, onlyActive bool) ([]*domain.Rule, error)
+	UpdateRule(ctx context.Context, rule *domain.Rule) error
+	DeleteRule(ctx context.Context, id string, accountID string) error
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrRuleNotFound = errors.New("rule not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the categorization rules repository.
+func NewPostgresRepository(db *pgxpool.Pool) RuleRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+const (
+	ruleColumns = `id, account_id, name, priority, conditions, category, active, created_at, updated_at`
+)
+
+// CreateRule inserts a new rule.
+func (r *postgresRepository) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return err
+	}
+
+	now := r.nowFunc()
+
+	query := `INSERT INTO categorization_rules (` + ruleColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err = r.db.Exec(ctx, query,
+		rule.ID,
+		rule.AccountID,
+		rule.Name,
+		rule.Priority,
+		conditions,
+		string(rule.Category),
+		rule.Active,
+		now,
+		now)
+	if err != nil {
+		return err
+	}
+
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	return nil
+}
+
+// GetRuleByID returns a rule of the account.
+func (r *postgresRepository) GetRuleByID(ctx context.Context, id string, accountID string) (*domain.Rule, error) {
+	query := `SELECT ` + ruleColumns + ` FROM categorization_rules WHERE id = $1 AND account_id = $2`
+
+	rule, err := scanRule(r.db.QueryRow(ctx, query, id, accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRuleNotFound
+	}
+
+	return rule, err
+}
+
+// GetRulesByAccountID returns the rules of the account in evaluation order.
+func (r *postgresRepository) GetRulesByAccountID(ctx context.Context, accountID string, onlyActive bool) ([]*domain.Rule, error) {
+	query := `SELECT ` + ruleColumns + ` FROM categorization_rules
+	WHERE account_id = $1 AND (NOT $2 OR active)
+	ORDER BY priority, created_at`
+
+	rows, err := r.db.Query(ctx, query, accountID, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	rules := []*domain.Rule{}
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// UpdateRule saves the editable fields of a rule.
+func (r *postgresRepository) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return err
+	}
+
+	now := r.nowFunc()
+
+	query := `UPDATE categorization_rules
+	SET name = $1, priority = $2, conditions = $3, category = $4, active = $5, updated_at = $6
+	WHERE id = $7 AND account_id = $8`
+
+	tag, err := r.db.Exec(ctx, query,
+		rule.Name,
+		rule.Priority,
+		conditions,
+		string(rule.Category),
+		rule.Active,
+		now,
+		rule.ID,
+		rule.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrRuleNotFound
+	}
+
+	rule.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteRule removes a rule of the account.
+func (r *postgresRepository) DeleteRule(ctx context.Context, id string, accountID string) error {
+	query := `DELETE FROM categorization_rules WHERE id = $1 AND account_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrRuleNotFound
+	}
+
+	return nil
+}
+
+func scanRule(row pgx.Row) (*domain.Rule, error) {
+	rule := &domain.Rule{}
+
+	var (
+		conditions []byte
+		category   string
+	)
+
+	err := row.Scan(&rule.ID, &rule.AccountID, &rule.Name, &rule.Priority, &conditions, &category, &rule.Active, &rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(conditions, &rule.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Category = movementsDomain.MovementCategory(category)
+
+	return rule, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/rules/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRuleRepository is a mock of the repository interface.
+type MockRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRuleRepository) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRuleRepository) GetRuleByID(ctx context.Context, id string, accountID string) (*domain.Rule, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Rule), args.Error(1)
+}
+
+func (m *MockRuleRepository) GetRulesByAccountID(ctx context.Context, accountID string, onlyActive bool) ([]*domain.Rule, error) {
+	args := m.Called(ctx, accountID, onlyActive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Rule), args.Error(1)
+}
+
+func (m *MockRuleRepository) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRuleRepository) DeleteRule(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
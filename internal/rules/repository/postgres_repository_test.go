@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	ruleRowColumns = []string{"id", "account_id", "name", "priority", "conditions", "category", "active", "created_at", "updated_at"}
+)
+
+func setupMockDB(t *testing.T) (RuleRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateRule(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rule := &domain.Rule{
+		ID:         "RUL1",
+		AccountID:  "acc1",
+		Name:       "rappi",
+		Priority:   1,
+		Conditions: []domain.Condition{{Field: domain.FieldDescription, Operator: domain.Contains, Value: "rappi"}},
+		Category:   movementsDomain.Food,
+		Active:     true,
+	}
+
+	mock.ExpectExec(`INSERT INTO categorization_rules`).
+		WithArgs("RUL1", "acc1", "rappi", 1, []byte(`[{"field":"description","operator":"contains","value":"rappi"}]`), "food", true, fixedTime, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateRule(context.Background(), rule))
+	c.Equal(fixedTime, rule.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetRulesByAccountID(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(ruleRowColumns).
+		AddRow("RUL1", "acc1", "rappi", 1, []byte(`[{"field":"description","operator":"contains","value":"rappi"}]`), "food", true, fixedTime, fixedTime).
+		AddRow("RUL2", "acc1", "salary", 2, []byte(`[{"field":"amount","operator":"gt","value":"2000000"}]`), "salary", true, fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM categorization_rules WHERE account_id = \$1 AND \(NOT \$2 OR active\) ORDER BY priority, created_at`).
+		WithArgs("acc1", true).
+		WillReturnRows(rows)
+
+	rules, err := repo.GetRulesByAccountID(context.Background(), "acc1", true)
+	c.NoError(err)
+	c.Len(rules, 2)
+	c.Equal(movementsDomain.Food, rules[0].Category)
+	c.True(rules[0].Matches(&movementsDomain.Movement{Description: "RAPPI"}))
+	c.True(rules[1].Matches(&movementsDomain.Movement{Amount: 2500000}))
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetRuleByID_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM categorization_rules WHERE id = \$1`).
+		WithArgs("RUL1", "acc1").
+		WillReturnRows(pgxmock.NewRows(ruleRowColumns))
+
+	_, err := repo.GetRuleByID(context.Background(), "RUL1", "acc1")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+}
+
+func TestUpdateAndDeleteRule_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	anyArgs := []any{}
+	for i := 0; i < 8; i++ {
+		anyArgs = append(anyArgs, pgxmock.AnyArg())
+	}
+
+	mock.ExpectExec(`UPDATE categorization_rules`).WithArgs(anyArgs...).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectExec(`DELETE FROM categorization_rules`).WithArgs("RUL1", "acc1").WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	c.ErrorIs(repo.UpdateRule(context.Background(), &domain.Rule{ID: "RUL1", AccountID: "acc1"}), ErrRuleNotFound)
+	c.ErrorIs(repo.DeleteRule(context.Background(), "RUL1", "acc1"), ErrRuleNotFound)
+	c.NoError(mock.ExpectationsWereMet())
+}
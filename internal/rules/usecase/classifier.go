@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/rules/domain"
+	"transaction-tracker/internal/rules/repository"
+)
+
+type accountRulesClassifier struct {
+	repo repository.RuleRepository
+}
+
+// NewAccountRulesClassifier creates a classifier that applies the active rules of the movement's
+// account in priority order. The first matching rule wins.
+func NewAccountRulesClassifier(repo repository.RuleRepository) classifier.Classifier {
+	return &accountRulesClassifier{repo: repo}
+}
+
+func (c *accountRulesClassifier) Classify(ctx context.Context, movement *movementsDomain.Movement) (*classifier.Classification, error) {
+	if movement.AccountID == "" {
+		return nil, classifier.ErrNoMatch
+	}
+
+	rules, err := c.repo.GetRulesByAccountID(ctx, movement.AccountID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.Matches(movement) {
+			classification := newClassification(rule)
+			return &classification, nil
+		}
+	}
+
+	return nil, classifier.ErrNoMatch
+}
+
+// ClassifyBatch applies the rules of each account, loading them once per batch.
+func (c *accountRulesClassifier) ClassifyBatch(ctx context.Context, movements []*movementsDomain.Movement) ([]*classifier.Classification, error) {
+	classifications := make([]*classifier.Classification, len(movements))
+	rulesByAccount := map[string][]*domain.Rule{}
+
+	for i, movement := range movements {
+		if movement.AccountID == "" {
+			continue
+		}
+
+		rules, ok := rulesByAccount[movement.AccountID]
+		if !ok {
+			var err error
+
+			rules, err = c.repo.GetRulesByAccountID(ctx, movement.AccountID, true)
+			if err != nil {
+				return nil, err
+			}
+
+			rulesByAccount[movement.AccountID] = rules
+		}
+
+		for _, rule := range rules {
+			if rule.Matches(movement) {
+				classification := newClassification(rule)
+				classifications[i] = &classification
+
+				break
+			}
+		}
+	}
+
+	return classifications, nil
+}
+
+// newClassification is the classification made by a rule. Rules are written by the user,
+// so they are fully trusted.
+func newClassification(rule *domain.Rule) classifier.Classification {
+	return classifier.Classification{
+		Category:   rule.Category,
+		Confidence: 1,
+		Source:     classifier.AccountRulesSource,
+	}
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+	"transaction-tracker/internal/rules/domain"
+)
+
+// RulesUsecase manages the categorization rules of an account.
+type RulesUsecase interface {
+	CreateRule(ctx context.Context, rule *domain.Rule) error
+	GetRule(ctx context.Context, id string, accountID string) (*domain.Rule, error)
+	GetRules(ctx context.Context, accountID string) ([]*domain.Rule, error)
+	UpdateRule(ctx context.Context, rule *domain.Rule) error
+	DeleteRule(ctx context.Context, id string, accountID string) error
+	PreviewRule(ctx context.Context, id string, accountID string) (*domain.Preview, error)
+	ApplyRule(ctx context.Context, id string, accountID string) (*domain.Preview, error)
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/rules/domain"
+	"transaction-tracker/internal/rules/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+)
+
+var (
+	ErrRuleNotFound = repository.ErrRuleNotFound
+)
+
+type rulesUsecase struct {
+	repo              repository.RuleRepository
+	movementsUsecase  movementsUsecase.MovementUsecase
+	categoriesUsecase categoriesUsecase.CategoriesUsecase
+	log               *loggerModels.Logger
+}
+
+// NewRulesUsecase creates a new instance of RulesUsecase. Rules are previewed and applied
+// over the movements read through movementsUsecase, and their category must belong to the
+// account's tree in categoriesUsecase.
+func NewRulesUsecase(ctx context.Context, repo repository.RuleRepository, movementsUsecase movementsUsecase.MovementUsecase, categoriesUsecase categoriesUsecase.CategoriesUsecase) RulesUsecase {
+	log, _ := logger.GetLogger(ctx, "rules-usecase")
+
+	return &rulesUsecase{
+		repo:              repo,
+		movementsUsecase:  movementsUsecase,
+		categoriesUsecase: categoriesUsecase,
+		log:               log,
+	}
+}
+
+func (u *rulesUsecase) validate(ctx context.Context, rule *domain.Rule) error {
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	return u.categoriesUsecase.ValidateCategory(ctx, rule.AccountID, rule.Category)
+}
+
+// CreateRule validates and stores a new rule.
+func (u *rulesUsecase) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	err := u.validate(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.CreateRule(ctx, rule)
+}
+
+func (u *rulesUsecase) GetRule(ctx context.Context, id string, accountID string) (*domain.Rule, error) {
+	return u.repo.GetRuleByID(ctx, id, accountID)
+}
+
+// GetRules returns the rules of the account in evaluation order.
+func (u *rulesUsecase) GetRules(ctx context.Context, accountID string) ([]*domain.Rule, error) {
+	return u.repo.GetRulesByAccountID(ctx, accountID, false)
+}
+
+// UpdateRule validates and stores the changes of a rule.
+func (u *rulesUsecase) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	err := u.validate(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateRule(ctx, rule)
+}
+
+func (u *rulesUsecase) DeleteRule(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteRule(ctx, id, accountID)
+}
+
+// PreviewRule counts the existing movements of the account the rule would recategorize,
+// without changing them.
+func (u *rulesUsecase) PreviewRule(ctx context.Context, id string, accountID string) (*domain.Preview, error) {
+	_, preview, _, err := u.preview(ctx, id, accountID)
+
+	return preview, err
+}
+
+// ApplyRule recategorizes the existing movements of the account matched by the rule.
+// Every changed movement emits its own movement.updated event.
+func (u *rulesUsecase) ApplyRule(ctx context.Context, id string, accountID string) (*domain.Preview, error) {
+	rule, preview, changed, err := u.preview(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	classification := newClassification(rule)
+
+	for _, m := range changed {
+		err := u.movementsUsecase.SetCategory(ctx, m, classification)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	u.log.Info(loggerModels.LogProperties{
+		Event: "rule_applied",
+		AdditionalParams: []loggerModels.Properties{
+			rule,
+			logger.MapToProperties(map[string]string{
+				"changed": strconv.Itoa(preview.Changed),
+			}),
+		},
+	})
+
+	return preview, nil
+}
+
+func (u *rulesUsecase) preview(ctx context.Context, id string, accountID string) (*domain.Rule, *domain.Preview, []*movementsDomain.Movement, error) {
+	rule, err := u.repo.GetRuleByID(ctx, id, accountID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	movements, err := u.movementsUsecase.GetAllMovementsByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	preview, changed := domain.PreviewRule(rule, movements)
+
+	return rule, preview, changed, nil
+}
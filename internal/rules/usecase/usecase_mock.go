@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"transaction-tracker/internal/rules/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockRulesUsecase is a mock implementation of the RulesUsecase interface.
+type MockRulesUsecase struct {
+	mock.Mock
+}
+
+func (m *MockRulesUsecase) CreateRule(ctx context.Context, rule *domain.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRulesUsecase) GetRule(ctx context.Context, id string, accountID string) (*domain.Rule, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Rule), args.Error(1)
+}
+
+func (m *MockRulesUsecase) GetRules(ctx context.Context, accountID string) ([]*domain.Rule, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Rule), args.Error(1)
+}
+
+func (m *MockRulesUsecase) UpdateRule(ctx context.Context, rule *domain.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRulesUsecase) DeleteRule(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockRulesUsecase) PreviewRule(ctx context.Context, id string, accountID string) (*domain.Preview, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Preview), args.Error(1)
+}
+
+func (m *MockRulesUsecase) ApplyRule(ctx context.Context, id string, accountID string) (*domain.Preview, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Preview), args.Error(1)
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	categoriesUsecase "transaction-tracker/internal/categories/usecase"
+	"transaction-tracker/internal/movements/classifier"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	movementsUsecase "transaction-tracker/internal/movements/usecase"
+	"transaction-tracker/internal/rules/domain"
+	"transaction-tracker/internal/rules/repository"
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type noopLogService struct{}
+
+func (noopLogService) Log(string, loggerModels.LogProperties) {}
+func (noopLogService) SetService(string)                      {}
+
+func newRappiRule(t *testing.T) *domain.Rule {
+	rule, err := domain.NewRule("acc1", "rappi", 1, movementsDomain.Food, []domain.Condition{
+		{Field: domain.FieldDescription, Operator: domain.Contains, Value: "rappi"},
+	})
+	require.NoError(t, err)
+
+	return rule
+}
+
+func TestCreateRule_Invalid(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockRuleRepository)
+	u := NewRulesUsecase(context.Background(), repo, new(movementsUsecase.MockMovementUsecase), new(categoriesUsecase.MockCategoriesUsecase))
+
+	err := u.CreateRule(context.Background(), &domain.Rule{AccountID: "acc1", Name: "empty", Category: movementsDomain.Food})
+	c.ErrorIs(err, domain.ErrInvalidRule)
+
+	repo.AssertNotCalled(t, "CreateRule", mock.Anything, mock.Anything)
+}
+
+func TestCreateRule_CategoryNotInTree(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	rule := newRappiRule(t)
+	rule.Category = "pets"
+
+	categories := new(categoriesUsecase.MockCategoriesUsecase)
+	categories.On("ValidateCategory", ctx, "acc1", rule.Category).Return(movementsDomain.ErrInvalidMovementCategory)
+
+	repo := new(repository.MockRuleRepository)
+	u := NewRulesUsecase(ctx, repo, new(movementsUsecase.MockMovementUsecase), categories)
+
+	c.ErrorIs(u.CreateRule(ctx, rule), movementsDomain.ErrInvalidMovementCategory)
+	repo.AssertNotCalled(t, "CreateRule", mock.Anything, mock.Anything)
+}
+
+func TestPreviewRule(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	rule := newRappiRule(t)
+
+	repo := new(repository.MockRuleRepository)
+	repo.On("GetRuleByID", ctx, rule.ID, "acc1").Return(rule, nil).Once()
+
+	movements := new(movementsUsecase.MockMovementUsecase)
+	movements.On("GetAllMovementsByAccountID", ctx, "acc1").Return([]*movementsDomain.Movement{
+		{ID: "MID1", Description: "RAPPI COLOMBIA", Category: movementsDomain.Unknown},
+		{ID: "MID2", Description: "Rappi pro", Category: movementsDomain.Food},
+		{ID: "MID3", Description: "Netflix", Category: movementsDomain.Entertainment},
+	}, nil).Once()
+
+	u := NewRulesUsecase(ctx, repo, movements, new(categoriesUsecase.MockCategoriesUsecase))
+
+	preview, err := u.PreviewRule(ctx, rule.ID, "acc1")
+	c.NoError(err)
+	c.Equal(2, preview.Matched)
+	c.Equal(1, preview.Changed)
+	c.Equal("MID1", preview.Changes[0].MovementID)
+
+	movements.AssertNotCalled(t, "SetCategory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplyRule(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	rule := newRappiRule(t)
+	changed := &movementsDomain.Movement{ID: "MID1", Description: "RAPPI COLOMBIA", Category: movementsDomain.Unknown}
+
+	repo := new(repository.MockRuleRepository)
+	repo.On("GetRuleByID", ctx, rule.ID, "acc1").Return(rule, nil).Once()
+
+	movements := new(movementsUsecase.MockMovementUsecase)
+	movements.On("GetAllMovementsByAccountID", ctx, "acc1").Return([]*movementsDomain.Movement{
+		changed,
+		{ID: "MID2", Description: "Netflix", Category: movementsDomain.Entertainment},
+	}, nil).Once()
+	movements.On("SetCategory", ctx, changed, classifier.Classification{
+		Category:   movementsDomain.Food,
+		Confidence: 1,
+		Source:     classifier.AccountRulesSource,
+	}).Return(nil).Once()
+
+	u := &rulesUsecase{
+		repo:             repo,
+		movementsUsecase: movements,
+		log:              &loggerModels.Logger{Service: noopLogService{}},
+	}
+
+	preview, err := u.ApplyRule(ctx, rule.ID, "acc1")
+	c.NoError(err)
+	c.Equal(1, preview.Changed)
+
+	movements.AssertExpectations(t)
+}
+
+func TestApplyRule_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(repository.MockRuleRepository)
+	repo.On("GetRuleByID", ctx, "RUL1", "acc1").Return(nil, repository.ErrRuleNotFound).Once()
+
+	u := NewRulesUsecase(ctx, repo, new(movementsUsecase.MockMovementUsecase), new(categoriesUsecase.MockCategoriesUsecase))
+
+	_, err := u.ApplyRule(ctx, "RUL1", "acc1")
+	require.ErrorIs(t, err, ErrRuleNotFound)
+}
+
+func TestAccountRulesClassifier(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	salary, err := domain.NewRule("acc1", "salary", 2, movementsDomain.Salary, []domain.Condition{
+		{Field: domain.FieldAmount, Operator: domain.GreaterThan, Value: "2,000,000"},
+		{Field: domain.FieldType, Operator: domain.Equals, Value: "income"},
+	})
+	c.NoError(err)
+
+	repo := new(repository.MockRuleRepository)
+	repo.On("GetRulesByAccountID", ctx, "acc1", true).Return([]*domain.Rule{newRappiRule(t), salary}, nil)
+
+	cls := NewAccountRulesClassifier(repo)
+
+	classification, err := cls.Classify(ctx, &movementsDomain.Movement{AccountID: "acc1", Amount: 3000000, Type: movementsDomain.Income, Description: "PAGO NOMINA"})
+	c.NoError(err)
+	c.Equal(movementsDomain.Salary, classification.Category)
+	c.Equal(classifier.AccountRulesSource, classification.Source)
+
+	_, err = cls.Classify(ctx, &movementsDomain.Movement{AccountID: "acc1", Amount: 3000000, Type: movementsDomain.Expense, Description: "PAGO"})
+	c.ErrorIs(err, classifier.ErrNoMatch)
+
+	_, err = cls.Classify(ctx, &movementsDomain.Movement{Description: "RAPPI"})
+	c.ErrorIs(err, classifier.ErrNoMatch)
+}
+
+func TestAccountRulesClassifier_ClassifyBatch(t *testing.T) {
+	c := require.New(t)
+	ctx := context.Background()
+
+	repo := new(repository.MockRuleRepository)
+	repo.On("GetRulesByAccountID", ctx, "acc1", true).Return([]*domain.Rule{newRappiRule(t)}, nil).Once()
+
+	cls := NewAccountRulesClassifier(repo).(classifier.BatchClassifier)
+
+	classifications, err := cls.ClassifyBatch(ctx, []*movementsDomain.Movement{
+		{AccountID: "acc1", Description: "RAPPI COLOMBIA"},
+		{AccountID: "acc1", Description: "Netflix"},
+		{Description: "RAPPI"},
+	})
+	c.NoError(err)
+	c.Equal(movementsDomain.Food, classifications[0].Category)
+	c.Nil(classifications[1])
+	c.Nil(classifications[2])
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_transfer_prefix = "TRF"
+
+	// DefaultWindow is how far apart the two sides of a detected transfer can be.
+	DefaultWindow = 72 * time.Hour
+	// MaxWindow is the widest window detection accepts.
+	MaxWindow = 15 * 24 * time.Hour
+	// HistoryDays is how far back movements are read when detecting transfers.
+	HistoryDays = 90
+)
+
+// Source tells how a transfer was found.
+type Source string
+
+const (
+	// DetectedSource transfers were paired automatically.
+	DetectedSource Source = "detected"
+	// ManualSource transfers were paired by the user.
+	ManualSource Source = "manual"
+)
+
+var (
+	// ErrInvalidTransfer is returned when two movements can't be the sides of a transfer.
+	ErrInvalidTransfer = errors.New("invalid transfer")
+)
+
+// Transfer is money moved between two accounts of the same user, as from a savings account to
+// a wallet. OutflowID is the expense it left with and InflowID the income it arrived as.
+type Transfer struct {
+	ID        string
+	AccountID string
+	OutflowID string
+	InflowID  string
+	Amount    float64
+	Source    Source
+	CreatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (t *Transfer) LogProperties() map[string]string {
+	return map[string]string{
+		"transfer_id": t.ID,
+		"account_id":  t.AccountID,
+		"outflow_id":  t.OutflowID,
+		"inflow_id":   t.InflowID,
+		"source":      string(t.Source),
+	}
+}
+
+// Candidate is a movement that is not a side of any transfer yet. Debts tells it is owed by
+// a contact or settles a debt, which a transfer between own accounts can't be.
+type Candidate struct {
+	MovementID    string
+	InstitutionID string
+	Type          movementsDomain.MovementType
+	Amount        float64
+	Date          time.Time
+	Debts         bool
+}
+
+// Dismissal is a pair of movements whose transfer the user deleted. Detection doesn't pair
+// them again.
+type Dismissal struct {
+	OutflowID string
+	InflowID  string
+}
+
+// NewTransfer pairs the outflow and inflow of the account as a transfer. Manual transfers
+// may have different amounts, since fees can be taken on the way; detected ones can't.
+func NewTransfer(accountID string, outflow *Candidate, inflow *Candidate, source Source) (*Transfer, error) {
+	if outflow.MovementID == inflow.MovementID {
+		return nil, fmt.Errorf("%w: both sides are the same movement", ErrInvalidTransfer)
+	}
+
+	if outflow.Type != movementsDomain.Expense || inflow.Type != movementsDomain.Income {
+		return nil, fmt.Errorf("%w: the outflow must be an expense and the inflow an income", ErrInvalidTransfer)
+	}
+
+	if outflow.Debts || inflow.Debts {
+		return nil, fmt.Errorf("%w: movements owed by or settling the debts of a contact can't be transfers", ErrInvalidTransfer)
+	}
+
+	return &Transfer{
+		ID:        _transfer_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID: accountID,
+		OutflowID: outflow.MovementID,
+		InflowID:  inflow.MovementID,
+		Amount:    outflow.Amount,
+		Source:    source,
+	}, nil
+}
+
+// ParseWindow returns the window written in hours, DefaultWindow when empty.
+func ParseWindow(hours int) (time.Duration, error) {
+	if hours == 0 {
+		return DefaultWindow, nil
+	}
+
+	window := time.Duration(hours) * time.Hour
+	if hours < 0 || window > MaxWindow {
+		return 0, fmt.Errorf("%w: window must be between 1 and %d hours", ErrInvalidTransfer, int(MaxWindow.Hours()))
+	}
+
+	return window, nil
+}
+
+// pair is an outflow and an inflow that could be the sides of a transfer.
+type pair struct {
+	outflow *Candidate
+	inflow  *Candidate
+	gap     time.Duration
+}
+
+// Detect pairs the expenses and incomes of the same amount made in different institutions
+// at most window apart, in either order, leaving out the dismissed pairs. Each movement is
+// paired once, closest pairs first.
+func Detect(accountID string, candidates []*Candidate, dismissals []*Dismissal, window time.Duration) []*Transfer {
+	dismissed := map[Dismissal]bool{}
+	for _, dismissal := range dismissals {
+		dismissed[*dismissal] = true
+	}
+
+	outflows := []*Candidate{}
+	inflows := []*Candidate{}
+	for _, candidate := range candidates {
+		switch candidate.Type {
+		case movementsDomain.Expense:
+			outflows = append(outflows, candidate)
+		case movementsDomain.Income:
+			inflows = append(inflows, candidate)
+		}
+	}
+
+	pairs := []*pair{}
+	for _, outflow := range outflows {
+		for _, inflow := range inflows {
+			if outflow.InstitutionID == inflow.InstitutionID || cents(outflow.Amount) != cents(inflow.Amount) {
+				continue
+			}
+
+			if dismissed[Dismissal{OutflowID: outflow.MovementID, InflowID: inflow.MovementID}] {
+				continue
+			}
+
+			gap := inflow.Date.Sub(outflow.Date).Abs()
+			if gap <= window {
+				pairs = append(pairs, &pair{outflow: outflow, inflow: inflow, gap: gap})
+			}
+		}
+	}
+
+	slices.SortStableFunc(pairs, func(a, b *pair) int {
+		return cmp.Compare(a.gap, b.gap)
+	})
+
+	paired := map[string]bool{}
+	transfers := []*Transfer{}
+	for _, p := range pairs {
+		if paired[p.outflow.MovementID] || paired[p.inflow.MovementID] {
+			continue
+		}
+
+		transfer, err := NewTransfer(accountID, p.outflow, p.inflow, DetectedSource)
+		if err != nil {
+			continue
+		}
+
+		paired[p.outflow.MovementID] = true
+		paired[p.inflow.MovementID] = true
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
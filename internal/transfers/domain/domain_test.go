@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+func candidate(id string, institutionID string, movementType movementsDomain.MovementType, amount float64, hours int) *Candidate {
+	return &Candidate{
+		MovementID:    id,
+		InstitutionID: institutionID,
+		Type:          movementType,
+		Amount:        amount,
+		Date:          start.Add(time.Duration(hours) * time.Hour),
+	}
+}
+
+func TestNewTransfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		transfer, err := NewTransfer("acc1", candidate("MID1", "davivienda", movementsDomain.Expense, 500, 0), candidate("MID2", "nequi", movementsDomain.Income, 498, 1), ManualSource)
+		c.NoError(err)
+		c.Contains(transfer.ID, _transfer_prefix)
+		c.Equal("MID1", transfer.OutflowID)
+		c.Equal("MID2", transfer.InflowID)
+		c.Equal(500.0, transfer.Amount)
+	})
+
+	t.Run("wrong direction", func(t *testing.T) {
+		_, err := NewTransfer("acc1", candidate("MID1", "davivienda", movementsDomain.Income, 500, 0), candidate("MID2", "nequi", movementsDomain.Expense, 500, 1), ManualSource)
+		require.ErrorIs(t, err, ErrInvalidTransfer)
+	})
+
+	t.Run("owed movement", func(t *testing.T) {
+		owed := candidate("MID1", "davivienda", movementsDomain.Expense, 500, 0)
+		owed.Debts = true
+
+		_, err := NewTransfer("acc1", owed, candidate("MID2", "nequi", movementsDomain.Income, 500, 1), ManualSource)
+		require.ErrorIs(t, err, ErrInvalidTransfer)
+	})
+
+	t.Run("same movement", func(t *testing.T) {
+		_, err := NewTransfer("acc1", candidate("MID1", "davivienda", movementsDomain.Expense, 500, 0), candidate("MID1", "davivienda", movementsDomain.Income, 500, 0), ManualSource)
+		require.ErrorIs(t, err, ErrInvalidTransfer)
+	})
+}
+
+func TestParseWindow(t *testing.T) {
+	c := require.New(t)
+
+	window, err := ParseWindow(0)
+	c.NoError(err)
+	c.Equal(DefaultWindow, window)
+
+	window, err = ParseWindow(24)
+	c.NoError(err)
+	c.Equal(24*time.Hour, window)
+
+	_, err = ParseWindow(-1)
+	c.ErrorIs(err, ErrInvalidTransfer)
+
+	_, err = ParseWindow(1000)
+	c.ErrorIs(err, ErrInvalidTransfer)
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("pairs across institutions within the window", func(t *testing.T) {
+		c := require.New(t)
+
+		transfers := Detect("acc1", []*Candidate{
+			candidate("OUT1", "davivienda", movementsDomain.Expense, 500000, 0),
+			candidate("IN1", "nequi", movementsDomain.Income, 500000, 2),
+			candidate("IN2", "nequi", movementsDomain.Income, 500000, 200),
+		}, nil, DefaultWindow)
+
+		c.Len(transfers, 1)
+		c.Equal("OUT1", transfers[0].OutflowID)
+		c.Equal("IN1", transfers[0].InflowID)
+		c.Equal(DetectedSource, transfers[0].Source)
+		c.Equal("acc1", transfers[0].AccountID)
+	})
+
+	t.Run("accepts the inflow first", func(t *testing.T) {
+		c := require.New(t)
+
+		transfers := Detect("acc1", []*Candidate{
+			candidate("IN1", "nequi", movementsDomain.Income, 120.5, 0),
+			candidate("OUT1", "davivienda", movementsDomain.Expense, 120.5, 5),
+		}, nil, DefaultWindow)
+
+		c.Len(transfers, 1)
+	})
+
+	t.Run("skips the same institution and other amounts", func(t *testing.T) {
+		c := require.New(t)
+
+		transfers := Detect("acc1", []*Candidate{
+			candidate("OUT1", "davivienda", movementsDomain.Expense, 500, 0),
+			candidate("IN1", "davivienda", movementsDomain.Income, 500, 1),
+			candidate("IN2", "nequi", movementsDomain.Income, 499.99, 1),
+		}, nil, DefaultWindow)
+
+		c.Empty(transfers)
+	})
+
+	t.Run("leaves out dismissed pairs", func(t *testing.T) {
+		c := require.New(t)
+
+		transfers := Detect("acc1", []*Candidate{
+			candidate("OUT1", "davivienda", movementsDomain.Expense, 500000, 0),
+			candidate("IN1", "nequi", movementsDomain.Income, 500000, 2),
+			candidate("IN2", "bancolombia", movementsDomain.Income, 500000, 20),
+		}, []*Dismissal{{OutflowID: "OUT1", InflowID: "IN1"}}, DefaultWindow)
+
+		c.Len(transfers, 1)
+		c.Equal("IN2", transfers[0].InflowID)
+	})
+
+	t.Run("pairs each movement once, closest first", func(t *testing.T) {
+		c := require.New(t)
+
+		transfers := Detect("acc1", []*Candidate{
+			candidate("OUT1", "davivienda", movementsDomain.Expense, 100, 0),
+			candidate("OUT2", "davivienda", movementsDomain.Expense, 100, 10),
+			candidate("IN1", "nequi", movementsDomain.Income, 100, 9),
+			candidate("IN2", "nequi", movementsDomain.Income, 100, 30),
+		}, nil, DefaultWindow)
+
+		c.Len(transfers, 2)
+		c.Equal("OUT2", transfers[0].OutflowID)
+		c.Equal("IN1", transfers[0].InflowID)
+		c.Equal("OUT1", transfers[1].OutflowID)
+		c.Equal("IN2", transfers[1].InflowID)
+	})
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/transfers/domain"
+)
+
+// TransferRepository stores the transfers of each account and reads the movements they are
+// paired from.
+type TransferRepository interface {
+	GetCandidates(ctx context.Context, accountID string, since time.Time) ([]*domain.Candidate, error)
+	GetCandidate(ctx context.Context, accountID string, movementID string) (*domain.Candidate, error)
+	GetAccountIDs(ctx context.Context) ([]string, error)
+	CreateTransfer(ctx context.Context, transfer *domain.Transfer) error
+	GetTransferByID(ctx context.Context, id string, accountID string) (*domain.Transfer, error)
+	GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error)
+	DeleteTransfer(ctx context.Context, id string, accountID string) error
+	GetDismissals(ctx context.Context, accountID string) ([]*domain.Dismissal, error)
+}
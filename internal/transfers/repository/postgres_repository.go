@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/transfers/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	transferColumns  = `id, account_id, outflow_movement_id, inflow_movement_id, amount, source, created_at`
+	candidateColumns = `id, institution_id, type, amount, date,
+	EXISTS (SELECT 1 FROM debts d WHERE d.movement_id = movements.id)
+		OR EXISTS (SELECT 1 FROM settlements s WHERE s.movement_id = movements.id)`
+)
+
+var (
+	ErrTransferNotFound = errors.New("transfer not found")
+	ErrMovementNotFound = errors.New("movement not found")
+	// ErrMovementTransferred is returned when a movement is already a side of a transfer.
+	ErrMovementTransferred = errors.New("movement is already part of a transfer")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the transfers repository.
+func NewPostgresRepository(db *pgxpool.Pool) TransferRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so a transfer and the link
+// of its movements are written together.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// GetCandidates returns the movements of the account made since the date that are not a side
+// of any transfer, owed by a contact or settling a debt, oldest first.
+func (r *postgresRepository) GetCandidates(ctx context.Context, accountID string, since time.Time) ([]*domain.Candidate, error) {
+	query := `SELECT ` + candidateColumns + `
+	FROM movements
+	WHERE account_id = $1 AND transfer_id = '' AND date >= $2
+		AND NOT EXISTS (SELECT 1 FROM debts d WHERE d.movement_id = movements.id)
+		AND NOT EXISTS (SELECT 1 FROM settlements s WHERE s.movement_id = movements.id)
+	ORDER BY date`
+
+	rows, err := r.db.Query(ctx, query, accountID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	candidates := []*domain.Candidate{}
+	for rows.Next() {
+		candidate, err := scanToCandidate(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// GetCandidate returns a movement of the account.
+func (r *postgresRepository) GetCandidate(ctx context.Context, accountID string, movementID string) (*domain.Candidate, error) {
+	query := `SELECT ` + candidateColumns + `
+	FROM movements
+	WHERE id = $1 AND account_id = $2`
+
+	candidate, err := scanToCandidate(r.db.QueryRow(ctx, query, movementID, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMovementNotFound
+	}
+
+	return candidate, err
+}
+
+// GetAccountIDs returns the accounts with movements.
+func (r *postgresRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT DISTINCT account_id FROM movements ORDER BY account_id`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	accountIDs := []string{}
+	for rows.Next() {
+		var accountID string
+
+		err := rows.Scan(&accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+// CreateTransfer stores a transfer and links its movements to it. It must run in a
+// transaction, which is left to roll back when either movement is already transferred.
+func (r *postgresRepository) CreateTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO transfers (` + transferColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.querier(ctx).Exec(ctx, query,
+		transfer.ID,
+		transfer.AccountID,
+		transfer.OutflowID,
+		transfer.InflowID,
+		transfer.Amount,
+		transfer.Source,
+		now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrMovementTransferred
+		}
+
+		return err
+	}
+
+	link := `UPDATE movements SET transfer_id = $1
+	WHERE account_id = $2 AND id IN ($3, $4) AND transfer_id = ''`
+
+	tag, err := r.querier(ctx).Exec(ctx, link, transfer.ID, transfer.AccountID, transfer.OutflowID, transfer.InflowID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() != 2 {
+		return ErrMovementTransferred
+	}
+
+	transfer.CreatedAt = now
+
+	return nil
+}
+
+// GetTransferByID returns a transfer of the account.
+func (r *postgresRepository) GetTransferByID(ctx context.Context, id string, accountID string) (*domain.Transfer, error) {
+	query := `SELECT ` + transferColumns + `
+	FROM transfers
+	WHERE id = $1 AND account_id = $2`
+
+	transfer, err := scanToTransfer(r.db.QueryRow(ctx, query, id, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTransferNotFound
+	}
+
+	return transfer, err
+}
+
+// GetTransfers returns the transfers of the account, newest first.
+func (r *postgresRepository) GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error) {
+	query := `SELECT ` + transferColumns + `
+	FROM transfers
+	WHERE account_id = $1
+	ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	transfers := []*domain.Transfer{}
+	for rows.Next() {
+		transfer, err := scanToTransfer(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// DeleteTransfer removes a transfer of the account and unlinks its movements, which count as
+// income and expense again. The pair is recorded as dismissed. It must run in a transaction.
+func (r *postgresRepository) DeleteTransfer(ctx context.Context, id string, accountID string) error {
+	dismissal := &domain.Dismissal{}
+
+	err := r.querier(ctx).QueryRow(ctx, `DELETE FROM transfers WHERE id = $1 AND account_id = $2
+	RETURNING outflow_movement_id, inflow_movement_id`, id, accountID).Scan(&dismissal.OutflowID, &dismissal.InflowID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrTransferNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.querier(ctx).Exec(ctx, `UPDATE movements SET transfer_id = '' WHERE account_id = $1 AND transfer_id = $2`, accountID, id)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO dismissed_transfers (account_id, outflow_movement_id, inflow_movement_id, created_at)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (outflow_movement_id, inflow_movement_id) DO NOTHING`
+
+	_, err = r.querier(ctx).Exec(ctx, query, accountID, dismissal.OutflowID, dismissal.InflowID, r.nowFunc())
+
+	return err
+}
+
+// GetDismissals returns the pairs of movements of the account whose transfer was deleted.
+func (r *postgresRepository) GetDismissals(ctx context.Context, accountID string) ([]*domain.Dismissal, error) {
+	query := `SELECT outflow_movement_id, inflow_movement_id
+	FROM dismissed_transfers
+	WHERE account_id = $1`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	dismissals := []*domain.Dismissal{}
+	for rows.Next() {
+		dismissal := &domain.Dismissal{}
+
+		err := rows.Scan(&dismissal.OutflowID, &dismissal.InflowID)
+		if err != nil {
+			return nil, err
+		}
+
+		dismissals = append(dismissals, dismissal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return dismissals, nil
+}
+
+func scanToCandidate(scanFn func(...any) error) (*domain.Candidate, error) {
+	candidate := &domain.Candidate{}
+
+	var movementType string
+
+	err := scanFn(&candidate.MovementID, &candidate.InstitutionID, &movementType, &candidate.Amount, &candidate.Date, &candidate.Debts)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate.Type = movementsDomain.MovementType(movementType)
+
+	return candidate, nil
+}
+
+func scanToTransfer(scanFn func(...any) error) (*domain.Transfer, error) {
+	transfer := &domain.Transfer{}
+
+	var source string
+
+	err := scanFn(&transfer.ID, &transfer.AccountID, &transfer.OutflowID, &transfer.InflowID, &transfer.Amount, &source, &transfer.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer.Source = domain.Source(source)
+
+	return transfer, nil
+}
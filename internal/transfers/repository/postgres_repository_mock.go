@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/transfers/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransferRepository is a mock of the repository interface.
+type MockTransferRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransferRepository) GetCandidates(ctx context.Context, accountID string, since time.Time) ([]*domain.Candidate, error) {
+	args := m.Called(ctx, accountID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Candidate), args.Error(1)
+}
+
+func (m *MockTransferRepository) GetCandidate(ctx context.Context, accountID string, movementID string) (*domain.Candidate, error) {
+	args := m.Called(ctx, accountID, movementID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Candidate), args.Error(1)
+}
+
+func (m *MockTransferRepository) GetAccountIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTransferRepository) CreateTransfer(ctx context.Context, transfer *domain.Transfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockTransferRepository) GetTransferByID(ctx context.Context, id string, accountID string) (*domain.Transfer, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransferRepository) GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransferRepository) DeleteTransfer(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockTransferRepository) GetDismissals(ctx context.Context, accountID string) ([]*domain.Dismissal, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Dismissal), args.Error(1)
+}
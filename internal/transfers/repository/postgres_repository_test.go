@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/transfers/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime    = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	transferRows = []string{"id", "account_id", "outflow_movement_id", "inflow_movement_id", "amount", "source", "created_at"}
+)
+
+func setupMockDB(t *testing.T) (TransferRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newTransfer() *domain.Transfer {
+	return &domain.Transfer{
+		ID:        "TRF1",
+		AccountID: "acc1",
+		OutflowID: "MID1",
+		InflowID:  "MID2",
+		Amount:    500000,
+		Source:    domain.DetectedSource,
+	}
+}
+
+func TestGetCandidates(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	since := fixedTime.AddDate(0, 0, -90)
+	rows := pgxmock.NewRows([]string{"id", "institution_id", "type", "amount", "date", "debts"}).
+		AddRow("MID1", "davivienda", "expense", 500000.0, fixedTime, false).
+		AddRow("MID2", "nequi", "income", 500000.0, fixedTime, false)
+
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE account_id = \$1 AND transfer_id = '' AND date >= \$2 `+
+		`AND NOT EXISTS \(SELECT 1 FROM debts (.+)\) AND NOT EXISTS \(SELECT 1 FROM settlements (.+)\) ORDER BY date`).
+		WithArgs("acc1", since).
+		WillReturnRows(rows)
+
+	candidates, err := repo.GetCandidates(context.Background(), "acc1", since)
+	c.NoError(err)
+	c.Len(candidates, 2)
+	c.Equal(movementsDomain.Expense, candidates[0].Type)
+	c.Equal("nequi", candidates[1].InstitutionID)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetCandidate_NotFound(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT (.+) FROM movements WHERE id = \$1 AND account_id = \$2`).
+		WithArgs("MID1", "acc1").
+		WillReturnError(pgx.ErrNoRows)
+
+	_, err := repo.GetCandidate(context.Background(), "acc1", "MID1")
+	require.ErrorIs(t, err, ErrMovementNotFound)
+}
+
+func TestGetAccountIDs(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT DISTINCT account_id FROM movements`).
+		WillReturnRows(pgxmock.NewRows([]string{"account_id"}).AddRow("acc1").AddRow("acc2"))
+
+	accountIDs, err := repo.GetAccountIDs(context.Background())
+	c.NoError(err)
+	c.Equal([]string{"acc1", "acc2"}, accountIDs)
+}
+
+func TestCreateTransfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		transfer := newTransfer()
+
+		mock.ExpectExec(`INSERT INTO transfers`).
+			WithArgs("TRF1", "acc1", "MID1", "MID2", 500000.0, domain.DetectedSource, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`UPDATE movements SET transfer_id = \$1 WHERE account_id = \$2 AND id IN \(\$3, \$4\) AND transfer_id = ''`).
+			WithArgs("TRF1", "acc1", "MID1", "MID2").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+
+		c.NoError(repo.CreateTransfer(context.Background(), transfer))
+		c.Equal(fixedTime, transfer.CreatedAt)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("movement already transferred", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO transfers`).
+			WithArgs("TRF1", "acc1", "MID1", "MID2", 500000.0, domain.DetectedSource, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		mock.ExpectExec(`UPDATE movements SET transfer_id`).
+			WithArgs("TRF1", "acc1", "MID1", "MID2").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := repo.CreateTransfer(context.Background(), newTransfer())
+		require.ErrorIs(t, err, ErrMovementTransferred)
+	})
+
+	t.Run("duplicated side", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO transfers`).
+			WithArgs("TRF1", "acc1", "MID1", "MID2", 500000.0, domain.DetectedSource, fixedTime).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateTransfer(context.Background(), newTransfer())
+		require.ErrorIs(t, err, ErrMovementTransferred)
+	})
+}
+
+func TestGetTransferByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(transferRows).
+			AddRow("TRF1", "acc1", "MID1", "MID2", 500000.0, "manual", fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM transfers WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("TRF1", "acc1").
+			WillReturnRows(rows)
+
+		transfer, err := repo.GetTransferByID(context.Background(), "TRF1", "acc1")
+		c.NoError(err)
+		c.Equal(domain.ManualSource, transfer.Source)
+		c.Equal("MID2", transfer.InflowID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM transfers`).
+			WithArgs("TRF1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetTransferByID(context.Background(), "TRF1", "acc1")
+		require.ErrorIs(t, err, ErrTransferNotFound)
+	})
+}
+
+func TestGetTransfers(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(transferRows).
+		AddRow("TRF1", "acc1", "MID1", "MID2", 500000.0, "detected", fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM transfers WHERE account_id = \$1 ORDER BY created_at DESC`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	transfers, err := repo.GetTransfers(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(transfers, 1)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`DELETE FROM transfers WHERE id = \$1 AND account_id = \$2 RETURNING outflow_movement_id, inflow_movement_id`).
+			WithArgs("TRF1", "acc1").
+			WillReturnRows(pgxmock.NewRows([]string{"outflow_movement_id", "inflow_movement_id"}).AddRow("MID1", "MID2"))
+		mock.ExpectExec(`UPDATE movements SET transfer_id = '' WHERE account_id = \$1 AND transfer_id = \$2`).
+			WithArgs("acc1", "TRF1").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+		mock.ExpectExec(`INSERT INTO dismissed_transfers (.+) ON CONFLICT (.+) DO NOTHING`).
+			WithArgs("acc1", "MID1", "MID2", fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.DeleteTransfer(context.Background(), "TRF1", "acc1"))
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`DELETE FROM transfers`).
+			WithArgs("TRF1", "acc1").
+			WillReturnError(pgx.ErrNoRows)
+
+		err := repo.DeleteTransfer(context.Background(), "TRF1", "acc1")
+		require.ErrorIs(t, err, ErrTransferNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetDismissals(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectQuery(`SELECT outflow_movement_id, inflow_movement_id FROM dismissed_transfers WHERE account_id = \$1`).
+		WithArgs("acc1").
+		WillReturnRows(pgxmock.NewRows([]string{"outflow_movement_id", "inflow_movement_id"}).AddRow("MID1", "MID2"))
+
+	dismissals, err := repo.GetDismissals(context.Background(), "acc1")
+	c.NoError(err)
+	c.Equal([]*domain.Dismissal{{OutflowID: "MID1", InflowID: "MID2"}}, dismissals)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/internal/transfers/domain"
+)
+
+// TransfersUsecase links the movements that move money between the own accounts of an
+// account, so they are not counted as income or expenses.
+type TransfersUsecase interface {
+	GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error)
+	GetTransfer(ctx context.Context, id string, accountID string) (*domain.Transfer, error)
+	CreateTransfer(ctx context.Context, accountID string, outflowID string, inflowID string) (*domain.Transfer, error)
+	DeleteTransfer(ctx context.Context, id string, accountID string) error
+	DetectTransfers(ctx context.Context, accountID string, window time.Duration) ([]*domain.Transfer, error)
+	RunDetection(ctx context.Context, interval time.Duration)
+}
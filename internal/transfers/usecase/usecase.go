@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/transfers/domain"
+	"transaction-tracker/internal/transfers/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/databases/postgres"
+	"transaction-tracker/shared"
+)
+
+// DefaultDetectionInterval is how often the transfers of every account are detected.
+const DefaultDetectionInterval = time.Hour
+
+var (
+	ErrTransferNotFound    = repository.ErrTransferNotFound
+	ErrMovementNotFound    = repository.ErrMovementNotFound
+	ErrMovementTransferred = repository.ErrMovementTransferred
+)
+
+type transfersUsecase struct {
+	repo       repository.TransferRepository
+	transactor postgres.Transactor
+	nowFunc    func() time.Time
+	log        *loggerModels.Logger
+}
+
+// NewTransfersUsecase creates a new instance of TransfersUsecase.
+func NewTransfersUsecase(ctx context.Context, repo repository.TransferRepository, transactor postgres.Transactor) TransfersUsecase {
+	log, _ := logger.GetLogger(ctx, "transfers-usecase")
+
+	return &transfersUsecase{
+		repo:       repo,
+		transactor: transactor,
+		nowFunc:    time.Now,
+		log:        log,
+	}
+}
+
+func (u *transfersUsecase) GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error) {
+	return u.repo.GetTransfers(ctx, accountID)
+}
+
+func (u *transfersUsecase) GetTransfer(ctx context.Context, id string, accountID string) (*domain.Transfer, error) {
+	return u.repo.GetTransferByID(ctx, id, accountID)
+}
+
+// CreateTransfer links an expense and an income of the account as the two sides of a
+// transfer. Neither of them can already be part of another one.
+func (u *transfersUsecase) CreateTransfer(ctx context.Context, accountID string, outflowID string, inflowID string) (*domain.Transfer, error) {
+	outflow, err := u.repo.GetCandidate(ctx, accountID, outflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	inflow, err := u.repo.GetCandidate(ctx, accountID, inflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := domain.NewTransfer(accountID, outflow, inflow, domain.ManualSource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return u.repo.CreateTransfer(ctx, transfer)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// DeleteTransfer removes a transfer, so its movements count again as income and expense.
+// Detection doesn't pair them again.
+func (u *transfersUsecase) DeleteTransfer(ctx context.Context, id string, accountID string) error {
+	return u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return u.repo.DeleteTransfer(ctx, id, accountID)
+	})
+}
+
+// DetectTransfers pairs the recent movements of the account that are not part of a transfer
+// and stores the transfers found. Pairs the user unlinked before are left out, and a pair
+// whose movement got linked meanwhile is skipped.
+func (u *transfersUsecase) DetectTransfers(ctx context.Context, accountID string, window time.Duration) ([]*domain.Transfer, error) {
+	candidates, err := u.repo.GetCandidates(ctx, accountID, u.nowFunc().AddDate(0, 0, -domain.HistoryDays))
+	if err != nil {
+		return nil, err
+	}
+
+	dismissals, err := u.repo.GetDismissals(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	created := []*domain.Transfer{}
+	for _, transfer := range domain.Detect(accountID, candidates, dismissals, window) {
+		err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return u.repo.CreateTransfer(ctx, transfer)
+		})
+		if errors.Is(err, ErrMovementTransferred) {
+			continue
+		}
+
+		if err != nil {
+			return created, err
+		}
+
+		created = append(created, transfer)
+	}
+
+	return created, nil
+}
+
+// RunDetection detects the transfers of every account every interval until the context is
+// done.
+func (u *transfersUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	shared.RunPerAccount(ctx, u.log, "transfers", interval, u.repo.GetAccountIDs, func(ctx context.Context, accountID string) error {
+		_, err := u.DetectTransfers(ctx, accountID, domain.DefaultWindow)
+		return err
+	})
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"transaction-tracker/internal/transfers/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransfersUsecase is a mock implementation of the TransfersUsecase interface.
+type MockTransfersUsecase struct {
+	mock.Mock
+}
+
+func (m *MockTransfersUsecase) GetTransfers(ctx context.Context, accountID string) ([]*domain.Transfer, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransfersUsecase) GetTransfer(ctx context.Context, id string, accountID string) (*domain.Transfer, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransfersUsecase) CreateTransfer(ctx context.Context, accountID string, outflowID string, inflowID string) (*domain.Transfer, error) {
+	args := m.Called(ctx, accountID, outflowID, inflowID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransfersUsecase) DeleteTransfer(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockTransfersUsecase) DetectTransfers(ctx context.Context, accountID string, window time.Duration) ([]*domain.Transfer, error) {
+	args := m.Called(ctx, accountID, window)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Transfer), args.Error(1)
+}
+
+func (m *MockTransfersUsecase) RunDetection(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
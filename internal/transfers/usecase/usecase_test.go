@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	movementsDomain "transaction-tracker/internal/movements/domain"
+	"transaction-tracker/internal/transfers/domain"
+	"transaction-tracker/internal/transfers/repository"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newUsecase(repo repository.TransferRepository) *transfersUsecase {
+	return &transfersUsecase{
+		repo:       repo,
+		transactor: postgres.NewMockTransactor(),
+		nowFunc:    func() time.Time { return fixedTime },
+	}
+}
+
+func newOutflow() *domain.Candidate {
+	return &domain.Candidate{MovementID: "MID1", InstitutionID: "davivienda", Type: movementsDomain.Expense, Amount: 500000, Date: fixedTime.Add(-2 * time.Hour)}
+}
+
+func newInflow() *domain.Candidate {
+	return &domain.Candidate{MovementID: "MID2", InstitutionID: "nequi", Type: movementsDomain.Income, Amount: 500000, Date: fixedTime}
+}
+
+func TestCreateTransfer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID1").Return(newOutflow(), nil)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID2").Return(newInflow(), nil)
+		repo.On("CreateTransfer", mock.Anything, mock.AnythingOfType("*domain.Transfer")).Return(nil)
+
+		transfer, err := newUsecase(repo).CreateTransfer(context.Background(), "acc1", "MID1", "MID2")
+		c.NoError(err)
+		c.Equal(domain.ManualSource, transfer.Source)
+		c.Equal("MID1", transfer.OutflowID)
+		c.Equal("MID2", transfer.InflowID)
+		c.Equal(500000.0, transfer.Amount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("sides swapped", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID2").Return(newInflow(), nil)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID1").Return(newOutflow(), nil)
+
+		_, err := newUsecase(repo).CreateTransfer(context.Background(), "acc1", "MID2", "MID1")
+		c.ErrorIs(err, domain.ErrInvalidTransfer)
+		repo.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("movement not found", func(t *testing.T) {
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID1").Return(nil, ErrMovementNotFound)
+
+		_, err := newUsecase(repo).CreateTransfer(context.Background(), "acc1", "MID1", "MID2")
+		require.ErrorIs(t, err, ErrMovementNotFound)
+	})
+
+	t.Run("movement already transferred", func(t *testing.T) {
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID1").Return(newOutflow(), nil)
+		repo.On("GetCandidate", mock.Anything, "acc1", "MID2").Return(newInflow(), nil)
+		repo.On("CreateTransfer", mock.Anything, mock.Anything).Return(ErrMovementTransferred)
+
+		_, err := newUsecase(repo).CreateTransfer(context.Background(), "acc1", "MID1", "MID2")
+		require.ErrorIs(t, err, ErrMovementTransferred)
+	})
+}
+
+func TestDeleteTransfer(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockTransferRepository)
+	repo.On("DeleteTransfer", mock.Anything, "TRF1", "acc1").Return(ErrTransferNotFound)
+
+	err := newUsecase(repo).DeleteTransfer(context.Background(), "TRF1", "acc1")
+	c.ErrorIs(err, ErrTransferNotFound)
+}
+
+func TestDetectTransfers(t *testing.T) {
+	t.Run("stores the pairs found", func(t *testing.T) {
+		c := require.New(t)
+
+		lunch := &domain.Candidate{MovementID: "MID3", InstitutionID: "davivienda", Type: movementsDomain.Expense, Amount: 35000, Date: fixedTime}
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidates", mock.Anything, "acc1", fixedTime.AddDate(0, 0, -domain.HistoryDays)).
+			Return([]*domain.Candidate{newOutflow(), lunch, newInflow()}, nil)
+		repo.On("GetDismissals", mock.Anything, "acc1").Return([]*domain.Dismissal{}, nil)
+		repo.On("CreateTransfer", mock.Anything, mock.AnythingOfType("*domain.Transfer")).Return(nil).Once()
+
+		transfers, err := newUsecase(repo).DetectTransfers(context.Background(), "acc1", domain.DefaultWindow)
+		c.NoError(err)
+		c.Len(transfers, 1)
+		c.Equal(domain.DetectedSource, transfers[0].Source)
+		c.Equal("MID1", transfers[0].OutflowID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("skips pairs already linked", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidates", mock.Anything, "acc1", mock.Anything).
+			Return([]*domain.Candidate{newOutflow(), newInflow()}, nil)
+		repo.On("GetDismissals", mock.Anything, "acc1").Return([]*domain.Dismissal{}, nil)
+		repo.On("CreateTransfer", mock.Anything, mock.Anything).Return(ErrMovementTransferred)
+
+		transfers, err := newUsecase(repo).DetectTransfers(context.Background(), "acc1", domain.DefaultWindow)
+		c.NoError(err)
+		c.Empty(transfers)
+	})
+
+	t.Run("leaves out dismissed pairs", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidates", mock.Anything, "acc1", mock.Anything).
+			Return([]*domain.Candidate{newOutflow(), newInflow()}, nil)
+		repo.On("GetDismissals", mock.Anything, "acc1").
+			Return([]*domain.Dismissal{{OutflowID: newOutflow().MovementID, InflowID: newInflow().MovementID}}, nil)
+
+		transfers, err := newUsecase(repo).DetectTransfers(context.Background(), "acc1", domain.DefaultWindow)
+		c.NoError(err)
+		c.Empty(transfers)
+		repo.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockTransferRepository)
+		repo.On("GetCandidates", mock.Anything, "acc1", mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newUsecase(repo).DetectTransfers(context.Background(), "acc1", domain.DefaultWindow)
+		c.Error(err)
+	})
+}
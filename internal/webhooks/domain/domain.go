@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/shared"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	_webhook_prefix  = "WHK"
+	_delivery_prefix = "WHD"
+	_secret_prefix   = "whsec_"
+
+	// DeliveryPending is a delivery waiting for its first or next attempt.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceeded is a delivery acknowledged with a 2xx response.
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryFailed is a delivery that ran out of attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+
+	// MaxAttempts is the number of times a delivery is tried before giving up.
+	MaxAttempts = 6
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Hour
+)
+
+var (
+	// ErrInvalidURL is returned when a webhook URL is not an absolute https URL of a public
+	// host.
+	ErrInvalidURL = errors.New("webhook url must be an absolute https url of a public host")
+	// ErrInvalidEventType is returned when a webhook subscribes to an event it cannot receive.
+	ErrInvalidEventType = errors.New("invalid webhook event type")
+
+	// SupportedEventTypes are the domain events webhooks can subscribe to.
+	SupportedEventTypes = []eventsDomain.EventType{
+		eventsDomain.MovementCreated,
+		eventsDomain.MovementUpdated,
+		eventsDomain.MovementDeleted,
+		eventsDomain.ExtractProcessed,
+		eventsDomain.RecurringMissed,
+		eventsDomain.RecurringPriceChanged,
+		eventsDomain.BudgetThresholdReached,
+		eventsDomain.WorkspaceInvitationCreated,
+		eventsDomain.AnomalyDetected,
+	}
+)
+
+// Webhook is an account subscription that receives events on an external URL.
+type Webhook struct {
+	ID         string
+	AccountID  string
+	URL        string
+	Secret     string
+	EventTypes []eventsDomain.EventType
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (w *Webhook) LogProperties() map[string]string {
+	return map[string]string{
+		"webhook_id": w.ID,
+		"account_id": w.AccountID,
+		"url":        w.URL,
+		"active":     strconv.FormatBool(w.Active),
+	}
+}
+
+// NewWebhook creates an active webhook with a random signing secret.
+func NewWebhook(accountID string, rawURL string, eventTypes []string) (*Webhook, error) {
+	err := ValidateURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := ParseEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Webhook{
+		ID:         _webhook_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		AccountID:  accountID,
+		URL:        rawURL,
+		Secret:     secret,
+		EventTypes: types,
+		Active:     true,
+	}, nil
+}
+
+// Subscribed reports whether the webhook receives the given event type.
+func (w *Webhook) Subscribed(eventType eventsDomain.EventType) bool {
+	return w.Active && slices.Contains(w.EventTypes, eventType)
+}
+
+// ValidateURL checks that the URL is an absolute https URL whose host is not localhost nor an
+// address of the local network. Host names are resolved when delivering, where
+// shared.PublicClient refuses the ones that resolve to the local network. In development plain
+// http and local hosts are accepted.
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	if shared.Development() {
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return ErrInvalidURL
+		}
+
+		return nil
+	}
+
+	if u.Scheme != "https" {
+		return ErrInvalidURL
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidURL
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !shared.IsPublicAddr(addr) {
+		return ErrInvalidURL
+	}
+
+	return nil
+}
+
+// ParseEventTypes validates the event types of a subscription. An empty list subscribes
+// to every supported event.
+func ParseEventTypes(eventTypes []string) ([]eventsDomain.EventType, error) {
+	if len(eventTypes) == 0 {
+		return slices.Clone(SupportedEventTypes), nil
+	}
+
+	types := []eventsDomain.EventType{}
+
+	for _, t := range eventTypes {
+		eventType := eventsDomain.EventType(t)
+		if !slices.Contains(SupportedEventTypes, eventType) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidEventType, t)
+		}
+
+		if !slices.Contains(types, eventType) {
+			types = append(types, eventType)
+		}
+	}
+
+	return types, nil
+}
+
+// NewSecret generates a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return _secret_prefix + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature sent in the X-Webhook-Signature header: the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Delivery is an attempt log of an event sent to a webhook.
+type Delivery struct {
+	ID             string
+	WebhookID      string
+	AccountID      string
+	EventID        string
+	EventType      eventsDomain.EventType
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (d *Delivery) LogProperties() map[string]string {
+	return map[string]string{
+		"delivery_id":     d.ID,
+		"webhook_id":      d.WebhookID,
+		"account_id":      d.AccountID,
+		"event_id":        d.EventID,
+		"event_type":      string(d.EventType),
+		"status":          string(d.Status),
+		"attempts":        strconv.Itoa(d.Attempts),
+		"response_status": strconv.Itoa(d.ResponseStatus),
+		"last_error":      d.LastError,
+	}
+}
+
+// NewDelivery creates a pending delivery of an event to a webhook.
+func NewDelivery(webhook *Webhook, event *eventsDomain.Event) (*Delivery, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Delivery{
+		ID:        _delivery_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		WebhookID: webhook.ID,
+		AccountID: webhook.AccountID,
+		EventID:   event.ID,
+		EventType: event.Type,
+		Payload:   payload,
+		Status:    DeliveryPending,
+	}, nil
+}
+
+// RecordAttempt updates the delivery with the result of an attempt. Failed attempts are
+// retried with exponential backoff until MaxAttempts is reached.
+func (d *Delivery) RecordAttempt(responseStatus int, err error, now time.Time) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = ""
+
+	if err == nil {
+		d.Status = DeliverySucceeded
+		d.NextAttemptAt = nil
+
+		return
+	}
+
+	d.LastError = err.Error()
+
+	if d.Attempts >= MaxAttempts {
+		d.Status = DeliveryFailed
+		d.NextAttemptAt = nil
+
+		return
+	}
+
+	next := now.Add(Backoff(d.Attempts))
+
+	d.Status = DeliveryPending
+	d.NextAttemptAt = &next
+}
+
+// Backoff returns the wait before the retry that follows the given attempt.
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := baseBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > maxBackoff {
+		return maxBackoff
+	}
+
+	return backoff
+}
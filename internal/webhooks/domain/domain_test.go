@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhook(t *testing.T) {
+	t.Run("defaults to every supported event", func(t *testing.T) {
+		c := require.New(t)
+
+		webhook, err := NewWebhook("acc1", "https://example.com/hook", nil)
+		c.NoError(err)
+		c.True(strings.HasPrefix(webhook.ID, _webhook_prefix))
+		c.True(strings.HasPrefix(webhook.Secret, _secret_prefix))
+		c.True(webhook.Active)
+		c.Equal(SupportedEventTypes, webhook.EventTypes)
+	})
+
+	t.Run("removes duplicated events", func(t *testing.T) {
+		c := require.New(t)
+
+		webhook, err := NewWebhook("acc1", "https://example.com/hook", []string{"movement.created", "movement.created"})
+		c.NoError(err)
+		c.Equal([]eventsDomain.EventType{eventsDomain.MovementCreated}, webhook.EventTypes)
+		c.True(webhook.Subscribed(eventsDomain.MovementCreated))
+		c.False(webhook.Subscribed(eventsDomain.MovementDeleted))
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		c := require.New(t)
+
+		for _, u := range []string{"", "example.com/hook", "ftp://example.com", "https://"} {
+			_, err := NewWebhook("acc1", u, nil)
+			c.ErrorIs(err, ErrInvalidURL, u)
+		}
+	})
+
+	t.Run("local network url", func(t *testing.T) {
+		c := require.New(t)
+
+		urls := []string{
+			"http://example.com/hook",
+			"https://localhost:8080/hook",
+			"https://127.0.0.1/hook",
+			"https://169.254.169.254/latest/meta-data",
+			"https://10.0.0.8/hook",
+			"https://192.168.1.20/hook",
+			"https://[::1]/hook",
+		}
+
+		for _, u := range urls {
+			_, err := NewWebhook("acc1", u, nil)
+			c.ErrorIs(err, ErrInvalidURL, u)
+		}
+	})
+
+	t.Run("local network url in development", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+
+		_, err := NewWebhook("acc1", "http://localhost:8080/hook", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("unsupported event", func(t *testing.T) {
+		c := require.New(t)
+
+		_, err := NewWebhook("acc1", "https://example.com/hook", []string{"message.failed"})
+		c.ErrorIs(err, ErrInvalidEventType)
+	})
+}
+
+func TestSign(t *testing.T) {
+	c := require.New(t)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`1700000000.{"id":"EVT1"}`))
+
+	signature := Sign("secret", 1700000000, []byte(`{"id":"EVT1"}`))
+	c.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+	c.NotEqual(signature, Sign("secret", 1700000001, []byte(`{"id":"EVT1"}`)))
+	c.NotEqual(signature, Sign("other", 1700000000, []byte(`{"id":"EVT1"}`)))
+}
+
+func TestRecordAttempt(t *testing.T) {
+	now := time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		d := &Delivery{Status: DeliveryPending, LastError: "boom"}
+		d.RecordAttempt(200, nil, now)
+
+		c.Equal(DeliverySucceeded, d.Status)
+		c.Equal(1, d.Attempts)
+		c.Empty(d.LastError)
+		c.Nil(d.NextAttemptAt)
+	})
+
+	t.Run("failure is retried with backoff", func(t *testing.T) {
+		c := require.New(t)
+
+		d := &Delivery{Status: DeliveryPending, Attempts: 1}
+		d.RecordAttempt(500, errors.New("unexpected status 500"), now)
+
+		c.Equal(DeliveryPending, d.Status)
+		c.Equal(2, d.Attempts)
+		c.Equal("unexpected status 500", d.LastError)
+		c.Equal(now.Add(time.Minute), *d.NextAttemptAt)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		c := require.New(t)
+
+		d := &Delivery{Status: DeliveryPending, Attempts: MaxAttempts - 1}
+		d.RecordAttempt(0, errors.New("timeout"), now)
+
+		c.Equal(DeliveryFailed, d.Status)
+		c.Nil(d.NextAttemptAt)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	c := require.New(t)
+
+	c.Equal(30*time.Second, Backoff(0))
+	c.Equal(30*time.Second, Backoff(1))
+	c.Equal(2*time.Minute, Backoff(3))
+	c.Equal(time.Hour, Backoff(20))
+	c.Equal(time.Hour, Backoff(100))
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+)
+
+// WebhookRepository stores webhook subscriptions and their delivery log.
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	GetWebhookByID(ctx context.Context, id string, accountID string) (*domain.Webhook, error)
+	GetWebhooksByAccountID(ctx context.Context, accountID string) ([]*domain.Webhook, error)
+	GetSubscribedWebhooks(ctx context.Context, accountID string, eventType eventsDomain.EventType) ([]*domain.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	DeleteWebhook(ctx context.Context, id string, accountID string) error
+	CreateDelivery(ctx context.Context, delivery *domain.Delivery) (bool, error)
+	UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error
+	GetDeliveriesByWebhookID(ctx context.Context, webhookID string, accountID string, limit int) ([]*domain.Delivery, error)
+	ClaimDueDeliveries(ctx context.Context, leaseUntil time.Time, limit int) ([]*domain.Delivery, error)
+}
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the webhooks repository.
+func NewPostgresRepository(db *pgxpool.Pool) WebhookRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+const (
+	webhookColumns  = `id, account_id, url, secret, event_types, active, created_at, updated_at`
+	deliveryColumns = `id, webhook_id, account_id, event_id, event_type, payload, status, attempts, response_status, last_error, next_attempt_at, created_at, updated_at`
+)
+
+// CreateWebhook inserts a new webhook.
+func (r *postgresRepository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO webhooks (` + webhookColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.db.Exec(ctx, query,
+		webhook.ID,
+		webhook.AccountID,
+		webhook.URL,
+		webhook.Secret,
+		eventTypesToStrings(webhook.EventTypes),
+		webhook.Active,
+		now,
+		now)
+	if err != nil {
+		return err
+	}
+
+	webhook.CreatedAt = now
+	webhook.UpdatedAt = now
+
+	return nil
+}
+
+// GetWebhookByID returns a webhook of the account.
+func (r *postgresRepository) GetWebhookByID(ctx context.Context, id string, accountID string) (*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 AND account_id = $2`
+
+	webhook, err := scanWebhook(r.db.QueryRow(ctx, query, id, accountID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, err
+}
+
+// GetWebhooksByAccountID returns every webhook of the account.
+func (r *postgresRepository) GetWebhooksByAccountID(ctx context.Context, accountID string) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE account_id = $1 ORDER BY created_at`
+
+	return r.queryWebhooks(ctx, query, accountID)
+}
+
+// GetSubscribedWebhooks returns the active webhooks of the account subscribed to the event type.
+func (r *postgresRepository) GetSubscribedWebhooks(ctx context.Context, accountID string, eventType eventsDomain.EventType) ([]*domain.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE account_id = $1 AND active AND $2 = ANY(event_types)`
+
+	return r.queryWebhooks(ctx, query, accountID, string(eventType))
+}
+
+// UpdateWebhook updates the URL, event types and status of a webhook.
+func (r *postgresRepository) UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	now := r.nowFunc()
+
+	query := `UPDATE webhooks SET url = $1, event_types = $2, active = $3, updated_at = $4 WHERE id = $5 AND account_id = $6`
+
+	tag, err := r.db.Exec(ctx, query,
+		webhook.URL,
+		eventTypesToStrings(webhook.EventTypes),
+		webhook.Active,
+		now,
+		webhook.ID,
+		webhook.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+
+	webhook.UpdatedAt = now
+
+	return nil
+}
+
+// DeleteWebhook removes a webhook and its delivery log.
+func (r *postgresRepository) DeleteWebhook(ctx context.Context, id string, accountID string) error {
+	query := `DELETE FROM webhooks WHERE id = $1 AND account_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, id, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// CreateDelivery inserts a delivery. It returns false when the event was already delivered
+// to the webhook, so redelivered bus messages are not sent twice.
+func (r *postgresRepository) CreateDelivery(ctx context.Context, delivery *domain.Delivery) (bool, error) {
+	now := r.nowFunc()
+
+	query := `INSERT INTO webhook_deliveries (` + deliveryColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	ON CONFLICT (webhook_id, event_id) DO NOTHING`
+
+	tag, err := r.db.Exec(ctx, query,
+		delivery.ID,
+		delivery.WebhookID,
+		delivery.AccountID,
+		delivery.EventID,
+		string(delivery.EventType),
+		delivery.Payload,
+		string(delivery.Status),
+		delivery.Attempts,
+		delivery.ResponseStatus,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		now,
+		now)
+	if err != nil {
+		return false, err
+	}
+
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateDelivery stores the result of a delivery attempt.
+func (r *postgresRepository) UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	now := r.nowFunc()
+
+	query := `UPDATE webhook_deliveries
+	SET status = $1, attempts = $2, response_status = $3, last_error = $4, next_attempt_at = $5, updated_at = $6
+	WHERE id = $7`
+
+	_, err := r.db.Exec(ctx, query,
+		string(delivery.Status),
+		delivery.Attempts,
+		delivery.ResponseStatus,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		now,
+		delivery.ID)
+	if err != nil {
+		return err
+	}
+
+	delivery.UpdatedAt = now
+
+	return nil
+}
+
+// GetDeliveriesByWebhookID returns the latest deliveries of a webhook of the account.
+func (r *postgresRepository) GetDeliveriesByWebhookID(ctx context.Context, webhookID string, accountID string, limit int) ([]*domain.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries
+	WHERE webhook_id = $1 AND account_id = $2
+	ORDER BY created_at DESC
+	LIMIT $3`
+
+	return r.queryDeliveries(ctx, query, webhookID, accountID, limit)
+}
+
+// ClaimDueDeliveries returns pending deliveries whose retry is due and leases them until
+// leaseUntil, so concurrent workers do not send them again in the meantime.
+func (r *postgresRepository) ClaimDueDeliveries(ctx context.Context, leaseUntil time.Time, limit int) ([]*domain.Delivery, error) {
+	query := `UPDATE webhook_deliveries SET next_attempt_at = $1
+	WHERE id IN (
+		SELECT id FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= $2
+		ORDER BY next_attempt_at
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED)
+	RETURNING ` + deliveryColumns
+
+	return r.queryDeliveries(ctx, query, leaseUntil, r.nowFunc(), limit)
+}
+
+func (r *postgresRepository) queryWebhooks(ctx context.Context, query string, args ...any) ([]*domain.Webhook, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	webhooks := []*domain.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (r *postgresRepository) queryDeliveries(ctx context.Context, query string, args ...any) ([]*domain.Delivery, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	deliveries := []*domain.Delivery{}
+	for rows.Next() {
+		d := &domain.Delivery{}
+
+		var (
+			eventType string
+			status    string
+			lastError *string
+		)
+
+		err := rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.AccountID,
+			&d.EventID,
+			&eventType,
+			&d.Payload,
+			&status,
+			&d.Attempts,
+			&d.ResponseStatus,
+			&lastError,
+			&d.NextAttemptAt,
+			&d.CreatedAt,
+			&d.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		d.EventType = eventsDomain.EventType(eventType)
+		d.Status = domain.DeliveryStatus(status)
+
+		if lastError != nil {
+			d.LastError = *lastError
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhook(row pgx.Row) (*domain.Webhook, error) {
+	w := &domain.Webhook{}
+
+	var eventTypes []string
+
+	err := row.Scan(&w.ID, &w.AccountID, &w.URL, &w.Secret, &eventTypes, &w.Active, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range eventTypes {
+		w.EventTypes = append(w.EventTypes, eventsDomain.EventType(t))
+	}
+
+	return w, nil
+}
+
+func eventTypesToStrings(eventTypes []eventsDomain.EventType) []string {
+	types := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		types = append(types, string(t))
+	}
+
+	return types
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookRepository is a mock of the repository interface.
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) GetWebhookByID(ctx context.Context, id string, accountID string) (*domain.Webhook, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetWebhooksByAccountID(ctx context.Context, accountID string) ([]*domain.Webhook, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) GetSubscribedWebhooks(ctx context.Context, accountID string, eventType eventsDomain.EventType) ([]*domain.Webhook, error) {
+	args := m.Called(ctx, accountID, eventType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhookRepository) UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) DeleteWebhook(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.Delivery) (bool, error) {
+	args := m.Called(ctx, delivery)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockWebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) GetDeliveriesByWebhookID(ctx context.Context, webhookID string, accountID string, limit int) ([]*domain.Delivery, error) {
+	args := m.Called(ctx, webhookID, accountID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Delivery), args.Error(1)
+}
+
+func (m *MockWebhookRepository) ClaimDueDeliveries(ctx context.Context, leaseUntil time.Time, limit int) ([]*domain.Delivery, error) {
+	args := m.Called(ctx, leaseUntil, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Delivery), args.Error(1)
+}
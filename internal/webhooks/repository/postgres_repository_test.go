@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+	webhookRowColumns  = []string{"id", "account_id", "url", "secret", "event_types", "active", "created_at", "updated_at"}
+	deliveryRowColumns = []string{"id", "webhook_id", "account_id", "event_id", "event_type", "payload", "status", "attempts", "response_status", "last_error", "next_attempt_at", "created_at", "updated_at"}
+)
+
+func setupMockDB(t *testing.T) (WebhookRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func TestCreateWebhook(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	webhook := &domain.Webhook{
+		ID:         "WHK1",
+		AccountID:  "acc1",
+		URL:        "https://example.com/hook",
+		Secret:     "whsec_1",
+		EventTypes: []eventsDomain.EventType{eventsDomain.MovementCreated},
+		Active:     true,
+	}
+
+	mock.ExpectExec(`INSERT INTO webhooks`).
+		WithArgs("WHK1", "acc1", "https://example.com/hook", "whsec_1", []string{"movement.created"}, true, fixedTime, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateWebhook(context.Background(), webhook))
+	c.Equal(fixedTime, webhook.CreatedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetWebhookByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(webhookRowColumns).
+			AddRow("WHK1", "acc1", "https://example.com/hook", "whsec_1", []string{"movement.created", "extract.processed"}, true, fixedTime, fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM webhooks WHERE id = \$1 AND account_id = \$2`).
+			WithArgs("WHK1", "acc1").
+			WillReturnRows(rows)
+
+		webhook, err := repo.GetWebhookByID(context.Background(), "WHK1", "acc1")
+		c.NoError(err)
+		c.Equal([]eventsDomain.EventType{eventsDomain.MovementCreated, eventsDomain.ExtractProcessed}, webhook.EventTypes)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM webhooks`).
+			WithArgs("WHK1", "acc1").
+			WillReturnRows(pgxmock.NewRows(webhookRowColumns))
+
+		_, err := repo.GetWebhookByID(context.Background(), "WHK1", "acc1")
+		c.ErrorIs(err, ErrWebhookNotFound)
+	})
+}
+
+func TestGetSubscribedWebhooks(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(webhookRowColumns).
+		AddRow("WHK1", "acc1", "https://example.com/hook", "whsec_1", []string{"movement.created"}, true, fixedTime, fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM webhooks WHERE account_id = \$1 AND active AND \$2 = ANY\(event_types\)`).
+		WithArgs("acc1", "movement.created").
+		WillReturnRows(rows)
+
+	webhooks, err := repo.GetSubscribedWebhooks(context.Background(), "acc1", eventsDomain.MovementCreated)
+	c.NoError(err)
+	c.Len(webhooks, 1)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestUpdateAndDeleteWebhook_NotFound(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`UPDATE webhooks`).
+		WithArgs("https://example.com/hook", []string{}, false, fixedTime, "WHK1", "acc1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+	mock.ExpectExec(`DELETE FROM webhooks`).
+		WithArgs("WHK1", "acc1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	err := repo.UpdateWebhook(context.Background(), &domain.Webhook{ID: "WHK1", AccountID: "acc1", URL: "https://example.com/hook"})
+	c.ErrorIs(err, ErrWebhookNotFound)
+
+	err = repo.DeleteWebhook(context.Background(), "WHK1", "acc1")
+	c.ErrorIs(err, ErrWebhookNotFound)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestCreateDelivery(t *testing.T) {
+	t.Run("created", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`INSERT INTO webhook_deliveries (.+) ON CONFLICT \(webhook_id, event_id\) DO NOTHING`).
+			WithArgs("WHD1", "WHK1", "acc1", "EVT1", "movement.created", json.RawMessage(`{}`), "pending", 0, 0, "", (*time.Time)(nil), fixedTime, fixedTime).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		created, err := repo.CreateDelivery(context.Background(), &domain.Delivery{
+			ID:        "WHD1",
+			WebhookID: "WHK1",
+			AccountID: "acc1",
+			EventID:   "EVT1",
+			EventType: eventsDomain.MovementCreated,
+			Payload:   json.RawMessage(`{}`),
+			Status:    domain.DeliveryPending,
+		})
+		c.NoError(err)
+		c.True(created)
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("already delivered", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		anyArgs := []any{}
+		for i := 0; i < 13; i++ {
+			anyArgs = append(anyArgs, pgxmock.AnyArg())
+		}
+
+		mock.ExpectExec(`INSERT INTO webhook_deliveries`).WithArgs(anyArgs...).WillReturnResult(pgxmock.NewResult("INSERT", 0))
+
+		created, err := repo.CreateDelivery(context.Background(), &domain.Delivery{ID: "WHD1"})
+		c.NoError(err)
+		c.False(created)
+	})
+}
+
+func TestClaimDueDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	lease := fixedTime.Add(time.Minute)
+	lastError := "unexpected status 500"
+
+	rows := pgxmock.NewRows(deliveryRowColumns).
+		AddRow("WHD1", "WHK1", "acc1", "EVT1", "movement.created", json.RawMessage(`{}`), "pending", 1, 500, &lastError, &lease, fixedTime, fixedTime)
+
+	mock.ExpectQuery(`UPDATE webhook_deliveries SET next_attempt_at = \$1 WHERE id IN \((.+) FOR UPDATE SKIP LOCKED\) RETURNING`).
+		WithArgs(lease, fixedTime, 20).
+		WillReturnRows(rows)
+
+	deliveries, err := repo.ClaimDueDeliveries(context.Background(), lease, 20)
+	c.NoError(err)
+	c.Len(deliveries, 1)
+	c.Equal(domain.DeliveryPending, deliveries[0].Status)
+	c.Equal(lastError, deliveries[0].LastError)
+	c.Equal(500, deliveries[0].ResponseStatus)
+	c.NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/pkg/eventbus"
+)
+
+// WebhooksUsecase manages webhook subscriptions and delivers domain events to them.
+type WebhooksUsecase interface {
+	CreateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	GetWebhook(ctx context.Context, id string, accountID string) (*domain.Webhook, error)
+	GetWebhooks(ctx context.Context, accountID string) ([]*domain.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error
+	DeleteWebhook(ctx context.Context, id string, accountID string) error
+	GetDeliveries(ctx context.Context, id string, accountID string) ([]*domain.Delivery, error)
+	SendTestEvent(ctx context.Context, id string, accountID string) (*domain.Delivery, error)
+	HandleEvent(ctx context.Context, event *eventsDomain.Event) error
+	HandleMessage(ctx context.Context, msg *eventbus.Message) error
+	RetryDeliveries(ctx context.Context) (int, error)
+	RunRetries(ctx context.Context, interval time.Duration)
+}
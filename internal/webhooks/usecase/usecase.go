@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/internal/webhooks/repository"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/eventbus"
+	"transaction-tracker/shared"
+)
+
+const (
+	// Subscription is the event bus subscription that feeds webhook deliveries.
+	Subscription = "webhooks"
+
+	// DefaultRetryInterval is how often failed deliveries are looked up for a retry.
+	DefaultRetryInterval = 15 * time.Second
+
+	// deliveryTimeout bounds a single delivery request, below shared.PublicClient's own timeout.
+	deliveryTimeout = 10 * time.Second
+
+	// deliveryLease keeps a delivery away from other workers while it is being sent.
+	deliveryLease = time.Minute
+
+	retryBatchSize  = 20
+	deliveriesLimit = 50
+
+	userAgent = "transaction-tracker-webhooks/1.0"
+)
+
+var (
+	ErrWebhookNotFound = repository.ErrWebhookNotFound
+)
+
+// HTTPClient sends webhook requests. shared.PublicClient satisfies it.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type webhooksUsecase struct {
+	repo    repository.WebhookRepository
+	client  HTTPClient
+	nowFunc func() time.Time
+	log     *loggerModels.Logger
+}
+
+// NewWebhooksUsecase creates a new instance of WebhooksUsecase that delivers through
+// shared.PublicClient, so deliveries never reach the local network.
+func NewWebhooksUsecase(ctx context.Context, repo repository.WebhookRepository) WebhooksUsecase {
+	log, _ := logger.GetLogger(ctx, "webhooks-usecase")
+
+	return &webhooksUsecase{
+		repo:    repo,
+		client:  &shared.PublicClient,
+		nowFunc: time.Now,
+		log:     log,
+	}
+}
+
+func (u *webhooksUsecase) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	return u.repo.CreateWebhook(ctx, webhook)
+}
+
+func (u *webhooksUsecase) GetWebhook(ctx context.Context, id string, accountID string) (*domain.Webhook, error) {
+	return u.repo.GetWebhookByID(ctx, id, accountID)
+}
+
+func (u *webhooksUsecase) GetWebhooks(ctx context.Context, accountID string) ([]*domain.Webhook, error) {
+	return u.repo.GetWebhooksByAccountID(ctx, accountID)
+}
+
+// UpdateWebhook validates and stores the URL, event types and status of a webhook.
+func (u *webhooksUsecase) UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	err := domain.ValidateURL(webhook.URL)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateWebhook(ctx, webhook)
+}
+
+func (u *webhooksUsecase) DeleteWebhook(ctx context.Context, id string, accountID string) error {
+	return u.repo.DeleteWebhook(ctx, id, accountID)
+}
+
+// GetDeliveries returns the latest deliveries of a webhook of the account.
+func (u *webhooksUsecase) GetDeliveries(ctx context.Context, id string, accountID string) ([]*domain.Delivery, error) {
+	_, err := u.repo.GetWebhookByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.GetDeliveriesByWebhookID(ctx, id, accountID, deliveriesLimit)
+}
+
+// SendTestEvent delivers a webhook.test event right away and returns the logged delivery.
+// Test deliveries are attempted once.
+func (u *webhooksUsecase) SendTestEvent(ctx context.Context, id string, accountID string) (*domain.Delivery, error) {
+	webhook, err := u.repo.GetWebhookByID(ctx, id, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := eventsDomain.NewEvent(eventsDomain.WebhookTest, accountID, webhook.ID, eventsDomain.WebhookTestPayload{
+		WebhookID: webhook.ID,
+		Message:   "this is a test event",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := domain.NewDelivery(webhook, event)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.repo.CreateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.deliver(ctx, webhook, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// HandleEvent creates a delivery for every webhook of the account subscribed to the event
+// and sends it. Failed sends are left to RetryDeliveries; only storage errors are returned.
+func (u *webhooksUsecase) HandleEvent(ctx context.Context, event *eventsDomain.Event) error {
+	if !slices.Contains(domain.SupportedEventTypes, event.Type) {
+		return nil
+	}
+
+	webhooks, err := u.repo.GetSubscribedWebhooks(ctx, event.AccountID, event.Type)
+	if err != nil {
+		return err
+	}
+
+	for _, webhook := range webhooks {
+		delivery, err := domain.NewDelivery(webhook, event)
+		if err != nil {
+			return err
+		}
+
+		// If the process dies before the first attempt the retry worker picks it up after the lease.
+		lease := u.nowFunc().Add(deliveryLease)
+		delivery.NextAttemptAt = &lease
+
+		created, err := u.repo.CreateDelivery(ctx, delivery)
+		if err != nil {
+			return err
+		}
+
+		if !created {
+			continue
+		}
+
+		err = u.deliver(ctx, webhook, delivery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleMessage is the event bus handler of the webhooks subscription. Messages that are not
+// domain events are acknowledged and dropped.
+func (u *webhooksUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	event, err := eventsDomain.ParseEvent(msg.Data)
+	if err != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "webhook_event_discarded",
+			Error: err,
+			AdditionalParams: []loggerModels.Properties{
+				msg,
+			},
+		})
+
+		return nil
+	}
+
+	return u.HandleEvent(ctx, event)
+}
+
+// RetryDeliveries sends a batch of deliveries whose retry is due and returns how many were attempted.
+func (u *webhooksUsecase) RetryDeliveries(ctx context.Context) (int, error) {
+	deliveries, err := u.repo.ClaimDueDeliveries(ctx, u.nowFunc().Add(deliveryLease), retryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	attempted := 0
+
+	for _, delivery := range deliveries {
+		webhook, err := u.repo.GetWebhookByID(ctx, delivery.WebhookID, delivery.AccountID)
+		if errors.Is(err, ErrWebhookNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return attempted, err
+		}
+
+		if !webhook.Active {
+			delivery.Status = domain.DeliveryFailed
+			delivery.LastError = "webhook is disabled"
+			delivery.NextAttemptAt = nil
+
+			err = u.repo.UpdateDelivery(ctx, delivery)
+			if err != nil {
+				return attempted, err
+			}
+
+			continue
+		}
+
+		err = u.deliver(ctx, webhook, delivery)
+		if err != nil {
+			return attempted, err
+		}
+
+		attempted++
+	}
+
+	return attempted, nil
+}
+
+// RunRetries retries due deliveries every interval until the context is done. Full batches
+// are followed by another pass right away.
+func (u *webhooksUsecase) RunRetries(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		attempted, err := u.RetryDeliveries(ctx)
+		if err != nil {
+			u.log.Error(loggerModels.LogProperties{
+				Event: "retry_webhook_deliveries_failed",
+				Error: err,
+			})
+		}
+
+		if err == nil && attempted == retryBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver sends the delivery payload and stores the result of the attempt.
+func (u *webhooksUsecase) deliver(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery) error {
+	status, sendErr := u.send(ctx, webhook, delivery)
+
+	delivery.RecordAttempt(status, sendErr, u.nowFunc())
+
+	if sendErr != nil && delivery.EventType == eventsDomain.WebhookTest {
+		delivery.Status = domain.DeliveryFailed
+		delivery.NextAttemptAt = nil
+	}
+
+	if sendErr != nil {
+		u.log.Error(loggerModels.LogProperties{
+			Event: "webhook_delivery_failed",
+			Error: sendErr,
+			AdditionalParams: []loggerModels.Properties{
+				delivery,
+			},
+		})
+	}
+
+	return u.repo.UpdateDelivery(ctx, delivery)
+}
+
+func (u *webhooksUsecase) send(ctx context.Context, webhook *domain.Webhook, delivery *domain.Delivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := u.nowFunc().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("X-Webhook-ID", delivery.ID)
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", domain.Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	res, err := u.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	// Drain a bit of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhooksUsecase is a mock implementation of the WebhooksUsecase interface.
+type MockWebhooksUsecase struct {
+	mock.Mock
+}
+
+func (m *MockWebhooksUsecase) CreateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhooksUsecase) GetWebhook(ctx context.Context, id string, accountID string) (*domain.Webhook, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhooksUsecase) GetWebhooks(ctx context.Context, accountID string) ([]*domain.Webhook, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+
+func (m *MockWebhooksUsecase) UpdateWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhooksUsecase) DeleteWebhook(ctx context.Context, id string, accountID string) error {
+	args := m.Called(ctx, id, accountID)
+	return args.Error(0)
+}
+
+func (m *MockWebhooksUsecase) GetDeliveries(ctx context.Context, id string, accountID string) ([]*domain.Delivery, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Delivery), args.Error(1)
+}
+
+func (m *MockWebhooksUsecase) SendTestEvent(ctx context.Context, id string, accountID string) (*domain.Delivery, error) {
+	args := m.Called(ctx, id, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Delivery), args.Error(1)
+}
+
+func (m *MockWebhooksUsecase) HandleEvent(ctx context.Context, event *eventsDomain.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockWebhooksUsecase) HandleMessage(ctx context.Context, msg *eventbus.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func (m *MockWebhooksUsecase) RetryDeliveries(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWebhooksUsecase) RunRetries(ctx context.Context, interval time.Duration) {
+	m.Called(ctx, interval)
+}
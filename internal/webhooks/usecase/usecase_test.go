@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	eventsDomain "transaction-tracker/internal/events/domain"
+	"transaction-tracker/internal/webhooks/domain"
+	"transaction-tracker/internal/webhooks/repository"
+	loggerModels "transaction-tracker/logger/models"
+	"transaction-tracker/pkg/eventbus"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+)
+
+type noopLogService struct{}
+
+func (noopLogService) Log(string, loggerModels.LogProperties) {}
+func (noopLogService) SetService(string)                      {}
+
+type receivedRequest struct {
+	headers http.Header
+	body    []byte
+}
+
+func newTestUsecase(repo repository.WebhookRepository, client HTTPClient) *webhooksUsecase {
+	return &webhooksUsecase{
+		repo:    repo,
+		client:  client,
+		nowFunc: func() time.Time { return fixedTime },
+		log:     &loggerModels.Logger{Service: noopLogService{}},
+	}
+}
+
+func newTestServer(t *testing.T, status int) (*httptest.Server, chan receivedRequest) {
+	received := make(chan receivedRequest, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedRequest{headers: r.Header, body: body}
+
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, received
+}
+
+func newTestWebhook(url string) *domain.Webhook {
+	return &domain.Webhook{
+		ID:         "WHK1",
+		AccountID:  "acc1",
+		URL:        url,
+		Secret:     "whsec_test",
+		EventTypes: []eventsDomain.EventType{eventsDomain.MovementCreated},
+		Active:     true,
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	ctx := context.Background()
+
+	event, err := eventsDomain.NewEvent(eventsDomain.MovementCreated, "acc1", "MID1", eventsDomain.MovementPayload{ID: "MID1"})
+	require.NoError(t, err)
+
+	t.Run("delivers signed event", func(t *testing.T) {
+		c := require.New(t)
+
+		server, received := newTestServer(t, http.StatusOK)
+		webhook := newTestWebhook(server.URL)
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetSubscribedWebhooks", mock.Anything, "acc1", eventsDomain.MovementCreated).Return([]*domain.Webhook{webhook}, nil)
+		repoMock.On("CreateDelivery", mock.Anything, mock.MatchedBy(func(d *domain.Delivery) bool {
+			return d.EventID == event.ID && d.WebhookID == "WHK1" && d.Status == domain.DeliveryPending && d.NextAttemptAt != nil
+		})).Return(true, nil)
+		repoMock.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(d *domain.Delivery) bool {
+			return d.Status == domain.DeliverySucceeded && d.Attempts == 1 && d.ResponseStatus == http.StatusOK
+		})).Return(nil)
+
+		u := newTestUsecase(repoMock, server.Client())
+
+		c.NoError(u.HandleEvent(ctx, event))
+
+		req := <-received
+		timestamp := strconv.FormatInt(fixedTime.Unix(), 10)
+
+		c.Equal("movement.created", req.headers.Get("X-Webhook-Event"))
+		c.Equal(timestamp, req.headers.Get("X-Webhook-Timestamp"))
+		c.Equal(domain.Sign("whsec_test", fixedTime.Unix(), req.body), req.headers.Get("X-Webhook-Signature"))
+
+		parsed, err := eventsDomain.ParseEvent(req.body)
+		c.NoError(err)
+		c.Equal(event.ID, parsed.ID)
+
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("failed delivery is scheduled for retry", func(t *testing.T) {
+		c := require.New(t)
+
+		server, _ := newTestServer(t, http.StatusInternalServerError)
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetSubscribedWebhooks", mock.Anything, "acc1", eventsDomain.MovementCreated).Return([]*domain.Webhook{newTestWebhook(server.URL)}, nil)
+		repoMock.On("CreateDelivery", mock.Anything, mock.Anything).Return(true, nil)
+		repoMock.On("UpdateDelivery", mock.Anything, mock.MatchedBy(func(d *domain.Delivery) bool {
+			return d.Status == domain.DeliveryPending &&
+				d.ResponseStatus == http.StatusInternalServerError &&
+				d.LastError == "unexpected status 500" &&
+				d.NextAttemptAt.Equal(fixedTime.Add(domain.Backoff(1)))
+		})).Return(nil)
+
+		u := newTestUsecase(repoMock, server.Client())
+
+		c.NoError(u.HandleEvent(ctx, event))
+		repoMock.AssertExpectations(t)
+	})
+
+	t.Run("already delivered event is skipped", func(t *testing.T) {
+		c := require.New(t)
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetSubscribedWebhooks", mock.Anything, "acc1", eventsDomain.MovementCreated).Return([]*domain.Webhook{newTestWebhook("http://localhost")}, nil)
+		repoMock.On("CreateDelivery", mock.Anything, mock.Anything).Return(false, nil)
+
+		u := newTestUsecase(repoMock, nil)
+
+		c.NoError(u.HandleEvent(ctx, event))
+		repoMock.AssertNotCalled(t, "UpdateDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unsupported event is ignored", func(t *testing.T) {
+		c := require.New(t)
+
+		failed, _ := eventsDomain.NewEvent(eventsDomain.MessageFailed, "acc1", "MSG1", eventsDomain.MessageFailedPayload{ID: "MSG1"})
+
+		repoMock := new(repository.MockWebhookRepository)
+		u := newTestUsecase(repoMock, nil)
+
+		c.NoError(u.HandleEvent(ctx, failed))
+		repoMock.AssertNotCalled(t, "GetSubscribedWebhooks", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		c := require.New(t)
+
+		expectedErr := errors.New("db down")
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetSubscribedWebhooks", mock.Anything, "acc1", eventsDomain.MovementCreated).Return(nil, expectedErr)
+
+		u := newTestUsecase(repoMock, nil)
+
+		c.ErrorIs(u.HandleEvent(ctx, event), expectedErr)
+	})
+}
+
+func TestHandleMessage_DiscardsInvalidEvents(t *testing.T) {
+	c := require.New(t)
+
+	repoMock := new(repository.MockWebhookRepository)
+	u := newTestUsecase(repoMock, nil)
+
+	c.NoError(u.HandleMessage(context.Background(), eventbus.NewMessage([]byte(`{"type":"other"}`))))
+	repoMock.AssertExpectations(t)
+}
+
+func TestSendTestEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		server, received := newTestServer(t, http.StatusNoContent)
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetWebhookByID", mock.Anything, "WHK1", "acc1").Return(newTestWebhook(server.URL), nil)
+		repoMock.On("CreateDelivery", mock.Anything, mock.MatchedBy(func(d *domain.Delivery) bool {
+			return d.EventType == eventsDomain.WebhookTest
+		})).Return(true, nil)
+		repoMock.On("UpdateDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		u := newTestUsecase(repoMock, server.Client())
+
+		delivery, err := u.SendTestEvent(ctx, "WHK1", "acc1")
+		c.NoError(err)
+		c.Equal(domain.DeliverySucceeded, delivery.Status)
+		c.Equal("webhook.test", (<-received).headers.Get("X-Webhook-Event"))
+	})
+
+	t.Run("failure is not retried", func(t *testing.T) {
+		c := require.New(t)
+
+		server, _ := newTestServer(t, http.StatusBadGateway)
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetWebhookByID", mock.Anything, "WHK1", "acc1").Return(newTestWebhook(server.URL), nil)
+		repoMock.On("CreateDelivery", mock.Anything, mock.Anything).Return(true, nil)
+		repoMock.On("UpdateDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		u := newTestUsecase(repoMock, server.Client())
+
+		delivery, err := u.SendTestEvent(ctx, "WHK1", "acc1")
+		c.NoError(err)
+		c.Equal(domain.DeliveryFailed, delivery.Status)
+		c.Equal(http.StatusBadGateway, delivery.ResponseStatus)
+		c.Nil(delivery.NextAttemptAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("GetWebhookByID", mock.Anything, "WHK1", "acc1").Return(nil, ErrWebhookNotFound)
+
+		_, err := newTestUsecase(repoMock, nil).SendTestEvent(ctx, "WHK1", "acc1")
+		require.ErrorIs(t, err, ErrWebhookNotFound)
+	})
+}
+
+func TestRetryDeliveries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries due deliveries", func(t *testing.T) {
+		c := require.New(t)
+
+		server, received := newTestServer(t, http.StatusOK)
+
+		due := &domain.Delivery{ID: "WHD1", WebhookID: "WHK1", AccountID: "acc1", EventType: eventsDomain.MovementCreated, Payload: []byte(`{}`), Status: domain.DeliveryPending, Attempts: 2}
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("ClaimDueDeliveries", mock.Anything, fixedTime.Add(deliveryLease), retryBatchSize).Return([]*domain.Delivery{due}, nil)
+		repoMock.On("GetWebhookByID", mock.Anything, "WHK1", "acc1").Return(newTestWebhook(server.URL), nil)
+		repoMock.On("UpdateDelivery", mock.Anything, due).Return(nil)
+
+		u := newTestUsecase(repoMock, server.Client())
+
+		attempted, err := u.RetryDeliveries(ctx)
+		c.NoError(err)
+		c.Equal(1, attempted)
+		c.Equal(domain.DeliverySucceeded, due.Status)
+		c.Equal(3, due.Attempts)
+		c.Equal("WHD1", (<-received).headers.Get("X-Webhook-ID"))
+	})
+
+	t.Run("disabled webhook fails the delivery", func(t *testing.T) {
+		c := require.New(t)
+
+		due := &domain.Delivery{ID: "WHD1", WebhookID: "WHK1", AccountID: "acc1", Status: domain.DeliveryPending}
+		webhook := newTestWebhook("http://localhost")
+		webhook.Active = false
+
+		repoMock := new(repository.MockWebhookRepository)
+		repoMock.On("ClaimDueDeliveries", mock.Anything, mock.Anything, retryBatchSize).Return([]*domain.Delivery{due}, nil)
+		repoMock.On("GetWebhookByID", mock.Anything, "WHK1", "acc1").Return(webhook, nil)
+		repoMock.On("UpdateDelivery", mock.Anything, due).Return(nil)
+
+		attempted, err := newTestUsecase(repoMock, nil).RetryDeliveries(ctx)
+		c.NoError(err)
+		c.Zero(attempted)
+		c.Equal(domain.DeliveryFailed, due.Status)
+	})
+}
+
+func TestUpdateWebhook_InvalidURL(t *testing.T) {
+	repoMock := new(repository.MockWebhookRepository)
+
+	err := newTestUsecase(repoMock, nil).UpdateWebhook(context.Background(), &domain.Webhook{URL: "not a url"})
+	require.ErrorIs(t, err, domain.ErrInvalidURL)
+	repoMock.AssertNotCalled(t, "UpdateWebhook", mock.Anything, mock.Anything)
+}
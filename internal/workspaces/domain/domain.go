@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	_workspace_prefix  = "WSP"
+	_invitation_prefix = "INV"
+
+	// MaxNameLength is the longest name a workspace can have.
+	MaxNameLength = 100
+	// InvitationTTL is how long an invitation can be accepted.
+	InvitationTTL = 7 * 24 * time.Hour
+)
+
+// Role is what a member can do in a workspace.
+type Role string
+
+const (
+	// Owner members can do everything, including managing the members.
+	Owner Role = "owner"
+	// Editor members can read and change the ledger.
+	Editor Role = "editor"
+	// Viewer members can only read the ledger.
+	Viewer Role = "viewer"
+)
+
+// Permission is an action on a workspace.
+type Permission string
+
+const (
+	Read   Permission = "read"
+	Write  Permission = "write"
+	Manage Permission = "manage"
+)
+
+// InvitationStatus is where an invitation is in its life.
+type InvitationStatus string
+
+const (
+	Pending  InvitationStatus = "pending"
+	Accepted InvitationStatus = "accepted"
+	Declined InvitationStatus = "declined"
+	Revoked  InvitationStatus = "revoked"
+)
+
+var (
+	// ErrInvalidWorkspace is returned when a workspace can't be created with the given data.
+	ErrInvalidWorkspace = errors.New("invalid workspace")
+	// ErrInvalidRole is returned when a role does not exist or can't be given.
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrInvalidInvitation is returned when an invitation can't be created or answered.
+	ErrInvalidInvitation = errors.New("invalid invitation")
+	// ErrForbidden is returned when the role of a member does not allow an action.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// permissions are the actions each role allows.
+var permissions = map[Role][]Permission{
+	Owner:  {Read, Write, Manage},
+	Editor: {Read, Write},
+	Viewer: {Read},
+}
+
+// Can reports whether the role allows the permission.
+func (r Role) Can(permission Permission) bool {
+	return slices.Contains(permissions[r], permission)
+}
+
+// ParseRole returns the role written in s. Members can be made editors or viewers; a
+// workspace has a single owner, the member who created it.
+func ParseRole(s string) (Role, error) {
+	switch role := Role(strings.ToLower(strings.TrimSpace(s))); role {
+	case Editor, Viewer:
+		return role, nil
+	default:
+		return "", fmt.Errorf("%w: role %q must be editor or viewer", ErrInvalidRole, s)
+	}
+}
+
+// Workspace is a ledger shared by its members, made of the movements of all of them. Each
+// movement keeps the account of the member it belongs to.
+type Workspace struct {
+	ID        string
+	Name      string
+	OwnerID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (w *Workspace) LogProperties() map[string]string {
+	return map[string]string{
+		"workspace_id": w.ID,
+		"owner_id":     w.OwnerID,
+	}
+}
+
+// NewWorkspace creates a workspace owned by the account.
+func NewWorkspace(ownerID string, name string) (*Workspace, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, fmt.Errorf("%w: name must have between 1 and %d characters", ErrInvalidWorkspace, MaxNameLength)
+	}
+
+	return &Workspace{
+		ID:      _workspace_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Name:    name,
+		OwnerID: ownerID,
+	}, nil
+}
+
+// Member is an account with access to a workspace.
+type Member struct {
+	WorkspaceID string
+	AccountID   string
+	Email       string
+	Role        Role
+	JoinedAt    time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (m *Member) LogProperties() map[string]string {
+	return map[string]string{
+		"workspace_id": m.WorkspaceID,
+		"account_id":   m.AccountID,
+		"role":         string(m.Role),
+	}
+}
+
+// Membership is a workspace seen by one of its members.
+type Membership struct {
+	Workspace *Workspace
+	Role      Role
+}
+
+// Invitation asks the owner of an email to join a workspace with a role.
+type Invitation struct {
+	ID          string
+	WorkspaceID string
+	Email       string
+	Role        Role
+	InvitedBy   string
+	Status      InvitationStatus
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// LogProperties is the map to logger attibutes
+func (i *Invitation) LogProperties() map[string]string {
+	return map[string]string{
+		"invitation_id": i.ID,
+		"workspace_id":  i.WorkspaceID,
+		"role":          string(i.Role),
+		"status":        string(i.Status),
+	}
+}
+
+// NewInvitation invites email to the workspace, valid for InvitationTTL.
+func NewInvitation(workspaceID string, email string, role Role, invitedBy string, now time.Time) (*Invitation, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != Editor && role != Viewer {
+		return nil, fmt.Errorf("%w: role %q must be editor or viewer", ErrInvalidRole, role)
+	}
+
+	return &Invitation{
+		ID:          _invitation_prefix + strings.ReplaceAll(uuid.New().String(), "-", ""),
+		WorkspaceID: workspaceID,
+		Email:       email,
+		Role:        role,
+		InvitedBy:   invitedBy,
+		Status:      Pending,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(InvitationTTL),
+	}, nil
+}
+
+// Open reports whether the invitation can still be answered.
+func (i *Invitation) Open(now time.Time) bool {
+	return i.Status == Pending && now.Before(i.ExpiresAt)
+}
+
+// NormalizeEmail returns the address in email, lowercased.
+func NormalizeEmail(email string) (string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Address != strings.TrimSpace(email) {
+		return "", fmt.Errorf("%w: %q is not an email address", ErrInvalidInvitation, email)
+	}
+
+	return strings.ToLower(address.Address), nil
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func TestRoleCan(t *testing.T) {
+	c := require.New(t)
+
+	c.True(Owner.Can(Manage))
+	c.True(Editor.Can(Write))
+	c.False(Editor.Can(Manage))
+	c.True(Viewer.Can(Read))
+	c.False(Viewer.Can(Write))
+	c.False(Role("admin").Can(Read))
+}
+
+func TestParseRole(t *testing.T) {
+	c := require.New(t)
+
+	role, err := ParseRole(" Editor ")
+	c.NoError(err)
+	c.Equal(Editor, role)
+
+	_, err = ParseRole("owner")
+	c.ErrorIs(err, ErrInvalidRole)
+
+	_, err = ParseRole("")
+	c.ErrorIs(err, ErrInvalidRole)
+}
+
+func TestNewWorkspace(t *testing.T) {
+	c := require.New(t)
+
+	workspace, err := NewWorkspace("acc1", "  Casa  ")
+	c.NoError(err)
+	c.True(strings.HasPrefix(workspace.ID, _workspace_prefix))
+	c.Equal("Casa", workspace.Name)
+	c.Equal("acc1", workspace.OwnerID)
+
+	_, err = NewWorkspace("acc1", " ")
+	c.ErrorIs(err, ErrInvalidWorkspace)
+
+	_, err = NewWorkspace("acc1", strings.Repeat("a", MaxNameLength+1))
+	c.ErrorIs(err, ErrInvalidWorkspace)
+}
+
+func TestNewInvitation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		invitation, err := NewInvitation("WSP1", "Ana@Example.com", Viewer, "acc1", now)
+		c.NoError(err)
+		c.True(strings.HasPrefix(invitation.ID, _invitation_prefix))
+		c.Equal("ana@example.com", invitation.Email)
+		c.Equal(Pending, invitation.Status)
+		c.Equal(now.Add(InvitationTTL), invitation.ExpiresAt)
+		c.True(invitation.Open(now))
+		c.False(invitation.Open(now.Add(InvitationTTL)))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := require.New(t)
+
+		_, err := NewInvitation("WSP1", "Ana <ana@example.com>", Viewer, "acc1", now)
+		c.ErrorIs(err, ErrInvalidInvitation)
+
+		_, err = NewInvitation("WSP1", "not-an-email", Viewer, "acc1", now)
+		c.ErrorIs(err, ErrInvalidInvitation)
+
+		_, err = NewInvitation("WSP1", "ana@example.com", Owner, "acc1", now)
+		c.ErrorIs(err, ErrInvalidRole)
+	})
+}
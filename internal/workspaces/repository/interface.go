@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"transaction-tracker/internal/workspaces/domain"
+)
+
+// WorkspaceRepository stores the workspaces, their members and the invitations to join them.
+type WorkspaceRepository interface {
+	CreateWorkspace(ctx context.Context, workspace *domain.Workspace, owner *domain.Member) error
+	GetWorkspaceByID(ctx context.Context, id string) (*domain.Workspace, error)
+	GetMemberships(ctx context.Context, accountID string) ([]*domain.Membership, error)
+	GetMember(ctx context.Context, workspaceID string, accountID string) (*domain.Member, error)
+	GetMembers(ctx context.Context, workspaceID string) ([]*domain.Member, error)
+	AddMember(ctx context.Context, member *domain.Member) error
+	UpdateMemberRole(ctx context.Context, workspaceID string, accountID string, role domain.Role) error
+	RemoveMember(ctx context.Context, workspaceID string, accountID string) error
+	CreateInvitation(ctx context.Context, invitation *domain.Invitation) error
+	GetInvitationByID(ctx context.Context, id string) (*domain.Invitation, error)
+	GetInvitations(ctx context.Context, workspaceID string) ([]*domain.Invitation, error)
+	GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*domain.Invitation, error)
+	UpdateInvitationStatus(ctx context.Context, id string, status domain.InvitationStatus) error
+}
@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transaction-tracker/internal/workspaces/domain"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// uniqueViolation is the Postgres error code of a duplicated key.
+	uniqueViolation = "23505"
+
+	workspaceColumns  = `id, name, owner_id, created_at, updated_at`
+	memberColumns     = `workspace_id, account_id, email, role, joined_at`
+	invitationColumns = `id, workspace_id, email, role, invited_by, status, created_at, expires_at`
+)
+
+var (
+	ErrWorkspaceNotFound  = errors.New("workspace not found")
+	ErrMemberNotFound     = errors.New("member not found")
+	ErrInvitationNotFound = errors.New("invitation not found")
+	// ErrAlreadyMember is returned when an account is already a member of the workspace.
+	ErrAlreadyMember = errors.New("account is already a member of the workspace")
+	// ErrAlreadyInvited is returned when an email already has a pending invitation to the
+	// workspace.
+	ErrAlreadyInvited = errors.New("email already has a pending invitation to the workspace")
+)
+
+// DBQuerier is the interface that abstracts the database methods we need.
+type DBQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type postgresRepository struct {
+	db      DBQuerier
+	nowFunc func() time.Time
+}
+
+// NewPostgresRepository creates the workspaces repository.
+func NewPostgresRepository(db *pgxpool.Pool) WorkspaceRepository {
+	return &postgresRepository{db: db, nowFunc: time.Now}
+}
+
+// querier returns the transaction stored in the context, if any, so a workspace and its owner,
+// or an accepted invitation and its member, are written together.
+func (r *postgresRepository) querier(ctx context.Context) DBQuerier {
+	if tx, ok := postgres.TxFromContext(ctx); ok {
+		return tx
+	}
+
+	return r.db
+}
+
+// CreateWorkspace stores a workspace with its owner as first member. It must run in a
+// transaction.
+func (r *postgresRepository) CreateWorkspace(ctx context.Context, workspace *domain.Workspace, owner *domain.Member) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO workspaces (` + workspaceColumns + `)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.querier(ctx).Exec(ctx, query, workspace.ID, workspace.Name, workspace.OwnerID, now, now)
+	if err != nil {
+		return err
+	}
+
+	workspace.CreatedAt = now
+	workspace.UpdatedAt = now
+
+	return r.AddMember(ctx, owner)
+}
+
+func (r *postgresRepository) GetWorkspaceByID(ctx context.Context, id string) (*domain.Workspace, error) {
+	query := `SELECT ` + workspaceColumns + ` FROM workspaces WHERE id = $1`
+
+	workspace, err := scanToWorkspace(r.db.QueryRow(ctx, query, id).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return workspace, err
+}
+
+// GetMemberships returns the workspaces the account is a member of with its role in each,
+// oldest first.
+func (r *postgresRepository) GetMemberships(ctx context.Context, accountID string) ([]*domain.Membership, error) {
+	query := `SELECT w.id, w.name, w.owner_id, w.created_at, w.updated_at, m.role
+	FROM workspaces w
+	JOIN workspace_members m ON m.workspace_id = w.id
+	WHERE m.account_id = $1
+	ORDER BY w.created_at`
+
+	rows, err := r.db.Query(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	memberships := []*domain.Membership{}
+	for rows.Next() {
+		workspace := &domain.Workspace{}
+
+		var role string
+
+		err := rows.Scan(&workspace.ID, &workspace.Name, &workspace.OwnerID, &workspace.CreatedAt, &workspace.UpdatedAt, &role)
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, &domain.Membership{Workspace: workspace, Role: domain.Role(role)})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return memberships, nil
+}
+
+func (r *postgresRepository) GetMember(ctx context.Context, workspaceID string, accountID string) (*domain.Member, error) {
+	query := `SELECT ` + memberColumns + `
+	FROM workspace_members
+	WHERE workspace_id = $1 AND account_id = $2`
+
+	member, err := scanToMember(r.db.QueryRow(ctx, query, workspaceID, accountID).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrMemberNotFound
+	}
+
+	return member, err
+}
+
+// GetMembers returns the members of the workspace in the order they joined.
+func (r *postgresRepository) GetMembers(ctx context.Context, workspaceID string) ([]*domain.Member, error) {
+	query := `SELECT ` + memberColumns + `
+	FROM workspace_members
+	WHERE workspace_id = $1
+	ORDER BY joined_at`
+
+	rows, err := r.db.Query(ctx, query, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	members := []*domain.Member{}
+	for rows.Next() {
+		member, err := scanToMember(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r *postgresRepository) AddMember(ctx context.Context, member *domain.Member) error {
+	now := r.nowFunc()
+
+	query := `INSERT INTO workspace_members (` + memberColumns + `)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.querier(ctx).Exec(ctx, query, member.WorkspaceID, member.AccountID, member.Email, string(member.Role), now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrAlreadyMember
+		}
+
+		return err
+	}
+
+	member.JoinedAt = now
+
+	return nil
+}
+
+func (r *postgresRepository) UpdateMemberRole(ctx context.Context, workspaceID string, accountID string, role domain.Role) error {
+	query := `UPDATE workspace_members SET role = $3 WHERE workspace_id = $1 AND account_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, workspaceID, accountID, string(role))
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) RemoveMember(ctx context.Context, workspaceID string, accountID string) error {
+	query := `DELETE FROM workspace_members WHERE workspace_id = $1 AND account_id = $2`
+
+	tag, err := r.db.Exec(ctx, query, workspaceID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrMemberNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) CreateInvitation(ctx context.Context, invitation *domain.Invitation) error {
+	query := `INSERT INTO workspace_invitations (` + invitationColumns + `)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.querier(ctx).Exec(ctx, query,
+		invitation.ID,
+		invitation.WorkspaceID,
+		invitation.Email,
+		string(invitation.Role),
+		invitation.InvitedBy,
+		string(invitation.Status),
+		invitation.CreatedAt,
+		invitation.ExpiresAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrAlreadyInvited
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetInvitationByID(ctx context.Context, id string) (*domain.Invitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM workspace_invitations WHERE id = $1`
+
+	invitation, err := scanToInvitation(r.db.QueryRow(ctx, query, id).Scan)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+
+	return invitation, err
+}
+
+// GetInvitations returns the invitations to the workspace, newest first.
+func (r *postgresRepository) GetInvitations(ctx context.Context, workspaceID string) ([]*domain.Invitation, error) {
+	query := `SELECT ` + invitationColumns + `
+	FROM workspace_invitations
+	WHERE workspace_id = $1
+	ORDER BY created_at DESC`
+
+	return r.queryInvitations(ctx, query, workspaceID)
+}
+
+// GetPendingInvitationsByEmail returns the invitations sent to the email that were not
+// answered yet, newest first. Expired ones are included.
+func (r *postgresRepository) GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*domain.Invitation, error) {
+	query := `SELECT ` + invitationColumns + `
+	FROM workspace_invitations
+	WHERE email = $1 AND status = $2
+	ORDER BY created_at DESC`
+
+	return r.queryInvitations(ctx, query, email, string(domain.Pending))
+}
+
+// UpdateInvitationStatus answers a pending invitation. It fails with ErrInvitationNotFound
+// when the invitation was already answered.
+func (r *postgresRepository) UpdateInvitationStatus(ctx context.Context, id string, status domain.InvitationStatus) error {
+	query := `UPDATE workspace_invitations SET status = $2 WHERE id = $1 AND status = $3`
+
+	tag, err := r.querier(ctx).Exec(ctx, query, id, string(status), string(domain.Pending))
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) queryInvitations(ctx context.Context, query string, args ...any) ([]*domain.Invitation, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	invitations := []*domain.Invitation{}
+	for rows.Next() {
+		invitation, err := scanToInvitation(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func scanToWorkspace(scanFn func(...any) error) (*domain.Workspace, error) {
+	workspace := &domain.Workspace{}
+
+	err := scanFn(&workspace.ID, &workspace.Name, &workspace.OwnerID, &workspace.CreatedAt, &workspace.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+func scanToMember(scanFn func(...any) error) (*domain.Member, error) {
+	member := &domain.Member{}
+
+	var role string
+
+	err := scanFn(&member.WorkspaceID, &member.AccountID, &member.Email, &role, &member.JoinedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Role = domain.Role(role)
+
+	return member, nil
+}
+
+func scanToInvitation(scanFn func(...any) error) (*domain.Invitation, error) {
+	invitation := &domain.Invitation{}
+
+	var role, status string
+
+	err := scanFn(
+		&invitation.ID,
+		&invitation.WorkspaceID,
+		&invitation.Email,
+		&role,
+		&invitation.InvitedBy,
+		&status,
+		&invitation.CreatedAt,
+		&invitation.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.Role = domain.Role(role)
+	invitation.Status = domain.InvitationStatus(status)
+
+	return invitation, nil
+}
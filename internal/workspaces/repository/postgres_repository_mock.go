@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"transaction-tracker/internal/workspaces/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWorkspaceRepository is a mock of the repository interface.
+type MockWorkspaceRepository struct {
+	mock.Mock
+}
+
+func (m *MockWorkspaceRepository) CreateWorkspace(ctx context.Context, workspace *domain.Workspace, owner *domain.Member) error {
+	args := m.Called(ctx, workspace, owner)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) GetWorkspaceByID(ctx context.Context, id string) (*domain.Workspace, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) GetMemberships(ctx context.Context, accountID string) ([]*domain.Membership, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Membership), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) GetMember(ctx context.Context, workspaceID string, accountID string) (*domain.Member, error) {
+	args := m.Called(ctx, workspaceID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) GetMembers(ctx context.Context, workspaceID string) ([]*domain.Member, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) AddMember(ctx context.Context, member *domain.Member) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) UpdateMemberRole(ctx context.Context, workspaceID string, accountID string, role domain.Role) error {
+	args := m.Called(ctx, workspaceID, accountID, role)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, workspaceID string, accountID string) error {
+	args := m.Called(ctx, workspaceID, accountID)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) CreateInvitation(ctx context.Context, invitation *domain.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+func (m *MockWorkspaceRepository) GetInvitationByID(ctx context.Context, id string) (*domain.Invitation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) GetInvitations(ctx context.Context, workspaceID string) ([]*domain.Invitation, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*domain.Invitation, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspaceRepository) UpdateInvitationStatus(ctx context.Context, id string, status domain.InvitationStatus) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
@@ -0,0 +1,341 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"transaction-tracker/internal/workspaces/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fixedTime      = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+	memberRows     = []string{"workspace_id", "account_id", "email", "role", "joined_at"}
+	invitationRows = []string{"id", "workspace_id", "email", "role", "invited_by", "status", "created_at", "expires_at"}
+)
+
+func setupMockDB(t *testing.T) (WorkspaceRepository, pgxmock.PgxPoolIface) {
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+
+	t.Cleanup(mockPool.Close)
+
+	return &postgresRepository{db: mockPool, nowFunc: func() time.Time { return fixedTime }}, mockPool
+}
+
+func newInvitation() *domain.Invitation {
+	return &domain.Invitation{
+		ID:          "INV1",
+		WorkspaceID: "WSP1",
+		Email:       "ana@example.com",
+		Role:        domain.Editor,
+		InvitedBy:   "acc1",
+		Status:      domain.Pending,
+		CreatedAt:   fixedTime,
+		ExpiresAt:   fixedTime.Add(domain.InvitationTTL),
+	}
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	workspace := &domain.Workspace{ID: "WSP1", Name: "Casa", OwnerID: "acc1"}
+	owner := &domain.Member{WorkspaceID: "WSP1", AccountID: "acc1", Email: "owner@example.com", Role: domain.Owner}
+
+	mock.ExpectExec(`INSERT INTO workspaces \(id, name, owner_id, created_at, updated_at\)`).
+		WithArgs("WSP1", "Casa", "acc1", fixedTime, fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mock.ExpectExec(`INSERT INTO workspace_members \(workspace_id, account_id, email, role, joined_at\)`).
+		WithArgs("WSP1", "acc1", "owner@example.com", "owner", fixedTime).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+	c.NoError(repo.CreateWorkspace(context.Background(), workspace, owner))
+	c.Equal(fixedTime, workspace.CreatedAt)
+	c.Equal(fixedTime, owner.JoinedAt)
+	c.NoError(mock.ExpectationsWereMet())
+}
+
+func TestGetWorkspaceByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows([]string{"id", "name", "owner_id", "created_at", "updated_at"}).
+			AddRow("WSP1", "Casa", "acc1", fixedTime, fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspaces WHERE id = \$1`).
+			WithArgs("WSP1").
+			WillReturnRows(rows)
+
+		workspace, err := repo.GetWorkspaceByID(context.Background(), "WSP1")
+		c.NoError(err)
+		c.Equal("Casa", workspace.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspaces`).
+			WithArgs("WSP1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetWorkspaceByID(context.Background(), "WSP1")
+		require.ErrorIs(t, err, ErrWorkspaceNotFound)
+	})
+}
+
+func TestGetMemberships(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows([]string{"id", "name", "owner_id", "created_at", "updated_at", "role"}).
+		AddRow("WSP1", "Casa", "acc1", fixedTime, fixedTime, "owner").
+		AddRow("WSP2", "Viaje", "acc2", fixedTime, fixedTime, "viewer")
+
+	mock.ExpectQuery(`SELECT (.+) FROM workspaces w JOIN workspace_members m ON m.workspace_id = w.id WHERE m.account_id = \$1 ORDER BY w.created_at`).
+		WithArgs("acc1").
+		WillReturnRows(rows)
+
+	memberships, err := repo.GetMemberships(context.Background(), "acc1")
+	c.NoError(err)
+	c.Len(memberships, 2)
+	c.Equal(domain.Owner, memberships[0].Role)
+	c.Equal("Viaje", memberships[1].Workspace.Name)
+}
+
+func TestGetMember(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(memberRows).AddRow("WSP1", "acc2", "ana@example.com", "editor", fixedTime)
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspace_members WHERE workspace_id = \$1 AND account_id = \$2`).
+			WithArgs("WSP1", "acc2").
+			WillReturnRows(rows)
+
+		member, err := repo.GetMember(context.Background(), "WSP1", "acc2")
+		c.NoError(err)
+		c.Equal(domain.Editor, member.Role)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspace_members`).
+			WithArgs("WSP1", "acc2").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetMember(context.Background(), "WSP1", "acc2")
+		require.ErrorIs(t, err, ErrMemberNotFound)
+	})
+}
+
+func TestGetMembers(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(memberRows).
+		AddRow("WSP1", "acc1", "owner@example.com", "owner", fixedTime).
+		AddRow("WSP1", "acc2", "ana@example.com", "viewer", fixedTime)
+
+	mock.ExpectQuery(`SELECT (.+) FROM workspace_members WHERE workspace_id = \$1 ORDER BY joined_at`).
+		WithArgs("WSP1").
+		WillReturnRows(rows)
+
+	members, err := repo.GetMembers(context.Background(), "WSP1")
+	c.NoError(err)
+	c.Len(members, 2)
+	c.Equal(domain.Viewer, members[1].Role)
+}
+
+func TestAddMember_AlreadyMember(t *testing.T) {
+	repo, mock := setupMockDB(t)
+
+	mock.ExpectExec(`INSERT INTO workspace_members`).
+		WithArgs("WSP1", "acc2", "ana@example.com", "editor", fixedTime).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+	err := repo.AddMember(context.Background(), &domain.Member{WorkspaceID: "WSP1", AccountID: "acc2", Email: "ana@example.com", Role: domain.Editor})
+	require.ErrorIs(t, err, ErrAlreadyMember)
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`UPDATE workspace_members SET role = \$3 WHERE workspace_id = \$1 AND account_id = \$2`).
+			WithArgs("WSP1", "acc2", "viewer").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		c.NoError(repo.UpdateMemberRole(context.Background(), "WSP1", "acc2", domain.Viewer))
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`UPDATE workspace_members`).
+			WithArgs("WSP1", "acc2", "viewer").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := repo.UpdateMemberRole(context.Background(), "WSP1", "acc2", domain.Viewer)
+		require.ErrorIs(t, err, ErrMemberNotFound)
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM workspace_members WHERE workspace_id = \$1 AND account_id = \$2`).
+			WithArgs("WSP1", "acc2").
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		c.NoError(repo.RemoveMember(context.Background(), "WSP1", "acc2"))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`DELETE FROM workspace_members`).
+			WithArgs("WSP1", "acc2").
+			WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := repo.RemoveMember(context.Background(), "WSP1", "acc2")
+		require.ErrorIs(t, err, ErrMemberNotFound)
+	})
+}
+
+func TestCreateInvitation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		invitation := newInvitation()
+		mock.ExpectExec(`INSERT INTO workspace_invitations \(id, workspace_id, email, role, invited_by, status, created_at, expires_at\)`).
+			WithArgs("INV1", "WSP1", "ana@example.com", "editor", "acc1", "pending", fixedTime, invitation.ExpiresAt).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		c.NoError(repo.CreateInvitation(context.Background(), invitation))
+		c.NoError(mock.ExpectationsWereMet())
+	})
+
+	t.Run("already invited", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		invitation := newInvitation()
+		mock.ExpectExec(`INSERT INTO workspace_invitations`).
+			WithArgs("INV1", "WSP1", "ana@example.com", "editor", "acc1", "pending", fixedTime, invitation.ExpiresAt).
+			WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+
+		err := repo.CreateInvitation(context.Background(), invitation)
+		require.ErrorIs(t, err, ErrAlreadyInvited)
+	})
+}
+
+func TestGetInvitationByID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		rows := pgxmock.NewRows(invitationRows).
+			AddRow("INV1", "WSP1", "ana@example.com", "editor", "acc1", "pending", fixedTime, fixedTime.Add(domain.InvitationTTL))
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspace_invitations WHERE id = \$1`).
+			WithArgs("INV1").
+			WillReturnRows(rows)
+
+		invitation, err := repo.GetInvitationByID(context.Background(), "INV1")
+		c.NoError(err)
+		c.Equal(domain.Pending, invitation.Status)
+		c.Equal(domain.Editor, invitation.Role)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectQuery(`SELECT (.+) FROM workspace_invitations`).
+			WithArgs("INV1").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err := repo.GetInvitationByID(context.Background(), "INV1")
+		require.ErrorIs(t, err, ErrInvitationNotFound)
+	})
+}
+
+func TestGetPendingInvitationsByEmail(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(invitationRows).
+		AddRow("INV1", "WSP1", "ana@example.com", "editor", "acc1", "pending", fixedTime, fixedTime.Add(domain.InvitationTTL))
+
+	mock.ExpectQuery(`SELECT (.+) FROM workspace_invitations WHERE email = \$1 AND status = \$2 ORDER BY created_at DESC`).
+		WithArgs("ana@example.com", "pending").
+		WillReturnRows(rows)
+
+	invitations, err := repo.GetPendingInvitationsByEmail(context.Background(), "ana@example.com")
+	c.NoError(err)
+	c.Len(invitations, 1)
+}
+
+func TestGetInvitations(t *testing.T) {
+	c := require.New(t)
+
+	repo, mock := setupMockDB(t)
+
+	rows := pgxmock.NewRows(invitationRows).
+		AddRow("INV1", "WSP1", "ana@example.com", "editor", "acc1", "accepted", fixedTime, fixedTime.Add(domain.InvitationTTL))
+
+	mock.ExpectQuery(`SELECT (.+) FROM workspace_invitations WHERE workspace_id = \$1 ORDER BY created_at DESC`).
+		WithArgs("WSP1").
+		WillReturnRows(rows)
+
+	invitations, err := repo.GetInvitations(context.Background(), "WSP1")
+	c.NoError(err)
+	c.Equal(domain.Accepted, invitations[0].Status)
+}
+
+func TestUpdateInvitationStatus(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`UPDATE workspace_invitations SET status = \$2 WHERE id = \$1 AND status = \$3`).
+			WithArgs("INV1", "accepted", "pending").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		c.NoError(repo.UpdateInvitationStatus(context.Background(), "INV1", domain.Accepted))
+	})
+
+	t.Run("already answered", func(t *testing.T) {
+		repo, mock := setupMockDB(t)
+
+		mock.ExpectExec(`UPDATE workspace_invitations`).
+			WithArgs("INV1", "accepted", "pending").
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := repo.UpdateInvitationStatus(context.Background(), "INV1", domain.Accepted)
+		require.ErrorIs(t, err, ErrInvitationNotFound)
+	})
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import "context"
+
+type workspaceKey struct{}
+
+// WithWorkspace returns a copy of ctx where the ledgers are read and written in the workspace,
+// instead of the personal ones of the account making the request.
+func WithWorkspace(ctx context.Context, workspaceID string) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, workspaceID)
+}
+
+// WorkspaceFromContext returns the workspace set with WithWorkspace, empty when there is none.
+func WorkspaceFromContext(ctx context.Context) string {
+	workspaceID, _ := ctx.Value(workspaceKey{}).(string)
+
+	return workspaceID
+}
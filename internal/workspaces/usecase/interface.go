@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	"transaction-tracker/internal/workspaces/domain"
+)
+
+// WorkspacesUsecase manages the ledgers shared by several accounts and decides what each of
+// their members can do.
+type WorkspacesUsecase interface {
+	CreateWorkspace(ctx context.Context, owner *accountsDomain.Account, name string) (*domain.Workspace, error)
+	GetWorkspaces(ctx context.Context, accountID string) ([]*domain.Membership, error)
+	Authorize(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) (*domain.Member, error)
+	GetMembers(ctx context.Context, workspaceID string, accountID string) ([]*domain.Member, error)
+	GetAccountIDs(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) ([]string, error)
+	UpdateMemberRole(ctx context.Context, workspaceID string, actorID string, memberID string, role domain.Role) (*domain.Member, error)
+	RemoveMember(ctx context.Context, workspaceID string, actorID string, memberID string) error
+	Invite(ctx context.Context, workspaceID string, actorID string, email string, role domain.Role) (*domain.Invitation, error)
+	GetInvitations(ctx context.Context, workspaceID string, actorID string) ([]*domain.Invitation, error)
+	RevokeInvitation(ctx context.Context, workspaceID string, actorID string, invitationID string) error
+	GetPendingInvitations(ctx context.Context, account *accountsDomain.Account) ([]*domain.Invitation, error)
+	AcceptInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) (*domain.Member, error)
+	DeclineInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) error
+}
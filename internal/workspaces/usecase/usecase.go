@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	"transaction-tracker/internal/workspaces/domain"
+	"transaction-tracker/internal/workspaces/repository"
+	"transaction-tracker/pkg/databases/postgres"
+)
+
+var (
+	ErrWorkspaceNotFound  = repository.ErrWorkspaceNotFound
+	ErrMemberNotFound     = repository.ErrMemberNotFound
+	ErrInvitationNotFound = repository.ErrInvitationNotFound
+	ErrAlreadyMember      = repository.ErrAlreadyMember
+	ErrAlreadyInvited     = repository.ErrAlreadyInvited
+	ErrForbidden          = domain.ErrForbidden
+)
+
+type workspacesUsecase struct {
+	repo          repository.WorkspaceRepository
+	transactor    postgres.Transactor
+	eventsUsecase eventsUsecase.EventsUsecase
+	nowFunc       func() time.Time
+}
+
+// NewWorkspacesUsecase creates a new instance of WorkspacesUsecase. Invitations are emitted in
+// evUsecase, so they can be delivered by email.
+func NewWorkspacesUsecase(repo repository.WorkspaceRepository, transactor postgres.Transactor, evUsecase eventsUsecase.EventsUsecase) WorkspacesUsecase {
+	return &workspacesUsecase{
+		repo:          repo,
+		transactor:    transactor,
+		eventsUsecase: evUsecase,
+		nowFunc:       time.Now,
+	}
+}
+
+// CreateWorkspace creates a workspace with the account as its owner.
+func (u *workspacesUsecase) CreateWorkspace(ctx context.Context, owner *accountsDomain.Account, name string) (*domain.Workspace, error) {
+	workspace, err := domain.NewWorkspace(owner.ID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	member := &domain.Member{
+		WorkspaceID: workspace.ID,
+		AccountID:   owner.ID,
+		Email:       strings.ToLower(owner.Email),
+		Role:        domain.Owner,
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return u.repo.CreateWorkspace(ctx, workspace, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// GetWorkspaces returns the workspaces the account is a member of.
+func (u *workspacesUsecase) GetWorkspaces(ctx context.Context, accountID string) ([]*domain.Membership, error) {
+	return u.repo.GetMemberships(ctx, accountID)
+}
+
+// Authorize returns the membership of the account in the workspace when its role allows the
+// permission. Accounts that are not members get ErrWorkspaceNotFound, so they can't tell
+// whether the workspace exists.
+func (u *workspacesUsecase) Authorize(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) (*domain.Member, error) {
+	member, err := u.repo.GetMember(ctx, workspaceID, accountID)
+	if errors.Is(err, ErrMemberNotFound) {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !member.Role.Can(permission) {
+		return nil, fmt.Errorf("%w: %s members can't %s the workspace", ErrForbidden, member.Role, permission)
+	}
+
+	return member, nil
+}
+
+// GetMembers returns the members of a workspace the account belongs to.
+func (u *workspacesUsecase) GetMembers(ctx context.Context, workspaceID string, accountID string) ([]*domain.Member, error) {
+	_, err := u.Authorize(ctx, workspaceID, accountID, domain.Read)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.GetMembers(ctx, workspaceID)
+}
+
+// GetAccountIDs returns the accounts of the members of the workspace, whose data make up its
+// ledger, when the role of the account allows the permission.
+func (u *workspacesUsecase) GetAccountIDs(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) ([]string, error) {
+	_, err := u.Authorize(ctx, workspaceID, accountID, permission)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.repo.GetMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		accountIDs = append(accountIDs, member.AccountID)
+	}
+
+	return accountIDs, nil
+}
+
+// UpdateMemberRole changes what a member can do. Only the owner can do it, and the owner's own
+// role can't change.
+func (u *workspacesUsecase) UpdateMemberRole(ctx context.Context, workspaceID string, actorID string, memberID string, role domain.Role) (*domain.Member, error) {
+	if role != domain.Editor && role != domain.Viewer {
+		return nil, fmt.Errorf("%w: role %q must be editor or viewer", domain.ErrInvalidRole, role)
+	}
+
+	_, err := u.Authorize(ctx, workspaceID, actorID, domain.Manage)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := u.repo.GetMember(ctx, workspaceID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Role == domain.Owner {
+		return nil, fmt.Errorf("%w: the role of the owner can't change", ErrForbidden)
+	}
+
+	err = u.repo.UpdateMemberRole(ctx, workspaceID, memberID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Role = role
+
+	return member, nil
+}
+
+// RemoveMember takes a member out of a workspace. The owner can remove anyone else and the
+// other members can only leave; the owner can't.
+func (u *workspacesUsecase) RemoveMember(ctx context.Context, workspaceID string, actorID string, memberID string) error {
+	permission := domain.Manage
+	if actorID == memberID {
+		permission = domain.Read
+	}
+
+	_, err := u.Authorize(ctx, workspaceID, actorID, permission)
+	if err != nil {
+		return err
+	}
+
+	member, err := u.repo.GetMember(ctx, workspaceID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == domain.Owner {
+		return fmt.Errorf("%w: the owner can't leave the workspace", ErrForbidden)
+	}
+
+	return u.repo.RemoveMember(ctx, workspaceID, memberID)
+}
+
+// Invite invites an email to join the workspace with the role. Only the owner can invite, and
+// the invitation is emitted so it is sent to the email.
+func (u *workspacesUsecase) Invite(ctx context.Context, workspaceID string, actorID string, email string, role domain.Role) (*domain.Invitation, error) {
+	_, err := u.Authorize(ctx, workspaceID, actorID, domain.Manage)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := domain.NewInvitation(workspaceID, email, role, actorID, u.nowFunc())
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := u.repo.GetMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if strings.EqualFold(member.Email, invitation.Email) {
+			return nil, ErrAlreadyMember
+		}
+	}
+
+	workspace, err := u.repo.GetWorkspaceByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.repo.CreateInvitation(ctx, invitation)
+		if err != nil {
+			return err
+		}
+
+		return u.eventsUsecase.Emit(ctx, eventsDomain.WorkspaceInvitationCreated, workspace.ID, invitation.ID, eventsDomain.WorkspaceInvitationPayload{
+			ID:            invitation.ID,
+			WorkspaceID:   workspace.ID,
+			WorkspaceName: workspace.Name,
+			Email:         invitation.Email,
+			Role:          string(invitation.Role),
+			InvitedBy:     invitation.InvitedBy,
+			ExpiresAt:     invitation.ExpiresAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// GetInvitations returns the invitations sent to join the workspace. Only the owner can see
+// them.
+func (u *workspacesUsecase) GetInvitations(ctx context.Context, workspaceID string, actorID string) ([]*domain.Invitation, error) {
+	_, err := u.Authorize(ctx, workspaceID, actorID, domain.Manage)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.repo.GetInvitations(ctx, workspaceID)
+}
+
+// RevokeInvitation cancels a pending invitation to the workspace.
+func (u *workspacesUsecase) RevokeInvitation(ctx context.Context, workspaceID string, actorID string, invitationID string) error {
+	_, err := u.Authorize(ctx, workspaceID, actorID, domain.Manage)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := u.repo.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+
+	if invitation.WorkspaceID != workspaceID {
+		return ErrInvitationNotFound
+	}
+
+	return u.repo.UpdateInvitationStatus(ctx, invitationID, domain.Revoked)
+}
+
+// GetPendingInvitations returns the invitations sent to the email of the account that can
+// still be answered.
+func (u *workspacesUsecase) GetPendingInvitations(ctx context.Context, account *accountsDomain.Account) ([]*domain.Invitation, error) {
+	invitations, err := u.repo.GetPendingInvitationsByEmail(ctx, strings.ToLower(account.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.nowFunc()
+
+	open := []*domain.Invitation{}
+	for _, invitation := range invitations {
+		if invitation.Open(now) {
+			open = append(open, invitation)
+		}
+	}
+
+	return open, nil
+}
+
+// AcceptInvitation makes the account a member of the workspace it was invited to, with the
+// role of the invitation. Only invitations sent to the email of the account can be accepted.
+func (u *workspacesUsecase) AcceptInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) (*domain.Member, error) {
+	invitation, err := u.openInvitation(ctx, invitationID, account)
+	if err != nil {
+		return nil, err
+	}
+
+	member := &domain.Member{
+		WorkspaceID: invitation.WorkspaceID,
+		AccountID:   account.ID,
+		Email:       invitation.Email,
+		Role:        invitation.Role,
+	}
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		err := u.repo.UpdateInvitationStatus(ctx, invitation.ID, domain.Accepted)
+		if err != nil {
+			return err
+		}
+
+		return u.repo.AddMember(ctx, member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// DeclineInvitation turns down an invitation sent to the email of the account.
+func (u *workspacesUsecase) DeclineInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) error {
+	invitation, err := u.openInvitation(ctx, invitationID, account)
+	if err != nil {
+		return err
+	}
+
+	return u.repo.UpdateInvitationStatus(ctx, invitation.ID, domain.Declined)
+}
+
+// openInvitation returns an invitation sent to the email of the account that can still be
+// answered. Invitations sent to other emails are not found.
+func (u *workspacesUsecase) openInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) (*domain.Invitation, error) {
+	invitation, err := u.repo.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(invitation.Email, account.Email) {
+		return nil, ErrInvitationNotFound
+	}
+
+	if !invitation.Open(u.nowFunc()) {
+		return nil, fmt.Errorf("%w: the invitation expired or was already answered", domain.ErrInvalidInvitation)
+	}
+
+	return invitation, nil
+}
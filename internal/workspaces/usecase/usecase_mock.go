@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	"transaction-tracker/internal/workspaces/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWorkspacesUsecase is a mock implementation of the WorkspacesUsecase interface.
+type MockWorkspacesUsecase struct {
+	mock.Mock
+}
+
+func (m *MockWorkspacesUsecase) CreateWorkspace(ctx context.Context, owner *accountsDomain.Account, name string) (*domain.Workspace, error) {
+	args := m.Called(ctx, owner, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Workspace), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) GetWorkspaces(ctx context.Context, accountID string) ([]*domain.Membership, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Membership), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) Authorize(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) (*domain.Member, error) {
+	args := m.Called(ctx, workspaceID, accountID, permission)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) GetMembers(ctx context.Context, workspaceID string, accountID string) ([]*domain.Member, error) {
+	args := m.Called(ctx, workspaceID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) GetAccountIDs(ctx context.Context, workspaceID string, accountID string, permission domain.Permission) ([]string, error) {
+	args := m.Called(ctx, workspaceID, accountID, permission)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) UpdateMemberRole(ctx context.Context, workspaceID string, actorID string, memberID string, role domain.Role) (*domain.Member, error) {
+	args := m.Called(ctx, workspaceID, actorID, memberID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) RemoveMember(ctx context.Context, workspaceID string, actorID string, memberID string) error {
+	args := m.Called(ctx, workspaceID, actorID, memberID)
+	return args.Error(0)
+}
+
+func (m *MockWorkspacesUsecase) Invite(ctx context.Context, workspaceID string, actorID string, email string, role domain.Role) (*domain.Invitation, error) {
+	args := m.Called(ctx, workspaceID, actorID, email, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) GetInvitations(ctx context.Context, workspaceID string, actorID string) ([]*domain.Invitation, error) {
+	args := m.Called(ctx, workspaceID, actorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) RevokeInvitation(ctx context.Context, workspaceID string, actorID string, invitationID string) error {
+	args := m.Called(ctx, workspaceID, actorID, invitationID)
+	return args.Error(0)
+}
+
+func (m *MockWorkspacesUsecase) GetPendingInvitations(ctx context.Context, account *accountsDomain.Account) ([]*domain.Invitation, error) {
+	args := m.Called(ctx, account)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Invitation), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) AcceptInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) (*domain.Member, error) {
+	args := m.Called(ctx, invitationID, account)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Member), args.Error(1)
+}
+
+func (m *MockWorkspacesUsecase) DeclineInvitation(ctx context.Context, invitationID string, account *accountsDomain.Account) error {
+	args := m.Called(ctx, invitationID, account)
+	return args.Error(0)
+}
@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	accountsDomain "transaction-tracker/internal/accounts/domain"
+	eventsDomain "transaction-tracker/internal/events/domain"
+	eventsUsecase "transaction-tracker/internal/events/usecase"
+	"transaction-tracker/internal/workspaces/domain"
+	"transaction-tracker/internal/workspaces/repository"
+	"transaction-tracker/pkg/databases/postgres"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedTime = time.Date(2025, 9, 20, 12, 0, 0, 0, time.UTC)
+
+func newUsecase(repo repository.WorkspaceRepository, events eventsUsecase.EventsUsecase) *workspacesUsecase {
+	return &workspacesUsecase{
+		repo:          repo,
+		transactor:    postgres.NewMockTransactor(),
+		eventsUsecase: events,
+		nowFunc:       func() time.Time { return fixedTime },
+	}
+}
+
+func newMember(accountID string, role domain.Role) *domain.Member {
+	return &domain.Member{WorkspaceID: "WSP1", AccountID: accountID, Email: accountID + "@example.com", Role: role}
+}
+
+func newInvitation() *domain.Invitation {
+	return &domain.Invitation{
+		ID:          "INV1",
+		WorkspaceID: "WSP1",
+		Email:       "ana@example.com",
+		Role:        domain.Editor,
+		InvitedBy:   "owner",
+		Status:      domain.Pending,
+		CreatedAt:   fixedTime,
+		ExpiresAt:   fixedTime.Add(domain.InvitationTTL),
+	}
+}
+
+func TestCreateWorkspace(t *testing.T) {
+	c := require.New(t)
+
+	repo := new(repository.MockWorkspaceRepository)
+	repo.On("CreateWorkspace", mock.Anything, mock.AnythingOfType("*domain.Workspace"), mock.MatchedBy(func(m *domain.Member) bool {
+		return m.AccountID == "owner" && m.Role == domain.Owner && m.Email == "owner@example.com"
+	})).Return(nil)
+
+	workspace, err := newUsecase(repo, nil).CreateWorkspace(context.Background(), &accountsDomain.Account{ID: "owner", Email: "Owner@example.com"}, "Casa")
+	c.NoError(err)
+	c.Equal("Casa", workspace.Name)
+	c.Equal("owner", workspace.OwnerID)
+	repo.AssertExpectations(t)
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name       string
+		member     *domain.Member
+		memberErr  error
+		permission domain.Permission
+		err        error
+	}{
+		{name: "viewer reads", member: newMember("acc2", domain.Viewer), permission: domain.Read},
+		{name: "viewer writes", member: newMember("acc2", domain.Viewer), permission: domain.Write, err: ErrForbidden},
+		{name: "editor writes", member: newMember("acc2", domain.Editor), permission: domain.Write},
+		{name: "editor manages", member: newMember("acc2", domain.Editor), permission: domain.Manage, err: ErrForbidden},
+		{name: "owner manages", member: newMember("acc2", domain.Owner), permission: domain.Manage},
+		{name: "not a member", memberErr: ErrMemberNotFound, permission: domain.Read, err: ErrWorkspaceNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := require.New(t)
+
+			repo := new(repository.MockWorkspaceRepository)
+			if tt.memberErr != nil {
+				repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(nil, tt.memberErr)
+			} else {
+				repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(tt.member, nil)
+			}
+
+			member, err := newUsecase(repo, nil).Authorize(context.Background(), "WSP1", "acc2", tt.permission)
+			if tt.err != nil {
+				c.ErrorIs(err, tt.err)
+				return
+			}
+
+			c.NoError(err)
+			c.Equal("acc2", member.AccountID)
+		})
+	}
+}
+
+func TestGetAccountIDs(t *testing.T) {
+	t.Run("members of the workspace", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Viewer), nil)
+		repo.On("GetMembers", mock.Anything, "WSP1").Return([]*domain.Member{newMember("owner", domain.Owner), newMember("acc2", domain.Viewer)}, nil)
+
+		accountIDs, err := newUsecase(repo, nil).GetAccountIDs(context.Background(), "WSP1", "acc2", domain.Read)
+		c.NoError(err)
+		c.Equal([]string{"owner", "acc2"}, accountIDs)
+	})
+
+	t.Run("role not allowed", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Viewer), nil)
+
+		_, err := newUsecase(repo, nil).GetAccountIDs(context.Background(), "WSP1", "acc2", domain.Write)
+		c.ErrorIs(err, ErrForbidden)
+		repo.AssertNotCalled(t, "GetMembers", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWithWorkspace(t *testing.T) {
+	c := require.New(t)
+
+	c.Empty(WorkspaceFromContext(context.Background()))
+	c.Equal("WSP1", WorkspaceFromContext(WithWorkspace(context.Background(), "WSP1")))
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Editor), nil)
+		repo.On("UpdateMemberRole", mock.Anything, "WSP1", "acc2", domain.Viewer).Return(nil)
+
+		member, err := newUsecase(repo, nil).UpdateMemberRole(context.Background(), "WSP1", "owner", "acc2", domain.Viewer)
+		c.NoError(err)
+		c.Equal(domain.Viewer, member.Role)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("editor can't manage", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Editor), nil)
+
+		_, err := newUsecase(repo, nil).UpdateMemberRole(context.Background(), "WSP1", "acc2", "acc3", domain.Viewer)
+		require.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("owner role can't change", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+
+		_, err := newUsecase(repo, nil).UpdateMemberRole(context.Background(), "WSP1", "owner", "owner", domain.Viewer)
+		require.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+
+		_, err := newUsecase(repo, nil).UpdateMemberRole(context.Background(), "WSP1", "owner", "acc2", domain.Owner)
+		require.ErrorIs(t, err, domain.ErrInvalidRole)
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Run("member leaves", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Viewer), nil)
+		repo.On("RemoveMember", mock.Anything, "WSP1", "acc2").Return(nil)
+
+		c.NoError(newUsecase(repo, nil).RemoveMember(context.Background(), "WSP1", "acc2", "acc2"))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("viewer can't remove others", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Viewer), nil)
+
+		err := newUsecase(repo, nil).RemoveMember(context.Background(), "WSP1", "acc2", "acc3")
+		require.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("owner can't leave", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+
+		err := newUsecase(repo, nil).RemoveMember(context.Background(), "WSP1", "owner", "owner")
+		require.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestInvite(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+		repo.On("GetMembers", mock.Anything, "WSP1").Return([]*domain.Member{newMember("owner", domain.Owner)}, nil)
+		repo.On("GetWorkspaceByID", mock.Anything, "WSP1").Return(&domain.Workspace{ID: "WSP1", Name: "Casa", OwnerID: "owner"}, nil)
+		repo.On("CreateInvitation", mock.Anything, mock.AnythingOfType("*domain.Invitation")).Return(nil)
+
+		events := new(eventsUsecase.MockEventsUsecase)
+		events.On("Emit", mock.Anything, eventsDomain.WorkspaceInvitationCreated, "WSP1", mock.Anything, mock.MatchedBy(func(p eventsDomain.WorkspaceInvitationPayload) bool {
+			return p.Email == "ana@example.com" && p.WorkspaceName == "Casa" && p.Role == "editor"
+		})).Return(nil)
+
+		invitation, err := newUsecase(repo, events).Invite(context.Background(), "WSP1", "owner", "Ana@example.com", domain.Editor)
+		c.NoError(err)
+		c.Equal("ana@example.com", invitation.Email)
+		c.Equal(fixedTime.Add(domain.InvitationTTL), invitation.ExpiresAt)
+		repo.AssertExpectations(t)
+		events.AssertExpectations(t)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+		repo.On("GetMembers", mock.Anything, "WSP1").Return([]*domain.Member{newMember("owner", domain.Owner), newMember("ana", domain.Viewer)}, nil)
+
+		_, err := newUsecase(repo, nil).Invite(context.Background(), "WSP1", "owner", "ana@example.com", domain.Editor)
+		require.ErrorIs(t, err, ErrAlreadyMember)
+	})
+
+	t.Run("editor can't invite", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "acc2").Return(newMember("acc2", domain.Editor), nil)
+
+		_, err := newUsecase(repo, nil).Invite(context.Background(), "WSP1", "acc2", "ana@example.com", domain.Editor)
+		require.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestGetPendingInvitations(t *testing.T) {
+	c := require.New(t)
+
+	expired := newInvitation()
+	expired.ID = "INV2"
+	expired.ExpiresAt = fixedTime.Add(-time.Hour)
+
+	repo := new(repository.MockWorkspaceRepository)
+	repo.On("GetPendingInvitationsByEmail", mock.Anything, "ana@example.com").Return([]*domain.Invitation{newInvitation(), expired}, nil)
+
+	invitations, err := newUsecase(repo, nil).GetPendingInvitations(context.Background(), &accountsDomain.Account{ID: "acc2", Email: "Ana@example.com"})
+	c.NoError(err)
+	c.Len(invitations, 1)
+	c.Equal("INV1", invitations[0].ID)
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	account := &accountsDomain.Account{ID: "acc2", Email: "ana@example.com"}
+
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetInvitationByID", mock.Anything, "INV1").Return(newInvitation(), nil)
+		repo.On("UpdateInvitationStatus", mock.Anything, "INV1", domain.Accepted).Return(nil)
+		repo.On("AddMember", mock.Anything, mock.MatchedBy(func(m *domain.Member) bool {
+			return m.WorkspaceID == "WSP1" && m.AccountID == "acc2" && m.Role == domain.Editor
+		})).Return(nil)
+
+		member, err := newUsecase(repo, nil).AcceptInvitation(context.Background(), "INV1", account)
+		c.NoError(err)
+		c.Equal(domain.Editor, member.Role)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("sent to another email", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetInvitationByID", mock.Anything, "INV1").Return(newInvitation(), nil)
+
+		_, err := newUsecase(repo, nil).AcceptInvitation(context.Background(), "INV1", &accountsDomain.Account{ID: "acc3", Email: "luis@example.com"})
+		require.ErrorIs(t, err, ErrInvitationNotFound)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		invitation := newInvitation()
+		invitation.ExpiresAt = fixedTime.Add(-time.Hour)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetInvitationByID", mock.Anything, "INV1").Return(invitation, nil)
+
+		_, err := newUsecase(repo, nil).AcceptInvitation(context.Background(), "INV1", account)
+		require.ErrorIs(t, err, domain.ErrInvalidInvitation)
+	})
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		c := require.New(t)
+
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP1", "owner").Return(newMember("owner", domain.Owner), nil)
+		repo.On("GetInvitationByID", mock.Anything, "INV1").Return(newInvitation(), nil)
+		repo.On("UpdateInvitationStatus", mock.Anything, "INV1", domain.Revoked).Return(nil)
+
+		c.NoError(newUsecase(repo, nil).RevokeInvitation(context.Background(), "WSP1", "owner", "INV1"))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("other workspace", func(t *testing.T) {
+		repo := new(repository.MockWorkspaceRepository)
+		repo.On("GetMember", mock.Anything, "WSP2", "owner").Return(&domain.Member{WorkspaceID: "WSP2", AccountID: "owner", Role: domain.Owner}, nil)
+		repo.On("GetInvitationByID", mock.Anything, "INV1").Return(newInvitation(), nil)
+
+		err := newUsecase(repo, nil).RevokeInvitation(context.Background(), "WSP2", "owner", "INV1")
+		require.ErrorIs(t, err, ErrInvitationNotFound)
+	})
+}
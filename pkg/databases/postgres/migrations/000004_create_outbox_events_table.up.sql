@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id              VARCHAR(255) PRIMARY KEY,
+    type            VARCHAR(100) NOT NULL,
+    version         INTEGER NOT NULL,
+    account_id      VARCHAR(255) NOT NULL,
+    aggregate_id    VARCHAR(255) NOT NULL,
+    payload         JSONB NOT NULL,
+    occurred_at     TIMESTAMP WITH TIME ZONE NOT NULL,
+    published_at    TIMESTAMP WITH TIME ZONE,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    last_error      TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (occurred_at) WHERE published_at IS NULL;
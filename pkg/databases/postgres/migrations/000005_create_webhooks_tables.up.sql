@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    url             TEXT NOT NULL,
+    secret          VARCHAR(255) NOT NULL,
+    event_types     TEXT[] NOT NULL,
+    active          BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_account_id ON webhooks (account_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              VARCHAR(255) PRIMARY KEY,
+    webhook_id      VARCHAR(255) NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    account_id      VARCHAR(255) NOT NULL,
+    event_id        VARCHAR(255) NOT NULL,
+    event_type      VARCHAR(100) NOT NULL,
+    payload         JSONB NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_outbox_events_account_occurred_at ON outbox_events (account_id, occurred_at, id) WHERE published_at IS NOT NULL;
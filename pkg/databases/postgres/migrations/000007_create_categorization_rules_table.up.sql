@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS categorization_rules (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    name            VARCHAR(255) NOT NULL,
+    priority        INTEGER NOT NULL DEFAULT 0,
+    conditions      JSONB NOT NULL,
+    category        VARCHAR(100) NOT NULL,
+    active          BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rules_account_priority ON categorization_rules (account_id, priority, created_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS categories (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    slug            VARCHAR(100) NOT NULL,
+    name            VARCHAR(100) NOT NULL,
+    parent_id       VARCHAR(255) REFERENCES categories (id) ON DELETE RESTRICT,
+    icon            VARCHAR(100) NOT NULL,
+    color           VARCHAR(7) NOT NULL,
+    system          BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (account_id, slug)
+);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS category_feedback;
+
+ALTER TABLE movements
+DROP COLUMN IF EXISTS category_confidence,
+DROP COLUMN IF EXISTS category_source;
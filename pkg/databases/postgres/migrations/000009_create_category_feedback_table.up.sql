@@ -0,0 +1,18 @@
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS category_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+ADD COLUMN IF NOT EXISTS category_source VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS category_feedback (
+    id                   VARCHAR(255) PRIMARY KEY,
+    account_id           VARCHAR(255) NOT NULL,
+    movement_id          VARCHAR(255) NOT NULL,
+    description          TEXT NOT NULL,
+    predicted_category   VARCHAR(100) NOT NULL,
+    predicted_confidence DOUBLE PRECISION NOT NULL,
+    predicted_source     VARCHAR(50) NOT NULL,
+    corrected_category   VARCHAR(100) NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_feedback_account_id ON category_feedback (account_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_category_feedback_movement_id ON category_feedback (movement_id, created_at DESC);
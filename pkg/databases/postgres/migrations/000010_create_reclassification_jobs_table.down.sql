@@ -0,0 +1 @@
+DROP TABLE IF EXISTS reclassification_jobs;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS reclassification_jobs (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    filter          JSONB NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    total           INTEGER NOT NULL DEFAULT 0,
+    processed       INTEGER NOT NULL DEFAULT 0,
+    changed         INTEGER NOT NULL DEFAULT 0,
+    skipped         INTEGER NOT NULL DEFAULT 0,
+    error           TEXT NOT NULL DEFAULT '',
+    started_at      TIMESTAMP WITH TIME ZONE,
+    finished_at     TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reclassification_jobs_account_created ON reclassification_jobs (account_id, created_at DESC);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_movements_account_merchant;
+
+ALTER TABLE movements
+DROP COLUMN IF EXISTS merchant_id;
+
+DROP TABLE IF EXISTS merchant_aliases;
+DROP TABLE IF EXISTS merchants;
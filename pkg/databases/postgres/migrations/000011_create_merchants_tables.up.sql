@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS merchants (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    name            VARCHAR(255) NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS merchant_aliases (
+    account_id      VARCHAR(255) NOT NULL,
+    alias           VARCHAR(255) NOT NULL,
+    merchant_id     VARCHAR(255) NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (account_id, alias)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_aliases_merchant_id ON merchant_aliases (merchant_id);
+
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS merchant_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_movements_account_merchant ON movements (account_id, merchant_id);
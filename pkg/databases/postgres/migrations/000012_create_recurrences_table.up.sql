@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS recurrences (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    merchant_id     VARCHAR(255) NOT NULL REFERENCES merchants (id) ON DELETE CASCADE,
+    name            VARCHAR(255) NOT NULL,
+    period          VARCHAR(20) NOT NULL,
+    amount          DECIMAL(10, 2) NOT NULL,
+    previous_amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    occurrences     INTEGER NOT NULL,
+    first_charge_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_charge_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    next_charge_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    status          VARCHAR(20) NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (account_id, merchant_id, period)
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurrences_account_next_charge ON recurrences (account_id, next_charge_at);
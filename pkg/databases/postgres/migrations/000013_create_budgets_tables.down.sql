@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_movements_account_category_date;
+
+DROP TABLE IF EXISTS budget_alerts;
+DROP TABLE IF EXISTS budgets;
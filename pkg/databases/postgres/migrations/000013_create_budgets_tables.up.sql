@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS budgets (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    category        VARCHAR(255) NOT NULL,
+    month           DATE NOT NULL,
+    amount          DECIMAL(10, 2) NOT NULL,
+    rollover        BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (account_id, category, month)
+);
+
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    budget_id       VARCHAR(255) NOT NULL REFERENCES budgets (id) ON DELETE CASCADE,
+    threshold       INTEGER NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (budget_id, threshold)
+);
+
+CREATE INDEX IF NOT EXISTS idx_movements_account_category_date ON movements (account_id, category, date);
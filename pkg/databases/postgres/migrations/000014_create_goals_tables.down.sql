@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS goal_allocations;
+DROP TABLE IF EXISTS goals;
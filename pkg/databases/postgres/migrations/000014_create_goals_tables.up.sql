@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS goals (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    name            VARCHAR(100) NOT NULL,
+    target_amount   DECIMAL(10, 2) NOT NULL,
+    target_date     DATE NOT NULL,
+    category        VARCHAR(255) NOT NULL DEFAULT '',
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_goals_account_id ON goals (account_id);
+
+-- A category feeds a single goal, so no movement is counted twice.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_goals_account_category ON goals (account_id, category) WHERE category <> '';
+
+CREATE TABLE IF NOT EXISTS goal_allocations (
+    movement_id     VARCHAR(255) PRIMARY KEY REFERENCES movements (id) ON DELETE CASCADE,
+    goal_id         VARCHAR(255) NOT NULL REFERENCES goals (id) ON DELETE CASCADE,
+    account_id      VARCHAR(255) NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_goal_allocations_goal_id ON goal_allocations (goal_id);
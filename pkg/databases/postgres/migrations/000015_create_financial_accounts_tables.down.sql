@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_movements_account_financial_account;
+
+ALTER TABLE movements
+DROP COLUMN IF EXISTS financial_account_id;
+
+DROP TABLE IF EXISTS balance_anchors;
+DROP TABLE IF EXISTS financial_accounts;
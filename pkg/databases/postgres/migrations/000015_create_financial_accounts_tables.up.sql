@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS financial_accounts (
+    id              VARCHAR(255) PRIMARY KEY,
+    account_id      VARCHAR(255) NOT NULL,
+    institution_id  VARCHAR(255) NOT NULL,
+    name            VARCHAR(100) NOT NULL,
+    kind            VARCHAR(50) NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_financial_accounts_account_institution ON financial_accounts (account_id, institution_id);
+
+-- Balances can outgrow the amount of a single movement.
+CREATE TABLE IF NOT EXISTS balance_anchors (
+    financial_account_id VARCHAR(255) NOT NULL REFERENCES financial_accounts (id) ON DELETE CASCADE,
+    date            TIMESTAMP WITH TIME ZONE NOT NULL,
+    account_id      VARCHAR(255) NOT NULL,
+    balance         DECIMAL(14, 2) NOT NULL,
+    source          VARCHAR(50) NOT NULL,
+    extract_id      VARCHAR(255) NOT NULL DEFAULT '',
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (financial_account_id, date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_anchors_account_id ON balance_anchors (account_id);
+
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS financial_account_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_movements_account_financial_account ON movements (account_id, financial_account_id, date);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_movements_account_transfer;
+
+ALTER TABLE movements
+DROP COLUMN IF EXISTS transfer_id;
+
+DROP TABLE IF EXISTS transfers;
@@ -0,0 +1,17 @@
+-- A movement is one side of at most one transfer. Deleting either side removes the transfer.
+CREATE TABLE IF NOT EXISTS transfers (
+    id                  VARCHAR(255) PRIMARY KEY,
+    account_id          VARCHAR(255) NOT NULL,
+    outflow_movement_id VARCHAR(255) NOT NULL UNIQUE REFERENCES movements (id) ON DELETE CASCADE,
+    inflow_movement_id  VARCHAR(255) NOT NULL UNIQUE REFERENCES movements (id) ON DELETE CASCADE,
+    amount              DECIMAL(10, 2) NOT NULL,
+    source              VARCHAR(50) NOT NULL,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_transfers_account_id ON transfers (account_id);
+
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS transfer_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_movements_account_transfer ON movements (account_id, transfer_id);
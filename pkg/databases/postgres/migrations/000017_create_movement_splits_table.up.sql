@@ -0,0 +1,13 @@
+-- The splits of a movement add up to its amount and replace its category in reports.
+CREATE TABLE IF NOT EXISTS movement_splits (
+    id          VARCHAR(255) PRIMARY KEY,
+    movement_id VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    account_id  VARCHAR(255) NOT NULL,
+    category    VARCHAR(100) NOT NULL,
+    amount      DECIMAL(10, 2) NOT NULL,
+    note        VARCHAR(255) NOT NULL DEFAULT '',
+    position    INTEGER NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_movement_splits_movement_id ON movement_splits (movement_id);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS movement_attachments;
+
+DROP INDEX IF EXISTS idx_movements_tags;
+
+ALTER TABLE movements
+DROP COLUMN IF EXISTS note,
+DROP COLUMN IF EXISTS tags;
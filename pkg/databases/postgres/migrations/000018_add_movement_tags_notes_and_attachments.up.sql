@@ -0,0 +1,19 @@
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}',
+ADD COLUMN IF NOT EXISTS note TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_movements_tags ON movements USING GIN (tags);
+
+-- Receipts of a movement. The files live in the file storage; path is where.
+CREATE TABLE IF NOT EXISTS movement_attachments (
+    id           VARCHAR(255) PRIMARY KEY,
+    movement_id  VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    account_id   VARCHAR(255) NOT NULL,
+    file_name    VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size         BIGINT NOT NULL,
+    path         TEXT NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_movement_attachments_movement_id ON movement_attachments (movement_id);
@@ -0,0 +1,12 @@
+ALTER TABLE movements
+DROP COLUMN IF EXISTS created_by;
+
+DROP INDEX IF EXISTS idx_workspace_invitations_email;
+DROP INDEX IF EXISTS idx_workspace_invitations_pending;
+
+DROP TABLE IF EXISTS workspace_invitations;
+
+DROP INDEX IF EXISTS idx_workspace_members_account_id;
+
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,38 @@
+-- A workspace is a ledger shared by its members, made of the movements of their accounts.
+CREATE TABLE IF NOT EXISTS workspaces (
+    id         VARCHAR(255) PRIMARY KEY,
+    name       VARCHAR(100) NOT NULL,
+    owner_id   VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+    workspace_id VARCHAR(255) NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    account_id   VARCHAR(255) NOT NULL,
+    email        VARCHAR(255) NOT NULL,
+    role         VARCHAR(50) NOT NULL,
+    joined_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (workspace_id, account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_members_account_id ON workspace_members (account_id);
+
+-- An email has at most one pending invitation to each workspace.
+CREATE TABLE IF NOT EXISTS workspace_invitations (
+    id           VARCHAR(255) PRIMARY KEY,
+    workspace_id VARCHAR(255) NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    email        VARCHAR(255) NOT NULL,
+    role         VARCHAR(50) NOT NULL,
+    invited_by   VARCHAR(255) NOT NULL,
+    status       VARCHAR(50) NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at   TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspace_invitations_pending ON workspace_invitations (workspace_id, email) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_workspace_invitations_email ON workspace_invitations (email, status);
+
+-- The member who added the movement, empty for personal and imported ones.
+ALTER TABLE movements
+ADD COLUMN IF NOT EXISTS created_by VARCHAR(255) NOT NULL DEFAULT '';
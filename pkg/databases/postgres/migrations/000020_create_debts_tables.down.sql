@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS settlements;
+DROP TABLE IF EXISTS debts;
+DROP TABLE IF EXISTS contacts;
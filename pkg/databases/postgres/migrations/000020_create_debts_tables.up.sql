@@ -0,0 +1,39 @@
+-- Contacts are the people an account pays for and gets paid back by.
+CREATE TABLE IF NOT EXISTS contacts (
+    id         VARCHAR(255) PRIMARY KEY,
+    account_id VARCHAR(255) NOT NULL,
+    name       VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contacts_account_name ON contacts (account_id, LOWER(name));
+
+-- A movement, or a split of it, is owed by at most one contact. split_id is not a foreign key
+-- because the splits of a movement are replaced when they are edited.
+CREATE TABLE IF NOT EXISTS debts (
+    id          VARCHAR(255) PRIMARY KEY,
+    account_id  VARCHAR(255) NOT NULL,
+    contact_id  VARCHAR(255) NOT NULL REFERENCES contacts (id) ON DELETE CASCADE,
+    movement_id VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    split_id    VARCHAR(255) NOT NULL DEFAULT '',
+    amount      DECIMAL(10, 2) NOT NULL,
+    note        VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (movement_id, split_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_debts_account_contact ON debts (account_id, contact_id);
+
+-- An income settles the debts of at most one contact.
+CREATE TABLE IF NOT EXISTS settlements (
+    id          VARCHAR(255) PRIMARY KEY,
+    account_id  VARCHAR(255) NOT NULL,
+    contact_id  VARCHAR(255) NOT NULL REFERENCES contacts (id) ON DELETE CASCADE,
+    movement_id VARCHAR(255) NOT NULL UNIQUE REFERENCES movements (id) ON DELETE CASCADE,
+    amount      DECIMAL(10, 2) NOT NULL,
+    source      VARCHAR(50) NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlements_account_contact ON settlements (account_id, contact_id);
@@ -0,0 +1,15 @@
+-- A movement is flagged at most once for each kind of anomaly.
+CREATE TABLE IF NOT EXISTS anomalies (
+    id           VARCHAR(255) PRIMARY KEY,
+    account_id   VARCHAR(255) NOT NULL,
+    movement_id  VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    kind         VARCHAR(50) NOT NULL,
+    reason       TEXT NOT NULL,
+    amount       DECIMAL(10, 2) NOT NULL,
+    status       VARCHAR(50) NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    dismissed_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (movement_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_anomalies_account_status ON anomalies (account_id, status);
@@ -0,0 +1,10 @@
+-- Pairs of movements whose transfer the user deleted, so detection doesn't pair them again.
+CREATE TABLE IF NOT EXISTS dismissed_transfers (
+    account_id          VARCHAR(255) NOT NULL,
+    outflow_movement_id VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    inflow_movement_id  VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (outflow_movement_id, inflow_movement_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dismissed_transfers_account_id ON dismissed_transfers (account_id);
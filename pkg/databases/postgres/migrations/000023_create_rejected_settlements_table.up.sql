@@ -0,0 +1,9 @@
+-- Incomes whose settlement of a contact the user deleted, so detection doesn't match them with
+-- that contact again.
+CREATE TABLE IF NOT EXISTS rejected_settlements (
+    account_id  VARCHAR(255) NOT NULL,
+    contact_id  VARCHAR(255) NOT NULL REFERENCES contacts (id) ON DELETE CASCADE,
+    movement_id VARCHAR(255) NOT NULL REFERENCES movements (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (movement_id, contact_id)
+);
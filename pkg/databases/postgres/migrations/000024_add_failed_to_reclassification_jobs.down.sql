@@ -0,0 +1,2 @@
+ALTER TABLE reclassification_jobs
+DROP COLUMN IF EXISTS failed;
@@ -0,0 +1,3 @@
+-- Movements a job could not classify because a classifier failed.
+ALTER TABLE reclassification_jobs
+ADD COLUMN IF NOT EXISTS failed INTEGER NOT NULL DEFAULT 0;
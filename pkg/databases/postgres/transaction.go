@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type txKey struct{}
+
+// Beginner is implemented by connection pools able to start a transaction.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Transactor runs a function inside a database transaction. Repositories taking part in
+// the transaction read it from the context with TxFromContext.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type transactor struct {
+	db Beginner
+}
+
+// NewTransactor creates a Transactor backed by the given pool.
+func NewTransactor(db Beginner) Transactor {
+	return &transactor{db: db}
+}
+
+// WithinTransaction commits when fn succeeds and rolls back otherwise. Nested calls join
+// the transaction already stored in the context.
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		_ = tx.Rollback(ctx)
+
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// TxFromContext returns the transaction started by WithinTransaction, if any.
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+
+	return tx, ok
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTransactor is a mock implementation of Transactor. When no error is configured
+// the function is run with the received context.
+type MockTransactor struct {
+	mock.Mock
+}
+
+// NewMockTransactor returns a MockTransactor that runs every function it receives.
+func NewMockTransactor() *MockTransactor {
+	transactor := new(MockTransactor)
+	transactor.On("WithinTransaction", mock.Anything).Return(nil)
+
+	return transactor
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+
+	return fn(ctx)
+}
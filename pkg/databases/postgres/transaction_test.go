@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithinTransaction(t *testing.T) {
+	t.Run("commit", func(t *testing.T) {
+		c := require.New(t)
+
+		pool, err := pgxmock.NewPool()
+		c.NoError(err)
+		defer pool.Close()
+
+		pool.ExpectBegin()
+		pool.ExpectExec("INSERT INTO outbox").WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		pool.ExpectCommit()
+
+		err = NewTransactor(pool).WithinTransaction(context.Background(), func(ctx context.Context) error {
+			tx, ok := TxFromContext(ctx)
+			c.True(ok)
+
+			_, err := tx.Exec(ctx, "INSERT INTO outbox")
+
+			return err
+		})
+		c.NoError(err)
+		c.NoError(pool.ExpectationsWereMet())
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		c := require.New(t)
+
+		pool, err := pgxmock.NewPool()
+		c.NoError(err)
+		defer pool.Close()
+
+		pool.ExpectBegin()
+		pool.ExpectRollback()
+
+		expectedErr := errors.New("failure")
+
+		err = NewTransactor(pool).WithinTransaction(context.Background(), func(ctx context.Context) error {
+			return expectedErr
+		})
+		c.ErrorIs(err, expectedErr)
+		c.NoError(pool.ExpectationsWereMet())
+	})
+
+	t.Run("nested joins outer transaction", func(t *testing.T) {
+		c := require.New(t)
+
+		pool, err := pgxmock.NewPool()
+		c.NoError(err)
+		defer pool.Close()
+
+		pool.ExpectBegin()
+		pool.ExpectCommit()
+
+		transactor := NewTransactor(pool)
+
+		err = transactor.WithinTransaction(context.Background(), func(ctx context.Context) error {
+			outer, _ := TxFromContext(ctx)
+
+			return transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				inner, _ := TxFromContext(ctx)
+				c.Same(outer, inner)
+
+				return nil
+			})
+		})
+		c.NoError(err)
+		c.NoError(pool.ExpectationsWereMet())
+	})
+
+	t.Run("no transaction in context", func(t *testing.T) {
+		_, ok := TxFromContext(context.Background())
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,102 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"google.golang.org/api/option"
+)
+
+// Driver identifies the broker backing the bus.
+type Driver string
+
+const (
+	// MemoryDriver keeps messages in the current process.
+	MemoryDriver Driver = "memory"
+	// GoogleDriver uses Google Cloud Pub/Sub.
+	GoogleDriver Driver = "google"
+	// NATSDriver uses NATS JetStream.
+	NATSDriver Driver = "nats"
+
+	defaultNATSStream = "transaction-tracker"
+)
+
+var (
+	// ErrUnknownDriver is returned when the configured driver is not supported.
+	ErrUnknownDriver = errors.New("unknown event bus driver")
+	// ErrMissingProjectID is returned when the Google driver has no project configured.
+	ErrMissingProjectID = errors.New("GOOGLE_PROJECT_ID must be configured to use the google event bus")
+	// ErrMissingNATSURL is returned when the NATS driver has no server configured.
+	ErrMissingNATSURL = errors.New("NATS_URL must be configured to use the nats event bus")
+
+	memoryOnce sync.Once
+	memory     Bus
+)
+
+// Config holds the settings needed to build a bus.
+type Config struct {
+	Driver                Driver
+	GoogleProjectID       string
+	GoogleCredentialsFile string
+	NATSURL               string
+	NATSStream            string
+}
+
+// ConfigFromEnv reads the bus configuration from the environment.
+// EVENT_BUS_DRIVER defaults to the in-memory driver.
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Driver:                Driver(os.Getenv("EVENT_BUS_DRIVER")),
+		GoogleProjectID:       os.Getenv("GOOGLE_PROJECT_ID"),
+		GoogleCredentialsFile: os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"),
+		NATSURL:               os.Getenv("NATS_URL"),
+		NATSStream:            os.Getenv("NATS_STREAM"),
+	}
+
+	if cfg.Driver == "" {
+		cfg.Driver = MemoryDriver
+	}
+
+	if cfg.NATSStream == "" {
+		cfg.NATSStream = defaultNATSStream
+	}
+
+	return cfg
+}
+
+// New creates the bus selected by the configuration. The memory driver returns a
+// process-wide instance so publishers and subscribers of the same binary share it.
+func New(ctx context.Context, cfg Config) (Bus, error) {
+	switch cfg.Driver {
+	case MemoryDriver:
+		memoryOnce.Do(func() {
+			memory = NewMemoryBus(MemoryOptions{})
+		})
+
+		return memory, nil
+
+	case GoogleDriver:
+		if cfg.GoogleProjectID == "" {
+			return nil, ErrMissingProjectID
+		}
+
+		opts := []option.ClientOption{}
+		if cfg.GoogleCredentialsFile != "" {
+			opts = append(opts, option.WithCredentialsFile(cfg.GoogleCredentialsFile))
+		}
+
+		return NewGooglePubSubBus(ctx, cfg.GoogleProjectID, opts...)
+
+	case NATSDriver:
+		if cfg.NATSURL == "" {
+			return nil, ErrMissingNATSURL
+		}
+
+		return NewNATSBus(ctx, cfg.NATSURL, cfg.NATSStream)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownDriver, cfg.Driver)
+}
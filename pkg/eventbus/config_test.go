@@ -0,0 +1,71 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		c := require.New(t)
+
+		t.Setenv("EVENT_BUS_DRIVER", "")
+		t.Setenv("NATS_STREAM", "")
+
+		cfg := ConfigFromEnv()
+		c.Equal(MemoryDriver, cfg.Driver)
+		c.Equal(defaultNATSStream, cfg.NATSStream)
+	})
+
+	t.Run("custom values", func(t *testing.T) {
+		c := require.New(t)
+
+		t.Setenv("EVENT_BUS_DRIVER", "nats")
+		t.Setenv("GOOGLE_PROJECT_ID", "project")
+		t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "key.json")
+		t.Setenv("NATS_URL", "nats://localhost:4222")
+		t.Setenv("NATS_STREAM", "events")
+
+		cfg := ConfigFromEnv()
+		c.Equal(Config{
+			Driver:                NATSDriver,
+			GoogleProjectID:       "project",
+			GoogleCredentialsFile: "key.json",
+			NATSURL:               "nats://localhost:4222",
+			NATSStream:            "events",
+		}, cfg)
+	})
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("memory is shared", func(t *testing.T) {
+		c := require.New(t)
+
+		first, err := New(ctx, Config{Driver: MemoryDriver})
+		c.NoError(err)
+
+		second, err := New(ctx, Config{Driver: MemoryDriver})
+		c.NoError(err)
+
+		c.Same(first, second)
+	})
+
+	t.Run("google without project", func(t *testing.T) {
+		_, err := New(ctx, Config{Driver: GoogleDriver})
+		require.ErrorIs(t, err, ErrMissingProjectID)
+	})
+
+	t.Run("nats without url", func(t *testing.T) {
+		_, err := New(ctx, Config{Driver: NATSDriver})
+		require.ErrorIs(t, err, ErrMissingNATSURL)
+	})
+
+	t.Run("unknown driver", func(t *testing.T) {
+		_, err := New(ctx, Config{Driver: "kafka"})
+		require.ErrorIs(t, err, ErrUnknownDriver)
+	})
+}
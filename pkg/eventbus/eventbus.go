@@ -0,0 +1,97 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrClosed is returned when the bus has been closed.
+	ErrClosed = errors.New("event bus closed")
+	// ErrMissingTopic is returned when a publish or subscribe call does not provide a topic.
+	ErrMissingTopic = errors.New("missing topic")
+	// ErrMissingSubscription is returned when a subscribe call does not provide a subscription name.
+	ErrMissingSubscription = errors.New("missing subscription")
+	// ErrAlreadySubscribed is returned when a subscription already has an active handler.
+	ErrAlreadySubscribed = errors.New("subscription already has an active handler")
+)
+
+// Handler processes a message received from a subscription.
+// Returning nil acknowledges the message, returning an error negatively acknowledges it
+// so the broker can redeliver it. Handlers may also call Ack or Nack explicitly.
+type Handler func(ctx context.Context, msg *Message) error
+
+// Bus defines a broker-agnostic publish/subscribe contract.
+type Bus interface {
+	// Publish sends a message to the given topic.
+	Publish(ctx context.Context, topic string, msg *Message) error
+	// Subscribe receives messages of a topic through a named subscription and blocks
+	// until the context is cancelled or the bus is closed.
+	Subscribe(ctx context.Context, topic string, subscription string, handler Handler) error
+	// Close releases the resources held by the bus.
+	Close() error
+}
+
+// Message is the unit of data exchanged through the bus.
+type Message struct {
+	ID          string
+	Data        []byte
+	OrderingKey string
+	Attributes  map[string]string
+	PublishedAt time.Time
+	Attempt     int
+
+	once   sync.Once
+	ackFn  func()
+	nackFn func()
+}
+
+// NewMessage creates a message with the given payload.
+func NewMessage(data []byte) *Message {
+	return &Message{
+		Data:       data,
+		Attributes: map[string]string{},
+	}
+}
+
+// LogProperties is the map to logger attibutes
+func (m *Message) LogProperties() map[string]string {
+	return map[string]string{
+		"message_id":   m.ID,
+		"ordering_key": m.OrderingKey,
+		"attempt":      strconv.Itoa(m.Attempt),
+		"published_at": m.PublishedAt.Local().String(),
+	}
+}
+
+// Ack acknowledges the message. Only the first call to Ack or Nack has effect.
+func (m *Message) Ack() {
+	m.once.Do(func() {
+		if m.ackFn != nil {
+			m.ackFn()
+		}
+	})
+}
+
+// Nack negatively acknowledges the message. Only the first call to Ack or Nack has effect.
+func (m *Message) Nack() {
+	m.once.Do(func() {
+		if m.nackFn != nil {
+			m.nackFn()
+		}
+	})
+}
+
+// handle runs the handler and settles the message based on its result.
+func handle(ctx context.Context, handler Handler, msg *Message) {
+	if err := handler(ctx, msg); err != nil {
+		msg.Nack()
+
+		return
+	}
+
+	msg.Ack()
+}
@@ -0,0 +1,116 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+
+	pubsub "cloud.google.com/go/pubsub/v2"
+	"google.golang.org/api/option"
+)
+
+type googleBus struct {
+	client     *pubsub.Client
+	mu         sync.Mutex
+	publishers map[string]*pubsub.Publisher
+}
+
+// NewGooglePubSubBus creates a bus backed by Google Cloud Pub/Sub.
+// Topics and subscriptions may be given as IDs or fully qualified names.
+func NewGooglePubSubBus(ctx context.Context, projectID string, opts ...option.ClientOption) (Bus, error) {
+	client, err := pubsub.NewClient(ctx, projectID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGoogleBus(client), nil
+}
+
+func newGoogleBus(client *pubsub.Client) *googleBus {
+	return &googleBus{
+		client:     client,
+		publishers: map[string]*pubsub.Publisher{},
+	}
+}
+
+// Publish publishes the message and waits for the server to acknowledge it.
+func (g *googleBus) Publish(ctx context.Context, topic string, msg *Message) error {
+	if topic == "" {
+		return ErrMissingTopic
+	}
+
+	publisher := g.publisher(topic)
+
+	id, err := publisher.Publish(ctx, &pubsub.Message{
+		Data:        msg.Data,
+		Attributes:  msg.Attributes,
+		OrderingKey: msg.OrderingKey,
+	}).Get(ctx)
+	if err != nil {
+		if msg.OrderingKey != "" {
+			publisher.ResumePublish(msg.OrderingKey)
+		}
+
+		return err
+	}
+
+	msg.ID = id
+
+	return nil
+}
+
+// Subscribe receives messages from an existing Pub/Sub subscription. The topic is bound
+// to the subscription on the Google side, so it is not used here.
+func (g *googleBus) Subscribe(ctx context.Context, _ string, subscription string, handler Handler) error {
+	if subscription == "" {
+		return ErrMissingSubscription
+	}
+
+	sub := g.client.Subscriber(subscription)
+
+	return sub.Receive(ctx, func(ctx context.Context, m *pubsub.Message) {
+		msg := &Message{
+			ID:          m.ID,
+			Data:        m.Data,
+			OrderingKey: m.OrderingKey,
+			Attributes:  m.Attributes,
+			PublishedAt: m.PublishTime,
+			Attempt:     1,
+			ackFn:       m.Ack,
+			nackFn:      m.Nack,
+		}
+
+		if m.DeliveryAttempt != nil {
+			msg.Attempt = *m.DeliveryAttempt
+		}
+
+		handle(ctx, handler, msg)
+	})
+}
+
+// Close flushes pending publications and closes the client.
+func (g *googleBus) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, publisher := range g.publishers {
+		publisher.Stop()
+	}
+
+	g.publishers = map[string]*pubsub.Publisher{}
+
+	return g.client.Close()
+}
+
+func (g *googleBus) publisher(topic string) *pubsub.Publisher {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	publisher, ok := g.publishers[topic]
+	if !ok {
+		publisher = g.client.Publisher(topic)
+		publisher.EnableMessageOrdering = true
+		g.publishers[topic] = publisher
+	}
+
+	return publisher
+}
@@ -0,0 +1,88 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"cloud.google.com/go/pubsub/v2/pstest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func setupGoogleBus(t *testing.T) Bus {
+	c := require.New(t)
+	ctx := context.Background()
+
+	srv := pstest.NewServer()
+	t.Cleanup(func() { srv.Close() })
+
+	_, err := srv.GServer.CreateTopic(ctx, &pubsubpb.Topic{Name: "projects/project/topics/topic"})
+	c.NoError(err)
+
+	_, err = srv.GServer.CreateSubscription(ctx, &pubsubpb.Subscription{
+		Name:                  "projects/project/subscriptions/sub",
+		Topic:                 "projects/project/topics/topic",
+		EnableMessageOrdering: true,
+	})
+	c.NoError(err)
+
+	conn, err := grpc.NewClient(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	c.NoError(err)
+
+	bus, err := NewGooglePubSubBus(ctx, "project", option.WithGRPCConn(conn))
+	c.NoError(err)
+
+	t.Cleanup(func() {
+		bus.Close()
+		conn.Close()
+	})
+
+	return bus
+}
+
+func TestGoogleBus_PublishSubscribe(t *testing.T) {
+	c := require.New(t)
+
+	bus := setupGoogleBus(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	msg := NewMessage([]byte("hello"))
+	msg.OrderingKey = "account-1"
+	msg.Attributes["type"] = "greeting"
+
+	c.NoError(bus.Publish(ctx, "topic", msg))
+	c.NotEmpty(msg.ID)
+
+	received := make(chan *Message, 1)
+
+	go bus.Subscribe(ctx, "topic", "sub", func(ctx context.Context, m *Message) error {
+		received <- m
+		return nil
+	})
+
+	select {
+	case got := <-received:
+		c.Equal("hello", string(got.Data))
+		c.Equal("account-1", got.OrderingKey)
+		c.Equal("greeting", got.Attributes["type"])
+		c.Equal(msg.ID, got.ID)
+	case <-ctx.Done():
+		c.Fail("message not received")
+	}
+}
+
+func TestGoogleBus_Errors(t *testing.T) {
+	c := require.New(t)
+
+	bus := setupGoogleBus(t)
+	ctx := context.Background()
+
+	c.ErrorIs(bus.Publish(ctx, "", NewMessage(nil)), ErrMissingTopic)
+	c.ErrorIs(bus.Subscribe(ctx, "topic", "", nil), ErrMissingSubscription)
+}
@@ -0,0 +1,95 @@
+package eventbus
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+// lanes dispatches messages to a fixed set of sequential workers. Messages sharing an
+// ordering key always land in the same lane, so they are handled one at a time and in
+// publish order, while messages without a key are spread across all lanes.
+type lanes struct {
+	queues []chan *Message
+	quit   chan struct{}
+	once   sync.Once
+	next   atomic.Uint32
+	wg     sync.WaitGroup
+}
+
+func newLanes(size int, buffer int, process func(msg *Message)) *lanes {
+	if size <= 0 {
+		size = 1
+	}
+
+	l := &lanes{queues: make([]chan *Message, size), quit: make(chan struct{})}
+
+	for i := range l.queues {
+		queue := make(chan *Message, buffer)
+		l.queues[i] = queue
+
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+
+			for {
+				select {
+				case msg := <-queue:
+					process(msg)
+				case <-l.quit:
+					for {
+						select {
+						case msg := <-queue:
+							process(msg)
+						default:
+							return
+						}
+					}
+				}
+			}
+		}()
+	}
+
+	return l
+}
+
+// dispatch enqueues the message in its lane, blocking while the lane is full. Once the lanes
+// are stopped messages are dropped, as they are for a topic without subscriptions. Queues are
+// never closed, so dispatching while stopping is safe.
+func (l *lanes) dispatch(ctx context.Context, msg *Message) error {
+	select {
+	case <-l.quit:
+		return nil
+	default:
+	}
+
+	select {
+	case l.queues[l.index(msg.OrderingKey)] <- msg:
+		return nil
+	case <-l.quit:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stop stops every lane and waits for the queued messages to be processed.
+func (l *lanes) stop() {
+	l.once.Do(func() {
+		close(l.quit)
+	})
+
+	l.wg.Wait()
+}
+
+func (l *lanes) index(orderingKey string) int {
+	if orderingKey == "" {
+		return int(l.next.Add(1)-1) % len(l.queues)
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(orderingKey))
+
+	return int(h.Sum32() % uint32(len(l.queues)))
+}
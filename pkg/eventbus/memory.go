@@ -0,0 +1,213 @@
+package eventbus
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultMemoryWorkers       = 4
+	defaultMemoryBufferSize    = 256
+	defaultMemoryMaxDeliveries = 5
+	defaultMemoryRetryDelay    = time.Second
+)
+
+// MemoryOptions configures the in-memory bus.
+type MemoryOptions struct {
+	// Workers is the number of concurrent lanes per subscription.
+	Workers int
+	// BufferSize is the number of messages each lane can hold before Publish blocks.
+	BufferSize int
+	// MaxDeliveries is the number of times a message is delivered before it is dropped.
+	MaxDeliveries int
+	// RetryDelay is the time to wait before redelivering a nacked message.
+	RetryDelay time.Duration
+}
+
+type memorySubscription struct {
+	handler Handler
+	lanes   *lanes
+}
+
+type memoryBus struct {
+	mu     sync.RWMutex
+	opts   MemoryOptions
+	topics map[string]map[string]*memorySubscription
+	closed bool
+	done   chan struct{}
+}
+
+// NewMemoryBus creates an in-process bus, useful for tests and single-binary deployments.
+// Messages published to a topic without active subscriptions are dropped.
+func NewMemoryBus(opts MemoryOptions) Bus {
+	if opts.Workers <= 0 {
+		opts.Workers = defaultMemoryWorkers
+	}
+
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = defaultMemoryBufferSize
+	}
+
+	if opts.MaxDeliveries <= 0 {
+		opts.MaxDeliveries = defaultMemoryMaxDeliveries
+	}
+
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = defaultMemoryRetryDelay
+	}
+
+	return &memoryBus{
+		opts:   opts,
+		topics: map[string]map[string]*memorySubscription{},
+		done:   make(chan struct{}),
+	}
+}
+
+// Publish delivers a copy of the message to every subscription of the topic. The lock is
+// released before dispatching, since a full lane blocks until its handler, which may publish
+// or unsubscribe, catches up.
+func (b *memoryBus) Publish(ctx context.Context, topic string, msg *Message) error {
+	if topic == "" {
+		return ErrMissingTopic
+	}
+
+	b.mu.RLock()
+
+	if b.closed {
+		b.mu.RUnlock()
+
+		return ErrClosed
+	}
+
+	subs := make([]*memorySubscription, 0, len(b.topics[topic]))
+	for _, sub := range b.topics[topic] {
+		subs = append(subs, sub)
+	}
+
+	b.mu.RUnlock()
+
+	if msg.ID == "" {
+		msg.ID = strings.ReplaceAll(uuid.New().String(), "-", "")
+	}
+
+	msg.PublishedAt = time.Now()
+
+	for _, sub := range subs {
+		err := sub.lanes.dispatch(ctx, &Message{
+			ID:          msg.ID,
+			Data:        msg.Data,
+			OrderingKey: msg.OrderingKey,
+			Attributes:  msg.Attributes,
+			PublishedAt: msg.PublishedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers the handler and blocks until the context is done or the bus is closed.
+func (b *memoryBus) Subscribe(ctx context.Context, topic string, subscription string, handler Handler) error {
+	if topic == "" {
+		return ErrMissingTopic
+	}
+
+	if subscription == "" {
+		return ErrMissingSubscription
+	}
+
+	b.mu.Lock()
+
+	if b.closed {
+		b.mu.Unlock()
+
+		return ErrClosed
+	}
+
+	subs, ok := b.topics[topic]
+	if !ok {
+		subs = map[string]*memorySubscription{}
+		b.topics[topic] = subs
+	}
+
+	if _, ok := subs[subscription]; ok {
+		b.mu.Unlock()
+
+		return ErrAlreadySubscribed
+	}
+
+	sub := &memorySubscription{handler: handler}
+	sub.lanes = newLanes(b.opts.Workers, b.opts.BufferSize, func(msg *Message) {
+		b.deliver(ctx, sub, msg)
+	})
+
+	subs[subscription] = sub
+
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+	case <-b.done:
+	}
+
+	b.mu.Lock()
+	delete(subs, subscription)
+	b.mu.Unlock()
+
+	sub.lanes.stop()
+
+	return nil
+}
+
+// deliver runs the handler until the message is acknowledged or the deliveries are exhausted.
+// Redeliveries happen in the same lane, so ordered messages keep their order.
+func (b *memoryBus) deliver(ctx context.Context, sub *memorySubscription, msg *Message) {
+	for attempt := 1; ; attempt++ {
+		acked := false
+
+		delivery := &Message{
+			ID:          msg.ID,
+			Data:        msg.Data,
+			OrderingKey: msg.OrderingKey,
+			Attributes:  msg.Attributes,
+			PublishedAt: msg.PublishedAt,
+			Attempt:     attempt,
+			ackFn:       func() { acked = true },
+		}
+
+		handle(ctx, sub.handler, delivery)
+
+		if acked || attempt >= b.opts.MaxDeliveries {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.done:
+			return
+		case <-time.After(b.opts.RetryDelay):
+		}
+	}
+}
+
+// Close stops every active subscription and rejects new publications.
+func (b *memoryBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+
+	b.closed = true
+	close(b.done)
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func subscribeAsync(ctx context.Context, bus Bus, topic string, subscription string, handler Handler) <-chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- bus.Subscribe(ctx, topic, subscription, handler)
+	}()
+
+	// Give the subscriber time to register before publishing.
+	time.Sleep(20 * time.Millisecond)
+
+	return done
+}
+
+func TestMemoryBus_PublishSubscribe(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan *Message, 1)
+
+	done := subscribeAsync(ctx, bus, "topic", "sub", func(ctx context.Context, msg *Message) error {
+		received <- msg
+		return nil
+	})
+
+	msg := NewMessage([]byte("hello"))
+	msg.Attributes["type"] = "greeting"
+
+	c.NoError(bus.Publish(ctx, "topic", msg))
+	c.NotEmpty(msg.ID)
+
+	select {
+	case got := <-received:
+		c.Equal("hello", string(got.Data))
+		c.Equal("greeting", got.Attributes["type"])
+		c.Equal(msg.ID, got.ID)
+		c.Equal(1, got.Attempt)
+	case <-time.After(time.Second):
+		c.Fail("message not received")
+	}
+
+	cancel()
+	c.NoError(<-done)
+}
+
+func TestMemoryBus_FanOutToSubscriptions(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	handler := func(ctx context.Context, msg *Message) error {
+		wg.Done()
+		return nil
+	}
+
+	subscribeAsync(ctx, bus, "topic", "sub-a", handler)
+	subscribeAsync(ctx, bus, "topic", "sub-b", handler)
+
+	c.NoError(bus.Publish(ctx, "topic", NewMessage([]byte("data"))))
+
+	waitOrFail(t, &wg)
+}
+
+func TestMemoryBus_NackRedelivers(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{RetryDelay: time.Millisecond, MaxDeliveries: 3})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := make(chan int, 3)
+
+	subscribeAsync(ctx, bus, "topic", "sub", func(ctx context.Context, msg *Message) error {
+		attempts <- msg.Attempt
+		if msg.Attempt < 2 {
+			return errors.New("temporary failure")
+		}
+
+		return nil
+	})
+
+	c.NoError(bus.Publish(ctx, "topic", NewMessage([]byte("data"))))
+
+	c.Equal(1, <-attempts)
+	c.Equal(2, <-attempts)
+
+	select {
+	case attempt := <-attempts:
+		c.Failf("unexpected redelivery", "attempt %d", attempt)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMemoryBus_DropsAfterMaxDeliveries(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{RetryDelay: time.Millisecond, MaxDeliveries: 2})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := make(chan int, 5)
+
+	subscribeAsync(ctx, bus, "topic", "sub", func(ctx context.Context, msg *Message) error {
+		attempts <- msg.Attempt
+		return errors.New("permanent failure")
+	})
+
+	c.NoError(bus.Publish(ctx, "topic", NewMessage([]byte("data"))))
+
+	time.Sleep(50 * time.Millisecond)
+	c.Len(attempts, 2)
+}
+
+func TestMemoryBus_OrderingKey(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{Workers: 8, RetryDelay: time.Millisecond})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	got := []string{}
+
+	var wg sync.WaitGroup
+	wg.Add(20)
+
+	failedOnce := false
+
+	subscribeAsync(ctx, bus, "topic", "sub", func(ctx context.Context, msg *Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// The first message fails once; ordering must hold across the redelivery.
+		if string(msg.Data) == "0" && !failedOnce {
+			failedOnce = true
+			return errors.New("retry")
+		}
+
+		got = append(got, string(msg.Data))
+		wg.Done()
+
+		return nil
+	})
+
+	for i := 0; i < 20; i++ {
+		msg := NewMessage([]byte(fmt.Sprintf("%d", i)))
+		msg.OrderingKey = "account-1"
+
+		c.NoError(bus.Publish(ctx, "topic", msg))
+	}
+
+	waitOrFail(t, &wg)
+
+	for i, data := range got {
+		c.Equal(fmt.Sprintf("%d", i), data)
+	}
+}
+
+func TestMemoryBus_UnsubscribeWhilePublishing(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{Workers: 1, BufferSize: 1})
+	defer bus.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan struct{})
+	handler := func(_ context.Context, msg *Message) error {
+		<-release
+
+		return bus.Publish(context.Background(), "other", NewMessage(nil))
+	}
+
+	done := subscribeAsync(ctx, bus, "topic", "sub", handler)
+
+	c.NoError(bus.Publish(context.Background(), "topic", NewMessage([]byte("handling"))))
+	time.Sleep(20 * time.Millisecond)
+	c.NoError(bus.Publish(context.Background(), "topic", NewMessage([]byte("queued"))))
+
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- bus.Publish(context.Background(), "topic", NewMessage([]byte("blocked")))
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	for _, ch := range []<-chan error{done, blocked} {
+		select {
+		case err := <-ch:
+			c.NoError(err)
+		case <-time.After(time.Second):
+			c.FailNow("bus deadlocked")
+		}
+	}
+}
+
+func TestMemoryBus_Errors(t *testing.T) {
+	c := require.New(t)
+
+	bus := NewMemoryBus(MemoryOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := func(ctx context.Context, msg *Message) error { return nil }
+
+	c.ErrorIs(bus.Publish(ctx, "", NewMessage(nil)), ErrMissingTopic)
+	c.ErrorIs(bus.Subscribe(ctx, "", "sub", handler), ErrMissingTopic)
+	c.ErrorIs(bus.Subscribe(ctx, "topic", "", handler), ErrMissingSubscription)
+
+	done := subscribeAsync(ctx, bus, "topic", "sub", handler)
+	c.ErrorIs(bus.Subscribe(ctx, "topic", "sub", handler), ErrAlreadySubscribed)
+
+	c.NoError(bus.Close())
+	c.NoError(<-done)
+	c.NoError(bus.Close())
+
+	c.ErrorIs(bus.Publish(ctx, "topic", NewMessage(nil)), ErrClosed)
+	c.ErrorIs(bus.Subscribe(ctx, "topic", "sub", handler), ErrClosed)
+}
+
+func TestMessage_AckNackOnce(t *testing.T) {
+	c := require.New(t)
+
+	acks, nacks := 0, 0
+	msg := &Message{
+		ackFn:  func() { acks++ },
+		nackFn: func() { nacks++ },
+	}
+
+	msg.Nack()
+	msg.Ack()
+	msg.Nack()
+
+	c.Equal(0, acks)
+	c.Equal(1, nacks)
+}
+
+func waitOrFail(t *testing.T, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for messages")
+	}
+}
@@ -0,0 +1,27 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBus is a mock of Bus
+type MockBus struct {
+	mock.Mock
+}
+
+func (m *MockBus) Publish(ctx context.Context, topic string, msg *Message) error {
+	args := m.Called(ctx, topic, msg)
+	return args.Error(0)
+}
+
+func (m *MockBus) Subscribe(ctx context.Context, topic string, subscription string, handler Handler) error {
+	args := m.Called(ctx, topic, subscription, handler)
+	return args.Error(0)
+}
+
+func (m *MockBus) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	natsOrderingKeyHeader = "Ordering-Key"
+	natsAttributePrefix   = "Attr-"
+
+	defaultNATSWorkers       = 4
+	defaultNATSMaxDeliveries = 5
+	defaultNATSRetryDelay    = time.Second
+)
+
+type natsBus struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	stream string
+}
+
+// NewNATSBus creates a bus backed by NATS JetStream. Every topic is mapped to the subject
+// "<stream>.<topic>" of a single stream, which is created when it does not exist.
+func NewNATSBus(ctx context.Context, url string, stream string) (Bus, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     stream,
+		Subjects: []string{stream + ".>"},
+	})
+	if err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	return &natsBus{conn: conn, js: js, stream: stream}, nil
+}
+
+// Publish stores the message in the stream. The message ID is used for deduplication.
+func (n *natsBus) Publish(ctx context.Context, topic string, msg *Message) error {
+	if topic == "" {
+		return ErrMissingTopic
+	}
+
+	if msg.ID == "" {
+		msg.ID = strings.ReplaceAll(uuid.New().String(), "-", "")
+	}
+
+	header := nats.Header{}
+	for key, value := range msg.Attributes {
+		header.Set(natsAttributePrefix+key, value)
+	}
+
+	if msg.OrderingKey != "" {
+		header.Set(natsOrderingKeyHeader, msg.OrderingKey)
+	}
+
+	_, err := n.js.PublishMsg(ctx, &nats.Msg{
+		Subject: n.subject(topic),
+		Data:    msg.Data,
+		Header:  header,
+	}, jetstream.WithMsgID(msg.ID))
+	if err != nil {
+		return err
+	}
+
+	msg.PublishedAt = time.Now()
+
+	return nil
+}
+
+// Subscribe binds a durable consumer named after the subscription and blocks until the
+// context is done. Messages sharing an ordering key are handled sequentially.
+func (n *natsBus) Subscribe(ctx context.Context, topic string, subscription string, handler Handler) error {
+	if topic == "" {
+		return ErrMissingTopic
+	}
+
+	if subscription == "" {
+		return ErrMissingSubscription
+	}
+
+	consumer, err := n.js.CreateOrUpdateConsumer(ctx, n.stream, jetstream.ConsumerConfig{
+		Durable:       subscription,
+		FilterSubject: n.subject(topic),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    defaultNATSMaxDeliveries,
+	})
+	if err != nil {
+		return err
+	}
+
+	l := newLanes(defaultNATSWorkers, 0, func(msg *Message) {
+		handle(ctx, handler, msg)
+	})
+
+	consumeCtx, err := consumer.Consume(func(m jetstream.Msg) {
+		_ = l.dispatch(ctx, toMessage(m))
+	})
+	if err != nil {
+		l.stop()
+
+		return err
+	}
+
+	<-ctx.Done()
+
+	consumeCtx.Stop()
+	l.stop()
+
+	return nil
+}
+
+// Close drains the connection.
+func (n *natsBus) Close() error {
+	return n.conn.Drain()
+}
+
+func (n *natsBus) subject(topic string) string {
+	return n.stream + "." + topic
+}
+
+func toMessage(m jetstream.Msg) *Message {
+	msg := &Message{
+		Data:       m.Data(),
+		Attributes: map[string]string{},
+		Attempt:    1,
+		ackFn: func() {
+			_ = m.Ack()
+		},
+		nackFn: func() {
+			_ = m.NakWithDelay(defaultNATSRetryDelay)
+		},
+	}
+
+	for key, values := range m.Headers() {
+		if len(values) == 0 {
+			continue
+		}
+
+		switch {
+		case key == jetstream.MsgIDHeader:
+			msg.ID = values[0]
+		case key == natsOrderingKeyHeader:
+			msg.OrderingKey = values[0]
+		case strings.HasPrefix(key, natsAttributePrefix):
+			msg.Attributes[strings.TrimPrefix(key, natsAttributePrefix)] = values[0]
+		}
+	}
+
+	metadata, err := m.Metadata()
+	if err == nil {
+		msg.Attempt = int(metadata.NumDelivered)
+		msg.PublishedAt = metadata.Timestamp
+
+		if msg.ID == "" {
+			msg.ID = strconv.FormatUint(metadata.Sequence.Stream, 10)
+		}
+	}
+
+	return msg
+}
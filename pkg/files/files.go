@@ -0,0 +1,150 @@
+package files
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// filesFolder is where the files of every account are kept, one folder per account.
+	filesFolder = "files"
+	filePerm    = 0600
+)
+
+var (
+	// ErrInvalidPath is returned when a path points outside of the storage.
+	ErrInvalidPath = errors.New("invalid file path")
+	// ErrFileNotFound is returned when a stored file does not exist.
+	ErrFileNotFound = errors.New("file not found")
+)
+
+// Storage keeps the files of the accounts, such as statements and receipts.
+type Storage interface {
+	Save(accountID string, folder string, fileName string, data []byte) (string, error)
+	Read(path string) ([]byte, error)
+	Delete(path string) error
+	DeleteFolder(accountID string, folder string) error
+}
+
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage creates a Storage that writes to root/files/<account>/<folder>. An empty
+// root is the working directory.
+func NewLocalStorage(root string) Storage {
+	return &localStorage{root: root}
+}
+
+func (s *localStorage) base() (string, error) {
+	root := s.root
+	if root == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", fmt.Errorf("error getting working directory: %w", err)
+		}
+
+		root = wd
+	}
+
+	return filepath.Join(root, filesFolder), nil
+}
+
+// Save writes data to fileName inside folder of the account and returns the path of the file.
+// The account and file name can't leave their folders.
+func (s *localStorage) Save(accountID string, folder string, fileName string, data []byte) (string, error) {
+	base, err := s.base()
+	if err != nil {
+		return "", err
+	}
+
+	folder = filepath.Clean(folder)
+	if filepath.IsAbs(folder) || folder == ".." || strings.HasPrefix(folder, ".."+string(filepath.Separator)) {
+		return "", ErrInvalidPath
+	}
+
+	dirPath := filepath.Join(base, filepath.Base(accountID), folder)
+
+	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
+		return "", fmt.Errorf("error creating directory: %w", err)
+	}
+
+	filePath := filepath.Join(dirPath, filepath.Base(fileName))
+
+	if err := os.WriteFile(filePath, data, filePerm); err != nil {
+		return "", fmt.Errorf("error writing file: %w", err)
+	}
+
+	return filePath, nil
+}
+
+// Read returns the content of a file saved in the storage.
+func (s *localStorage) Read(path string) ([]byte, error) {
+	path, err := s.inside(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrFileNotFound
+	}
+
+	return data, err
+}
+
+// Delete removes a file saved in the storage. Files already gone are not an error.
+func (s *localStorage) Delete(path string) error {
+	path, err := s.inside(path)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// DeleteFolder removes folder of the account with every file in it. Folders already gone are
+// not an error.
+func (s *localStorage) DeleteFolder(accountID string, folder string) error {
+	base, err := s.base()
+	if err != nil {
+		return err
+	}
+
+	account := filepath.Base(accountID)
+	if account == "." || account == ".." || account == string(filepath.Separator) {
+		return ErrInvalidPath
+	}
+
+	folder = filepath.Clean(folder)
+	if filepath.IsAbs(folder) || folder == "." || folder == ".." || strings.HasPrefix(folder, ".."+string(filepath.Separator)) {
+		return ErrInvalidPath
+	}
+
+	return os.RemoveAll(filepath.Join(base, account, folder))
+}
+
+// inside checks the path is a file of the storage.
+func (s *localStorage) inside(path string) (string, error) {
+	base, err := s.base()
+	if err != nil {
+		return "", err
+	}
+
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(base, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", ErrInvalidPath
+	}
+
+	return path, nil
+}
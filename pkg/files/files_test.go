@@ -0,0 +1,60 @@
+package files
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	c := require.New(t)
+
+	root := t.TempDir()
+	storage := NewLocalStorage(root)
+
+	path, err := storage.Save("acc1", "attachments/MID1", "ATT1.pdf", []byte("receipt"))
+	c.NoError(err)
+	c.Equal(filepath.Join(root, "files", "acc1", "attachments", "MID1", "ATT1.pdf"), path)
+
+	data, err := storage.Read(path)
+	c.NoError(err)
+	c.Equal([]byte("receipt"), data)
+
+	c.NoError(storage.Delete(path))
+	c.NoError(storage.Delete(path))
+
+	_, err = storage.Read(path)
+	c.ErrorIs(err, ErrFileNotFound)
+
+	path, err = storage.Save("acc1", "attachments/MID1", "ATT2.png", []byte("receipt"))
+	c.NoError(err)
+
+	c.NoError(storage.DeleteFolder("acc1", "attachments/MID1"))
+	c.NoError(storage.DeleteFolder("acc1", "attachments/MID1"))
+
+	_, err = storage.Read(path)
+	c.ErrorIs(err, ErrFileNotFound)
+}
+
+func TestLocalStorage_StaysInside(t *testing.T) {
+	c := require.New(t)
+
+	root := t.TempDir()
+	storage := NewLocalStorage(root)
+
+	path, err := storage.Save("../acc2", "extracts", "../../statement.pdf", []byte("statement"))
+	c.NoError(err)
+	c.Equal(filepath.Join(root, "files", "acc2", "extracts", "statement.pdf"), path)
+
+	_, err = storage.Save("acc1", "../acc2", "statement.pdf", []byte("statement"))
+	c.ErrorIs(err, ErrInvalidPath)
+
+	_, err = storage.Read(filepath.Join(root, "files", "..", "secrets"))
+	c.ErrorIs(err, ErrInvalidPath)
+
+	c.ErrorIs(storage.Delete("/etc/passwd"), ErrInvalidPath)
+	c.ErrorIs(storage.DeleteFolder("acc1", ".."), ErrInvalidPath)
+	c.ErrorIs(storage.DeleteFolder("acc1", "."), ErrInvalidPath)
+	c.ErrorIs(storage.DeleteFolder("", "attachments"), ErrInvalidPath)
+}
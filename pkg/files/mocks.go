@@ -0,0 +1,34 @@
+package files
+
+import (
+	"github.com/stretchr/testify/mock"
+)
+
+// MockStorage is a mock of the Storage interface.
+type MockStorage struct {
+	mock.Mock
+}
+
+func (m *MockStorage) Save(accountID string, folder string, fileName string, data []byte) (string, error) {
+	args := m.Called(accountID, folder, fileName, data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStorage) Read(path string) ([]byte, error) {
+	args := m.Called(path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockStorage) Delete(path string) error {
+	args := m.Called(path)
+	return args.Error(0)
+}
+
+func (m *MockStorage) DeleteFolder(accountID string, folder string) error {
+	args := m.Called(accountID, folder)
+	return args.Error(0)
+}
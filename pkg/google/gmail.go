@@ -5,12 +5,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"io/fs"
 	"net/http"
-	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
+	"transaction-tracker/pkg/files"
 
 	"google.golang.org/api/gmail/v1"
 	"google.golang.org/api/option"
@@ -41,11 +41,12 @@ var (
 	// ErrHistoryNotFounf is returned when history no found or not has messages
 	ErrHistoryNotFound = errors.New("history not found")
 
-	extractsFolder = "files/%s/extracts"
+	extractsFolder = "extracts"
 )
 
 type gmailService struct {
-	Client *gmail.Service
+	Client  *gmail.Service
+	storage files.Storage
 }
 
 // NewGmailClient creates a new GmailAPI with the provided http.Client.
@@ -56,7 +57,8 @@ func NewGmailClient(ctx context.Context, client *http.Client) (GmailAPI, error)
 	}
 
 	return &gmailService{
-		Client: service,
+		Client:  service,
+		storage: files.NewLocalStorage(""),
 	}, nil
 }
 
@@ -126,28 +128,9 @@ func (g *gmailService) getAttachmentData(ctx context.Context, messageID string,
 	return data, nil
 }
 
-func (g *gmailService) saveAttachment(accountID, fileName string, data []byte, perm fs.FileMode) (string, error) {
-	// Sanitize accountID to prevent path traversal.
-	safeAccountID := filepath.Clean(filepath.Base(accountID))
-
-	exePath, err := os.Getwd()
-	if err != nil {
-		return "", fmt.Errorf("error getting executable path: %w", err)
-	}
-
-	dirPath := filepath.Join(exePath, fmt.Sprintf(extractsFolder, safeAccountID), fmt.Sprintf("%d", time.Now().Year()))
-
-	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
-		return "", fmt.Errorf("error creating directory: %w", err)
-	}
-
-	filePath := filepath.Join(dirPath, fileName)
-
-	if err := os.WriteFile(filePath, data, perm); err != nil {
-		return "", fmt.Errorf("error writing file: %w", err)
-	}
-
-	return filePath, nil
+// saveAttachment stores a statement in the extracts folder of the account for the current year.
+func (g *gmailService) saveAttachment(accountID, fileName string, data []byte) (string, error) {
+	return g.storage.Save(accountID, filepath.Join(extractsFolder, strconv.Itoa(time.Now().Year())), fileName, data)
 }
 
 // DownloadAttachments downloads attachments from a specific message and saves them to the filesystem.
@@ -164,7 +147,7 @@ func (g *gmailService) DownloadAttachments(ctx context.Context, accountID string
 				return 0, 0, "", err
 			}
 
-			filePath, err := g.saveAttachment(accountID, part.Filename, data, 0600)
+			filePath, err := g.saveAttachment(accountID, part.Filename, data)
 			if err != nil {
 				return 0, 0, "", err
 			}
@@ -19,6 +19,7 @@ var (
 	extractTextFromPDF = documentextractor.ExtractTextFromPDF
 	movementRegex      = regexp.MustCompile(`(?m)^(\d{2}\s+\d{2})\s+\$\s*([\d,]+\.\d{2})([+-])\s+(\d{4})\s+(.+)$`)
 	yearRegex          = regexp.MustCompile(`INFORME DEL MES:.*?/(\d{4})`)
+	closingRegex       = regexp.MustCompile(`Nuevo Saldo\s+\$\s*(-?[\d,]+\.\d{2})`)
 	regex              = regexp.MustCompile(
 		`Fecha\s*:\s*(?P<fecha>[0-9]{4}[/-][0-9]{2}[/-][0-9]{2})\s*Hora\s*:\s*(?P<hora>[0-9:]+)\s*Valor\s+Transacci(?:ón|on)\s*:\s*\$?\s*(?P<valor>[0-9.,]+)\s*Clase\s+de\s+Movimiento\s*:\s*(?P<clase>[^,.;\n]+)[,.;\s]*Lugar\s+de\s+Transacci(?:ón|on)\s*:\s*(?P<lugar>.+)`)
 )
@@ -203,6 +204,8 @@ func cleanAndNormalizeText(text string) string {
 	return strings.Join(cleanedLines, "\n")
 }
 
+// GetMovements reads the movements of the statement. It also sets the institution and the
+// closing balance of the extract, so the balance can be anchored once it is processed.
 func (d *davivienda) GetMovements() ([]*movementDomain.Movement, error) {
 	if d.extract == nil || d.extract.Path == "" || d.password == "" {
 		return nil, fmt.Errorf("missing extract, path or password")
@@ -218,6 +221,14 @@ func (d *davivienda) GetMovements() ([]*movementDomain.Movement, error) {
 		return nil, err
 	}
 
+	closingBalance, err := parseClosingBalance(text)
+	if err != nil {
+		return nil, err
+	}
+
+	d.extract.InstitutionID = institutionID
+	d.extract.ClosingBalance = closingBalance
+
 	matches := movementRegex.FindAllStringSubmatch(text, -1)
 	movements := make([]*movementDomain.Movement, 0, len(matches))
 
@@ -251,6 +262,20 @@ func parseYear(text string) (int64, error) {
 	return year, nil
 }
 
+func parseClosingBalance(text string) (*float64, error) {
+	match := closingRegex.FindStringSubmatch(text)
+	if len(match) < 2 {
+		return nil, nil
+	}
+
+	balance, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing closing balance: %w", err)
+	}
+
+	return &balance, nil
+}
+
 func parseMovement(m []string, year int64, accountID, messageID, extractID string) (*movementDomain.Movement, error) {
 	dayAndMonth := strings.Split(m[1], " ")
 	date, err := time.Parse("2006-01-02", fmt.Sprintf("%d-%s-%s", year, dayAndMonth[1], dayAndMonth[0]))
@@ -111,3 +111,25 @@ BANCO DAVIVIENDA`,
 	c.NoError(err)
 	c.Equal(float64(700000), movements[0].Amount)
 }
+
+func TestDavivienda_GetMovements_ClosingBalance(t *testing.T) {
+	c := require.New(t)
+
+	extractTextFromPDF = func(_ string, _ string) (string, error) {
+		return pdfText, nil
+	}
+
+	extract := &extractDomain.Extract{ID: "EXI1", AccountID: "acc1", Path: "/tmp/extract.pdf"}
+	davivienda := &davivienda{extract: extract, password: "password"}
+
+	movements, err := davivienda.GetMovements()
+	c.NoError(err)
+	c.Len(movements, 4)
+	c.Equal(institutionID, extract.InstitutionID)
+	c.NotNil(extract.ClosingBalance)
+	c.Equal(947232.64, *extract.ClosingBalance)
+
+	balance, err := parseClosingBalance("Saldo Anterior $0.00")
+	c.NoError(err)
+	c.Nil(balance)
+}
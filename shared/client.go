@@ -1,7 +1,100 @@
 package shared
 
-import "net/http"
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+)
 
 var (
-	Client = http.Client{}
+	// Client is the HTTP client shared by every outgoing call. Callers that need a tighter
+	// deadline should set it on the request context.
+	Client = http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   5 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 20 * time.Second,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   10,
+		},
+	}
+
+	// PublicClient sends requests to URLs given by users, as webhooks are. Outside
+	// development it refuses to connect to addresses that are not public. The address is
+	// checked when dialing, once the host is resolved, so a host name that resolves to the
+	// local network, even after it was validated, is refused too. Proxies are not used since
+	// the check would apply to the proxy instead of the target.
+	PublicClient = http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   5 * time.Second,
+				KeepAlive: 30 * time.Second,
+				Control:   publicOnly,
+			}).DialContext,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: 20 * time.Second,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConns:          100,
+			MaxIdleConnsPerHost:   10,
+		},
+	}
+
+	// ErrPrivateAddress is returned when PublicClient is asked to connect to an address that
+	// is not public.
+	ErrPrivateAddress = errors.New("address is not public")
+
+	// sharedAddressSpace is the carrier-grade NAT range, 100.64.0.0/10.
+	sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
 )
+
+// Development reports whether APP_ENV is development. URLs given by users can then use plain
+// http and point to the local network, so a local receiver can be used.
+func Development() bool {
+	return os.Getenv("APP_ENV") == "development"
+}
+
+// IsPublicAddr reports whether the address is reachable on the internet: it is not a
+// loopback, private, link-local, shared, multicast or unspecified one.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// publicOnly rejects the connections to addresses that are not public outside development.
+func publicOnly(network string, address string, _ syscall.RawConn) error {
+	if Development() {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, address)
+	}
+
+	if !IsPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, addrPort.Addr())
+	}
+
+	return nil
+}
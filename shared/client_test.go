@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	c := require.New(t)
+
+	for _, raw := range []string{"127.0.0.1", "10.0.0.8", "172.16.4.1", "192.168.1.1", "169.254.169.254", "100.64.0.1", "0.0.0.0", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1"} {
+		c.False(IsPublicAddr(netip.MustParseAddr(raw)), raw)
+	}
+
+	for _, raw := range []string{"8.8.8.8", "190.24.10.5", "2800:3f0:4005::200e"} {
+		c.True(IsPublicAddr(netip.MustParseAddr(raw)), raw)
+	}
+}
+
+func TestPublicClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	t.Run("refuses the local network", func(t *testing.T) {
+		t.Setenv("APP_ENV", "")
+
+		_, err := PublicClient.Get(server.URL)
+		require.ErrorIs(t, err, ErrPrivateAddress)
+	})
+
+	t.Run("allows it in development", func(t *testing.T) {
+		t.Setenv("APP_ENV", "development")
+
+		resp, err := PublicClient.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
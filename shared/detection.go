@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"context"
+	"time"
+	"transaction-tracker/logger"
+	loggerModels "transaction-tracker/logger/models"
+)
+
+// RunPerAccount calls detect for every account returned by accountIDs right away and then
+// every interval, until the context is done. A failing account is logged and does not stop
+// the others. name tells the detections apart in the logs.
+func RunPerAccount(ctx context.Context, log *loggerModels.Logger, name string, interval time.Duration, accountIDs func(ctx context.Context) ([]string, error), detect func(ctx context.Context, accountID string) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ids, err := accountIDs(ctx)
+		if err != nil {
+			log.Error(loggerModels.LogProperties{
+				Event: "get_detection_accounts_failed",
+				Error: err,
+				AdditionalParams: []loggerModels.Properties{
+					logger.MapToProperties(map[string]string{
+						"detection": name,
+					}),
+				},
+			})
+		}
+
+		for _, accountID := range ids {
+			err := detect(ctx, accountID)
+			if err != nil {
+				log.Error(loggerModels.LogProperties{
+					Event: "detection_failed",
+					Error: err,
+					AdditionalParams: []loggerModels.Properties{
+						logger.MapToProperties(map[string]string{
+							"detection":  name,
+							"account_id": accountID,
+						}),
+					},
+				})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	loggerModels "transaction-tracker/logger/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingLogService struct {
+	events []string
+}
+
+func (s *recordingLogService) Log(_ string, properties loggerModels.LogProperties) {
+	s.events = append(s.events, properties.Event)
+}
+
+func (s *recordingLogService) SetService(string) {}
+
+func TestRunPerAccount(t *testing.T) {
+	c := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	service := &recordingLogService{}
+	detected := []string{}
+
+	accountIDs := func(context.Context) ([]string, error) {
+		return []string{"acc1", "acc2"}, nil
+	}
+
+	detect := func(_ context.Context, accountID string) error {
+		detected = append(detected, accountID)
+		if len(detected) == 2 {
+			cancel()
+		}
+
+		if accountID == "acc1" {
+			return errors.New("db down")
+		}
+
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		RunPerAccount(ctx, &loggerModels.Logger{Service: service}, "transfers", time.Hour, accountIDs, detect)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("detection did not stop with the context")
+	}
+
+	c.Equal([]string{"acc1", "acc2"}, detected, "a failing account does not stop the others")
+	c.Equal([]string{"detection_failed"}, service.events)
+}
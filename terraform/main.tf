@@ -79,3 +79,58 @@ resource "google_pubsub_subscription_iam_member" "sub_reader" {
   role         = "roles/pubsub.subscriber"
   member       = "serviceAccount:${google_service_account.app_sa.email}"
 }
+
+# Domain events relayed from the outbox
+resource "google_pubsub_topic" "domain_events" {
+  name = "domain-events"
+
+  message_retention_duration = "86400s"
+}
+
+resource "google_pubsub_topic_iam_member" "domain_events_publisher" {
+  topic  = google_pubsub_topic.domain_events.name
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:${google_service_account.app_sa.email}"
+}
+
+# Outgoing webhooks consume movement and extract events
+resource "google_pubsub_subscription" "webhooks_subscription" {
+  name  = "webhooks"
+  topic = google_pubsub_topic.domain_events.name
+
+  ack_deadline_seconds    = 60
+  enable_message_ordering = true
+}
+
+resource "google_pubsub_subscription_iam_member" "webhooks_viewer" {
+  subscription = google_pubsub_subscription.webhooks_subscription.name
+  role         = "roles/pubsub.viewer"
+  member       = "serviceAccount:${google_service_account.app_sa.email}"
+}
+
+resource "google_pubsub_subscription_iam_member" "webhooks_reader" {
+  subscription = google_pubsub_subscription.webhooks_subscription.name
+  role         = "roles/pubsub.subscriber"
+  member       = "serviceAccount:${google_service_account.app_sa.email}"
+}
+
+# Live movement streams of the API
+resource "google_pubsub_subscription" "movements_stream_subscription" {
+  name  = "movements-stream"
+  topic = google_pubsub_topic.domain_events.name
+
+  ack_deadline_seconds    = 10
+  enable_message_ordering = true
+}
+
+resource "google_pubsub_subscription_iam_member" "movements_stream_viewer" {
+  subscription = google_pubsub_subscription.movements_stream_subscription.name
+  role         = "roles/pubsub.viewer"
+  member       = "serviceAccount:${google_service_account.app_sa.email}"
+}
+
+resource "google_pubsub_subscription_iam_member" "movements_stream_reader" {
+  subscription = google_pubsub_subscription.movements_stream_subscription.name
+  role         = "roles/pubsub.subscriber"
+  member       = "serviceAccount:${google_service_account.app_sa.email}"
+}
@@ -25,6 +25,18 @@ class ClassificationOut(BaseModel):
     confidence: float
 
 
+class BatchTextIn(BaseModel):
+    """Input schema for batch text classification."""
+
+    descriptions: list[str]
+
+
+class BatchClassificationOut(BaseModel):
+    """Output schema for batch classification, one result per description."""
+
+    results: list[ClassificationOut]
+
+
 # --- FastAPI App Initialization ---
 app = FastAPI(
     title="Text Classification API",
@@ -85,6 +97,37 @@ def classify_text(input_data: TextIn):
     return ClassificationOut(category=label, confidence=confidence)
 
 
+@app.post(
+    "/classify/batch",
+    response_model=BatchClassificationOut,
+    summary="Classify many text descriptions",
+    description="Predicts the category of each description in a single pass of the model. Results keep the order of the input.",
+)
+def classify_batch(input_data: BatchTextIn):
+    """
+    Classifies every input description.
+    """
+    if not input_data.descriptions:
+        return BatchClassificationOut(results=[])
+
+    emb = embedder.encode(input_data.descriptions, convert_to_numpy=True)
+    probs = clf.predict_proba(emb)
+
+    results = []
+    for row in probs:
+        idx = int(np.argmax(row))
+        confidence = float(row[idx])
+
+        if confidence < CONFIDENCE_THRESHOLD:
+            results.append(ClassificationOut(category="unknown", confidence=confidence))
+            continue
+
+        label = le.inverse_transform([idx])[0]
+        results.append(ClassificationOut(category=label, confidence=confidence))
+
+    return BatchClassificationOut(results=results)
+
+
 if __name__ == "__main__":
     # Note: uvicorn is imported at the top now for cleaner structure
     # The host is usually '127.0.0.1' or 'localhost' for local development,